@@ -27,6 +27,7 @@ func printInfo() {
 	printTuple(fmat, "Configuration", config.K9sConfigFile, color.Cyan)
 	printTuple(fmat, "Logs", config.K9sLogs, color.Cyan)
 	printTuple(fmat, "Screen Dumps", config.K9sDumpDir, color.Cyan)
+	printTuple(fmat, "Audit Log", config.K9sAuditLog, color.Cyan)
 }
 
 func printLogo(c color.Paint) {
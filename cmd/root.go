@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
@@ -97,6 +98,10 @@ func run(cmd *cobra.Command, args []string) {
 func loadConfiguration() *config.Config {
 	log.Info().Msg("🐶 K9s starting up...")
 
+	if k9sFlags.Snapshot != nil && *k9sFlags.Snapshot != "" {
+		return loadSnapshot(*k9sFlags.Snapshot)
+	}
+
 	// Load K9s config file...
 	k8sCfg := client.NewConfig(k8sFlags)
 	k9sCfg := config.NewConfig(k8sCfg)
@@ -105,26 +110,16 @@ func loadConfiguration() *config.Config {
 		log.Warn().Msg("Unable to locate K9s config. Generating new configuration...")
 	}
 
-	if *k9sFlags.RefreshRate != config.DefaultRefreshRate {
-		k9sCfg.K9s.OverrideRefreshRate(*k9sFlags.RefreshRate)
-	}
-
-	if k9sFlags.Headless != nil {
-		k9sCfg.K9s.OverrideHeadless(*k9sFlags.Headless)
-	}
-
-	if k9sFlags.ReadOnly != nil {
-		k9sCfg.K9s.OverrideReadOnly(*k9sFlags.ReadOnly)
-	}
-
-	if k9sFlags.Command != nil {
-		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
-	}
+	overrideK9sFlags(k9sCfg)
 
 	if isBoolSet(k9sFlags.AllNamespaces) && k9sCfg.SetActiveNamespace(client.AllNamespaces) != nil {
 		log.Error().Msg("Setting active namespace")
 	}
 
+	if k8sFlags.KubeConfig == nil || *k8sFlags.KubeConfig == "" {
+		client.MergeKubeConfigDirs(k9sCfg.K9s.KubeConfigDirs)
+	}
+
 	if err := k9sCfg.Refine(k8sFlags); err != nil {
 		log.Panic().Err(err)
 	}
@@ -142,6 +137,58 @@ func loadConfiguration() *config.Config {
 	return k9sCfg
 }
 
+// loadSnapshot configures K9s to browse a cluster snapshot in readonly mode.
+// The k9s configuration is read but never saved for snapshot sessions.
+func loadSnapshot(path string) *config.Config {
+	snap, err := client.LoadSnapshot(path)
+	if err != nil {
+		log.Panic().Err(err).Msg("Snapshot load")
+	}
+	conn := client.NewSnapshotConnection(snap)
+	k9sCfg := config.NewConfig(conn.Config())
+	if err := k9sCfg.Load(config.K9sConfigFile); err != nil {
+		log.Warn().Msg("Unable to locate K9s config. Using defaults...")
+	}
+
+	overrideK9sFlags(k9sCfg)
+	k9sCfg.K9s.OverrideReadOnly(true)
+	k9sCfg.K9s.OverrideSnapshot(path)
+	k9sCfg.K9s.CurrentContext, k9sCfg.K9s.CurrentCluster = snap.Context, snap.Cluster
+	ns := snap.Namespace
+	if isBoolSet(k9sFlags.AllNamespaces) {
+		ns = client.AllNamespaces
+	}
+	if ns != "" && k9sCfg.SetActiveNamespace(ns) != nil {
+		log.Error().Msg("Setting active namespace")
+	}
+	k9sCfg.SetConnection(conn)
+	log.Info().Msgf("📸 Browsing snapshot %s taken %s", path, snap.CreatedAt.Format(time.RFC3339))
+
+	return k9sCfg
+}
+
+func overrideK9sFlags(k9sCfg *config.Config) {
+	if *k9sFlags.RefreshRate != config.DefaultRefreshRate {
+		k9sCfg.K9s.OverrideRefreshRate(*k9sFlags.RefreshRate)
+	}
+
+	if k9sFlags.Headless != nil {
+		k9sCfg.K9s.OverrideHeadless(*k9sFlags.Headless)
+	}
+
+	if k9sFlags.ReadOnly != nil {
+		k9sCfg.K9s.OverrideReadOnly(*k9sFlags.ReadOnly)
+	}
+
+	if k9sFlags.Command != nil {
+		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
+	}
+
+	if k9sFlags.Source != nil && *k9sFlags.Source != "" {
+		k9sCfg.K9s.OverrideSource(*k9sFlags.Source)
+	}
+}
+
 func isBoolSet(b *bool) bool {
 	return b != nil && *b
 }
@@ -199,6 +246,18 @@ func initK9sFlags() {
 		false,
 		"Disable all commands that modify the cluster",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Source,
+		"source",
+		config.DefaultSource,
+		"Specify a script file of K9s commands to run when the application launches",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		config.DefaultSnapshot,
+		"Browse a cluster snapshot taken with the snapshot command instead of a live cluster",
+	)
 }
 
 func initK8sFlags() {
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
 )
 
 const (
@@ -26,14 +29,17 @@ type Config struct {
 	currentContext string
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
+	readOnly       bool
+	apiCalls       *APICalls
 	mutex          *sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
-		flags: f,
-		mutex: &sync.RWMutex{},
+		flags:    f,
+		apiCalls: NewAPICalls(),
+		mutex:    &sync.RWMutex{},
 	}
 }
 
@@ -42,6 +48,27 @@ func (c *Config) Flags() *genericclioptions.ConfigFlags {
 	return c.flags
 }
 
+// APICalls returns the api server calls tracer.
+func (c *Config) APICalls() *APICalls {
+	return c.apiCalls
+}
+
+// SetReadOnly toggles whether mutating calls are allowed on this connection.
+func (c *Config) SetReadOnly(b bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.readOnly = b
+}
+
+// IsReadOnly returns true if mutating calls are disallowed.
+func (c *Config) IsReadOnly() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.readOnly
+}
+
 // SwitchContext changes the kubeconfig context to a new cluster.
 func (c *Config) SwitchContext(name string) error {
 	currentCtx, err := c.CurrentContextName()
@@ -60,6 +87,24 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ForContext returns a new configuration bound to a given kubeconfig context.
+func (c *Config) ForContext(name string) (*Config, error) {
+	if _, err := c.GetContext(name); err != nil {
+		return nil, fmt.Errorf("context %s does not exist", name)
+	}
+
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.CacheDir, flags.KubeConfig = c.flags.CacheDir, c.flags.KubeConfig
+	flags.Insecure, flags.Timeout = c.flags.Insecure, c.flags.Timeout
+	flags.Impersonate, flags.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
+	flags.Context = &name
+
+	cfg := NewConfig(flags)
+	cfg.currentContext, cfg.readOnly = name, c.IsReadOnly()
+
+	return cfg, nil
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -100,6 +145,40 @@ func (c *Config) Contexts() (map[string]*clientcmdapi.Context, error) {
 	return cfg.Contexts, nil
 }
 
+// CheckContext checks if the api server of a given context is reachable.
+func (c *Config) CheckContext(name string, timeout time.Duration) error {
+	_, err := c.PingContext(name, timeout)
+
+	return err
+}
+
+// PingContext returns the api server version of a given context or an error if unreachable.
+func (c *Config) PingContext(name string, timeout time.Duration) (string, error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := cfg.Contexts[name]; !ok {
+		return "", fmt.Errorf("context %s does not exist", name)
+	}
+
+	rest, err := clientcmd.NewNonInteractiveClientConfig(cfg, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return "", err
+	}
+	rest.Timeout = timeout
+	dial, err := kubernetes.NewForConfig(rest)
+	if err != nil {
+		return "", err
+	}
+	info, err := dial.Discovery().ServerVersion()
+	if err != nil {
+		return "", err
+	}
+
+	return info.GitVersion, nil
+}
+
 // DelContext remove a given context from the configuration.
 func (c *Config) DelContext(n string) error {
 	cfg, err := c.RawConfig()
@@ -310,6 +389,7 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	}
 	c.restConfig.QPS = defaultQPS
 	c.restConfig.Burst = defaultBurst
+	c.restConfig.WrapTransport = transport.Wrappers(c.restConfig.WrapTransport, c.apiCalls.Wrap)
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
 
 	return c.restConfig, nil
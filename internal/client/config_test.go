@@ -128,6 +128,27 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigForContext(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	cfg.SetReadOnly(true)
+
+	other, err := cfg.ForContext("blee")
+	assert.Nil(t, err)
+	ctx, err := other.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+	assert.True(t, other.IsReadOnly())
+	assert.Equal(t, kubeConfig, *other.Flags().KubeConfig)
+
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.NotEqual(t, "blee", ctx)
+
+	_, err = cfg.ForContext("bozo")
+	assert.Equal(t, errors.New("context bozo does not exist"), err)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// MergeKubeConfigDirs appends all kubeconfig files located in the given
+// directories to the KUBECONFIG list so their contexts are merged with the
+// default ones.
+func MergeKubeConfigDirs(dirs []string) {
+	if len(dirs) == 0 {
+		return
+	}
+	paths := KubeConfigPaths(os.Getenv(clientcmd.RecommendedConfigPathEnvVar), dirs)
+	if err := os.Setenv(clientcmd.RecommendedConfigPathEnvVar, strings.Join(paths, string(os.PathListSeparator))); err != nil {
+		log.Error().Err(err).Msg("Unable to merge kubeconfig files")
+	}
+}
+
+// KubeConfigPaths returns the kubeconfig files to load given a KUBECONFIG list
+// and a collection of directories. The KUBECONFIG list or the default
+// kubeconfig file takes precedence over files found in directories.
+func KubeConfigPaths(env string, dirs []string) []string {
+	var paths []string
+	seen := make(map[string]struct{})
+	add := func(p string) {
+		if p == "" {
+			return
+		}
+		if _, ok := seen[p]; ok {
+			return
+		}
+		seen[p] = struct{}{}
+		paths = append(paths, p)
+	}
+
+	if env != "" {
+		for _, p := range filepath.SplitList(env) {
+			add(p)
+		}
+	} else if _, err := os.Stat(clientcmd.RecommendedHomeFile); err == nil {
+		add(clientcmd.RecommendedHomeFile)
+	}
+
+	for _, dir := range dirs {
+		for _, f := range kubeConfigFiles(expandHome(dir)) {
+			add(f)
+		}
+	}
+
+	return paths
+}
+
+func kubeConfigFiles(dir string) []string {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to read kubeconfig dir %s", dir)
+		return nil
+	}
+
+	ss := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		ss = append(ss, filepath.Join(dir, f.Name()))
+	}
+	sort.Strings(ss)
+
+	return ss
+}
+
+func expandHome(p string) string {
+	if !strings.HasPrefix(p, "~") {
+		return p
+	}
+	return filepath.Join(mustHomeDir(), strings.TrimPrefix(p, "~"))
+}
@@ -0,0 +1,28 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubeConfigPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfigs")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	for _, f := range []string{"prod.yml", "dev.yml", ".hidden"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, f), []byte{}, 0600))
+	}
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "sub"), 0700))
+
+	env := "/tmp/c1" + string(os.PathListSeparator) + filepath.Join(dir, "prod.yml")
+	assert.Equal(t, []string{
+		"/tmp/c1",
+		filepath.Join(dir, "prod.yml"),
+		filepath.Join(dir, "dev.yml"),
+	}, client.KubeConfigPaths(env, []string{dir, "/nowhere"}))
+}
@@ -37,6 +37,9 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
+	// History tracks recent nodes and pods resource usage.
+	History *MetricsHistory
+
 	cache *cache.LRUExpireCache
 }
 
@@ -44,6 +47,7 @@ type MetricsServer struct {
 func NewMetricsServer(c Connection) *MetricsServer {
 	return &MetricsServer{
 		Connection: c,
+		History:    NewMetricsHistory(MetricsWindow),
 		cache:      cache.NewLRUExpireCache(mxCacheSize),
 	}
 }
@@ -155,6 +159,13 @@ func (m *MetricsServer) FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error) {
 		return mx, err
 	}
 	m.cache.Add(key, mxList, mxCacheExpiry)
+	for _, mx := range mxList.Items {
+		m.History.Add(HistoryKey("v1/nodes", mx.Name), MetricsSample{
+			Timestamp: mx.Timestamp.Time,
+			CPU:       mx.Usage.Cpu().MilliValue(),
+			MEM:       ToMB(mx.Usage.Memory().Value()),
+		})
+	}
 
 	return mxList, nil
 }
@@ -189,6 +200,9 @@ func (m *MetricsServer) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, e
 		return mx, err
 	}
 	m.cache.Add(key, mxList, mxCacheExpiry)
+	for i := range mxList.Items {
+		m.recordPod(&mxList.Items[i])
+	}
 
 	return mxList, err
 }
@@ -226,6 +240,7 @@ func (m *MetricsServer) FetchPodMetrics(fqn string) (*mv1beta1.PodMetrics, error
 		return mx, err
 	}
 	m.cache.Add(key, mx, mxCacheExpiry)
+	m.recordPod(mx)
 
 	return mx, nil
 }
@@ -250,6 +265,16 @@ func (m *MetricsServer) PodsMetrics(pods *mv1beta1.PodMetricsList, mmx PodsMetri
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func (m *MetricsServer) recordPod(mx *mv1beta1.PodMetrics) {
+	var s MetricsSample
+	for _, c := range mx.Containers {
+		s.CPU += c.Usage.Cpu().MilliValue()
+		s.MEM += ToMB(c.Usage.Memory().Value())
+	}
+	s.Timestamp = mx.Timestamp.Time
+	m.History.Add(HistoryKey("v1/pods", FQN(mx.Namespace, mx.Name)), s)
+}
+
 const megaByte = 1024 * 1024
 
 // ToMB converts bytes to megabytes.
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsWindow tracks how long resource usage samples are kept around.
+var MetricsWindow = 15 * time.Minute
+
+// MetricsSample represents a resource usage sample.
+type MetricsSample struct {
+	Timestamp time.Time
+	// CPU tracks cpu usage in millicores.
+	CPU int64
+	// MEM tracks memory usage in MB.
+	MEM int64
+}
+
+// MetricsHistory tracks a rolling window of resource usage samples.
+type MetricsHistory struct {
+	window  time.Duration
+	samples map[string][]MetricsSample
+	mx      sync.RWMutex
+}
+
+// NewMetricsHistory returns a new instance.
+func NewMetricsHistory(window time.Duration) *MetricsHistory {
+	return &MetricsHistory{
+		window:  window,
+		samples: make(map[string][]MetricsSample),
+	}
+}
+
+// Window returns the history time window.
+func (h *MetricsHistory) Window() time.Duration {
+	return h.window
+}
+
+// Add records a sample for a given resource unless already known.
+func (h *MetricsHistory) Add(key string, s MetricsSample) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss := h.samples[key]
+	if len(ss) > 0 && !s.Timestamp.After(ss[len(ss)-1].Timestamp) {
+		return
+	}
+	ss = append(ss, s)
+	cutoff := s.Timestamp.Add(-h.window)
+	var i int
+	for i < len(ss) && ss[i].Timestamp.Before(cutoff) {
+		i++
+	}
+	h.samples[key] = ss[i:]
+}
+
+// Samples returns a resource usage samples, oldest first.
+func (h *MetricsHistory) Samples(key string) []MetricsSample {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	ss := make([]MetricsSample, len(h.samples[key]))
+	copy(ss, h.samples[key])
+
+	return ss
+}
+
+// HistoryKey returns a resource metrics history key.
+func HistoryKey(gvr, fqn string) string {
+	return gvr + ":" + fqn
+}
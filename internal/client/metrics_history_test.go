@@ -0,0 +1,29 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHistoryAdd(t *testing.T) {
+	h := client.NewMetricsHistory(2 * time.Minute)
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	h.Add("v1/pods:default/p1", client.MetricsSample{Timestamp: t0, CPU: 10, MEM: 1})
+	h.Add("v1/pods:default/p1", client.MetricsSample{Timestamp: t0, CPU: 20, MEM: 2})
+	h.Add("v1/pods:default/p1", client.MetricsSample{Timestamp: t0.Add(time.Minute), CPU: 30, MEM: 3})
+	h.Add("v1/pods:default/p1", client.MetricsSample{Timestamp: t0.Add(3 * time.Minute), CPU: 40, MEM: 4})
+
+	ss := h.Samples("v1/pods:default/p1")
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, int64(30), ss[0].CPU)
+	assert.Equal(t, int64(40), ss[1].CPU)
+	assert.Equal(t, 0, len(h.Samples("v1/pods:default/p2")))
+}
+
+func TestHistoryKey(t *testing.T) {
+	assert.Equal(t, "v1/nodes:n1", client.HistoryKey("v1/nodes", "n1"))
+}
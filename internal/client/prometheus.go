@@ -0,0 +1,231 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	promTimeout     = 5 * time.Second
+	promCacheExpiry = 10 * time.Second
+	promSteps       = 60
+
+	// PromNamespace is substituted with the active namespace in PromQL queries.
+	PromNamespace = "$NAMESPACE"
+)
+
+var (
+	proms   = make(map[string]*Prometheus)
+	promsMx sync.Mutex
+)
+
+// PromQuery represents a PromQL backed metric column.
+type PromQuery struct {
+	Name  string `yaml:"name"`
+	Query string `yaml:"query"`
+	// Label names the series label holding the resource name. Defaults to pod.
+	Label string `yaml:"label,omitempty"`
+}
+
+// PromSample represents a PromQL series sample.
+type PromSample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// PromSeries represents a PromQL series over a time range.
+type PromSeries struct {
+	Labels map[string]string
+	Values []float64
+}
+
+// Prometheus represents a Prometheus datasource.
+type Prometheus struct {
+	url    string
+	client *http.Client
+	cache  *cache.LRUExpireCache
+}
+
+// PrometheusFor returns a Prometheus datasource for a given url.
+func PrometheusFor(u string) *Prometheus {
+	promsMx.Lock()
+	defer promsMx.Unlock()
+
+	if p, ok := proms[u]; ok {
+		return p
+	}
+	p := NewPrometheus(u)
+	proms[u] = p
+
+	return p
+}
+
+// NewPrometheus returns a new Prometheus datasource.
+func NewPrometheus(u string) *Prometheus {
+	return &Prometheus{
+		url:    strings.TrimSuffix(u, "/"),
+		client: &http.Client{Timeout: promTimeout},
+		cache:  cache.NewLRUExpireCache(mxCacheSize),
+	}
+}
+
+// Values returns a query results keyed by resource fully qualified name.
+func (p *Prometheus) Values(q PromQuery, ns string) (map[string]float64, error) {
+	ss, err := p.Query(promQL(q.Query, ns))
+	if err != nil {
+		return nil, err
+	}
+
+	vv := make(map[string]float64, len(ss))
+	for _, s := range ss {
+		vv[promFQN(q, s.Labels)] = s.Value
+	}
+
+	return vv, nil
+}
+
+// Series returns a query results over a time window keyed by resource fully qualified name.
+func (p *Prometheus) Series(q PromQuery, ns string, window time.Duration) (map[string][]float64, error) {
+	end := time.Now()
+	step := window / promSteps
+	if step < time.Second {
+		step = time.Second
+	}
+	ss, err := p.QueryRange(promQL(q.Query, ns), end.Add(-window), end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	vv := make(map[string][]float64, len(ss))
+	for _, s := range ss {
+		vv[promFQN(q, s.Labels)] = s.Values
+	}
+
+	return vv, nil
+}
+
+// Query runs an instant PromQL query.
+func (p *Prometheus) Query(q string) ([]PromSample, error) {
+	if entry, ok := p.cache.Get(q); ok {
+		if ss, ok := entry.([]PromSample); ok {
+			return ss, nil
+		}
+	}
+
+	var res promResult
+	if err := p.get("/api/v1/query", url.Values{"query": {q}}, &res); err != nil {
+		return nil, err
+	}
+	ss := make([]PromSample, 0, len(res.Data.Result))
+	for _, r := range res.Data.Result {
+		v, err := promValue(r.Value)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, PromSample{Labels: r.Metric, Value: v})
+	}
+	p.cache.Add(q, ss, promCacheExpiry)
+
+	return ss, nil
+}
+
+// QueryRange runs a PromQL query over a time range.
+func (p *Prometheus) QueryRange(q string, start, end time.Time, step time.Duration) ([]PromSeries, error) {
+	params := url.Values{
+		"query": {q},
+		"start": {strconv.FormatInt(start.Unix(), 10)},
+		"end":   {strconv.FormatInt(end.Unix(), 10)},
+		"step":  {strconv.Itoa(int(step.Seconds()))},
+	}
+	var res promResult
+	if err := p.get("/api/v1/query_range", params, &res); err != nil {
+		return nil, err
+	}
+
+	ss := make([]PromSeries, 0, len(res.Data.Result))
+	for _, r := range res.Data.Result {
+		s := PromSeries{Labels: r.Metric, Values: make([]float64, 0, len(r.Values))}
+		for _, raw := range r.Values {
+			v, err := promValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			s.Values = append(s.Values, v)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+func (p *Prometheus) get(path string, params url.Values, res *promResult) error {
+	resp, err := p.client.Get(p.url + path + "?" + params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("invalid prometheus response: %v", err)
+	}
+	if res.Status != "success" {
+		return fmt.Errorf("prometheus query failed: %s", res.Error)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type promResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// promFQN returns the resource fully qualified name a series refers to.
+func promFQN(q PromQuery, ll map[string]string) string {
+	l := q.Label
+	if l == "" {
+		l = "pod"
+	}
+
+	return FQN(ll["namespace"], ll[l])
+}
+
+// promQL substitutes the active namespace in a query.
+func promQL(q, ns string) string {
+	if IsAllNamespaces(ns) || IsClusterScoped(ns) {
+		ns = ".*"
+	}
+
+	return strings.Replace(q, PromNamespace, ns, -1)
+}
+
+// promValue extracts a sample value from a [timestamp, "value"] pair.
+func promValue(raw []interface{}) (float64, error) {
+	if len(raw) != 2 {
+		return 0, fmt.Errorf("invalid prometheus sample %v", raw)
+	}
+	s, ok := raw[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid prometheus sample value %v", raw[1])
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusValues(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("query"))
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[
+{"metric":{"namespace":"default","pod":"p1"},"value":[1580000000,"0.25"]},
+{"metric":{"namespace":"kube-system","pod":"p2"},"value":[1580000000,"12"]}]}}`)
+	}))
+	defer srv.Close()
+
+	p := NewPrometheus(srv.URL + "/")
+	q := PromQuery{Name: "RPS", Query: `sum(rate(http_requests_total{namespace=~"$NAMESPACE"}[1m])) by (namespace,pod)`}
+	vv, err := p.Values(q, "default")
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]float64{"default/p1": 0.25, "kube-system/p2": 12}, vv)
+	assert.Equal(t, []string{`sum(rate(http_requests_total{namespace=~"default"}[1m])) by (namespace,pod)`}, queries)
+
+	_, err = p.Values(q, "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+}
+
+func TestPrometheusQueryRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query_range", r.URL.Path)
+		assert.Equal(t, "60", r.URL.Query().Get("step"))
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+{"metric":{"node":"n1"},"values":[[1580000000,"1"],[1580000060,"2.5"]]}]}}`)
+	}))
+	defer srv.Close()
+
+	p := NewPrometheus(srv.URL)
+	now := time.Now()
+	ss, err := p.QueryRange("node_load1", now.Add(-time.Hour), now, time.Minute)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []PromSeries{{Labels: map[string]string{"node": "n1"}, Values: []float64{1, 2.5}}}, ss)
+}
+
+func TestPrometheusQueryFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+	}))
+	defer srv.Close()
+
+	_, err := NewPrometheus(srv.URL).Query("sum(")
+	assert.Equal(t, "prometheus query failed: parse error", err.Error())
+}
+
+func TestPromQL(t *testing.T) {
+	uu := map[string]struct {
+		ns, e string
+	}{
+		"ns":      {ns: "fred", e: `up{namespace=~"fred"}`},
+		"all":     {ns: NamespaceAll, e: `up{namespace=~".*"}`},
+		"cluster": {ns: ClusterScope, e: `up{namespace=~".*"}`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, promQL(`up{namespace=~"$NAMESPACE"}`, u.ns))
+		})
+	}
+}
+
+func TestPrometheusSeries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "15", r.URL.Query().Get("step"))
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+{"metric":{"namespace":"default","pod":"p1"},"values":[[1580000000,"1"],[1580000015,"3"]]}]}}`)
+	}))
+	defer srv.Close()
+
+	vv, err := NewPrometheus(srv.URL).Series(PromQuery{Query: "up"}, "default", 15*time.Minute)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]float64{"default/p1": {1, 3}}, vv)
+}
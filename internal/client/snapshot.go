@@ -0,0 +1,281 @@
+package client
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// snapshotHost tracks an unreachable api server for snapshot sessions.
+const snapshotHost = "https://snapshot.invalid"
+
+// ErrSnapshot indicates an operation requiring a live cluster.
+var ErrSnapshot = errors.New("not available in snapshot mode")
+
+// Snapshot represents a cluster resources dump.
+type Snapshot struct {
+	Context   string                                  `json:"context"`
+	Cluster   string                                  `json:"cluster"`
+	User      string                                  `json:"user"`
+	Namespace string                                  `json:"namespace,omitempty"`
+	Version   *version.Info                           `json:"version,omitempty"`
+	CreatedAt time.Time                               `json:"createdAt"`
+	Resources []*metav1.APIResourceList               `json:"resources"`
+	Objects   map[string][]*unstructured.Unstructured `json:"objects"`
+}
+
+// SaveSnapshot writes a gzipped snapshot to a given path.
+func SaveSnapshot(path string, s *Snapshot) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing snapshot %s", path)
+		}
+	}()
+
+	w := gzip.NewWriter(f)
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// LoadSnapshot reads a gzipped snapshot from a given path.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %v", path, err)
+	}
+	defer r.Close()
+
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %v", path, err)
+	}
+	if s.Context == "" {
+		return nil, fmt.Errorf("invalid snapshot %s: no context", path)
+	}
+
+	return &s, nil
+}
+
+var _ Connection = (*SnapshotConnection)(nil)
+
+// SnapshotConnection serves resources from a snapshot instead of an api server.
+// Only read access is granted.
+type SnapshotConnection struct {
+	snapshot *Snapshot
+	config   *Config
+	dial     kubernetes.Interface
+	dynDial  dynamic.Interface
+}
+
+// NewSnapshotConnection returns a new connection for a given snapshot.
+func NewSnapshotConnection(s *Snapshot) *SnapshotConnection {
+	return &SnapshotConnection{
+		snapshot: s,
+		config:   NewSnapshotConfig(s),
+		dial:     snapshotDial(s),
+		dynDial:  snapshotDynDial(s),
+	}
+}
+
+// NewSnapshotConfig returns a configuration bound to a snapshot context.
+// The local kubeconfig is ignored so nothing ever reaches a live cluster.
+func NewSnapshotConfig(s *Snapshot) *Config {
+	kubeConfig := os.DevNull
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = &kubeConfig
+	flags.Context, flags.ClusterName, flags.AuthInfoName = &s.Context, &s.Cluster, &s.User
+	if s.Namespace != "" {
+		flags.Namespace = &s.Namespace
+	}
+
+	raw := clientcmdapi.NewConfig()
+	raw.CurrentContext = s.Context
+	raw.Clusters[s.Cluster] = &clientcmdapi.Cluster{Server: snapshotHost}
+	raw.AuthInfos[s.User] = clientcmdapi.NewAuthInfo()
+	raw.Contexts[s.Context] = &clientcmdapi.Context{Cluster: s.Cluster, AuthInfo: s.User, Namespace: s.Namespace}
+
+	cfg := NewConfig(flags)
+	cfg.clientConfig = clientcmd.NewDefaultClientConfig(*raw, &clientcmd.ConfigOverrides{})
+	cfg.restConfig = &restclient.Config{Host: snapshotHost}
+	cfg.currentContext, cfg.readOnly = s.Context, true
+
+	return cfg
+}
+
+// Snapshot returns the connection snapshot.
+func (s *SnapshotConnection) Snapshot() *Snapshot {
+	return s.snapshot
+}
+
+// CanI only grants read access.
+func (s *SnapshotConnection) CanI(_, _ string, verbs []string) (bool, error) {
+	for _, v := range verbs {
+		switch v {
+		case GetVerb, ListVerb, WatchVerb:
+		default:
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Config returns the snapshot configuration.
+func (s *SnapshotConnection) Config() *Config {
+	return s.config
+}
+
+// DialOrDie returns a client serving the snapshot resources.
+func (s *SnapshotConnection) DialOrDie() kubernetes.Interface {
+	return s.dial
+}
+
+// SwitchContext is not supported on snapshots.
+func (s *SnapshotConnection) SwitchContext(string) error {
+	return ErrSnapshot
+}
+
+// CachedDiscoveryOrDie returns no discovery client. Use ServerPreferredResources instead.
+func (s *SnapshotConnection) CachedDiscoveryOrDie() *disk.CachedDiscoveryClient {
+	return nil
+}
+
+// RestConfigOrDie returns a configuration to an unreachable api server.
+func (s *SnapshotConnection) RestConfigOrDie() *restclient.Config {
+	return s.config.restConfig
+}
+
+// MXDial is not supported on snapshots.
+func (s *SnapshotConnection) MXDial() (*versioned.Clientset, error) {
+	return nil, ErrSnapshot
+}
+
+// DynDialOrDie returns a dynamic client serving the snapshot resources.
+func (s *SnapshotConnection) DynDialOrDie() dynamic.Interface {
+	return s.dynDial
+}
+
+// HasMetrics returns false as snapshots carry no metrics.
+func (s *SnapshotConnection) HasMetrics() bool {
+	return false
+}
+
+// ValidNamespaces returns the snapshot namespaces.
+func (s *SnapshotConnection) ValidNamespaces() ([]v1.Namespace, error) {
+	oo := s.snapshot.Objects["v1/namespaces"]
+	nns := make([]v1.Namespace, 0, len(oo))
+	for _, o := range oo {
+		var ns v1.Namespace
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &ns); err != nil {
+			return nil, err
+		}
+		nns = append(nns, ns)
+	}
+
+	return nns, nil
+}
+
+// ServerVersion returns the snapshot cluster version.
+func (s *SnapshotConnection) ServerVersion() (*version.Info, error) {
+	if s.snapshot.Version == nil {
+		return nil, ErrSnapshot
+	}
+
+	return s.snapshot.Version, nil
+}
+
+// CheckConnectivity always succeeds on snapshots.
+func (s *SnapshotConnection) CheckConnectivity() bool {
+	return true
+}
+
+// ServerPreferredResources returns the snapshot api resources.
+func (s *SnapshotConnection) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return s.snapshot.Resources, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// snapshotDynDial serves the snapshot resources keyed by their exact gvr.
+func snapshotDynDial(s *Snapshot) dynamic.Interface {
+	sch := runtime.NewScheme()
+	dial := dynfake.NewSimpleDynamicClient(sch)
+	tracker := k8stesting.NewObjectTracker(sch, serializer.NewCodecFactory(sch).UniversalDecoder())
+	for gvr, oo := range s.Objects {
+		res := NewGVR(gvr).GVR()
+		for _, o := range oo {
+			if err := tracker.Create(res, o, o.GetNamespace()); err != nil {
+				log.Warn().Err(err).Msgf("Snapshot skipping %s %s", gvr, FQN(o.GetNamespace(), o.GetName()))
+			}
+		}
+	}
+	dial.PrependReactor("*", "*", k8stesting.ObjectReaction(tracker))
+	dial.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := tracker.Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		return true, w, nil
+	})
+
+	return dial
+}
+
+// snapshotDial serves the snapshot resources the typed client knows about.
+func snapshotDial(s *Snapshot) kubernetes.Interface {
+	dial := fake.NewSimpleClientset()
+	for _, oo := range s.Objects {
+		for _, o := range oo {
+			typed, err := scheme.Scheme.New(o.GroupVersionKind())
+			if err != nil {
+				continue
+			}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, typed); err != nil {
+				continue
+			}
+			if err := dial.Tracker().Add(typed); err != nil {
+				log.Debug().Err(err).Msgf("Snapshot skipping typed %s", FQN(o.GetNamespace(), o.GetName()))
+			}
+		}
+	}
+
+	return dial
+}
@@ -0,0 +1,132 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSnapshotSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snap.json.gz")
+	assert.Nil(t, client.SaveSnapshot(path, makeSnapshot()))
+
+	s, err := client.LoadSnapshot(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", s.Context)
+	assert.Equal(t, "zorg", s.Cluster)
+	assert.Equal(t, 1, len(s.Resources))
+	assert.Equal(t, 1, len(s.Objects["v1/pods"]))
+	assert.Equal(t, "p1", s.Objects["v1/pods"][0].GetName())
+}
+
+func TestSnapshotLoadInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snap.json.gz")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("blee"), 0600))
+
+	_, err = client.LoadSnapshot(path)
+	assert.NotNil(t, err)
+}
+
+func TestSnapshotConnectionCanI(t *testing.T) {
+	uu := map[string]struct {
+		verbs []string
+		e     bool
+	}{
+		"read":   {verbs: []string{"get", "list", "watch"}, e: true},
+		"delete": {verbs: []string{"delete"}},
+		"mixed":  {verbs: []string{"get", "patch"}},
+	}
+
+	conn := client.NewSnapshotConnection(makeSnapshot())
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := conn.CanI("default", "v1/pods", u.verbs)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestSnapshotConnectionDial(t *testing.T) {
+	conn := client.NewSnapshotConnection(makeSnapshot())
+
+	oo, err := conn.DynDialOrDie().Resource(client.NewGVR("v1/pods").GVR()).Namespace("ns1").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo.Items))
+
+	po, err := conn.DialOrDie().CoreV1().Pods("ns1").Get("p1", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "p1", po.Name)
+
+	nns, err := conn.ValidNamespaces()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(nns))
+	assert.Equal(t, "ns1", nns[0].Name)
+
+	assert.Equal(t, client.ErrSnapshot, conn.SwitchContext("blee"))
+	assert.False(t, conn.HasMetrics())
+}
+
+func TestSnapshotConfig(t *testing.T) {
+	cfg := client.NewSnapshotConnection(makeSnapshot()).Config()
+
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ctx)
+
+	cl, err := cfg.CurrentClusterName()
+	assert.Nil(t, err)
+	assert.Equal(t, "zorg", cl)
+}
+
+// Helpers...
+
+func makeSnapshot() *client.Snapshot {
+	return &client.Snapshot{
+		Context:   "fred",
+		Cluster:   "zorg",
+		User:      "blee",
+		Namespace: "ns1",
+		CreatedAt: time.Now(),
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+				},
+			},
+		},
+		Objects: map[string][]*unstructured.Unstructured{
+			"v1/pods": {
+				{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Pod",
+					"metadata":   map[string]interface{}{"name": "p1", "namespace": "ns1"},
+				}},
+			},
+			"v1/namespaces": {
+				{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Namespace",
+					"metadata":   map[string]interface{}{"name": "ns1"},
+				}},
+			},
+		},
+	}
+}
@@ -0,0 +1,251 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxAPICalls caps the number of traced api calls.
+const maxAPICalls = 500
+
+// APICall represents a traced api server request.
+type APICall struct {
+	ID        int64
+	Verb      string
+	GVR       string
+	Namespace string
+	Name      string
+	Path      string
+	Status    int
+	Bytes     int64
+	Latency   time.Duration
+	Slow      bool
+	At        time.Time
+}
+
+// APICallListener represents an api calls listener.
+type APICallListener interface {
+	// SlowAPICall notifies an api call exceeded the slow threshold.
+	SlowAPICall(APICall)
+}
+
+// APICalls traces the latest api server calls in a ring buffer.
+type APICalls struct {
+	calls     []APICall
+	next      int
+	seq       int64
+	slow      int64
+	listeners []APICallListener
+	mx        sync.RWMutex
+}
+
+// NewAPICalls returns a new api calls tracer.
+func NewAPICalls() *APICalls {
+	return &APICalls{calls: make([]APICall, 0, maxAPICalls)}
+}
+
+// SetSlowThreshold sets the latency above which calls are flagged slow.
+// A zero threshold disables slow calls detection.
+func (a *APICalls) SetSlowThreshold(d time.Duration) {
+	atomic.StoreInt64(&a.slow, int64(d))
+}
+
+// AddListener registers an api calls listener.
+func (a *APICalls) AddListener(l APICallListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.listeners = append(a.listeners, l)
+}
+
+// RemoveListener unregisters an api calls listener.
+func (a *APICalls) RemoveListener(l APICallListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	for i, lis := range a.listeners {
+		if lis == l {
+			a.listeners = append(a.listeners[:i:i], a.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// Record traces an api call, overwriting the oldest one when full.
+func (a *APICalls) Record(c APICall) {
+	slow := time.Duration(atomic.LoadInt64(&a.slow))
+	c.Slow = slow > 0 && c.Latency >= slow
+
+	a.mx.Lock()
+	a.seq++
+	c.ID = a.seq
+	if len(a.calls) < maxAPICalls {
+		a.calls = append(a.calls, c)
+	} else {
+		a.calls[a.next] = c
+	}
+	a.next = (a.next + 1) % maxAPICalls
+	ll := a.listeners
+	a.mx.Unlock()
+
+	log.Debug().
+		Str("verb", c.Verb).
+		Str("gvr", c.GVR).
+		Str("ns", c.Namespace).
+		Str("name", c.Name).
+		Int("status", c.Status).
+		Int64("bytes", c.Bytes).
+		Dur("latency", c.Latency).
+		Bool("slow", c.Slow).
+		Msg("API call")
+
+	if !c.Slow {
+		return
+	}
+	for _, l := range ll {
+		l.SlowAPICall(c)
+	}
+}
+
+// List returns the traced api calls, latest first.
+func (a *APICalls) List() []APICall {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	cc := make([]APICall, 0, len(a.calls))
+	for i := 1; i <= len(a.calls); i++ {
+		cc = append(cc, a.calls[(a.next-i+len(a.calls))%len(a.calls)])
+	}
+
+	return cc
+}
+
+// Wrap traces the requests going through a given transport.
+func (a *APICalls) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &traceTripper{calls: a, rt: rt}
+}
+
+// traceTripper traces api requests.
+type traceTripper struct {
+	calls *APICalls
+	rt    http.RoundTripper
+}
+
+// RoundTrip executes and traces a request. Watches are long lived and skipped.
+func (t *traceTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Query().Get("watch") == "true" {
+		return t.rt.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	c := APICall{
+		Path:    req.URL.Path,
+		Latency: time.Since(start),
+		At:      start,
+	}
+	c.Verb, c.GVR, c.Namespace, c.Name = parseAPIPath(req.Method, req.URL)
+	if resp == nil || resp.Body == nil {
+		t.calls.Record(c)
+		return resp, err
+	}
+	c.Status = resp.StatusCode
+	resp.Body = &tracedBody{ReadCloser: resp.Body, done: func(n int64) {
+		c.Bytes = n
+		t.calls.Record(c)
+	}}
+
+	return resp, err
+}
+
+// tracedBody counts a response body bytes.
+type tracedBody struct {
+	io.ReadCloser
+
+	bytes int64
+	done  func(int64)
+	once  sync.Once
+}
+
+// Read reads the response body.
+func (b *tracedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytes += int64(n)
+	if err == io.EOF {
+		b.finish()
+	}
+
+	return n, err
+}
+
+// Close closes the response body.
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.finish()
+
+	return err
+}
+
+func (b *tracedBody) finish() {
+	b.once.Do(func() {
+		b.done(b.bytes)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseAPIPath extracts a request verb and resource from an api server url,
+// ie /apis/apps/v1/namespaces/fred/deployments/blee.
+func parseAPIPath(method string, u *url.URL) (verb, gvr, ns, name string) {
+	tokens := strings.Split(strings.Trim(u.Path, "/"), "/")
+	var rest []string
+	switch {
+	case len(tokens) >= 3 && tokens[0] == "api":
+		gvr, rest = tokens[1], tokens[2:]
+	case len(tokens) >= 4 && tokens[0] == "apis":
+		gvr, rest = tokens[1]+"/"+tokens[2], tokens[3:]
+	default:
+		return strings.ToLower(method), u.Path, "", ""
+	}
+
+	if len(rest) >= 3 && rest[0] == "namespaces" && rest[2] != "status" && rest[2] != "finalize" {
+		ns, rest = rest[1], rest[2:]
+	}
+	gvr += "/" + rest[0]
+	if len(rest) > 1 {
+		name = strings.Join(rest[1:], "/")
+	}
+
+	return toVerb(method, name), gvr, ns, name
+}
+
+func toVerb(method, name string) string {
+	switch method {
+	case http.MethodGet:
+		if name == "" {
+			return ListVerb
+		}
+		return GetVerb
+	case http.MethodPost:
+		return CreateVerb
+	case http.MethodPut:
+		return UpdateVerb
+	case http.MethodPatch:
+		return PatchVerb
+	case http.MethodDelete:
+		if name == "" {
+			return "deletecollection"
+		}
+		return DeleteVerb
+	default:
+		return strings.ToLower(method)
+	}
+}
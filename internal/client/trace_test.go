@@ -0,0 +1,129 @@
+package client_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPICallsRecord(t *testing.T) {
+	a := client.NewAPICalls()
+	for i := 0; i < 510; i++ {
+		a.Record(client.APICall{GVR: "v1/pods", Latency: time.Duration(i)})
+	}
+
+	cc := a.List()
+	assert.Equal(t, 500, len(cc))
+	assert.Equal(t, int64(510), cc[0].ID)
+	assert.Equal(t, time.Duration(509), cc[0].Latency)
+	assert.Equal(t, int64(11), cc[499].ID)
+}
+
+type slowListener struct {
+	calls []client.APICall
+}
+
+func (s *slowListener) SlowAPICall(c client.APICall) {
+	s.calls = append(s.calls, c)
+}
+
+func TestAPICallsSlow(t *testing.T) {
+	a := client.NewAPICalls()
+	var l slowListener
+	a.AddListener(&l)
+
+	a.Record(client.APICall{GVR: "v1/pods", Latency: 2 * time.Second})
+	a.SetSlowThreshold(time.Second)
+	a.Record(client.APICall{GVR: "v1/nodes", Latency: 500 * time.Millisecond})
+	a.Record(client.APICall{GVR: "v1/events", Latency: 2 * time.Second})
+
+	assert.Equal(t, 1, len(l.calls))
+	assert.Equal(t, "v1/events", l.calls[0].GVR)
+	assert.True(t, a.List()[0].Slow)
+
+	a.RemoveListener(&l)
+	a.Record(client.APICall{GVR: "v1/events", Latency: 2 * time.Second})
+	assert.Equal(t, 1, len(l.calls))
+}
+
+func TestAPICallsWrap(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte("blee"))
+	}))
+	defer srv.Close()
+
+	uu := map[string]struct {
+		method, path        string
+		verb, gvr, ns, name string
+		status              int
+	}{
+		"list": {
+			method: http.MethodGet, path: "/api/v1/pods",
+			verb: "list", gvr: "v1/pods", status: http.StatusOK,
+		},
+		"get": {
+			method: http.MethodGet, path: "/apis/apps/v1/namespaces/fred/deployments/blee",
+			verb: "get", gvr: "apps/v1/deployments", ns: "fred", name: "blee", status: http.StatusOK,
+		},
+		"subresource": {
+			method: http.MethodGet, path: "/api/v1/namespaces/fred/pods/p1/log",
+			verb: "get", gvr: "v1/pods", ns: "fred", name: "p1/log", status: http.StatusOK,
+		},
+		"namespace": {
+			method: http.MethodPut, path: "/api/v1/namespaces/fred/finalize",
+			verb: "update", gvr: "v1/namespaces", name: "fred/finalize", status: http.StatusOK,
+		},
+		"delete": {
+			method: http.MethodDelete, path: "/api/v1/namespaces/fred/pods/p1",
+			verb: "delete", gvr: "v1/pods", ns: "fred", name: "p1", status: http.StatusNotFound,
+		},
+		"discovery": {
+			method: http.MethodGet, path: "/apis/apps/v1",
+			verb: "get", gvr: "/apis/apps/v1", status: http.StatusOK,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := client.NewAPICalls()
+			c := http.Client{Transport: a.Wrap(http.DefaultTransport)}
+			req, err := http.NewRequest(u.method, srv.URL+u.path, nil)
+			assert.Nil(t, err)
+			resp, err := c.Do(req)
+			assert.Nil(t, err)
+			_, _ = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			cc := a.List()
+			assert.Equal(t, 1, len(cc))
+			assert.Equal(t, u.verb, cc[0].Verb)
+			assert.Equal(t, u.gvr, cc[0].GVR)
+			assert.Equal(t, u.ns, cc[0].Namespace)
+			assert.Equal(t, u.name, cc[0].Name)
+			assert.Equal(t, u.status, cc[0].Status)
+			assert.Equal(t, int64(4), cc[0].Bytes)
+		})
+	}
+}
+
+func TestAPICallsWrapSkipsWatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	a := client.NewAPICalls()
+	c := http.Client{Transport: a.Wrap(http.DefaultTransport)}
+	resp, err := c.Get(srv.URL + "/api/v1/pods?watch=true")
+	assert.Nil(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, 0, len(a.List()))
+}
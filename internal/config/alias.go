@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/rs/zerolog/log"
@@ -18,6 +20,44 @@ type Alias map[string]string
 // ShortNames represents a collection of shortnames for aliases.
 type ShortNames map[string][]string
 
+// AliasArgs represents an alias expanded to a resource and its default parameters.
+type AliasArgs struct {
+	GVR        string
+	Namespace  string
+	Filter     string
+	SortColumn string
+	SortAsc    bool
+}
+
+// ParseAliasArgs expands an alias command of the form
+// `<resource> [namespace] [sort:<column>[:desc]] [/<filter>]`.
+func ParseAliasArgs(cmd string) AliasArgs {
+	var args AliasArgs
+	if i := strings.Index(cmd, " /"); i != -1 {
+		args.Filter, cmd = strings.TrimSpace(cmd[i+2:]), cmd[:i]
+	}
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 0 {
+		return args
+	}
+	args.GVR = tokens[0]
+	for _, t := range tokens[1:] {
+		if !strings.HasPrefix(t, "sort:") {
+			args.Namespace = t
+			continue
+		}
+		ss := strings.Split(strings.TrimPrefix(t, "sort:"), ":")
+		args.SortColumn, args.SortAsc = strings.ToUpper(ss[0]), len(ss) < 2 || ss[1] != "desc"
+	}
+
+	return args
+}
+
+// HasParams checks if the alias carries default parameters.
+func (a AliasArgs) HasParams() bool {
+	return a.Namespace != "" || a.Filter != "" || a.SortColumn != ""
+}
+
 // Aliases represents a collection of aliases.
 type Aliases struct {
 	Alias Alias `yaml:"alias"`
@@ -67,6 +107,16 @@ func (a *Aliases) Get(k string) (string, bool) {
 	return v, ok
 }
 
+// Expand retrieves an alias resource and its default parameters.
+func (a *Aliases) Expand(k string) (AliasArgs, bool) {
+	cmd, ok := a.Get(k)
+	if !ok {
+		return AliasArgs{}, false
+	}
+
+	return ParseAliasArgs(cmd), true
+}
+
 // Define declares a new alias.
 func (a *Aliases) Define(gvr string, aliases ...string) {
 	a.mx.Lock()
@@ -108,6 +158,79 @@ func (a *Aliases) LoadFileAliases(path string) error {
 	return nil
 }
 
+// Persist records custom aliases for a command in the aliases file.
+func (a *Aliases) Persist(cmd string, aliases ...string) error {
+	return a.PersistFileAliases(K9sAlias, cmd, aliases...)
+}
+
+// PersistFileAliases records custom aliases for a command in a given file.
+func (a *Aliases) PersistFileAliases(path, cmd string, aliases ...string) error {
+	if ParseAliasArgs(cmd).GVR == "" {
+		return fmt.Errorf("invalid alias command %q", cmd)
+	}
+	custom, err := readFileAliases(path)
+	if err != nil {
+		return err
+	}
+	a.mx.Lock()
+	for _, alias := range aliases {
+		custom.Alias[alias], a.Alias[alias] = cmd, cmd
+	}
+	a.mx.Unlock()
+
+	return custom.SaveAliases(path)
+}
+
+// Remove deletes custom aliases from the aliases file.
+func (a *Aliases) Remove(aliases ...string) (int, error) {
+	return a.RemoveFileAliases(K9sAlias, aliases...)
+}
+
+// RemoveFileAliases deletes custom aliases from a given file and returns
+// the count of removed aliases.
+func (a *Aliases) RemoveFileAliases(path string, aliases ...string) (int, error) {
+	custom, err := readFileAliases(path)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	a.mx.Lock()
+	for _, alias := range aliases {
+		if _, ok := custom.Alias[alias]; !ok {
+			continue
+		}
+		delete(custom.Alias, alias)
+		delete(a.Alias, alias)
+		count++
+	}
+	a.mx.Unlock()
+	if count == 0 {
+		return 0, nil
+	}
+
+	return count, custom.SaveAliases(path)
+}
+
+// IsCustom checks if an alias is defined in the aliases file.
+func (a *Aliases) IsCustom(alias string) bool {
+	custom, err := readFileAliases(K9sAlias)
+	if err != nil {
+		return false
+	}
+	_, ok := custom.Alias[alias]
+
+	return ok
+}
+
+func readFileAliases(path string) (*Aliases, error) {
+	custom := NewAliases()
+	if err := custom.LoadFileAliases(path); err != nil {
+		return nil, err
+	}
+
+	return custom, nil
+}
+
 func (a *Aliases) loadDefaultAliases() {
 	a.mx.Lock()
 	defer a.mx.Unlock()
@@ -164,6 +287,73 @@ func (a *Aliases) loadDefaultAliases() {
 		a.Alias["pulse"] = pulses
 		a.Alias["pulses"] = pulses
 	}
+	const plugins = "plugins"
+	{
+		a.Alias["plugin"] = plugins
+		a.Alias[plugins] = plugins
+		a.Alias["hotkeys"] = plugins
+		a.Alias["hk"] = plugins
+	}
+	const notifications = "notifications"
+	{
+		a.Alias["notif"] = notifications
+		a.Alias["notification"] = notifications
+		a.Alias[notifications] = notifications
+	}
+	const alerts = "alerts"
+	{
+		a.Alias["alert"] = alerts
+		a.Alias[alerts] = alerts
+	}
+	const dashboard = "dashboard"
+	{
+		a.Alias["dash"] = dashboard
+		a.Alias[dashboard] = dashboard
+	}
+	const audits = "audits"
+	{
+		a.Alias["audit"] = audits
+		a.Alias[audits] = audits
+	}
+	const hops = "hops"
+	{
+		a.Alias["hop"] = hops
+		a.Alias[hops] = hops
+	}
+	const palette = "palette"
+	{
+		a.Alias[palette] = palette
+	}
+	const debug = "debug"
+	{
+		a.Alias[debug] = debug
+	}
+	const informers = "informers"
+	{
+		a.Alias["informer"] = informers
+		a.Alias[informers] = informers
+	}
+	const apicalls = "apicalls"
+	{
+		a.Alias["apicall"] = apicalls
+		a.Alias[apicalls] = apicalls
+	}
+	const tlscerts = "tlscerts"
+	{
+		a.Alias["tlscert"] = tlscerts
+		a.Alias[tlscerts] = tlscerts
+	}
+	const webhooks = "webhooks"
+	{
+		a.Alias["webhook"] = webhooks
+		a.Alias[webhooks] = webhooks
+	}
+	const images = "images"
+	{
+		a.Alias["image"] = images
+		a.Alias["img"] = images
+		a.Alias[images] = images
+	}
 }
 
 // Save alias to disk.
@@ -85,3 +85,64 @@ func TestAliasesSave(t *testing.T) {
 	assert.Nil(t, a.LoadFileAliases("/tmp/a.yml"))
 	assert.Equal(t, 2, len(a.Alias))
 }
+
+func TestParseAliasArgs(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   config.AliasArgs
+	}{
+		"plain": {
+			cmd: "v1/pods",
+			e:   config.AliasArgs{GVR: "v1/pods"},
+		},
+		"ns": {
+			cmd: "v1/pods kube-system",
+			e:   config.AliasArgs{GVR: "v1/pods", Namespace: "kube-system"},
+		},
+		"full": {
+			cmd: "v1/pods kube-system sort:age:desc /coredns",
+			e:   config.AliasArgs{GVR: "v1/pods", Namespace: "kube-system", SortColumn: "AGE", Filter: "coredns"},
+		},
+		"labels": {
+			cmd: "v1/pods sort:NAME /-l app=fred",
+			e:   config.AliasArgs{GVR: "v1/pods", SortColumn: "NAME", SortAsc: true, Filter: "-l app=fred"},
+		},
+		"empty": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.ParseAliasArgs(u.cmd))
+		})
+	}
+}
+
+func TestAliasesPersist(t *testing.T) {
+	path := "/tmp/a_persist.yml"
+	a := config.NewAliases()
+	a.Alias["test"] = "fred"
+	assert.Nil(t, a.SaveAliases(path))
+
+	assert.Nil(t, a.PersistFileAliases(path, "v1/pods kube-system /coredns", "cp", "dns"))
+	args, ok := a.Expand("cp")
+	assert.True(t, ok)
+	assert.Equal(t, "kube-system", args.Namespace)
+	assert.True(t, args.HasParams())
+
+	b := config.NewAliases()
+	assert.Nil(t, b.LoadFileAliases(path))
+	assert.Equal(t, 3, len(b.Alias))
+
+	count, err := a.RemoveFileAliases(path, "cp", "bozo")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	_, ok = a.Get("cp")
+	assert.False(t, ok)
+
+	b = config.NewAliases()
+	assert.Nil(t, b.LoadFileAliases(path))
+	assert.Equal(t, 2, len(b.Alias))
+
+	assert.NotNil(t, a.PersistFileAliases(path, " ", "blee"))
+}
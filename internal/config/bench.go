@@ -33,8 +33,10 @@ type (
 
 	// Benchmark represents a generic benchmark.
 	Benchmark struct {
-		C int `yaml:"concurrency"`
-		N int `yaml:"requests"`
+		C        int `yaml:"concurrency"`
+		N        int `yaml:"requests"`
+		Duration int `yaml:"duration"`
+		RampUp   int `yaml:"rampUp"`
 	}
 
 	// HTTP represents an http request.
@@ -47,13 +49,17 @@ type (
 		Headers http.Header `yaml:"headers"`
 	}
 
-	// BenchConfig represents a service benchmark.
+	// BenchConfig represents a service benchmark. A duration in seconds
+	// bounds the run instead of a number of requests, while the ramp up
+	// spreads the workers start over a number of seconds.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int  `yaml:"concurrency"`
+		N        int  `yaml:"requests"`
+		Duration int  `yaml:"duration"`
+		RampUp   int  `yaml:"rampUp"`
+		Auth     Auth `yaml:"auth"`
+		HTTP     HTTP `yaml:"http"`
 	}
 )
 
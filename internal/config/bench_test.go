@@ -65,6 +65,7 @@ func TestBenchServiceLoad(t *testing.T) {
 	uu := map[string]struct {
 		key                string
 		c, n               int
+		duration, rampUp   int
 		method, host, path string
 		http2              bool
 		body               string
@@ -75,6 +76,8 @@ func TestBenchServiceLoad(t *testing.T) {
 			"default/nginx",
 			2,
 			1000,
+			0,
+			0,
 			"GET",
 			"10.10.10.10",
 			"/",
@@ -87,6 +90,8 @@ func TestBenchServiceLoad(t *testing.T) {
 			"blee/fred",
 			10,
 			1500,
+			30,
+			10,
 			"POST",
 			"20.20.20.20",
 			"/zorg",
@@ -107,6 +112,8 @@ func TestBenchServiceLoad(t *testing.T) {
 			svc := b.Benchmarks.Services[u.key]
 			assert.Equal(t, u.c, svc.C)
 			assert.Equal(t, u.n, svc.N)
+			assert.Equal(t, u.duration, svc.Duration)
+			assert.Equal(t, u.rampUp, svc.RampUp)
 			assert.Equal(t, u.method, svc.HTTP.Method)
 			assert.Equal(t, u.host, svc.HTTP.Host)
 			assert.Equal(t, u.path, svc.HTTP.Path)
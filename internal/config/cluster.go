@@ -4,8 +4,27 @@ import "github.com/derailed/k9s/internal/client"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace   *Namespace  `yaml:"namespace"`
+	View        *View       `yaml:"view"`
+	Prometheus  *Prometheus `yaml:"prometheus,omitempty"`
+	ReadOnly    *bool       `yaml:"readOnly,omitempty"`
+	RefreshRate int         `yaml:"refreshRate,omitempty"`
+	Skin        string      `yaml:"skin,omitempty"`
+	Session     *Session    `yaml:"session,omitempty"`
+	Drift       *Drift      `yaml:"drift,omitempty"`
+}
+
+// Prometheus tracks a cluster Prometheus datasource.
+type Prometheus struct {
+	URL string `yaml:"url"`
+}
+
+// Drift tracks the manifests a cluster live resources are checked against.
+type Drift struct {
+	// Dir tracks a manifests directory or kustomization.
+	Dir string `yaml:"dir"`
+	// Ref tracks an optional git ref to read the manifests from.
+	Ref string `yaml:"ref,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
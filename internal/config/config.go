@@ -23,6 +23,8 @@ var (
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
 	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sAuditLog represents the audit trail of mutating actions performed via K9s.
+	K9sAuditLog = filepath.Join(K9sHome, "audit.log")
 )
 
 type (
@@ -130,6 +132,24 @@ func (c *Config) FavNamespaces() []string {
 	return []string{}
 }
 
+// ToggleFavNamespace stars or unstars a namespace in the current cluster.
+func (c *Config) ToggleFavNamespace(ns string) (bool, error) {
+	if c.K9s.ActiveCluster() == nil {
+		return false, errors.New("no active cluster. unable to toggle favorite namespace")
+	}
+
+	return c.K9s.ActiveCluster().Namespace.ToggleFavorite(ns), nil
+}
+
+// NamespaceUsage returns how many times namespaces were switched to in the current cluster.
+func (c *Config) NamespaceUsage() map[string]int {
+	if cl := c.K9s.ActiveCluster(); cl != nil && cl.Namespace != nil {
+		return cl.Namespace.Usage
+	}
+
+	return map[string]int{}
+}
+
 // SetActiveNamespace set the active namespace in the current cluster.
 func (c *Config) SetActiveNamespace(ns string) error {
 	if c.K9s.ActiveCluster() != nil {
@@ -163,6 +183,22 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// SetSession records the navigation state of the current cluster.
+func (c *Config) SetSession(s *Session) {
+	if cl := c.K9s.ActiveCluster(); cl != nil {
+		cl.Session = s
+	}
+}
+
+// Session returns the last recorded navigation state of the current cluster.
+func (c *Config) Session() *Session {
+	if cl := c.CurrentCluster(); cl != nil {
+		return cl.Session
+	}
+
+	return nil
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.client
@@ -191,8 +227,11 @@ func (c *Config) Load(path string) error {
 	return nil
 }
 
-// Save configuration to disk.
+// Save configuration to disk. Snapshot sessions leave the configuration untouched.
 func (c *Config) Save() error {
+	if c.K9s.GetSnapshot() != "" {
+		return nil
+	}
 	log.Debug().Msg("[Config] Saving configuration...")
 	c.Validate()
 
@@ -219,7 +258,7 @@ func (c *Config) Validate() {
 func (c *Config) Dump(msg string) {
 	log.Debug().Msgf("Current Cluster: %s\n", c.K9s.CurrentCluster)
 	for k, cl := range c.K9s.Clusters {
-		log.Debug().Msgf("K9s cluster: %s -- %s\n", k, cl.Namespace)
+		log.Debug().Msgf("K9s cluster: %s -- %v\n", k, cl.Namespace)
 	}
 }
 
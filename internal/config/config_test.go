@@ -267,6 +267,7 @@ var expectedConfig = `k9s:
   currentContext: blee
   currentCluster: blee
   fullScreenLogs: false
+  editDryRun: false
   clusters:
     blee:
       namespace:
@@ -321,6 +322,7 @@ var resetConfig = `k9s:
   currentContext: blee
   currentCluster: blee
   fullScreenLogs: false
+  editDryRun: false
   clusters:
     blee:
       namespace:
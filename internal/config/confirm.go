@@ -0,0 +1,80 @@
+package config
+
+import (
+	"path"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ConfirmNone performs an action without confirmation.
+	ConfirmNone = "none"
+	// ConfirmPrompt asks for a yes/no confirmation.
+	ConfirmPrompt = "prompt"
+	// ConfirmName requires the resource name to be typed to confirm.
+	ConfirmName = "name"
+)
+
+// Confirmation tracks how destructive actions must be confirmed.
+type Confirmation struct {
+	Verbs      []string `yaml:"verbs,omitempty"`
+	Resources  []string `yaml:"resources,omitempty"`
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	Mode       string   `yaml:"mode"`
+}
+
+// Matches checks if the rule applies to an action on a given resource.
+func (c Confirmation) Matches(verb, gvr, ns string) bool {
+	if len(c.Verbs) > 0 && !InList(c.Verbs, verb) {
+		return false
+	}
+	if len(c.Resources) > 0 && !InList(c.Resources, gvr) && !InList(c.Resources, client.NewGVR(gvr).R()) {
+		return false
+	}
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, p := range c.Namespaces {
+		ok, err := path.Match(p, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid confirmation namespace pattern %q", p)
+			continue
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetMode returns the rule confirmation mode.
+func (c Confirmation) GetMode() string {
+	switch c.Mode {
+	case ConfirmNone, ConfirmName:
+		return c.Mode
+	default:
+		return ConfirmPrompt
+	}
+}
+
+// StricterConfirm returns the most demanding of two confirmation modes.
+func StricterConfirm(m1, m2 string) string {
+	if confirmRank(m2) > confirmRank(m1) {
+		return m2
+	}
+
+	return m1
+}
+
+func confirmRank(mode string) int {
+	switch mode {
+	case ConfirmNone:
+		return 0
+	case ConfirmName:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,48 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmMode(t *testing.T) {
+	k := config.NewK9s()
+	k.Confirmations = []config.Confirmation{
+		{Verbs: []string{"delete"}, Namespaces: []string{"kube-system", "prod-*"}, Mode: config.ConfirmName},
+		{Verbs: []string{"kill"}, Resources: []string{"pods"}, Mode: config.ConfirmPrompt},
+		{Resources: []string{"v1/configmaps"}, Mode: config.ConfirmNone},
+	}
+
+	uu := map[string]struct {
+		verb, gvr, ns, dflt string
+		e                   string
+	}{
+		"protected": {verb: "delete", gvr: "v1/pods", ns: "kube-system", dflt: config.ConfirmPrompt, e: config.ConfirmName},
+		"pattern":   {verb: "delete", gvr: "apps/v1/deployments", ns: "prod-eu", dflt: config.ConfirmPrompt, e: config.ConfirmName},
+		"resource":  {verb: "kill", gvr: "v1/pods", ns: "default", dflt: config.ConfirmNone, e: config.ConfirmPrompt},
+		"gvr":       {verb: "delete", gvr: "v1/configmaps", ns: "default", dflt: config.ConfirmPrompt, e: config.ConfirmNone},
+		"default":   {verb: "delete", gvr: "v1/pods", ns: "default", dflt: config.ConfirmPrompt, e: config.ConfirmPrompt},
+		"cluster":   {verb: "delete", gvr: "v1/nodes", dflt: config.ConfirmPrompt, e: config.ConfirmPrompt},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.ConfirmMode(u.verb, u.gvr, u.ns, u.dflt))
+		})
+	}
+}
+
+func TestConfirmationGetMode(t *testing.T) {
+	assert.Equal(t, config.ConfirmPrompt, config.Confirmation{}.GetMode())
+	assert.Equal(t, config.ConfirmPrompt, config.Confirmation{Mode: "blee"}.GetMode())
+	assert.Equal(t, config.ConfirmName, config.Confirmation{Mode: config.ConfirmName}.GetMode())
+}
+
+func TestStricterConfirm(t *testing.T) {
+	assert.Equal(t, config.ConfirmName, config.StricterConfirm(config.ConfirmPrompt, config.ConfirmName))
+	assert.Equal(t, config.ConfirmPrompt, config.StricterConfirm(config.ConfirmPrompt, config.ConfirmNone))
+	assert.Equal(t, config.ConfirmPrompt, config.StricterConfirm(config.ConfirmNone, config.ConfirmPrompt))
+}
@@ -0,0 +1,31 @@
+package config
+
+const defaultDebugImage = "busybox:1.31"
+
+// DebugContainer tracks ephemeral debug container configuration.
+type DebugContainer struct {
+	Image   string   `yaml:"image"`
+	Command []string `yaml:"command,omitempty"`
+}
+
+// NewDebugContainer returns a new instance.
+func NewDebugContainer() *DebugContainer {
+	return &DebugContainer{
+		Image:   defaultDebugImage,
+		Command: defaultDebugCommand(),
+	}
+}
+
+// Validate checks the debug container settings and uses defaults if not set.
+func (d *DebugContainer) Validate() {
+	if d.Image == "" {
+		d.Image = defaultDebugImage
+	}
+	if len(d.Command) == 0 {
+		d.Command = defaultDebugCommand()
+	}
+}
+
+func defaultDebugCommand() []string {
+	return []string{"sh"}
+}
@@ -0,0 +1,40 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugContainerValidate(t *testing.T) {
+	uu := map[string]struct {
+		d, e config.DebugContainer
+	}{
+		"blank": {
+			e: *config.NewDebugContainer(),
+		},
+		"custom": {
+			d: config.DebugContainer{Image: "nicolaka/netshoot", Command: []string{"bash"}},
+			e: config.DebugContainer{Image: "nicolaka/netshoot", Command: []string{"bash"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.d.Validate()
+			assert.Equal(t, u.e, u.d)
+		})
+	}
+}
+
+func TestK9sGetDebugContainer(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, config.NewDebugContainer(), k.GetDebugContainer())
+
+	k.DebugContainer = &config.DebugContainer{Image: "alpine"}
+	d := k.GetDebugContainer()
+	assert.Equal(t, "alpine", d.Image)
+	assert.Equal(t, []string{"sh"}, d.Command)
+}
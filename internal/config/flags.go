@@ -9,6 +9,12 @@ const (
 
 	// DefaultCommand represents the default command to run.
 	DefaultCommand = ""
+
+	// DefaultSource represents the default startup script.
+	DefaultSource = ""
+
+	// DefaultSnapshot represents the default snapshot to browse.
+	DefaultSnapshot = ""
 )
 
 // Flags represents K9s configuration flags.
@@ -19,6 +25,8 @@ type Flags struct {
 	Command       *string
 	AllNamespaces *bool
 	ReadOnly      *bool
+	Source        *string
+	Snapshot      *string
 }
 
 // NewFlags returns new configuration flags.
@@ -30,6 +38,8 @@ func NewFlags() *Flags {
 		Command:       strPtr(DefaultCommand),
 		AllNamespaces: boolPtr(false),
 		ReadOnly:      boolPtr(false),
+		Source:        strPtr(DefaultSource),
+		Snapshot:      strPtr(DefaultSnapshot),
 	}
 }
 
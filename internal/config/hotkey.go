@@ -16,10 +16,15 @@ type HotKeys struct {
 }
 
 // HotKey describes a K9s hotkey.
+// Namespace, Path and Filter are optional and may reference K9s env vars ie $NAMESPACE.
 type HotKey struct {
 	ShortCut    string `yaml:"shortCut"`
 	Description string `yaml:"description"`
 	Command     string `yaml:"command"`
+	Namespace   string `yaml:"namespace,omitempty"`
+	Path        string `yaml:"path,omitempty"`
+	Filter      string `yaml:"filter,omitempty"`
+	Disabled    bool   `yaml:"disabled,omitempty"`
 }
 
 // NewHotKeys returns a new plugin.
@@ -11,11 +11,19 @@ func TestHotKeyLoad(t *testing.T) {
 	h := config.NewHotKeys()
 	assert.Nil(t, h.LoadHotKeys("testdata/hot_key.yml"))
 
-	assert.Equal(t, 1, len(h.HotKey))
+	assert.Equal(t, 2, len(h.HotKey))
 
 	k, ok := h.HotKey["pods"]
 	assert.True(t, ok)
 	assert.Equal(t, "shift-0", k.ShortCut)
 	assert.Equal(t, "Launch pod view", k.Description)
 	assert.Equal(t, "pods", k.Command)
+	assert.Equal(t, "", k.Filter)
+
+	k, ok = h.HotKey["web"]
+	assert.True(t, ok)
+	assert.Equal(t, "pods", k.Command)
+	assert.Equal(t, "prod", k.Namespace)
+	assert.Equal(t, "-l app=web", k.Filter)
+	assert.Equal(t, "", k.Path)
 }
@@ -0,0 +1,48 @@
+package config
+
+import "time"
+
+const (
+	// ImageScannerTrivy parses trivy json reports.
+	ImageScannerTrivy = "trivy"
+	// ImageScannerGrype parses grype json reports.
+	ImageScannerGrype = "grype"
+	// ImageScannerVar represents the scanned image in the scanner args.
+	ImageScannerVar = "$IMAGE"
+
+	defaultImageScannerTimeout = 300
+)
+
+// ImageScanner tracks the external command scanning images for vulnerabilities.
+type ImageScanner struct {
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args,omitempty"`
+	Format  string   `yaml:"format,omitempty"`
+	Timeout int      `yaml:"timeout,omitempty"`
+}
+
+// Validate checks the image scanner settings and uses defaults if not set.
+func (s *ImageScanner) Validate() {
+	if s.Format == "" {
+		s.Format = ImageScannerTrivy
+	}
+	if len(s.Args) == 0 {
+		s.Args = defaultImageScannerArgs(s.Format)
+	}
+	if s.Timeout <= 0 {
+		s.Timeout = defaultImageScannerTimeout
+	}
+}
+
+// GetTimeout returns how long a scan may run.
+func (s *ImageScanner) GetTimeout() time.Duration {
+	return time.Duration(s.Timeout) * time.Second
+}
+
+func defaultImageScannerArgs(format string) []string {
+	if format == ImageScannerGrype {
+		return []string{ImageScannerVar, "-o", "json"}
+	}
+
+	return []string{"image", "--quiet", "--format", "json", ImageScannerVar}
+}
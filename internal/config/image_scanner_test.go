@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScannerValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e config.ImageScanner
+	}{
+		"trivy": {
+			s: config.ImageScanner{Command: "trivy"},
+			e: config.ImageScanner{
+				Command: "trivy",
+				Args:    []string{"image", "--quiet", "--format", "json", "$IMAGE"},
+				Format:  "trivy",
+				Timeout: 300,
+			},
+		},
+		"grype": {
+			s: config.ImageScanner{Command: "grype", Format: "grype"},
+			e: config.ImageScanner{
+				Command: "grype",
+				Args:    []string{"$IMAGE", "-o", "json"},
+				Format:  "grype",
+				Timeout: 300,
+			},
+		},
+		"custom": {
+			s: config.ImageScanner{Command: "scan.sh", Args: []string{"$IMAGE"}, Timeout: 60},
+			e: config.ImageScanner{Command: "scan.sh", Args: []string{"$IMAGE"}, Format: "trivy", Timeout: 60},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate()
+			assert.Equal(t, u.e, u.s)
+		})
+	}
+}
+
+func TestK9sGetImageScanner(t *testing.T) {
+	k := config.NewK9s()
+	assert.Nil(t, k.GetImageScanner())
+
+	k.ImageScanner = &config.ImageScanner{Format: "grype"}
+	assert.Nil(t, k.GetImageScanner())
+
+	k.ImageScanner.Command = "grype"
+	s := k.GetImageScanner()
+	assert.Equal(t, []string{"$IMAGE", "-o", "json"}, s.Args)
+	assert.Equal(t, 5*time.Minute, s.GetTimeout())
+}
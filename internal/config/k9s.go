@@ -1,30 +1,78 @@
 package config
 
-import "github.com/derailed/k9s/internal/client"
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
 
 const (
 	defaultRefreshRate    = 2
 	defaultLogRequestSize = 200
 	defaultLogBufferSize  = 1000
 	defaultReadOnly       = false
+	defaultMetricsWindow  = 15
+	defaultInformerIdle   = 300
+	defaultPageThreshold  = 5000
+	defaultSlowAPICall    = 1000
+	defaultCostLabel      = "app"
+
+	// minContextsHealthInterval guards against hammering api servers.
+	minContextsHealthInterval = 10
 )
 
+// defaultFindResources lists the resources searched by the find command.
+var defaultFindResources = []string{
+	"v1/pods",
+	"v1/services",
+	"v1/configmaps",
+	"v1/secrets",
+	"v1/serviceaccounts",
+	"v1/persistentvolumeclaims",
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"apps/v1/replicasets",
+	"batch/v1/jobs",
+	"batch/v1beta1/cronjobs",
+	"extensions/v1beta1/ingresses",
+}
+
 // K9s tracks K9s configuration options.
 type K9s struct {
-	RefreshRate       int                 `yaml:"refreshRate"`
-	Headless          bool                `yaml:"headless"`
-	ReadOnly          bool                `yaml:"readOnly"`
-	LogBufferSize     int                 `yaml:"logBufferSize"`
-	LogRequestSize    int                 `yaml:"logRequestSize"`
-	CurrentContext    string              `yaml:"currentContext"`
-	CurrentCluster    string              `yaml:"currentCluster"`
-	FullScreenLogs    bool                `yaml:"fullScreenLogs"`
-	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
-	Thresholds        Threshold           `yaml:"thresholds"`
+	RefreshRate       int                  `yaml:"refreshRate"`
+	Headless          bool                 `yaml:"headless"`
+	ReadOnly          bool                 `yaml:"readOnly"`
+	LogBufferSize     int                  `yaml:"logBufferSize"`
+	LogRequestSize    int                  `yaml:"logRequestSize"`
+	CurrentContext    string               `yaml:"currentContext"`
+	CurrentCluster    string               `yaml:"currentCluster"`
+	FullScreenLogs    bool                 `yaml:"fullScreenLogs"`
+	EditDryRun        bool                 `yaml:"editDryRun"`
+	Clusters          map[string]*Cluster  `yaml:"clusters,omitempty"`
+	Thresholds        Threshold            `yaml:"thresholds"`
+	ShellPod          *ShellPod            `yaml:"shellPod,omitempty"`
+	DebugContainer    *DebugContainer      `yaml:"debugContainer,omitempty"`
+	ProcessCommand    []string             `yaml:"processCommand,omitempty"`
+	MetricsWindow     int                  `yaml:"metricsWindow,omitempty"`
+	Notifications     []Notification       `yaml:"notifications,omitempty"`
+	Confirmations     []Confirmation       `yaml:"confirmations,omitempty"`
+	KubeConfigDirs    []string             `yaml:"kubeConfigDirs,omitempty"`
+	ContextsUsed      map[string]time.Time `yaml:"contextsUsed,omitempty"`
+	ContextsHealth    int                  `yaml:"contextsHealth,omitempty"`
+	FindResources     []string             `yaml:"findResources,omitempty"`
+	InformerIdle      int                  `yaml:"informerIdle,omitempty"`
+	PageThreshold     int                  `yaml:"pageThreshold,omitempty"`
+	SlowAPICall       int                  `yaml:"slowApiCall,omitempty"`
+	CostLabel         string               `yaml:"costLabel,omitempty"`
+	ImageScanner      *ImageScanner        `yaml:"imageScanner,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualReadOnly    *bool
 	manualCommand     *string
+	manualSource      *string
+	manualSnapshot    *string
 }
 
 // NewK9s create a new K9s configuration.
@@ -59,6 +107,43 @@ func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
 }
 
+// OverrideSource set the startup script manually.
+func (k *K9s) OverrideSource(path string) {
+	k.manualSource = &path
+}
+
+// OverrideSnapshot set the snapshot to browse instead of a live cluster.
+func (k *K9s) OverrideSnapshot(path string) {
+	k.manualSnapshot = &path
+}
+
+// GetCommand returns the startup command if any.
+func (k *K9s) GetCommand() string {
+	if k.manualCommand == nil {
+		return ""
+	}
+
+	return *k.manualCommand
+}
+
+// GetSource returns the startup script if any.
+func (k *K9s) GetSource() string {
+	if k.manualSource == nil {
+		return ""
+	}
+
+	return *k.manualSource
+}
+
+// GetSnapshot returns the snapshot being browsed if any.
+func (k *K9s) GetSnapshot() string {
+	if k.manualSnapshot == nil {
+		return ""
+	}
+
+	return *k.manualSnapshot
+}
+
 // GetHeadless returns headless setting.
 func (k *K9s) GetHeadless() bool {
 	h := k.Headless
@@ -69,9 +154,13 @@ func (k *K9s) GetHeadless() bool {
 	return h
 }
 
-// GetRefreshRate returns the current refresh rate.
+// GetRefreshRate returns the current refresh rate. A cluster setting overrides
+// the global one, while the command line flag always wins.
 func (k *K9s) GetRefreshRate() int {
 	rate := k.RefreshRate
+	if c, ok := k.Clusters[k.CurrentCluster]; ok && c != nil && c.RefreshRate > 0 {
+		rate = c.RefreshRate
+	}
 	if k.manualRefreshRate != 0 {
 		rate = k.manualRefreshRate
 	}
@@ -79,15 +168,193 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
-// GetReadOnly returns the readonly setting.
+// GetReadOnly returns the readonly setting. A cluster setting overrides the
+// global one, while the command line flag always wins.
 func (k *K9s) GetReadOnly() bool {
 	readOnly := k.ReadOnly
+	if c, ok := k.Clusters[k.CurrentCluster]; ok && c != nil && c.ReadOnly != nil {
+		readOnly = *c.ReadOnly
+	}
 	if k.manualReadOnly != nil && *k.manualReadOnly {
 		readOnly = *k.manualReadOnly
 	}
 	return readOnly
 }
 
+// GetSkin returns the skin file configured for the current cluster if any.
+// Relative paths are resolved against the K9s home directory.
+func (k *K9s) GetSkin() string {
+	c, ok := k.Clusters[k.CurrentCluster]
+	if !ok || c == nil || c.Skin == "" {
+		return ""
+	}
+	if filepath.IsAbs(c.Skin) {
+		return c.Skin
+	}
+
+	return filepath.Join(K9sHome, c.Skin)
+}
+
+// GetDrift returns the drift detection manifests configured for the current
+// cluster if any.
+func (k *K9s) GetDrift() *Drift {
+	c, ok := k.Clusters[k.CurrentCluster]
+	if !ok || c == nil || c.Drift == nil || c.Drift.Dir == "" {
+		return nil
+	}
+
+	return c.Drift
+}
+
+// GetShellPod returns the node shell pod settings.
+func (k *K9s) GetShellPod() *ShellPod {
+	if k.ShellPod == nil {
+		return NewShellPod()
+	}
+	k.ShellPod.Validate()
+
+	return k.ShellPod
+}
+
+// GetMetricsWindow returns how long resource usage history is kept around.
+func (k *K9s) GetMetricsWindow() time.Duration {
+	w := k.MetricsWindow
+	if w <= 0 {
+		w = defaultMetricsWindow
+	}
+
+	return time.Duration(w) * time.Minute
+}
+
+// GetContextsHealthInterval returns the contexts health polling interval or 0 if disabled.
+func (k *K9s) GetContextsHealthInterval() time.Duration {
+	if k.ContextsHealth <= 0 {
+		return 0
+	}
+	i := k.ContextsHealth
+	if i < minContextsHealthInterval {
+		i = minContextsHealthInterval
+	}
+
+	return time.Duration(i) * time.Second
+}
+
+// GetInformerIdle returns how long unused resource informers are kept or 0 if never evicted.
+func (k *K9s) GetInformerIdle() time.Duration {
+	switch {
+	case k.InformerIdle < 0:
+		return 0
+	case k.InformerIdle == 0:
+		return defaultInformerIdle * time.Second
+	default:
+		return time.Duration(k.InformerIdle) * time.Second
+	}
+}
+
+// GetPageThreshold returns the resource count above which views list resources a page at a time or 0 if disabled.
+func (k *K9s) GetPageThreshold() int64 {
+	switch {
+	case k.PageThreshold < 0:
+		return 0
+	case k.PageThreshold == 0:
+		return defaultPageThreshold
+	default:
+		return int64(k.PageThreshold)
+	}
+}
+
+// GetSlowAPICall returns the latency above which api calls are flagged slow or 0 if disabled.
+func (k *K9s) GetSlowAPICall() time.Duration {
+	switch {
+	case k.SlowAPICall < 0:
+		return 0
+	case k.SlowAPICall == 0:
+		return defaultSlowAPICall * time.Millisecond
+	default:
+		return time.Duration(k.SlowAPICall) * time.Millisecond
+	}
+}
+
+// GetFindResources returns the resources searched by the find command.
+func (k *K9s) GetFindResources() []string {
+	if len(k.FindResources) == 0 {
+		return defaultFindResources
+	}
+
+	return k.FindResources
+}
+
+// GetCostLabel returns the label key pods resources are aggregated by.
+func (k *K9s) GetCostLabel() string {
+	if k.CostLabel == "" {
+		return defaultCostLabel
+	}
+
+	return k.CostLabel
+}
+
+// GetImageScanner returns the image vulnerability scanner if one is configured.
+func (k *K9s) GetImageScanner() *ImageScanner {
+	if k.ImageScanner == nil || k.ImageScanner.Command == "" {
+		return nil
+	}
+	k.ImageScanner.Validate()
+
+	return k.ImageScanner
+}
+
+// GetProcessCommand returns the command listing a container processes.
+func (k *K9s) GetProcessCommand() []string {
+	if len(k.ProcessCommand) == 0 {
+		return []string{"top", "-b", "-n", "1"}
+	}
+
+	return k.ProcessCommand
+}
+
+// GetDebugContainer returns the ephemeral debug container settings.
+func (k *K9s) GetDebugContainer() *DebugContainer {
+	if k.DebugContainer == nil {
+		return NewDebugContainer()
+	}
+	k.DebugContainer.Validate()
+
+	return k.DebugContainer
+}
+
+// ConfirmMode returns how an action on a given resource must be confirmed.
+// The first matching confirmation rule wins, otherwise the default mode applies.
+func (k *K9s) ConfirmMode(verb, gvr, ns, dflt string) string {
+	for _, c := range k.Confirmations {
+		if c.Matches(verb, gvr, ns) {
+			return c.GetMode()
+		}
+	}
+
+	return dflt
+}
+
+// TouchContext records a context was just used.
+func (k *K9s) TouchContext(name string) {
+	if name == "" {
+		return
+	}
+	if k.ContextsUsed == nil {
+		k.ContextsUsed = make(map[string]time.Time)
+	}
+	k.ContextsUsed[name] = time.Now()
+}
+
+// ContextsUsage returns when each context was last used.
+func (k *K9s) ContextsUsage() map[string]time.Time {
+	mm := make(map[string]time.Time, len(k.ContextsUsed))
+	for n, t := range k.ContextsUsed {
+		mm[n] = t
+	}
+
+	return mm
+}
+
 // ActiveCluster returns the currently active cluster.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
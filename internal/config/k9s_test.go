@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	m "github.com/petergtz/pegomock"
@@ -79,3 +80,169 @@ func TestK9sActiveCluster(t *testing.T) {
 	assert.Equal(t, "kube-system", cl.Namespace.Active)
 	assert.Equal(t, 5, len(cl.Namespace.Favorites))
 }
+
+func TestK9sGetProcessCommand(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, []string{"top", "-b", "-n", "1"}, k.GetProcessCommand())
+
+	k.ProcessCommand = []string{"ps", "aux"}
+	assert.Equal(t, []string{"ps", "aux"}, k.GetProcessCommand())
+}
+
+func TestK9sGetMetricsWindow(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, 15*time.Minute, k.GetMetricsWindow())
+
+	k.MetricsWindow = 5
+	assert.Equal(t, 5*time.Minute, k.GetMetricsWindow())
+}
+
+func TestK9sGetRefreshRate(t *testing.T) {
+	uu := map[string]struct {
+		cluster, manual, e int
+	}{
+		"default": {e: 2},
+		"cluster": {cluster: 10, e: 10},
+		"manual":  {cluster: 10, manual: 1, e: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.CurrentCluster = "c1"
+			c.Clusters["c1"] = &config.Cluster{RefreshRate: u.cluster}
+			if u.manual != 0 {
+				c.OverrideRefreshRate(u.manual)
+			}
+			assert.Equal(t, u.e, c.GetRefreshRate())
+		})
+	}
+}
+
+func TestK9sGetSkin(t *testing.T) {
+	home := config.K9sHome
+	defer func() { config.K9sHome = home }()
+	config.K9sHome = "/tmp/k9s"
+	c := config.NewK9s()
+	c.CurrentCluster = "c1"
+	assert.Equal(t, "", c.GetSkin())
+
+	c.Clusters["c1"] = &config.Cluster{Skin: "prod_skin.yml"}
+	assert.Equal(t, "/tmp/k9s/prod_skin.yml", c.GetSkin())
+
+	c.Clusters["c1"].Skin = "/etc/k9s/prod.yml"
+	assert.Equal(t, "/etc/k9s/prod.yml", c.GetSkin())
+}
+
+func TestK9sGetDrift(t *testing.T) {
+	c := config.NewK9s()
+	c.CurrentCluster = "c1"
+	assert.Nil(t, c.GetDrift())
+
+	c.Clusters["c1"] = &config.Cluster{Drift: &config.Drift{}}
+	assert.Nil(t, c.GetDrift())
+
+	c.Clusters["c1"].Drift = &config.Drift{Dir: "/tmp/gitops", Ref: "main"}
+	assert.Equal(t, &config.Drift{Dir: "/tmp/gitops", Ref: "main"}, c.GetDrift())
+}
+
+func TestK9sGetCostLabel(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, "app", k.GetCostLabel())
+
+	k.CostLabel = "team"
+	assert.Equal(t, "team", k.GetCostLabel())
+}
+
+func TestK9sGetContextsHealthInterval(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, time.Duration(0), k.GetContextsHealthInterval())
+
+	k.ContextsHealth = 2
+	assert.Equal(t, 10*time.Second, k.GetContextsHealthInterval())
+
+	k.ContextsHealth = 60
+	assert.Equal(t, time.Minute, k.GetContextsHealthInterval())
+}
+
+func TestK9sGetInformerIdle(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, 5*time.Minute, k.GetInformerIdle())
+
+	k.InformerIdle = 30
+	assert.Equal(t, 30*time.Second, k.GetInformerIdle())
+
+	k.InformerIdle = -1
+	assert.Equal(t, time.Duration(0), k.GetInformerIdle())
+}
+
+func TestK9sGetPageThreshold(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, int64(5000), k.GetPageThreshold())
+
+	k.PageThreshold = 200
+	assert.Equal(t, int64(200), k.GetPageThreshold())
+
+	k.PageThreshold = -1
+	assert.Equal(t, int64(0), k.GetPageThreshold())
+}
+
+func TestK9sGetSlowAPICall(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, time.Second, k.GetSlowAPICall())
+
+	k.SlowAPICall = 250
+	assert.Equal(t, 250*time.Millisecond, k.GetSlowAPICall())
+
+	k.SlowAPICall = -1
+	assert.Equal(t, time.Duration(0), k.GetSlowAPICall())
+}
+
+func TestK9sGetSnapshot(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, "", k.GetSnapshot())
+
+	k.OverrideSnapshot("/tmp/snap.json.gz")
+	assert.Equal(t, "/tmp/snap.json.gz", k.GetSnapshot())
+}
+
+func TestK9sGetReadOnly(t *testing.T) {
+	on, off := true, false
+	uu := map[string]struct {
+		global  bool
+		cluster *bool
+		manual  bool
+		e       bool
+	}{
+		"default":          {},
+		"global":           {global: true, e: true},
+		"cluster":          {cluster: &on, e: true},
+		"cluster-override": {global: true, cluster: &off},
+		"manual":           {cluster: &off, manual: true, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s()
+			k.ReadOnly, k.CurrentCluster = u.global, "c1"
+			k.Clusters["c1"] = &config.Cluster{ReadOnly: u.cluster}
+			if u.manual {
+				k.OverrideReadOnly(true)
+			}
+			assert.Equal(t, u.e, k.GetReadOnly())
+		})
+	}
+}
+
+func TestK9sTouchContext(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, 0, len(k.ContextsUsage()))
+
+	k.TouchContext("")
+	k.TouchContext("c1")
+	uu := k.ContextsUsage()
+	assert.Equal(t, 1, len(uu))
+	assert.False(t, uu["c1"].IsZero())
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// NotifyJSON posts webhook notifications as json documents.
+	NotifyJSON = "json"
+	// NotifySlack posts webhook notifications as Slack compatible messages.
+	NotifySlack = "slack"
+
+	// DefaultNotifyMessage represents the default notification message template.
+	DefaultNotifyMessage = "[{{.Cluster}}] {{.Rule}}: {{.Resource}} {{.Namespace}}/{{.Name}} is {{.Reason}}"
+)
+
+// Notification tracks a watched resource condition that raises a notification.
+type Notification struct {
+	Name      string   `yaml:"name"`
+	Resource  string   `yaml:"resource"`
+	Status    string   `yaml:"status"`
+	For       string   `yaml:"for,omitempty"`
+	Bell      bool     `yaml:"bell"`
+	Command   []string `yaml:"command,omitempty"`
+	Webhook   string   `yaml:"webhook,omitempty"`
+	Format    string   `yaml:"format,omitempty"`
+	Message   string   `yaml:"message,omitempty"`
+	RateLimit string   `yaml:"rateLimit,omitempty"`
+}
+
+// IsValid checks a notification rule can be evaluated.
+func (n Notification) IsValid() bool {
+	return n.Name != "" && n.Resource != "" && n.Status != ""
+}
+
+// Duration returns how long a resource must match the rule before a notification is raised.
+func (n Notification) Duration() time.Duration {
+	return n.parseDuration(n.For)
+}
+
+// Interval returns the minimum time between two deliveries of the rule.
+func (n Notification) Interval() time.Duration {
+	return n.parseDuration(n.RateLimit)
+}
+
+// GetFormat returns the webhook payload format.
+func (n Notification) GetFormat() string {
+	if n.Format == NotifySlack {
+		return NotifySlack
+	}
+
+	return NotifyJSON
+}
+
+// GetMessage returns the notification message template.
+func (n Notification) GetMessage() string {
+	if n.Message == "" {
+		return DefaultNotifyMessage
+	}
+
+	return n.Message
+}
+
+func (n Notification) parseDuration(s string) time.Duration {
+	if s == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid duration for notification %q", n.Name)
+		return 0
+	}
+
+	return d
+}
+
+// GetNotifications returns the valid notification rules.
+func (k *K9s) GetNotifications() []Notification {
+	nn := make([]Notification, 0, len(k.Notifications))
+	for _, n := range k.Notifications {
+		if !n.IsValid() {
+			log.Warn().Msgf("Skipping invalid notification rule %q", n.Name)
+			continue
+		}
+		nn = append(nn, n)
+	}
+
+	return nn
+}
@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDuration(t *testing.T) {
+	uu := map[string]struct {
+		n config.Notification
+		e time.Duration
+	}{
+		"none": {
+			n: config.Notification{Name: "crash"},
+		},
+		"minutes": {
+			n: config.Notification{Name: "pending", For: "5m"},
+			e: 5 * time.Minute,
+		},
+		"toast": {
+			n: config.Notification{Name: "pending", For: "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.n.Duration())
+		})
+	}
+}
+
+func TestK9sGetNotifications(t *testing.T) {
+	k := config.NewK9s()
+	k.Notifications = []config.Notification{
+		{Name: "crash", Resource: "v1/pods", Status: "CrashLoopBackOff"},
+		{Name: "blee", Resource: "v1/nodes"},
+		{Resource: "v1/persistentvolumeclaims", Status: "Pending"},
+	}
+
+	nn := k.GetNotifications()
+	assert.Equal(t, 1, len(nn))
+	assert.Equal(t, "crash", nn[0].Name)
+}
+
+func TestNotificationDelivery(t *testing.T) {
+	n := config.Notification{Name: "crash"}
+	assert.Equal(t, config.NotifyJSON, n.GetFormat())
+	assert.Equal(t, config.DefaultNotifyMessage, n.GetMessage())
+	assert.Equal(t, time.Duration(0), n.Interval())
+
+	n = config.Notification{Name: "crash", Format: config.NotifySlack, Message: "{{.Name}}", RateLimit: "10m"}
+	assert.Equal(t, config.NotifySlack, n.GetFormat())
+	assert.Equal(t, "{{.Name}}", n.GetMessage())
+	assert.Equal(t, 10*time.Minute, n.Interval())
+}
@@ -14,8 +14,9 @@ const (
 
 // Namespace tracks active and favorites namespaces.
 type Namespace struct {
-	Active    string   `yaml:"active"`
-	Favorites []string `yaml:"favorites"`
+	Active    string         `yaml:"active"`
+	Favorites []string       `yaml:"favorites"`
+	Usage     map[string]int `yaml:"usage,omitempty"`
 }
 
 // NewNamespace create a new namespace configuration.
@@ -43,6 +44,11 @@ func (n *Namespace) Validate(c client.Connection, ks KubeSettings) {
 			n.rmFavNS(ns)
 		}
 	}
+	for ns := range n.Usage {
+		if ns != allNS && !InList(nn, ns) {
+			delete(n.Usage, ns)
+		}
+	}
 }
 
 // SetActive set the active namespace.
@@ -51,10 +57,30 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	n.Active = ns
 	if ns != "" {
 		n.addFavNS(ns)
+		n.touch(ns)
 	}
 	return nil
 }
 
+// ToggleFavorite stars or unstars a namespace. Returns true if the namespace
+// is now a favorite.
+func (n *Namespace) ToggleFavorite(ns string) bool {
+	if InList(n.Favorites, ns) {
+		n.rmFavNS(ns)
+		return false
+	}
+	n.addFavNS(ns)
+
+	return true
+}
+
+func (n *Namespace) touch(ns string) {
+	if n.Usage == nil {
+		n.Usage = make(map[string]int)
+	}
+	n.Usage[ns]++
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == allNS || n.Active == ""
 }
@@ -77,6 +77,27 @@ func TestNSSetActive(t *testing.T) {
 	}
 }
 
+func TestNSSetActiveUsage(t *testing.T) {
+	mk := NewMockKubeSettings()
+	ns := config.NewNamespace()
+	assert.Nil(t, ns.SetActive("ns1", mk))
+	assert.Nil(t, ns.SetActive("ns2", mk))
+	assert.Nil(t, ns.SetActive("ns1", mk))
+
+	assert.Equal(t, map[string]int{"ns1": 2, "ns2": 1}, ns.Usage)
+}
+
+func TestNSToggleFavorite(t *testing.T) {
+	ns := config.NewNamespace()
+
+	assert.True(t, ns.ToggleFavorite("ns1"))
+	assert.Equal(t, []string{"ns1", "default"}, ns.Favorites)
+	assert.False(t, ns.ToggleFavorite("default"))
+	assert.Equal(t, []string{"ns1"}, ns.Favorites)
+	assert.False(t, ns.ToggleFavorite("ns1"))
+	assert.Equal(t, []string{}, ns.Favorites)
+}
+
 func TestNSValidateRmFavs(t *testing.T) {
 	allNS := []string{"default", "kube-system"}
 
@@ -1,14 +1,22 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
 
-// K9sPlugins manages K9s plugins.
-var K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
+var (
+	// K9sPlugins manages K9s plugins.
+	K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
+	// K9sPluginsDir manages K9s plugins defined as individual files.
+	K9sPluginsDir = filepath.Join(K9sHome, "plugins")
+)
 
 // Plugins represents a collection of plugins.
 type Plugins struct {
@@ -23,6 +31,9 @@ type Plugin struct {
 	Command     string   `yaml:"command"`
 	Background  bool     `yaml:"background"`
 	Args        []string `yaml:"args"`
+	Disabled    bool     `yaml:"disabled,omitempty"`
+	Dangerous   bool     `yaml:"dangerous,omitempty"`
+	Source      string   `yaml:"-"`
 }
 
 // NewPlugins returns a new plugin.
@@ -34,7 +45,20 @@ func NewPlugins() Plugins {
 
 // Load K9s plugins.
 func (p Plugins) Load() error {
-	return p.LoadPlugins(K9sPlugins)
+	var errs []string
+	if err := p.LoadPlugins(K9sPlugins); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, err.Error())
+	}
+	for _, dir := range PluginsDirs() {
+		if err := p.LoadPluginsDir(dir); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("plugins load failed: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
 }
 
 // LoadPlugins loads plugins from a given file.
@@ -49,8 +73,132 @@ func (p Plugins) LoadPlugins(path string) error {
 		return err
 	}
 	for k, v := range pp.Plugin {
+		v.Source = path
 		p.Plugin[k] = v
 	}
 
 	return nil
 }
+
+// LoadPluginsDir loads all plugin files from a given directory.
+// A file either holds a plugin collection or a single plugin named after the file.
+// Plugins conflicting with already loaded ones are skipped and reported.
+func (p Plugins) LoadPluginsDir(dir string) error {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	sort.Slice(ff, func(i, j int) bool {
+		return ff[i].Name() < ff[j].Name()
+	})
+
+	var errs []string
+	for _, f := range ff {
+		if f.IsDir() || !isYAML(f.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		pp, err := readPluginFile(path)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", path, err))
+			continue
+		}
+		for _, k := range pp.names() {
+			plugin := pp.Plugin[k]
+			plugin.Source = path
+			if err := p.add(k, plugin); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", path, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// PluginsDirs returns all directories hosting individual plugin files.
+func PluginsDirs() []string {
+	return []string{K9sPluginsDir, xdgPluginsDir()}
+}
+
+func (p Plugins) add(name string, plugin Plugin) error {
+	if _, ok := p.Plugin[name]; ok {
+		return fmt.Errorf("plugin %q is already defined", name)
+	}
+	for k, v := range p.Plugin {
+		if strings.EqualFold(v.ShortCut, plugin.ShortCut) && overlaps(v.Scopes, plugin.Scopes) {
+			return fmt.Errorf("plugin %q shortcut %s conflicts with plugin %q", name, plugin.ShortCut, k)
+		}
+	}
+	p.Plugin[name] = plugin
+
+	return nil
+}
+
+func (p Plugins) names() []string {
+	kk := make([]string, 0, len(p.Plugin))
+	for k := range p.Plugin {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+func readPluginFile(path string) (Plugins, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Plugins{}, err
+	}
+
+	var pp Plugins
+	if err := yaml.Unmarshal(raw, &pp); err != nil {
+		return pp, err
+	}
+	if len(pp.Plugin) > 0 {
+		return pp, nil
+	}
+
+	var plugin Plugin
+	if err := yaml.Unmarshal(raw, &plugin); err != nil {
+		return pp, err
+	}
+	if plugin.ShortCut == "" {
+		return pp, fmt.Errorf("no plugin definition found")
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	return Plugins{Plugin: map[string]Plugin{name: plugin}}, nil
+}
+
+func overlaps(s1, s2 []string) bool {
+	if InList(s1, "all") || InList(s2, "all") {
+		return true
+	}
+	for _, s := range s1 {
+		if InList(s2, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isYAML(file string) bool {
+	ext := filepath.Ext(file)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+func xdgPluginsDir() string {
+	home := os.Getenv("XDG_CONFIG_HOME")
+	if home == "" {
+		home = filepath.Join(mustK9sHome(), ".config")
+	}
+
+	return filepath.Join(home, "k9s", "plugins")
+}
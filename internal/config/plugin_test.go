@@ -19,4 +19,35 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, []string{"po", "dp"}, k.Scopes)
 	assert.Equal(t, "duh", k.Command)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
+	assert.Equal(t, "testdata/plugin.yml", k.Source)
+	assert.False(t, k.Disabled)
+}
+
+func TestPluginLoadDir(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins("testdata/plugin.yml"))
+	assert.NotNil(t, p.LoadPluginsDir("testdata/plugins"))
+
+	assert.Equal(t, 3, len(p.Plugin))
+	k, ok := p.Plugin["log_tail"]
+	assert.True(t, ok)
+	assert.Equal(t, "Ctrl-L", k.ShortCut)
+	assert.Equal(t, []string{"po"}, k.Scopes)
+	assert.Equal(t, "kubectl", k.Command)
+	assert.Equal(t, "testdata/plugins/log_tail.yml", k.Source)
+
+	k, ok = p.Plugin["blah"]
+	assert.True(t, ok)
+	assert.Equal(t, "blee", k.Description)
+
+	_, ok = p.Plugin["dive"]
+	assert.True(t, ok)
+	_, ok = p.Plugin["zorg"]
+	assert.False(t, ok)
+}
+
+func TestPluginLoadDirMissing(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPluginsDir("testdata/blee"))
+	assert.Equal(t, 0, len(p.Plugin))
 }
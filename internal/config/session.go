@@ -0,0 +1,30 @@
+package config
+
+// Session tracks a cluster navigation state.
+type Session struct {
+	Views []SessionView `yaml:"views,omitempty"`
+}
+
+// SessionView tracks a resource view navigation state.
+type SessionView struct {
+	GVR        string `yaml:"gvr"`
+	Path       string `yaml:"path,omitempty"`
+	Labels     string `yaml:"labels,omitempty"`
+	Fields     string `yaml:"fields,omitempty"`
+	Filter     string `yaml:"filter,omitempty"`
+	SortColumn string `yaml:"sortColumn,omitempty"`
+	SortAsc    bool   `yaml:"sortAsc,omitempty"`
+}
+
+// Restorable returns true if the session holds more than a plain resource view.
+func (s *Session) Restorable() bool {
+	if s == nil || len(s.Views) == 0 {
+		return false
+	}
+	if len(s.Views) > 1 {
+		return true
+	}
+	v := s.Views[0]
+
+	return v.Path != "" || v.Labels != "" || v.Fields != "" || v.Filter != ""
+}
@@ -0,0 +1,37 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRestorable(t *testing.T) {
+	uu := map[string]struct {
+		s *config.Session
+		e bool
+	}{
+		"nil":   {},
+		"empty": {s: &config.Session{}},
+		"plain": {s: &config.Session{Views: []config.SessionView{{GVR: "v1/pods"}}}},
+		"filter": {
+			s: &config.Session{Views: []config.SessionView{{GVR: "v1/pods", Filter: "fred"}}},
+			e: true,
+		},
+		"stack": {
+			s: &config.Session{Views: []config.SessionView{
+				{GVR: "apps/v1/deployments"},
+				{GVR: "v1/pods", Path: "default/dp1", Labels: "app=dp1"},
+			}},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.s.Restorable())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package config
+
+const (
+	defaultShellPodImage     = "busybox:1.31"
+	defaultShellPodNamespace = "default"
+)
+
+// ShellPod tracks node shell pod configuration.
+type ShellPod struct {
+	Image     string   `yaml:"image"`
+	Namespace string   `yaml:"namespace"`
+	Command   []string `yaml:"command,omitempty"`
+	Template  string   `yaml:"template,omitempty"`
+}
+
+// NewShellPod returns a new instance.
+func NewShellPod() *ShellPod {
+	return &ShellPod{
+		Image:     defaultShellPodImage,
+		Namespace: defaultShellPodNamespace,
+		Command:   defaultShellPodCommand(),
+	}
+}
+
+// Validate checks the shell pod settings and uses defaults if not set.
+func (s *ShellPod) Validate() {
+	if s.Image == "" {
+		s.Image = defaultShellPodImage
+	}
+	if s.Namespace == "" {
+		s.Namespace = defaultShellPodNamespace
+	}
+	if len(s.Command) == 0 {
+		s.Command = defaultShellPodCommand()
+	}
+}
+
+// defaultShellPodCommand enters the node namespaces via the host init process.
+func defaultShellPodCommand() []string {
+	return []string{"nsenter", "-t", "1", "-m", "-u", "-i", "-n", "-p", "--", "sh"}
+}
@@ -0,0 +1,40 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellPodValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e config.ShellPod
+	}{
+		"blank": {
+			e: *config.NewShellPod(),
+		},
+		"custom": {
+			s: config.ShellPod{Image: "alpine", Namespace: "fred", Command: []string{"bash"}},
+			e: config.ShellPod{Image: "alpine", Namespace: "fred", Command: []string{"bash"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate()
+			assert.Equal(t, u.e, u.s)
+		})
+	}
+}
+
+func TestK9sGetShellPod(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, config.NewShellPod(), k.GetShellPod())
+
+	k.ShellPod = &config.ShellPod{Template: "fred.yml"}
+	s := k.GetShellPod()
+	assert.Equal(t, "fred.yml", s.Template)
+	assert.Equal(t, "busybox:1.31", s.Image)
+}
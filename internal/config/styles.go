@@ -123,6 +123,26 @@ type (
 		CursorColor Color       `yaml:"cursorColor"`
 		MarkColor   Color       `yaml:"markColor"`
 		Header      TableHeader `yaml:"header"`
+		Gauge       TableGauge  `yaml:"gauge"`
+		Fit         TableFit    `yaml:"fit"`
+	}
+
+	// TableGauge tracks table metrics gauge styles.
+	TableGauge struct {
+		OkColor       Color `yaml:"okColor"`
+		WarnColor     Color `yaml:"warnColor"`
+		CritColor     Color `yaml:"critColor"`
+		WarnThreshold int   `yaml:"warnThreshold"`
+		CritThreshold int   `yaml:"critThreshold"`
+	}
+
+	// TableFit tracks table usage vs requests styles.
+	TableFit struct {
+		UnderColor     Color `yaml:"underColor"`
+		OkColor        Color `yaml:"okColor"`
+		OverColor      Color `yaml:"overColor"`
+		UnderThreshold int   `yaml:"underThreshold"`
+		OverThreshold  int   `yaml:"overThreshold"`
 	}
 
 	// TableHeader tracks table header styles.
@@ -314,6 +334,54 @@ func newTable() Table {
 		CursorColor: "aqua",
 		MarkColor:   "palegreen",
 		Header:      newTableHeader(),
+		Gauge:       newTableGauge(),
+		Fit:         newTableFit(),
+	}
+}
+
+// NewTableGauge returns a new table gauge style.
+func newTableGauge() TableGauge {
+	return TableGauge{
+		OkColor:       "palegreen",
+		WarnColor:     "orange",
+		CritColor:     "orangered",
+		WarnThreshold: 70,
+		CritThreshold: 90,
+	}
+}
+
+// ColorFor returns the gauge color for a given percentage.
+func (g TableGauge) ColorFor(perc int) tcell.Color {
+	switch {
+	case g.CritThreshold > 0 && perc >= g.CritThreshold:
+		return g.CritColor.Color()
+	case g.WarnThreshold > 0 && perc >= g.WarnThreshold:
+		return g.WarnColor.Color()
+	default:
+		return g.OkColor.Color()
+	}
+}
+
+// NewTableFit returns a new table fit style.
+func newTableFit() TableFit {
+	return TableFit{
+		UnderColor:     "dodgerblue",
+		OkColor:        "palegreen",
+		OverColor:      "orangered",
+		UnderThreshold: -50,
+		OverThreshold:  0,
+	}
+}
+
+// ColorFor returns the fit color for a given usage delta percentage.
+func (f TableFit) ColorFor(delta int) tcell.Color {
+	switch {
+	case delta > f.OverThreshold:
+		return f.OverColor.Color()
+	case delta < f.UnderThreshold:
+		return f.UnderColor.Color()
+	default:
+		return f.OkColor.Color()
 	}
 }
 
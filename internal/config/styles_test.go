@@ -60,3 +60,43 @@ func TestSkinBoarked(t *testing.T) {
 	s := config.NewStyles()
 	assert.NotNil(t, s.Load("testdata/skin_boarked.yml"))
 }
+
+func TestTableGaugeColorFor(t *testing.T) {
+	uu := map[string]struct {
+		perc int
+		e    tcell.Color
+	}{
+		"ok":   {perc: 10, e: tcell.ColorPaleGreen},
+		"warn": {perc: 70, e: tcell.ColorOrange},
+		"crit": {perc: 95, e: tcell.ColorOrangeRed},
+		"over": {perc: 250, e: tcell.ColorOrangeRed},
+	}
+
+	g := config.NewStyles().Table().Gauge
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, g.ColorFor(u.perc))
+		})
+	}
+}
+
+func TestTableFitColorFor(t *testing.T) {
+	uu := map[string]struct {
+		delta int
+		e     tcell.Color
+	}{
+		"under": {delta: -80, e: tcell.ColorDodgerBlue},
+		"ok":    {delta: -20, e: tcell.ColorPaleGreen},
+		"at":    {delta: 0, e: tcell.ColorPaleGreen},
+		"over":  {delta: 35, e: tcell.ColorOrangeRed},
+	}
+
+	f := config.NewStyles().Table().Fit
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f.ColorFor(u.delta))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// K9sTemplatesDir tracks resource creation templates.
+var K9sTemplatesDir = filepath.Join(K9sHome, "templates")
+
+var templateExts = []string{".yaml", ".yml"}
+
+// TemplateFor returns the first template matching the given resource names.
+func TemplateFor(names ...string) (string, bool) {
+	return templateIn(K9sTemplatesDir, names...)
+}
+
+func templateIn(dir string, names ...string) (string, bool) {
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		for _, ext := range templateExts {
+			path := filepath.Join(dir, n+ext)
+			if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+				return path, true
+			}
+		}
+	}
+
+	return "", false
+}
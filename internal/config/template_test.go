@@ -0,0 +1,28 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateIn(t *testing.T) {
+	uu := map[string]struct {
+		names []string
+		path  string
+		ok    bool
+	}{
+		"singular": {names: []string{"deployment"}, path: "testdata/templates/deployment.yml", ok: true},
+		"fallback": {names: []string{"", "dp", "deployment"}, path: "testdata/templates/deployment.yml", ok: true},
+		"missing":  {names: []string{"pod"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path, ok := templateIn("testdata/templates", u.names...)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
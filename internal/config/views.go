@@ -3,7 +3,10 @@ package config
 import (
 	"io/ioutil"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"gopkg.in/yaml.v2"
 )
 
@@ -18,7 +21,53 @@ type ViewConfigListener interface {
 
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns []string `yaml:"columns"`
+	Columns []string           `yaml:"columns"`
+	Metrics []client.PromQuery `yaml:"metrics,omitempty"`
+	Colors  []ColorRule        `yaml:"colors,omitempty"`
+
+	// ServerTable renders the columns computed by the api server rather than k9s.
+	ServerTable bool `yaml:"serverTable,omitempty"`
+
+	// RefreshRate overrides the global refresh rate in seconds.
+	RefreshRate int `yaml:"refreshRate,omitempty"`
+}
+
+// ColorRule colors rows whose column value matches a regular expression.
+type ColorRule struct {
+	Column string `yaml:"column"`
+	Match  string `yaml:"match"`
+	Color  Color  `yaml:"color"`
+}
+
+// ColumnNames returns the view column names stripped of their field expressions.
+func (v ViewSetting) ColumnNames() []string {
+	cc := make([]string, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		if f, ok := parseFieldColumn(c); ok {
+			c = f.Name
+		}
+		cc = append(cc, c)
+	}
+
+	return cc
+}
+
+// FieldColumns returns the columns evaluated from field expressions, ie NODE:.spec.nodeName.
+func (v ViewSetting) FieldColumns() []FieldColumn {
+	ff := make([]FieldColumn, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		if f, ok := parseFieldColumn(c); ok {
+			ff = append(ff, f)
+		}
+	}
+
+	return ff
+}
+
+// FieldColumn represents a column evaluated from a JSONPath expression against the live resource.
+type FieldColumn struct {
+	Name string
+	Path string
 }
 
 // ViewSettings represent a collection of view configurations.
@@ -83,6 +132,42 @@ func (v *CustomView) RemoveListener(gvr string) {
 
 }
 
+// PromQueries returns the PromQL metric columns for a given resource.
+func (v *CustomView) PromQueries(gvr string) []client.PromQuery {
+	if v == nil {
+		return nil
+	}
+
+	return v.K9s.Views[gvr].Metrics
+}
+
+// FieldColumns returns the JSONPath backed columns for a given resource.
+func (v *CustomView) FieldColumns(gvr string) []FieldColumn {
+	if v == nil {
+		return nil
+	}
+
+	return v.K9s.Views[gvr].FieldColumns()
+}
+
+// ServerTable returns true if a given resource should be rendered by the api server.
+func (v *CustomView) ServerTable(gvr string) bool {
+	if v == nil {
+		return false
+	}
+
+	return v.K9s.Views[gvr].ServerTable
+}
+
+// RefreshRate returns a given resource refresh rate override or 0 if none.
+func (v *CustomView) RefreshRate(gvr string) time.Duration {
+	if v == nil {
+		return 0
+	}
+
+	return time.Duration(v.K9s.Views[gvr].RefreshRate) * time.Second
+}
+
 func (v *CustomView) fireConfigChanged() {
 	for gvr, list := range v.listeners {
 		if v, ok := v.K9s.Views[gvr]; ok {
@@ -90,3 +175,20 @@ func (v *CustomView) fireConfigChanged() {
 		}
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseFieldColumn extracts a column name and field expression in the shape of NAME:.path.
+func parseFieldColumn(c string) (FieldColumn, bool) {
+	i := strings.Index(c, ":")
+	if i <= 0 || i == len(c)-1 {
+		return FieldColumn{}, false
+	}
+	path := strings.TrimSpace(c[i+1:])
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "{") {
+		return FieldColumn{}, false
+	}
+
+	return FieldColumn{Name: strings.TrimSpace(c[:i]), Path: path}, true
+}
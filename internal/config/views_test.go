@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,68 @@ func TestViewSettingsLoad(t *testing.T) {
 	cfg := config.NewCustomView()
 
 	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
-	assert.Equal(t, 1, len(cfg.K9s.Views))
-	assert.Equal(t, 4, len(cfg.K9s.Views["v1/pods"].Columns))
+	assert.Equal(t, 2, len(cfg.K9s.Views))
+	assert.Equal(t, 6, len(cfg.K9s.Views["v1/pods"].Columns))
+}
+
+func TestViewSettingsPromQueries(t *testing.T) {
+	cfg := config.NewCustomView()
+
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+	qq := cfg.PromQueries("v1/pods")
+	assert.Equal(t, 1, len(qq))
+	assert.Equal(t, "RPS", qq[0].Name)
+	assert.Equal(t, 0, len(cfg.PromQueries("v1/nodes")))
+}
+
+func TestViewSettingsFieldColumns(t *testing.T) {
+	cfg := config.NewCustomView()
+
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+	assert.Equal(t, []config.FieldColumn{
+		{Name: "NODE", Path: ".spec.nodeName"},
+		{Name: "TEAM", Path: "{.metadata.labels.team}"},
+	}, cfg.FieldColumns("v1/pods"))
+	assert.Equal(t, 0, len(cfg.FieldColumns("v1/nodes")))
+}
+
+func TestViewSettingColumnNames(t *testing.T) {
+	v := config.ViewSetting{
+		Columns: []string{"NAME", "PROBES(L:R)", "NODE:.spec.nodeName", ":.spec.bozo", "FRED:"},
+	}
+
+	assert.Equal(t, []string{"NAME", "PROBES(L:R)", "NODE", ":.spec.bozo", "FRED:"}, v.ColumnNames())
+	assert.Equal(t, []config.FieldColumn{{Name: "NODE", Path: ".spec.nodeName"}}, v.FieldColumns())
+}
+
+func TestViewSettingsColors(t *testing.T) {
+	cfg := config.NewCustomView()
+
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+	assert.Equal(t, []config.ColorRule{
+		{Column: "STATUS", Match: "^Evicted$", Color: "gray"},
+	}, cfg.K9s.Views["v1/pods"].Colors)
+}
+
+func TestViewSettingsServerTable(t *testing.T) {
+	cfg := config.NewCustomView()
+
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+	assert.True(t, cfg.ServerTable("stable.example.com/v1/crontabs"))
+	assert.False(t, cfg.ServerTable("v1/pods"))
+	assert.False(t, cfg.ServerTable("v1/nodes"))
+
+	var nilCfg *config.CustomView
+	assert.False(t, nilCfg.ServerTable("v1/pods"))
+}
+
+func TestViewSettingsRefreshRate(t *testing.T) {
+	cfg := config.NewCustomView()
+
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+	assert.Equal(t, 10*time.Second, cfg.RefreshRate("v1/pods"))
+	assert.Equal(t, time.Duration(0), cfg.RefreshRate("v1/nodes"))
+
+	var nilCfg *config.CustomView
+	assert.Equal(t, time.Duration(0), nilCfg.RefreshRate("v1/pods"))
 }
@@ -0,0 +1,330 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// AccessVerbs tracks the verbs checked by access reviews.
+var AccessVerbs = []string{
+	"get",
+	"list",
+	"watch",
+	"create",
+	"patch",
+	"update",
+	"delete",
+	"deletecollection",
+}
+
+const (
+	// MaxReviewSubjects caps the number of subjects reviewed by WhoCan.
+	MaxReviewSubjects = 500
+
+	reviewWorkers = 10
+)
+
+var _ Accessor = (*Access)(nil)
+
+// Access represents access reviews results.
+type Access struct {
+	NonResource
+}
+
+// List returns a collection of access reviews results.
+func (a *Access) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	rr, ok := ctx.Value(internal.KeyAccess).([]render.AccessRes)
+	if !ok {
+		return nil, errors.New("no access reviews found in context")
+	}
+
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+// CanI reviews the current user access to a resource across all namespaces.
+func CanI(f Factory, gvr string, verbs []string) ([]render.AccessRes, error) {
+	verbs = expandVerbs(verbs)
+	meta, err := MetaAccess.MetaFor(client.NewGVR(gvr))
+	if err != nil {
+		return nil, err
+	}
+	nss := []string{client.ClusterScope}
+	if meta.Namespaced {
+		nss = []string{client.AllNamespaces}
+		nn, err := f.Client().ValidNamespaces()
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nn {
+			nss = append(nss, n.Name)
+		}
+	}
+	user, err := f.Client().Config().CurrentUserName()
+	if err != nil {
+		return nil, err
+	}
+
+	dial := f.Client().DialOrDie().AuthorizationV1().SelfSubjectAccessReviews()
+	rr := make([]render.AccessRes, 0, len(nss))
+	for _, ns := range nss {
+		res := render.AccessRes{
+			Kind:      rbacv1.UserKind,
+			Subject:   user,
+			Namespace: accessNamespace(ns),
+			Resource:  client.NewGVR(gvr).R(),
+			Checked:   verbs,
+		}
+		for _, v := range verbs {
+			sar := authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: resourceAttributes(gvr, "", v),
+				},
+			}
+			sar.Spec.ResourceAttributes.Namespace = reviewNamespace(ns)
+			resp, err := dial.Create(&sar)
+			if err != nil {
+				return nil, err
+			}
+			if resp.Status.Allowed {
+				res.Allowed = append(res.Allowed, v)
+			}
+		}
+		rr = append(rr, res)
+	}
+
+	return rr, nil
+}
+
+// WhoCan reviews all bound subjects access to a given resource.
+func WhoCan(ctx context.Context, f Factory, gvr, path string, verbs []string) ([]render.AccessRes, error) {
+	verbs = expandVerbs(verbs)
+	crbs, err := fetchClusterRoleBindings(f)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(f)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := reviewSubjects(crbs, rbs)
+	if len(ss) > MaxReviewSubjects {
+		return nil, fmt.Errorf("too many subjects to review (%d). Max is %d", len(ss), MaxReviewSubjects)
+	}
+
+	ns, _ := client.Namespaced(path)
+	dial := f.Client().DialOrDie().AuthorizationV1().SubjectAccessReviews()
+	aa, err := reviewAll(ctx, ss, verbs, func(s rbacv1.Subject, verb string) (bool, error) {
+		resp, err := dial.Create(subjectReview(s, resourceAttributes(gvr, path, verb)))
+		if err != nil {
+			return false, err
+		}
+		return resp.Status.Allowed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]render.AccessRes, 0, len(ss))
+	for i, s := range ss {
+		if len(aa[i]) == 0 {
+			continue
+		}
+		rr = append(rr, render.AccessRes{
+			Kind:      s.Kind,
+			Subject:   FQN(s.Namespace, s.Name),
+			Namespace: ns,
+			Resource:  client.NewGVR(gvr).R(),
+			Checked:   verbs,
+			Allowed:   aa[i],
+		})
+	}
+
+	return rr, nil
+}
+
+type reviewFunc func(s rbacv1.Subject, verb string) (bool, error)
+
+// reviewAll reviews subjects concurrently and returns the allowed verbs per subject.
+func reviewAll(ctx context.Context, ss []rbacv1.Subject, verbs []string, review reviewFunc) ([][]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		aa    = make([][]string, len(ss))
+		jobs  = make(chan int)
+		wg    sync.WaitGroup
+		mx    sync.Mutex
+		first error
+	)
+	fail := func(err error) {
+		mx.Lock()
+		defer mx.Unlock()
+		if first == nil {
+			first = err
+			cancel()
+		}
+	}
+	for w := 0; w < reviewWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				for _, v := range verbs {
+					if ctx.Err() != nil {
+						break
+					}
+					ok, err := review(ss[i], v)
+					if err != nil {
+						fail(err)
+						break
+					}
+					if ok {
+						aa[i] = append(aa[i], v)
+					}
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range ss {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if first != nil {
+		return nil, first
+	}
+
+	return aa, ctx.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func expandVerbs(verbs []string) []string {
+	for _, v := range verbs {
+		if v == "*" || v == "all" {
+			return AccessVerbs
+		}
+	}
+
+	return verbs
+}
+
+func accessNamespace(ns string) string {
+	switch {
+	case client.IsClusterScoped(ns):
+		return ""
+	case client.IsAllNamespaces(ns):
+		return client.NamespaceAll
+	default:
+		return ns
+	}
+}
+
+func reviewNamespace(ns string) string {
+	if client.IsClusterWide(ns) {
+		return ""
+	}
+
+	return ns
+}
+
+func resourceAttributes(gvr, path, verb string) *authorizationv1.ResourceAttributes {
+	g := client.NewGVR(gvr)
+	res := g.GVR()
+	ns, n := client.Namespaced(path)
+
+	return &authorizationv1.ResourceAttributes{
+		Namespace:   reviewNamespace(ns),
+		Name:        n,
+		Verb:        verb,
+		Group:       res.Group,
+		Resource:    res.Resource,
+		Subresource: g.SubResource(),
+	}
+}
+
+// reviewSubjects returns all distinct subjects held by bindings.
+func reviewSubjects(crbs []rbacv1.ClusterRoleBinding, rbs []rbacv1.RoleBinding) []rbacv1.Subject {
+	seen := make(map[string]struct{})
+	var ss []rbacv1.Subject
+	add := func(s rbacv1.Subject, ns string) {
+		if s.Kind == rbacv1.ServiceAccountKind && s.Namespace == "" {
+			s.Namespace = ns
+		}
+		s.APIGroup = ""
+		k := s.Kind + ":" + FQN(s.Namespace, s.Name)
+		if _, ok := seen[k]; ok {
+			return
+		}
+		seen[k] = struct{}{}
+		ss = append(ss, s)
+	}
+	for _, crb := range crbs {
+		for _, s := range crb.Subjects {
+			add(s, "")
+		}
+	}
+	for _, rb := range rbs {
+		for _, s := range rb.Subjects {
+			add(s, rb.Namespace)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Kind != ss[j].Kind {
+			return ss[i].Kind < ss[j].Kind
+		}
+		return FQN(ss[i].Namespace, ss[i].Name) < FQN(ss[j].Namespace, ss[j].Name)
+	})
+
+	return ss
+}
+
+func subjectReview(s rbacv1.Subject, attrs *authorizationv1.ResourceAttributes) *authorizationv1.SubjectAccessReview {
+	sar := authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: attrs,
+		},
+	}
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		ss := subjectsFor(s.Kind, FQN(s.Namespace, s.Name))
+		for _, sub := range ss[1:] {
+			switch sub.Kind {
+			case rbacv1.UserKind:
+				sar.Spec.User = sub.Name
+			case rbacv1.GroupKind:
+				sar.Spec.Groups = append(sar.Spec.Groups, sub.Name)
+			}
+		}
+	case rbacv1.GroupKind:
+		sar.Spec.Groups = []string{s.Name}
+	default:
+		sar.Spec.User = s.Name
+	}
+
+	return &sar
+}
@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestExpandVerbs(t *testing.T) {
+	uu := map[string]struct {
+		verbs, e []string
+	}{
+		"plain": {verbs: []string{"get", "list"}, e: []string{"get", "list"}},
+		"star":  {verbs: []string{"*"}, e: AccessVerbs},
+		"all":   {verbs: []string{"get", "all"}, e: AccessVerbs},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, expandVerbs(u.verbs))
+		})
+	}
+}
+
+func TestResourceAttributes(t *testing.T) {
+	a := resourceAttributes("apps/v1/deployments", "default/fred", "get")
+
+	assert.Equal(t, "default", a.Namespace)
+	assert.Equal(t, "fred", a.Name)
+	assert.Equal(t, "get", a.Verb)
+	assert.Equal(t, "apps", a.Group)
+	assert.Equal(t, "deployments", a.Resource)
+}
+
+func TestReviewSubjects(t *testing.T) {
+	crbs := []rbacv1.ClusterRoleBinding{
+		{Subjects: []rbacv1.Subject{
+			{Kind: rbacv1.UserKind, Name: "fred"},
+			{Kind: rbacv1.GroupKind, Name: "blee"},
+		}},
+	}
+	rbs := []rbacv1.RoleBinding{}
+	rb := rbacv1.RoleBinding{Subjects: []rbacv1.Subject{
+		{Kind: rbacv1.UserKind, Name: "fred"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "sa1"},
+	}}
+	rb.Namespace = "default"
+	rbs = append(rbs, rb)
+
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.GroupKind, Name: "blee"},
+		{Kind: rbacv1.ServiceAccountKind, Namespace: "default", Name: "sa1"},
+		{Kind: rbacv1.UserKind, Name: "fred"},
+	}, reviewSubjects(crbs, rbs))
+}
+
+func TestReviewAll(t *testing.T) {
+	ss := make([]rbacv1.Subject, 50)
+	for i := range ss {
+		ss[i] = rbacv1.Subject{Kind: rbacv1.UserKind, Name: fmt.Sprintf("u%d", i)}
+	}
+
+	var inflight, peak int32
+	aa, err := reviewAll(context.Background(), ss, []string{"get", "list"}, func(s rbacv1.Subject, verb string) (bool, error) {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		return s.Name == "u3" && verb == "list", nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(ss), len(aa))
+	assert.Equal(t, []string{"list"}, aa[3])
+	assert.Nil(t, aa[4])
+	assert.True(t, atomic.LoadInt32(&peak) <= reviewWorkers)
+}
+
+func TestReviewAllFailed(t *testing.T) {
+	ss := make([]rbacv1.Subject, 50)
+	var calls int32
+	_, err := reviewAll(context.Background(), ss, AccessVerbs, func(rbacv1.Subject, string) (bool, error) {
+		atomic.AddInt32(&calls, 1)
+		return false, errors.New("boom")
+	})
+
+	assert.Equal(t, errors.New("boom"), err)
+	assert.True(t, atomic.LoadInt32(&calls) <= reviewWorkers)
+}
+
+func TestReviewAllCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := reviewAll(ctx, make([]rbacv1.Subject, 10), AccessVerbs, func(rbacv1.Subject, string) (bool, error) {
+		return true, nil
+	})
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestSubjectReview(t *testing.T) {
+	uu := map[string]struct {
+		s      rbacv1.Subject
+		user   string
+		groups []string
+	}{
+		"user": {
+			s:    rbacv1.Subject{Kind: rbacv1.UserKind, Name: "fred"},
+			user: "fred",
+		},
+		"group": {
+			s:      rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "blee"},
+			groups: []string{"blee"},
+		},
+		"sa": {
+			s:    rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "default", Name: "sa1"},
+			user: "system:serviceaccount:default:sa1",
+			groups: []string{
+				"system:serviceaccounts",
+				"system:serviceaccounts:default",
+				"system:authenticated",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sar := subjectReview(u.s, resourceAttributes("v1/pods", "default/p1", "get"))
+			assert.Equal(t, u.user, sar.Spec.User)
+			assert.Equal(t, u.groups, sar.Spec.Groups)
+			assert.Equal(t, "p1", sar.Spec.ResourceAttributes.Name)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// AlertFlash tracks alerts raised by flash messages.
+	AlertFlash = "flash"
+	// AlertNotification tracks alerts raised by notification rules.
+	AlertNotification = "notification"
+	// AlertAPI tracks alerts raised by api server calls.
+	AlertAPI = "api"
+
+	maxAlerts = 200
+
+	// alertDedupWindow collapses a message reported by several paths at once.
+	alertDedupWindow = time.Second
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// Alert represents the alerts inbox.
+type Alert struct {
+	NonResource
+}
+
+// List returns the inbox alerts.
+func (a *Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	inbox, ok := ctx.Value(internal.KeyAlerts).(*AlertInbox)
+	if !ok {
+		return nil, fmt.Errorf("expecting *AlertInbox but got %T", ctx.Value(internal.KeyAlerts))
+	}
+
+	aa := inbox.List()
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, res := range aa {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// AlertListener listens to alerts inbox changes.
+type AlertListener interface {
+	// AlertsChanged notifies the count of unacknowledged alerts changed.
+	AlertsChanged(unacked int)
+}
+
+// AlertInbox collects application alerts until they are acknowledged.
+type AlertInbox struct {
+	alerts    []render.AlertRes
+	seq       int
+	listeners []AlertListener
+	mx        sync.RWMutex
+}
+
+// NewAlertInbox returns a new inbox.
+func NewAlertInbox() *AlertInbox {
+	return &AlertInbox{}
+}
+
+// AddListener registers an inbox listener.
+func (a *AlertInbox) AddListener(l AlertListener) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.listeners = append(a.listeners, l)
+}
+
+// Raise records a new alert or bumps an existing one with the same message.
+func (a *AlertInbox) Raise(source, level, msg string) {
+	a.raise(source, level, msg, time.Now())
+}
+
+func (a *AlertInbox) raise(source, level, msg string, at time.Time) {
+	a.mx.Lock()
+	var found bool
+	for i := len(a.alerts) - 1; i >= 0; i-- {
+		al := &a.alerts[i]
+		if al.Message != msg {
+			continue
+		}
+		found = true
+		if at.Sub(al.Last) < alertDedupWindow {
+			if source != AlertFlash {
+				al.Source = source
+			}
+			break
+		}
+		al.Level, al.Acked, al.Last = level, false, at
+		al.Count++
+		break
+	}
+	if !found {
+		a.seq++
+		a.alerts = append(a.alerts, render.AlertRes{
+			ID:      strconv.Itoa(a.seq),
+			Source:  source,
+			Level:   level,
+			Message: msg,
+			Count:   1,
+			First:   at,
+			Last:    at,
+		})
+		if len(a.alerts) > maxAlerts {
+			a.alerts = a.alerts[len(a.alerts)-maxAlerts:]
+		}
+	}
+	a.mx.Unlock()
+
+	a.fireChanged()
+}
+
+// Ack acknowledges the given alerts.
+func (a *AlertInbox) Ack(ids ...string) {
+	a.mx.Lock()
+	for _, id := range ids {
+		for i := range a.alerts {
+			if a.alerts[i].ID == id {
+				a.alerts[i].Acked = true
+			}
+		}
+	}
+	a.mx.Unlock()
+
+	a.fireChanged()
+}
+
+// AckAll acknowledges all alerts.
+func (a *AlertInbox) AckAll() {
+	a.mx.Lock()
+	for i := range a.alerts {
+		a.alerts[i].Acked = true
+	}
+	a.mx.Unlock()
+
+	a.fireChanged()
+}
+
+// Clear removes acknowledged alerts.
+func (a *AlertInbox) Clear() {
+	a.mx.Lock()
+	aa := a.alerts[:0]
+	for _, al := range a.alerts {
+		if !al.Acked {
+			aa = append(aa, al)
+		}
+	}
+	a.alerts = aa
+	a.mx.Unlock()
+
+	a.fireChanged()
+}
+
+// Unacked returns the count of unacknowledged alerts.
+func (a *AlertInbox) Unacked() int {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	var count int
+	for _, al := range a.alerts {
+		if !al.Acked {
+			count++
+		}
+	}
+
+	return count
+}
+
+// List returns all alerts.
+func (a *AlertInbox) List() []render.AlertRes {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	aa := make([]render.AlertRes, len(a.alerts))
+	copy(aa, a.alerts)
+
+	return aa
+}
+
+func (a *AlertInbox) fireChanged() {
+	count := a.Unacked()
+
+	a.mx.RLock()
+	ll := make([]AlertListener, len(a.listeners))
+	copy(ll, a.listeners)
+	a.mx.RUnlock()
+
+	for _, l := range ll {
+		l.AlertsChanged(count)
+	}
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertInboxRaise(t *testing.T) {
+	var l alertListener
+	a, now := NewAlertInbox(), time.Now()
+	a.AddListener(&l)
+
+	a.raise(AlertAPI, render.AlertErr, "boom", now)
+	a.raise(AlertFlash, render.AlertErr, "boom", now.Add(10*time.Millisecond))
+	a.raise(AlertNotification, render.AlertWarn, "crash: default/p1 is CrashLoopBackOff", now)
+	a.raise(AlertFlash, render.AlertErr, "boom", now.Add(time.Minute))
+
+	aa := a.List()
+	assert.Equal(t, 2, len(aa))
+	assert.Equal(t, "1", aa[0].ID)
+	assert.Equal(t, AlertAPI, aa[0].Source)
+	assert.Equal(t, 2, aa[0].Count)
+	assert.Equal(t, now.Add(time.Minute), aa[0].Last)
+	assert.Equal(t, 2, l.count)
+}
+
+func TestAlertInboxAck(t *testing.T) {
+	var l alertListener
+	a, now := NewAlertInbox(), time.Now()
+	a.AddListener(&l)
+	a.raise(AlertAPI, render.AlertErr, "a1", now)
+	a.raise(AlertAPI, render.AlertErr, "a2", now)
+	a.raise(AlertAPI, render.AlertErr, "a3", now)
+
+	a.Ack("1", "3")
+	assert.Equal(t, 1, a.Unacked())
+	assert.Equal(t, 1, l.count)
+
+	a.Clear()
+	aa := a.List()
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, "a2", aa[0].Message)
+
+	a.raise(AlertAPI, render.AlertErr, "a1", now.Add(time.Minute))
+	a.AckAll()
+	assert.Equal(t, 0, l.count)
+	assert.Equal(t, 2, len(a.List()))
+}
+
+func TestAlertList(t *testing.T) {
+	inbox := NewAlertInbox()
+	inbox.Raise(AlertFlash, render.AlertErr, "boom")
+
+	var a Alert
+	_, err := a.List(context.Background(), "")
+	assert.NotNil(t, err)
+
+	oo, err := a.List(context.WithValue(context.Background(), internal.KeyAlerts, inbox), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+}
+
+// Helpers...
+
+type alertListener struct {
+	count int
+}
+
+func (l *alertListener) AlertsChanged(count int) {
+	l.count = count
+}
@@ -32,10 +32,26 @@ func NewAlias(f Factory) *Alias {
 
 // Check verifies an alias is defined for this command.
 func (a *Alias) Check(cmd string) bool {
-	_, ok := a.Aliases.Get(cmd)
+	_, ok := a.Expand(cmd)
 	return ok
 }
 
+// Expand retrieves an alias parameters, falling back to fully qualified
+// resources ie apps/v1/deployments.
+func (a *Alias) Expand(cmd string) (config.AliasArgs, bool) {
+	if args, ok := a.Aliases.Expand(cmd); ok {
+		return args, true
+	}
+	if !strings.Contains(cmd, "/") {
+		return config.AliasArgs{}, false
+	}
+	if _, err := MetaAccess.MetaFor(client.NewGVR(cmd)); err != nil {
+		return config.AliasArgs{}, false
+	}
+
+	return config.AliasArgs{GVR: cmd}, true
+}
+
 // List returns a collection of aliases.
 func (a *Alias) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	aa, ok := ctx.Value(internal.KeyAliases).(*Alias)
@@ -44,9 +60,14 @@ func (a *Alias) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	}
 	m := aa.ShortNames()
 	oo := make([]runtime.Object, 0, len(m))
-	for gvr, aliases := range m {
+	for cmd, aliases := range m {
 		sort.StringSlice(aliases).Sort()
-		oo = append(oo, render.AliasRes{GVR: gvr, Aliases: aliases})
+		args := config.ParseAliasArgs(cmd)
+		oo = append(oo, render.AliasRes{
+			GVR:     args.GVR,
+			Args:    strings.TrimSpace(strings.TrimPrefix(cmd, args.GVR)),
+			Aliases: aliases,
+		})
 	}
 
 	return oo, nil
@@ -54,9 +75,9 @@ func (a *Alias) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 
 // AsGVR returns a matching gvr if it exists.
 func (a *Alias) AsGVR(cmd string) (client.GVR, bool) {
-	gvr, ok := a.Aliases.Get(cmd)
+	args, ok := a.Expand(cmd)
 	if ok {
-		return client.NewGVR(gvr), true
+		return client.NewGVR(args.GVR), true
 	}
 	return client.GVR{}, false
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
@@ -28,6 +29,34 @@ func TestAliasList(t *testing.T) {
 	assert.Equal(t, 2, len(oo[0].(render.AliasRes).Aliases))
 }
 
+func TestAliasExpand(t *testing.T) {
+	dao.MetaAccess.RegisterMeta("v1/fred", metav1.APIResource{Name: "fred", Kind: "Fred"})
+	a := makeAliases()
+
+	uu := map[string]struct {
+		cmd string
+		gvr string
+		ok  bool
+	}{
+		"alias":   {cmd: "f", gvr: "v1/fred", ok: true},
+		"gvr":     {cmd: "v1/fred", gvr: "v1/fred", ok: true},
+		"unknown": {cmd: "v1/zorg"},
+		"bare":    {cmd: "zorg"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, ok := a.AsGVR(u.cmd)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ok, a.Check(u.cmd))
+			if ok {
+				assert.Equal(t, u.gvr, gvr.String())
+			}
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
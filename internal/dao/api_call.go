@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*APICall)(nil)
+
+// APICall represents the traced api server calls.
+type APICall struct {
+	NonResource
+}
+
+// List returns the latest api server calls.
+func (a *APICall) List(_ context.Context, _ string) ([]runtime.Object, error) {
+	conn := a.Client()
+	if conn == nil || conn.Config() == nil {
+		return nil, errors.New("no client connection")
+	}
+
+	cc := conn.Config().APICalls().List()
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, c := range cc {
+		oo = append(oo, render.APICallRes{APICall: c})
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,188 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ApplyFieldManager tracks the field manager used for server-side applies.
+	ApplyFieldManager = "k9s"
+
+	defaultNamespace = "default"
+)
+
+var _ Accessor = (*Apply)(nil)
+
+// Apply represents manifests apply results.
+type Apply struct {
+	NonResource
+}
+
+// List returns a collection of apply results.
+func (a *Apply) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	rr, ok := ctx.Value(internal.KeyApplied).([]render.ApplyRes)
+	if !ok {
+		return nil, errors.New("no apply results found in context")
+	}
+
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+// ApplyManifests server-side applies all manifests from a given file or directory.
+// Namespaced objects without a namespace land in the given namespace.
+func ApplyManifests(f Factory, path, ns string) ([]render.ApplyRes, error) {
+	if err := ensureWritable(f); err != nil {
+		return nil, err
+	}
+
+	ff, err := manifestFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse all manifests upfront so a bad file does not leave a partial apply.
+	var uu []*unstructured.Unstructured
+	for _, file := range ff {
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		oo, err := manifestObjects(string(raw))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %s", file, err)
+		}
+		uu = append(uu, oo...)
+	}
+	if len(uu) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", path)
+	}
+
+	return applyObjects(f, uu, ns), nil
+}
+
+// ApplyRaw server-side applies all objects defined in a raw manifest.
+func ApplyRaw(f Factory, raw []byte, ns string) ([]render.ApplyRes, error) {
+	if err := ensureWritable(f); err != nil {
+		return nil, err
+	}
+
+	uu, err := manifestObjects(string(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	return applyObjects(f, uu, ns), nil
+}
+
+func applyObjects(f Factory, uu []*unstructured.Unstructured, ns string) []render.ApplyRes {
+	if client.IsClusterWide(ns) {
+		ns = defaultNamespace
+	}
+	rr := make([]render.ApplyRes, 0, len(uu))
+	for _, u := range uu {
+		rr = append(rr, applyObject(f, u, ns))
+	}
+
+	return rr
+}
+
+func applyObject(f Factory, u *unstructured.Unstructured, ns string) render.ApplyRes {
+	res := render.ApplyRes{
+		Kind:      u.GetKind(),
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+	}
+	fail := func(err error) render.ApplyRes {
+		res.Result, res.Message = render.ApplyFailed, err.Error()
+		return res
+	}
+
+	gvr, meta, ok := gvrForKind(u.GetAPIVersion(), u.GetKind())
+	if !ok {
+		return fail(fmt.Errorf("unknown resource kind %q", u.GetKind()))
+	}
+	res.GVR = gvr.String()
+	if !meta.Namespaced {
+		res.Namespace = ""
+	} else if res.Namespace == "" {
+		res.Namespace = ns
+		u.SetNamespace(ns)
+	}
+
+	raw, err := json.Marshal(u.Object)
+	if err != nil {
+		return fail(err)
+	}
+	dial := dynClientFor(f, gvr, res.Namespace)
+	var rev string
+	o, err := dial.Get(res.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		rev = o.GetResourceVersion()
+	case !apierrors.IsNotFound(err):
+		return fail(err)
+	}
+
+	o, err = dial.Patch(res.Name, types.ApplyPatchType, raw, metav1.PatchOptions{FieldManager: ApplyFieldManager})
+	if err != nil {
+		return fail(err)
+	}
+	switch rev {
+	case "":
+		res.Result = render.ApplyCreated
+	case o.GetResourceVersion():
+		res.Result = render.ApplyUnchanged
+	default:
+		res.Result = render.ApplyConfigured
+	}
+
+	return res
+}
+
+func manifestFiles(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+
+	ff, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, f := range ff {
+		if f.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(f.Name())) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Join(path, f.Name()))
+		}
+	}
+
+	return files, nil
+}
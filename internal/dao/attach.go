@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// DetachKeys tracks the key sequence detaching from an attached container, ie ctrl-p ctrl-q.
+var DetachKeys = []byte{0x10, 0x11}
+
+// AttachOptions tracks container attach settings.
+type AttachOptions struct {
+	Container   string
+	Stdin, TTY  bool
+	In          io.Reader
+	Out, ErrOut io.Writer
+	SizeQueue   remotecommand.TerminalSizeQueue
+	// Detach signals the operator is done with the session.
+	Detach <-chan struct{}
+}
+
+// AttachSpec returns whether a container accepts stdin and allocates a TTY.
+func (p *Pod) AttachSpec(path, co string) (bool, bool, error) {
+	ns, n := client.Namespaced(path)
+	po, err := p.Client().DialOrDie().CoreV1().Pods(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return false, false, err
+	}
+
+	for _, c := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if c.Name == co {
+			return c.Stdin, c.TTY, nil
+		}
+	}
+	for _, c := range po.Spec.EphemeralContainers {
+		if c.Name == co {
+			return c.Stdin, c.TTY, nil
+		}
+	}
+
+	return false, false, fmt.Errorf("unable to locate container %s in pod %s", co, path)
+}
+
+// Attach attaches to a container main process until it terminates or the operator detaches.
+// It returns true if the operator detached.
+func (p *Pod) Attach(path string, opts AttachOptions) (bool, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:attach", []string{client.CreateVerb})
+	if err != nil {
+		return false, err
+	}
+	if !auth {
+		return false, fmt.Errorf("user is not authorized to attach to pod %s", path)
+	}
+
+	cfg, err := p.Client().Config().RESTConfig()
+	if err != nil {
+		return false, err
+	}
+	req := p.Client().DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("attach").
+		VersionedParams(&v1.PodAttachOptions{
+			Container: opts.Container,
+			Stdin:     opts.Stdin,
+			Stdout:    true,
+			Stderr:    !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return false, err
+	}
+	conn := connUpgrader{Upgrader: upgrader}
+	exec, err := remotecommand.NewSPDYExecutorForTransports(transport, &conn, "POST", req.URL())
+	if err != nil {
+		return false, err
+	}
+
+	keys := make(chan struct{})
+	stream := remotecommand.StreamOptions{
+		Stdout:            opts.Out,
+		Tty:               opts.TTY,
+		TerminalSizeQueue: opts.SizeQueue,
+	}
+	if opts.Stdin {
+		stream.Stdin = &detachReader{in: opts.In, keys: DetachKeys, detach: keys}
+	}
+	if !opts.TTY {
+		stream.Stderr = opts.ErrOut
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- exec.Stream(stream)
+	}()
+	select {
+	case err := <-errChan:
+		return false, err
+	case <-keys:
+	case <-opts.Detach:
+	}
+	conn.close()
+
+	return true, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// connUpgrader tracks the attach connection so a session can be detached.
+type connUpgrader struct {
+	spdy.Upgrader
+
+	mx   sync.Mutex
+	conn httpstream.Connection
+}
+
+// NewConnection validates the response and creates a new connection.
+func (c *connUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := c.Upgrader.NewConnection(resp)
+	c.mx.Lock()
+	c.conn = conn
+	c.mx.Unlock()
+
+	return conn, err
+}
+
+func (c *connUpgrader) close() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.conn == nil {
+		return
+	}
+	if err := c.conn.Close(); err != nil {
+		log.Error().Err(err).Msg("Closing attach connection")
+	}
+}
+
+// detachReader forwards input until the detach key sequence is typed.
+type detachReader struct {
+	in      io.Reader
+	keys    []byte
+	match   int
+	pending []byte
+	detach  chan struct{}
+}
+
+// Read reads input minus the detach keys.
+func (d *detachReader) Read(p []byte) (int, error) {
+	if len(d.pending) > 0 {
+		n := copy(p, d.pending)
+		d.pending = d.pending[n:]
+		return n, nil
+	}
+
+	buff := make([]byte, len(p))
+	n, err := d.in.Read(buff)
+	out := make([]byte, 0, n+len(d.keys))
+	for _, b := range buff[:n] {
+		if b == d.keys[d.match] {
+			d.match++
+			if d.match == len(d.keys) {
+				close(d.detach)
+				return copy(p, out), io.EOF
+			}
+			continue
+		}
+		out = append(out, d.keys[:d.match]...)
+		d.match = 0
+		if b == d.keys[0] {
+			d.match = 1
+			continue
+		}
+		out = append(out, b)
+	}
+	c := copy(p, out)
+	d.pending = out[c:]
+
+	return c, err
+}
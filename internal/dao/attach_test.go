@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetachReader(t *testing.T) {
+	uu := map[string]struct {
+		in       string
+		e        string
+		detached bool
+	}{
+		"plain": {
+			in: "ls -al\n",
+			e:  "ls -al\n",
+		},
+		"detach": {
+			in:       "ls\x10\x11 -al\n",
+			e:        "ls",
+			detached: true,
+		},
+		"partial": {
+			in: "ls\x10 -al\n",
+			e:  "ls\x10 -al\n",
+		},
+		"doubleCtrlP": {
+			in:       "ls\x10\x10\x11",
+			e:        "ls\x10",
+			detached: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			detach := make(chan struct{})
+			r := detachReader{in: bytes.NewBufferString(u.in), keys: DetachKeys, detach: detach}
+			out, err := ioutil.ReadAll(&r)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(out))
+			select {
+			case <-detach:
+				assert.True(t, u.detached)
+			default:
+				assert.False(t, u.detached)
+			}
+		})
+	}
+}
+
+func TestDetachReaderPending(t *testing.T) {
+	detach := make(chan struct{})
+	r := detachReader{in: bytes.NewBufferString("\x10ab"), keys: DetachKeys, detach: detach}
+
+	var out []byte
+	p := make([]byte, 1)
+	for {
+		n, err := r.Read(p)
+		out = append(out, p[:n]...)
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, "\x10ab", string(out))
+}
@@ -0,0 +1,135 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Audit)(nil)
+
+// Audit represents the audit trail of mutating actions.
+type Audit struct {
+	NonResource
+}
+
+// List returns the audit trail entries.
+func (a *Audit) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no audit log found in context")
+	}
+
+	ee, err := ReadAuditLog(path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+// ReadAuditLog loads the audit trail entries from a given file.
+func ReadAuditLog(path string) ([]render.AuditRes, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ee []render.AuditRes
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e render.AuditRes
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid audit entry in %s", path)
+			continue
+		}
+		ee = append(ee, e)
+	}
+
+	return ee, scanner.Err()
+}
+
+// Auditor records mutating actions performed via K9s as json lines.
+type Auditor struct {
+	path              string
+	user              string
+	kubeUser, context string
+	mx                sync.Mutex
+}
+
+// NewAuditor returns a new auditor appending to a given file.
+func NewAuditor(path, user string) *Auditor {
+	return &Auditor{path: path, user: user}
+}
+
+// Path returns the audit log location.
+func (a *Auditor) Path() string {
+	return a.path
+}
+
+// SetIdentity sets the kube context and user actions are performed as.
+func (a *Auditor) SetIdentity(context, kubeUser string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.context, a.kubeUser = context, kubeUser
+}
+
+// Record appends an action to the audit log.
+func (a *Auditor) Record(action, gvr, path, details string, err error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	e := render.AuditRes{
+		Time:     time.Now(),
+		User:     a.user,
+		KubeUser: a.kubeUser,
+		Context:  a.context,
+		Action:   action,
+		Resource: gvr,
+		Path:     path,
+		Details:  details,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if err := a.write(e); err != nil {
+		log.Error().Err(err).Msgf("Audit log write failed for %s %s", action, path)
+	}
+}
+
+func (a *Auditor) write(e render.AuditRes) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(a.path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(raw, '\n'))
+
+	return err
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditorRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit", "audit.log")
+	a := NewAuditor(path, "fernand")
+	a.SetIdentity("ctx1", "admin")
+	a.Record("delete", "v1/pods", "default/p1", "cascade=true force=false", nil)
+	a.Record("scale", "apps/v1/deployments", "default/d1", "replicas=3", errors.New("boom"))
+
+	ee, err := ReadAuditLog(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "fernand", ee[0].User)
+	assert.Equal(t, "admin", ee[0].KubeUser)
+	assert.Equal(t, "ctx1", ee[0].Context)
+	assert.Equal(t, "delete", ee[0].Action)
+	assert.Equal(t, "default/p1", ee[0].Path)
+	assert.Equal(t, "", ee[0].Error)
+	assert.Equal(t, "boom", ee[1].Error)
+
+	var au Audit
+	oo, err := au.List(context.WithValue(context.Background(), internal.KeyPath, path), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(oo))
+}
+
+func TestReadAuditLogMissing(t *testing.T) {
+	ee, err := ReadAuditLog("/tmp/k9s-no-such-audit.log")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ee))
+}
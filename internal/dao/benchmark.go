@@ -23,7 +23,7 @@ type Benchmark struct {
 }
 
 // Delete nukes a resource.
-func (b *Benchmark) Delete(path string, cascade, force bool) error {
+func (b *Benchmark) Delete(path string, _ DeleteOptions) error {
 	return os.Remove(path)
 }
 
@@ -0,0 +1,307 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*Cert)(nil)
+
+const secretGVR = "v1/secrets"
+
+// webhookGVRs tracks webhook configurations carrying ca bundles, preferred versions first.
+var webhookGVRs = [][]string{
+	{
+		"admissionregistration.k8s.io/v1/mutatingwebhookconfigurations",
+		"admissionregistration.k8s.io/v1beta1/mutatingwebhookconfigurations",
+	},
+	{
+		"admissionregistration.k8s.io/v1/validatingwebhookconfigurations",
+		"admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations",
+	},
+}
+
+var pemCert = []byte("-----BEGIN CERTIFICATE-----")
+
+// Cert represents the certificates held by secrets, ingresses and webhooks.
+type Cert struct {
+	NonResource
+}
+
+// List returns the certificates of a given resource or all secrets and webhooks
+// certificates when none is given.
+func (c *Cert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	var (
+		cc  []render.CertRes
+		err error
+	)
+	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
+		gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+		if !ok {
+			return nil, fmt.Errorf("no target resource for %q", c.gvr)
+		}
+		cc, err = CertsFor(c.Factory, client.NewGVR(gvr), path)
+	} else {
+		cc, err = c.scan(ns)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, co := range cc {
+		oo = append(oo, co)
+	}
+
+	return oo, nil
+}
+
+// scan collects all secrets certificates in a namespace along with the webhooks
+// ca bundles when viewing all namespaces.
+func (c *Cert) scan(ns string) ([]render.CertRes, error) {
+	oo, err := c.Factory.List(secretGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var cc []render.CertRes
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		cc = append(cc, secretCerts(u)...)
+	}
+	if !client.IsAllNamespaces(ns) {
+		return cc, nil
+	}
+
+	for _, wc := range webhookConfigs(c.Factory) {
+		cc = append(cc, webhookCerts(wc.gvr, wc.o)...)
+	}
+
+	return cc, nil
+}
+
+// CertsFor returns the certificates held by a secret, ingress or webhook configuration.
+func CertsFor(f Factory, gvr client.GVR, path string) ([]render.CertRes, error) {
+	o, err := Fetch(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case gvr.String() == secretGVR:
+		return secretCerts(o), nil
+	case gvr.R() == "ingresses":
+		return ingressCerts(f, o)
+	case strings.HasSuffix(gvr.R(), "webhookconfigurations"):
+		return webhookCerts(gvr.String(), o), nil
+	default:
+		return nil, fmt.Errorf("no certificates available for %s", gvr)
+	}
+}
+
+// CertDetails returns a certificate details as yaml.
+func CertDetails(f Factory, id string) (string, error) {
+	tokens := strings.Split(id, "|")
+	if len(tokens) != 4 {
+		return "", fmt.Errorf("invalid certificate id %q", id)
+	}
+	cc, err := CertsFor(f, client.NewGVR(tokens[0]), tokens[1])
+	if err != nil {
+		return "", err
+	}
+	for _, c := range cc {
+		if c.ID() == id {
+			return certDetails(c, time.Now())
+		}
+	}
+
+	return "", fmt.Errorf("no certificate %s found on %s", tokens[2], tokens[1])
+}
+
+// ParseCerts parses a pem encoded certificate chain.
+func ParseCerts(raw []byte) ([]*x509.Certificate, error) {
+	var cc []*x509.Certificate
+	for {
+		var b *pem.Block
+		b, raw = pem.Decode(raw)
+		if b == nil {
+			break
+		}
+		if b.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return cc, err
+		}
+		cc = append(cc, c)
+	}
+	if len(cc) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+
+	return cc, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func preferredGVR(gg []string) (string, bool) {
+	for _, g := range gg {
+		if _, err := MetaAccess.MetaFor(client.NewGVR(g)); err == nil {
+			return g, true
+		}
+	}
+
+	return "", false
+}
+
+func secretCerts(o *unstructured.Unstructured) []render.CertRes {
+	data, _, _ := unstructured.NestedStringMap(o.Object, "data")
+	kk := make([]string, 0, len(data))
+	for k := range data {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	var cc []render.CertRes
+	path := client.FQN(o.GetNamespace(), o.GetName())
+	for _, k := range kk {
+		raw, err := base64.StdEncoding.DecodeString(data[k])
+		if err != nil || !bytes.Contains(raw, pemCert) {
+			continue
+		}
+		cc = append(cc, toCertRes(secretGVR, "secret", path, k, raw)...)
+	}
+
+	return cc
+}
+
+func webhookCerts(gvr string, o *unstructured.Unstructured) []render.CertRes {
+	kind := "validating"
+	if strings.HasPrefix(client.NewGVR(gvr).R(), "mutating") {
+		kind = "mutating"
+	}
+	hh, _, _ := unstructured.NestedSlice(o.Object, "webhooks")
+
+	var cc []render.CertRes
+	for _, h := range hh {
+		m, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(m, "name")
+		bundle, _, _ := unstructured.NestedString(m, "clientConfig", "caBundle")
+		if bundle == "" {
+			continue
+		}
+		raw, err := base64.StdEncoding.DecodeString(bundle)
+		if err != nil {
+			cc = append(cc, render.CertRes{GVR: gvr, Kind: kind, Path: o.GetName(), Key: name, Err: err})
+			continue
+		}
+		cc = append(cc, toCertRes(gvr, kind, o.GetName(), name, raw)...)
+	}
+
+	return cc
+}
+
+func ingressCerts(f Factory, o *unstructured.Unstructured) ([]render.CertRes, error) {
+	tt, _, _ := unstructured.NestedSlice(o.Object, "spec", "tls")
+	var cc []render.CertRes
+	for _, t := range tt {
+		m, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		n, _, _ := unstructured.NestedString(m, "secretName")
+		if n == "" {
+			continue
+		}
+		s, err := Fetch(f, client.NewGVR(secretGVR), client.FQN(o.GetNamespace(), n))
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, secretCerts(s)...)
+	}
+
+	return cc, nil
+}
+
+func toCertRes(gvr, kind, path, key string, raw []byte) []render.CertRes {
+	certs, err := ParseCerts(raw)
+	if err != nil && len(certs) == 0 {
+		return []render.CertRes{{GVR: gvr, Kind: kind, Path: path, Key: key, Err: err}}
+	}
+	cc := make([]render.CertRes, 0, len(certs))
+	for i, c := range certs {
+		cc = append(cc, render.CertRes{GVR: gvr, Kind: kind, Path: path, Key: key, Index: i, Cert: c})
+	}
+
+	return cc
+}
+
+type certDetail struct {
+	Source       string   `json:"source"`
+	Path         string   `json:"path"`
+	Key          string   `json:"key"`
+	Index        int      `json:"index"`
+	Status       string   `json:"status"`
+	Error        string   `json:"error,omitempty"`
+	Subject      string   `json:"subject,omitempty"`
+	Issuer       string   `json:"issuer,omitempty"`
+	SANs         []string `json:"sans,omitempty"`
+	Serial       string   `json:"serial,omitempty"`
+	NotBefore    string   `json:"notBefore,omitempty"`
+	NotAfter     string   `json:"notAfter,omitempty"`
+	Expires      string   `json:"expires,omitempty"`
+	IsCA         bool     `json:"isCA"`
+	KeyAlgorithm string   `json:"keyAlgorithm,omitempty"`
+	SigAlgorithm string   `json:"signatureAlgorithm,omitempty"`
+}
+
+func certDetails(c render.CertRes, now time.Time) (string, error) {
+	d := certDetail{
+		Source: c.GVR,
+		Path:   c.Path,
+		Key:    c.Key,
+		Index:  c.Index,
+		Status: c.Status(now),
+	}
+	if c.Err != nil {
+		d.Error = c.Err.Error()
+	}
+	if x := c.Cert; x != nil {
+		d.Subject, d.Issuer = x.Subject.String(), x.Issuer.String()
+		d.SANs = render.CertSANs(x)
+		d.Serial = x.SerialNumber.String()
+		d.NotBefore, d.NotAfter = x.NotBefore.Format(time.RFC3339), x.NotAfter.Format(time.RFC3339)
+		d.Expires = render.CertExpiresIn(x.NotAfter, now)
+		d.IsCA = x.IsCA
+		d.KeyAlgorithm, d.SigAlgorithm = x.PublicKeyAlgorithm.String(), x.SignatureAlgorithm.String()
+	}
+	raw, err := yaml.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
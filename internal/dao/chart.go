@@ -4,18 +4,26 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 var (
-	_ Accessor  = (*Chart)(nil)
-	_ Nuker     = (*Chart)(nil)
-	_ Describer = (*Chart)(nil)
+	_ Accessor    = (*Chart)(nil)
+	_ Nuker       = (*Chart)(nil)
+	_ Describer   = (*Chart)(nil)
+	_ Rollbacker  = (*Chart)(nil)
+	_ Valuer      = (*Chart)(nil)
+	_ Manifester  = (*Chart)(nil)
+	_ Uninstaller = (*Chart)(nil)
 )
 
 // Chart represents a helm chart.
@@ -88,15 +96,123 @@ func (c *Chart) ToYAML(path string) (string, error) {
 	return resp.Manifest, nil
 }
 
+// GetValues returns the chart release values. When all is set, computed
+// values are returned otherwise only user supplied values are returned.
+func (c *Chart) GetValues(path string, all bool) ([]byte, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	vals := action.NewGetValues(cfg)
+	vals.AllValues = all
+	resp, err := vals.Run(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(resp)
+}
+
+// SetValues upgrades a chart release using the given user supplied values.
+func (c *Chart) SetValues(path string, values []byte) error {
+	if err := ensureWritable(c.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+	rel, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return err
+	}
+	vals := make(map[string]interface{})
+	if err := yaml.Unmarshal(values, &vals); err != nil {
+		return err
+	}
+	u := action.NewUpgrade(cfg)
+	u.Namespace = ns
+	_, err = u.Run(n, rel.Chart, vals)
+
+	return err
+}
+
+// History returns all revisions of a chart release, most recent first.
+func (c *Chart) History(path string) ([]*release.Release, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := action.NewHistory(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Version > rr[j].Version
+	})
+
+	return rr, nil
+}
+
+// Rollback rolls a chart release back to a given revision.
+func (c *Chart) Rollback(path string, rev int) error {
+	if err := ensureWritable(c.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+	r := action.NewRollback(cfg)
+	r.Version = rev
+
+	return r.Run(n)
+}
+
+// Manifest returns the chart release manifest followed by its hooks.
+func (c *Chart) Manifest(path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return "", err
+	}
+	resp, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return "", err
+	}
+
+	return releaseManifest(resp), nil
+}
+
 // Delete uninstall a Chart.
-func (c *Chart) Delete(path string, cascade, force bool) error {
+func (c *Chart) Delete(path string, _ DeleteOptions) error {
+	return c.Uninstall(path, false, false)
+}
+
+// Uninstall uninstalls a chart release, optionally retaining its history.
+// Dry runs are allowed in read-only mode.
+func (c *Chart) Uninstall(path string, keepHistory, dryRun bool) error {
+	if !dryRun {
+		if err := ensureWritable(c.Factory); err != nil {
+			return err
+		}
+	}
+
 	ns, n := client.Namespaced(path)
 	cfg, err := c.EnsureHelmConfig(ns)
 	if err != nil {
 		return err
 	}
 
-	res, err := action.NewUninstall(cfg).Run(n)
+	u := action.NewUninstall(cfg)
+	u.KeepHistory, u.DryRun = keepHistory, dryRun
+	res, err := u.Run(n)
 	if err != nil {
 		return err
 	}
@@ -118,6 +234,20 @@ func (c *Chart) EnsureHelmConfig(ns string) (*action.Configuration, error) {
 	return cfg, nil
 }
 
+func releaseManifest(r *release.Release) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(r.Manifest))
+	for _, h := range r.Hooks {
+		ee := make([]string, 0, len(h.Events))
+		for _, e := range h.Events {
+			ee = append(ee, e.String())
+		}
+		fmt.Fprintf(&b, "\n---\n# Source: %s\n# Hook: %s\n%s", h.Path, strings.Join(ee, ","), strings.TrimSpace(h.Manifest))
+	}
+
+	return b.String()
+}
+
 func helmLogger(s string, args ...interface{}) {
 	log.Debug().Msgf("%s %v", s, args)
 }
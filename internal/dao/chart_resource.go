@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"helm.sh/helm/v3/pkg/action"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var _ Accessor = (*ChartResource)(nil)
+
+// ChartResource represents a Kubernetes object installed by a helm chart release.
+type ChartResource struct {
+	NonResource
+}
+
+// List returns all objects defined in a chart release manifest.
+func (c *ChartResource) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", c.GVR())
+	}
+
+	ns, n := client.Namespaced(path)
+	var chart Chart
+	chart.Init(c.Factory, client.NewGVR("charts"))
+	cfg, err := chart.EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+	uu, err := manifestObjects(rel.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(uu))
+	for _, u := range uu {
+		res := render.ChartResourceRes{
+			Kind:      u.GetKind(),
+			Namespace: u.GetNamespace(),
+			Name:      u.GetName(),
+		}
+		if gvr, meta, ok := gvrForKind(u.GetAPIVersion(), u.GetKind()); ok {
+			res.GVR = gvr.String()
+			if meta.Namespaced && res.Namespace == "" {
+				res.Namespace = rel.Namespace
+			}
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Helpers...
+
+func manifestObjects(manifest string) ([]*unstructured.Unstructured, error) {
+	var uu []*unstructured.Unstructured
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		var o map[string]interface{}
+		if err := d.Decode(&o); err != nil {
+			if err == io.EOF {
+				return uu, nil
+			}
+			return nil, err
+		}
+		if len(o) == 0 {
+			continue
+		}
+		uu = append(uu, &unstructured.Unstructured{Object: o})
+	}
+}
+
+func gvrForKind(apiVersion, kind string) (client.GVR, metav1.APIResource, bool) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return client.GVR{}, metav1.APIResource{}, false
+	}
+
+	var (
+		match client.GVR
+		meta  metav1.APIResource
+		found bool
+	)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		m, err := MetaAccess.MetaFor(gvr)
+		if err != nil || m.Kind != kind || gvr.G() != gv.Group {
+			continue
+		}
+		if gvr.V() == gv.Version {
+			return gvr, m, true
+		}
+		match, meta, found = gvr, m, true
+	}
+
+	return match, meta, found
+}
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestReleaseManifest(t *testing.T) {
+	r := release.Release{
+		Manifest: "---\n# Source: fred/templates/svc.yaml\nkind: Service\n",
+		Hooks: []*release.Hook{
+			{
+				Path:     "fred/templates/test.yaml",
+				Manifest: "kind: Pod\n",
+				Events:   []release.HookEvent{release.HookTest},
+			},
+		},
+	}
+
+	e := "---\n# Source: fred/templates/svc.yaml\nkind: Service\n---\n# Source: fred/templates/test.yaml\n# Hook: test\nkind: Pod"
+	assert.Equal(t, e, releaseManifest(&r))
+}
+
+func TestReleaseTestPods(t *testing.T) {
+	r := release.Release{
+		Namespace: "default",
+		Hooks: []*release.Hook{
+			{Name: "fred-test", Kind: "Pod", Events: []release.HookEvent{release.HookTest}},
+			{Name: "fred-cfg", Kind: "ConfigMap", Events: []release.HookEvent{release.HookTest}},
+			{Name: "fred-init", Kind: "Pod", Events: []release.HookEvent{release.HookPreInstall}},
+		},
+	}
+
+	assert.Equal(t, []string{"default/fred-test"}, testPods(&r))
+}
+
+func TestReleaseTestFailures(t *testing.T) {
+	r := release.Release{
+		Hooks: []*release.Hook{
+			{Name: "t1", Events: []release.HookEvent{release.HookTest}, LastRun: release.HookExecution{Phase: release.HookPhaseSucceeded}},
+			{Name: "t2", Events: []release.HookEvent{release.HookTest}, LastRun: release.HookExecution{Phase: release.HookPhaseFailed}},
+			{Name: "t3", Events: []release.HookEvent{release.HookPreInstall}, LastRun: release.HookExecution{Phase: release.HookPhaseFailed}},
+		},
+	}
+
+	err := testFailures(&r)
+	assert.NotNil(t, err)
+	assert.Equal(t, "failed tests: t2", err.Error())
+
+	r.Hooks[1].LastRun.Phase = release.HookPhaseSucceeded
+	assert.Nil(t, testFailures(&r))
+}
+
+func TestManifestObjects(t *testing.T) {
+	m := `---
+# Source: fred/templates/svc.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: fred
+---
+# Source: fred/templates/empty.yaml
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+  namespace: blee
+`
+
+	uu, err := manifestObjects(m)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(uu))
+	assert.Equal(t, "Service", uu[0].GetKind())
+	assert.Equal(t, "", uu[0].GetNamespace())
+	assert.Equal(t, "apps/v1", uu[1].GetAPIVersion())
+	assert.Equal(t, "blee", uu[1].GetNamespace())
+}
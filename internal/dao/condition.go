@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Condition)(nil)
+
+// Condition represents a resource status conditions.
+type Condition struct {
+	NonResource
+}
+
+// List returns a resource status conditions.
+func (c *Condition) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", c.gvr)
+	}
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("no target resource for %q", c.gvr)
+	}
+
+	o, err := Fetch(c.Factory, client.NewGVR(gvr), path)
+	if err != nil {
+		return nil, err
+	}
+	cc := render.Conditions(o.Object)
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, co := range cc {
+		oo = append(oo, co)
+	}
+
+	return oo, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -11,10 +12,12 @@ import (
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	restclient "k8s.io/client-go/rest"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -78,6 +81,79 @@ func (c *Container) TailLogs(ctx context.Context, logChan chan<- []byte, opts Lo
 	return tailLogs(ctx, c, logChan, opts)
 }
 
+// ContainerHistory represents a container restarts history.
+type ContainerHistory struct {
+	Container    string            `json:"container"`
+	Image        string            `json:"image"`
+	PullPolicy   string            `json:"imagePullPolicy,omitempty"`
+	RestartCount int32             `json:"restartCount"`
+	State        v1.ContainerState `json:"state"`
+	LastState    v1.ContainerState `json:"lastState"`
+	Events       []string          `json:"events,omitempty"`
+}
+
+// History returns a container status and the events it emitted across restarts.
+func (c *Container) History(path, co string) (string, error) {
+	po, err := c.fetchPod(path)
+	if err != nil {
+		return "", err
+	}
+
+	h := ContainerHistory{Container: co}
+	for _, spec := range append(po.Spec.InitContainers, po.Spec.Containers...) {
+		if spec.Name == co {
+			h.Image, h.PullPolicy = spec.Image, string(spec.ImagePullPolicy)
+		}
+	}
+	if cs := getContainerStatus(co, po.Status); cs != nil {
+		h.RestartCount, h.State, h.LastState = cs.RestartCount, cs.State, cs.LastTerminationState
+	}
+	if h.Events, err = c.events(po, co); err != nil {
+		return "", err
+	}
+
+	raw, err := yaml.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func (c *Container) events(po *v1.Pod, co string) ([]string, error) {
+	oo, err := c.Factory.List("v1/events", po.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	sel := fields.ParseSelectorOrDie(EventsSelector("Pod", po.Namespace, po.Name))
+	var evts []v1.Event
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !sel.Matches(eventFields(u)) {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			return nil, err
+		}
+		if !containerEvent(evt.InvolvedObject.FieldPath, co) {
+			continue
+		}
+		evts = append(evts, evt)
+	}
+	sort.SliceStable(evts, func(i, j int) bool {
+		return evts[i].LastTimestamp.Before(&evts[j].LastTimestamp)
+	})
+
+	ee := make([]string, 0, len(evts))
+	for _, evt := range evts {
+		ee = append(ee, fmt.Sprintf("%s %s (x%d): %s", evt.Type, evt.Reason, evt.Count, evt.Message))
+	}
+
+	return ee, nil
+}
+
 // Logs fetch container logs for a given pod and container.
 func (c *Container) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
@@ -123,6 +199,17 @@ func containerMetrics(n string, pmx *mv1beta1.PodMetrics) (*mv1beta1.ContainerMe
 	return nil, nil
 }
 
+// containerEvent checks if an event field path refers to a given container.
+func containerEvent(fieldPath, co string) bool {
+	for _, p := range []string{"spec.containers{", "spec.initContainers{"} {
+		if fieldPath == p+co+"}" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getContainerStatus(co string, status v1.PodStatus) *v1.ContainerStatus {
 	for _, c := range status.ContainerStatuses {
 		if c.Name == co {
@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MaxViewFileSize tracks the largest container file that can be viewed.
+const MaxViewFileSize = 100 * 1024
+
+var (
+	_ Accessor = (*ContainerFS)(nil)
+
+	lsRX = regexp.MustCompile(`^([-bcdlps][-rwxsStT]{9})\S*\s+\d+\s+(\S+)\s+(\S+)\s+(\d+,\s*\d+|\d+)\s+(\w{3}\s+\d{1,2}\s+[\d:]{4,5})\s(.+)$`)
+)
+
+// ContainerFS represents a container file system dao.
+type ContainerFS struct {
+	NonResource
+}
+
+// List returns a container directory entries.
+func (c *ContainerFS) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", c.gvr)
+	}
+	co, ok := ctx.Value(internal.KeyContainer).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context container for %q", c.gvr)
+	}
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		dir = "/"
+	}
+
+	var buff bytes.Buffer
+	if err := execIn(c.Client(), fqn, co, []string{"ls", "-la", listDir(dir)}, &buff); err != nil {
+		if buff.Len() == 0 {
+			return nil, err
+		}
+		log.Warn().Err(err).Msgf("Partial listing for %s", dir)
+	}
+
+	ff := parseListing(path.Clean(dir), buff.String())
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		oo = append(oo, f)
+	}
+
+	return oo, nil
+}
+
+// Cat returns the content of a small text file.
+func (c *ContainerFS) Cat(fqn, co, file string) (string, error) {
+	var buff bytes.Buffer
+	cmd := []string{"head", "-c", strconv.Itoa(MaxViewFileSize + 1), file}
+	if err := execIn(c.Client(), fqn, co, cmd, &buff); err != nil {
+		return "", err
+	}
+	if buff.Len() > MaxViewFileSize {
+		return "", fmt.Errorf("file %s exceeds %dKb. Download it instead", file, MaxViewFileSize/1024)
+	}
+	if bytes.IndexByte(buff.Bytes(), 0) != -1 {
+		return "", fmt.Errorf("file %s is not a text file. Download it instead", file)
+	}
+
+	return buff.String(), nil
+}
+
+// Download copies a container file to a local directory and returns the local file path.
+func (c *ContainerFS) Download(fqn, co, file, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return "", err
+	}
+	_, n := client.Namespaced(fqn)
+	local := filepath.Join(dir, fmt.Sprintf("%s-%s-%s", n, co, path.Base(file)))
+	f, err := os.OpenFile(local, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing download file %s", local)
+		}
+	}()
+
+	if err := execIn(c.Client(), fqn, co, []string{"cat", file}, f); err != nil {
+		if e := os.Remove(local); e != nil {
+			log.Error().Err(e).Msgf("Removing download file %s", local)
+		}
+		return "", err
+	}
+
+	return local, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// listDir ensures symlinked directories are followed while listing.
+func listDir(dir string) string {
+	dir = path.Clean(dir)
+	if dir == "/" {
+		return dir
+	}
+
+	return dir + "/"
+}
+
+func parseListing(dir, out string) []render.ContainerFileRes {
+	lines := strings.Split(out, "\n")
+	ff := make([]render.ContainerFileRes, 0, len(lines))
+	for _, l := range lines {
+		mm := lsRX.FindStringSubmatch(strings.TrimRight(l, "\r"))
+		if mm == nil {
+			continue
+		}
+		f := render.ContainerFileRes{
+			Dir:      dir,
+			Name:     mm[6],
+			Mode:     mm[1],
+			Owner:    mm[2],
+			Group:    mm[3],
+			Size:     strings.Join(strings.Fields(mm[4]), " "),
+			Modified: strings.Join(strings.Fields(mm[5]), " "),
+		}
+		if f.Type() == render.FileLink {
+			if tokens := strings.SplitN(f.Name, " -> ", 2); len(tokens) == 2 {
+				f.Name, f.Link = tokens[0], tokens[1]
+			}
+		}
+		if f.Name == "." || f.Name == ".." {
+			continue
+		}
+		ff = append(ff, f)
+	}
+
+	return ff
+}
@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseListing(t *testing.T) {
+	out := `total 48
+drwxr-xr-x    1 root     root          4096 Jan 21 10:02 .
+drwxr-xr-x    1 root     root          4096 Jan 21 10:02 ..
+-rw-r--r--    1 root     root           213 Jan 21  2020 hosts
+lrwxrwxrwx    1 root     root            12 Jan 21 10:02 mtab -> /proc/mounts
+drwxr-xr-x    2 nobody   nogroup       4096 Feb  3 09:15 my dir
+crw-rw-rw-    1 root     root        1,   3 Jan 21 10:02 null
+`
+	ff := parseListing("/etc", out)
+
+	assert.Equal(t, 4, len(ff))
+	assert.Equal(t, render.ContainerFileRes{
+		Dir: "/etc", Name: "hosts", Mode: "-rw-r--r--", Owner: "root", Group: "root", Size: "213", Modified: "Jan 21 2020",
+	}, ff[0])
+	assert.Equal(t, "mtab", ff[1].Name)
+	assert.Equal(t, "/proc/mounts", ff[1].Link)
+	assert.Equal(t, "my dir", ff[2].Name)
+	assert.True(t, ff[2].IsDir())
+	assert.Equal(t, "nobody", ff[2].Owner)
+	assert.Equal(t, "Feb 3 09:15", ff[2].Modified)
+	assert.Equal(t, "1, 3", ff[3].Size)
+	assert.Equal(t, render.FileOther, ff[3].Type())
+}
+
+func TestListDir(t *testing.T) {
+	uu := map[string]string{
+		"/":         "/",
+		"":          "./",
+		"/usr/bin":  "/usr/bin/",
+		"/usr/bin/": "/usr/bin/",
+	}
+
+	for k, e := range uu {
+		assert.Equal(t, e, listDir(k))
+	}
+}
@@ -2,7 +2,9 @@ package dao
 
 import (
 	"context"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
@@ -34,14 +36,21 @@ func (c *Context) Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
 // List all Contexts on the current cluster.
-func (c *Context) List(_ context.Context, _ string) ([]runtime.Object, error) {
+func (c *Context) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	ctxs, err := c.config().Contexts()
 	if err != nil {
 		return nil, err
 	}
+	used, _ := ctx.Value(internal.KeyLastUsed).(map[string]time.Time)
+	health, _ := ctx.Value(internal.KeyCtxHealth).(map[string]render.ContextHealth)
 	cc := make([]runtime.Object, 0, len(ctxs))
 	for k, v := range ctxs {
-		cc = append(cc, render.NewNamedContext(c.config(), k, v))
+		nc := render.NewNamedContext(c.config(), k, v)
+		nc.LastUsed = used[k]
+		if h, ok := health[k]; ok {
+			nc.Health = &h
+		}
+		cc = append(cc, nc)
 	}
 
 	return cc, nil
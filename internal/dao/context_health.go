@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// contextPingTimeout bounds a single api server health check.
+const contextPingTimeout = 5 * time.Second
+
+// ContextPinger represents a kubeconfig capable of checking its contexts.
+type ContextPinger interface {
+	// ContextNames returns all kubeconfig context names.
+	ContextNames() ([]string, error)
+
+	// PingContext returns a context api server version or an error if unreachable.
+	PingContext(name string, timeout time.Duration) (string, error)
+}
+
+// ContextHealth periodically checks the api servers of all contexts.
+type ContextHealth struct {
+	pinger   ContextPinger
+	interval time.Duration
+	health   map[string]render.ContextHealth
+	mx       sync.RWMutex
+}
+
+// NewContextHealth returns a new contexts health poller.
+func NewContextHealth(p ContextPinger, interval time.Duration) *ContextHealth {
+	return &ContextHealth{
+		pinger:   p,
+		interval: interval,
+		health:   make(map[string]render.ContextHealth),
+	}
+}
+
+// Start polls all contexts until the given context is canceled.
+func (c *ContextHealth) Start(ctx context.Context) {
+	go func() {
+		for {
+			c.poll()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.interval):
+			}
+		}
+	}()
+}
+
+// Health returns the last known health of all contexts.
+func (c *ContextHealth) Health() map[string]render.ContextHealth {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	hh := make(map[string]render.ContextHealth, len(c.health))
+	for k, v := range c.health {
+		hh[k] = v
+	}
+
+	return hh
+}
+
+func (c *ContextHealth) poll() {
+	nn, err := c.pinger.ContextNames()
+	if err != nil {
+		log.Error().Err(err).Msg("Contexts health check failed")
+		return
+	}
+
+	hh := make(map[string]render.ContextHealth, len(nn))
+	var (
+		wg sync.WaitGroup
+		mx sync.Mutex
+	)
+	wg.Add(len(nn))
+	for _, n := range nn {
+		go func(n string) {
+			defer wg.Done()
+			h := c.ping(n)
+			mx.Lock()
+			hh[n] = h
+			mx.Unlock()
+		}(n)
+	}
+	wg.Wait()
+
+	c.mx.Lock()
+	c.health = hh
+	c.mx.Unlock()
+}
+
+func (c *ContextHealth) ping(n string) render.ContextHealth {
+	t := time.Now()
+	v, err := c.pinger.PingContext(n, contextPingTimeout)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Context %s is unreachable", n)
+	}
+
+	return render.ContextHealth{
+		Version: v,
+		Latency: time.Since(t),
+		Err:     err,
+		Checked: t,
+	}
+}
@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextHealthPoll(t *testing.T) {
+	h := NewContextHealth(pinger{"c1": "v1.16.2", "c2": ""}, time.Minute)
+	assert.Equal(t, 0, len(h.Health()))
+
+	h.poll()
+	hh := h.Health()
+	assert.Equal(t, 2, len(hh))
+	assert.Nil(t, hh["c1"].Err)
+	assert.Equal(t, "v1.16.2", hh["c1"].Version)
+	assert.False(t, hh["c1"].Checked.IsZero())
+	assert.NotNil(t, hh["c2"].Err)
+}
+
+// Helpers...
+
+type pinger map[string]string
+
+func (p pinger) ContextNames() ([]string, error) {
+	nn := make([]string, 0, len(p))
+	for n := range p {
+		nn = append(nn, n)
+	}
+
+	return nn, nil
+}
+
+func (p pinger) PingContext(n string, _ time.Duration) (string, error) {
+	if p[n] == "" {
+		return "", errors.New("unreachable")
+	}
+
+	return p[n], nil
+}
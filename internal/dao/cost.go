@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"context"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+var _ Accessor = (*Cost)(nil)
+
+// Cost represents pods resources aggregated by a label value.
+type Cost struct {
+	NonResource
+}
+
+// List returns the active pods requests, limits and usage grouped by a given
+// label value.
+func (c *Cost) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	key, _ := ctx.Value(internal.KeyGroupBy).(string)
+	oo, err := c.Factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+	var pmx *mv1beta1.PodMetricsList
+	if c.Client().HasMetrics() {
+		if pmx, err = client.DialMetrics(c.Client()).FetchPodsMetrics(ns); err != nil {
+			log.Warn().Err(err).Msgf("No pods metrics")
+			pmx = nil
+		}
+	}
+
+	cc := PodsCost(key, pp, pmx)
+	res := make([]runtime.Object, 0, len(cc))
+	for _, co := range cc {
+		res = append(res, co)
+	}
+
+	return res, nil
+}
+
+// PodsCost sums the active pods requests, limits and usage per label value.
+// Usage is only reported when pods metrics are given.
+func PodsCost(key string, pp []v1.Pod, pmx *mv1beta1.PodMetricsList) []render.CostRes {
+	mx := make(map[string]mv1beta1.PodMetrics)
+	if pmx != nil {
+		for _, m := range pmx.Items {
+			mx[client.FQN(m.Namespace, m.Name)] = m
+		}
+	}
+
+	groups := make(map[string]*render.CostRes)
+	nss := make(map[string]map[string]struct{})
+	for _, po := range pp {
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		g, ok := groups[po.Labels[key]]
+		if !ok {
+			g = &render.CostRes{Label: key, Group: po.Labels[key], HasMetrics: pmx != nil}
+			groups[g.Group] = g
+			nss[g.Group] = make(map[string]struct{})
+		}
+		g.Pods++
+		nss[g.Group][po.Namespace] = struct{}{}
+		for _, co := range po.Spec.Containers {
+			req, lim := co.Resources.Requests, co.Resources.Limits
+			g.CPUReq += requestOrLimit(req, lim, v1.ResourceCPU).MilliValue()
+			g.MEMReq += requestOrLimit(req, lim, v1.ResourceMemory).Value()
+			g.CPULim += lim.Cpu().MilliValue()
+			g.MEMLim += lim.Memory().Value()
+		}
+		if m, ok := mx[client.FQN(po.Namespace, po.Name)]; ok {
+			for _, co := range m.Containers {
+				g.CPU += co.Usage.Cpu().MilliValue()
+				g.MEM += co.Usage.Memory().Value()
+			}
+		}
+	}
+
+	cc := make([]render.CostRes, 0, len(groups))
+	for n, g := range groups {
+		g.Namespaces = len(nss[n])
+		cc = append(cc, *g)
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Group < cc[j].Group
+	})
+
+	return cc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// requestOrLimit returns a resource request, which defaults to its limit when unset.
+func requestOrLimit(req, lim v1.ResourceList, n v1.ResourceName) *resource.Quantity {
+	if q, ok := req[n]; ok {
+		return &q
+	}
+	if q, ok := lim[n]; ok {
+		return &q
+	}
+
+	return &resource.Quantity{}
+}
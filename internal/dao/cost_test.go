@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestPodsCost(t *testing.T) {
+	pp := []v1.Pod{
+		makeCostPod("ns1", "p1", "fred", v1.PodRunning, v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m"), v1.ResourceMemory: resource.MustParse("64Mi")}, nil),
+		makeCostPod("ns2", "p2", "fred", v1.PodRunning, nil, v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m"), v1.ResourceMemory: resource.MustParse("128Mi")}),
+		makeCostPod("ns1", "p3", "", v1.PodPending, v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}, nil),
+		makeCostPod("ns1", "p4", "fred", v1.PodSucceeded, v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}, nil),
+	}
+	pmx := mv1beta1.PodMetricsList{Items: []mv1beta1.PodMetrics{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+			Containers: []mv1beta1.ContainerMetrics{
+				{Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("50m"), v1.ResourceMemory: resource.MustParse("32Mi")}},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		pmx *mv1beta1.PodMetricsList
+		e   []render.CostRes
+	}{
+		"metrics": {
+			pmx: &pmx,
+			e: []render.CostRes{
+				{Label: "team", Pods: 1, Namespaces: 1, CPUReq: 1000, HasMetrics: true},
+				{
+					Label:      "team",
+					Group:      "fred",
+					Pods:       2,
+					Namespaces: 2,
+					CPUReq:     300,
+					CPULim:     200,
+					CPU:        50,
+					MEMReq:     192 * 1024 * 1024,
+					MEMLim:     128 * 1024 * 1024,
+					MEM:        32 * 1024 * 1024,
+					HasMetrics: true,
+				},
+			},
+		},
+		"noMetrics": {
+			e: []render.CostRes{
+				{Label: "team", Pods: 1, Namespaces: 1, CPUReq: 1000},
+				{
+					Label:      "team",
+					Group:      "fred",
+					Pods:       2,
+					Namespaces: 2,
+					CPUReq:     300,
+					CPULim:     200,
+					MEMReq:     192 * 1024 * 1024,
+					MEMLim:     128 * 1024 * 1024,
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, PodsCost("team", pp, u.pmx))
+		})
+	}
+}
+
+// Helpers...
+
+func makeCostPod(ns, n, team string, phase v1.PodPhase, req, lim v1.ResourceList) v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "c1", Resources: v1.ResourceRequirements{Requests: req, Limits: lim}},
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+	if team != "" {
+		po.Labels = map[string]string{"team": team}
+	}
+
+	return po
+}
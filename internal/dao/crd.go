@@ -2,10 +2,17 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 var (
@@ -29,3 +36,140 @@ func (c *CustomResourceDefinition) List(ctx context.Context, _ string) ([]runtim
 	const gvr = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
 	return c.Factory.List(gvr, "-", true, labelSel)
 }
+
+// PrinterColumn represents a custom resource additional printer column.
+type PrinterColumn struct {
+	Name     string
+	Type     string
+	JSONPath string
+	Priority int32
+}
+
+// CRDSpec tracks a custom resource printer columns and subresources.
+type CRDSpec struct {
+	Columns []PrinterColumn
+	Scale   bool
+	Status  bool
+}
+
+// CRDTable renders custom resources as a table using their printer columns.
+func CRDTable(oo []runtime.Object, cc []PrinterColumn) (*metav1beta1.Table, error) {
+	t := metav1beta1.Table{
+		ColumnDefinitions: make([]metav1beta1.TableColumnDefinition, 0, len(cc)+2),
+		Rows:              make([]metav1beta1.TableRow, 0, len(oo)),
+	}
+	t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: "Name", Type: "string"})
+	pp := make([]*render.JSONPath, 0, len(cc))
+	for _, c := range cc {
+		jp, err := render.NewJSONPath(c.Name, c.JSONPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid printer column %s: %v", c.Name, err)
+		}
+		pp = append(pp, jp)
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{
+			Name:     c.Name,
+			Type:     c.Type,
+			Priority: c.Priority,
+		})
+	}
+	t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: "Age", Type: "date"})
+
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		raw, err := u.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		cells := make([]interface{}, 0, len(t.ColumnDefinitions))
+		cells = append(cells, u.GetName())
+		for i, jp := range pp {
+			cells = append(cells, printerCell(jp, cc[i].Type, u.Object))
+		}
+		cells = append(cells, time.Since(u.GetCreationTimestamp().Time).String())
+		t.Rows = append(t.Rows, metav1beta1.TableRow{
+			Cells:  cells,
+			Object: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	return &t, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func printerCell(jp *render.JSONPath, kind string, o map[string]interface{}) string {
+	cell, err := jp.Eval(o)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Printer column evaluation failed")
+		return ""
+	}
+	if kind != "date" || cell == "" {
+		return cell
+	}
+	t, err := time.Parse(time.RFC3339, cell)
+	if err != nil {
+		return cell
+	}
+
+	return duration.HumanDuration(time.Since(t))
+}
+
+func extractCRDSpec(crd map[string]interface{}, version string) CRDSpec {
+	var s CRDSpec
+	spec, _, _ := unstructured.NestedMap(crd, "spec")
+	s.Columns = printerColumns(spec)
+	s.Scale, s.Status = subresources(spec)
+
+	vv, _, _ := unstructured.NestedSlice(spec, "versions")
+	for _, v := range vv {
+		vs, ok := v.(map[string]interface{})
+		if !ok || vs["name"] != version {
+			continue
+		}
+		if cc := printerColumns(vs); len(cc) > 0 {
+			s.Columns = cc
+		}
+		if scale, status := subresources(vs); scale || status {
+			s.Scale, s.Status = scale, status
+		}
+	}
+
+	return s
+}
+
+func printerColumns(spec map[string]interface{}) []PrinterColumn {
+	cc, _, _ := unstructured.NestedSlice(spec, "additionalPrinterColumns")
+	pp := make([]PrinterColumn, 0, len(cc))
+	for _, c := range cc {
+		col, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var p PrinterColumn
+		p.Name, _, _ = unstructured.NestedString(col, "name")
+		p.Type, _, _ = unstructured.NestedString(col, "type")
+		// v1beta1 CRDs spell it JSONPath while v1 uses jsonPath.
+		if p.JSONPath, _, _ = unstructured.NestedString(col, "jsonPath"); p.JSONPath == "" {
+			p.JSONPath, _, _ = unstructured.NestedString(col, "JSONPath")
+		}
+		prio, _, _ := unstructured.NestedInt64(col, "priority")
+		p.Priority = int32(prio)
+		if p.Name == "" || p.JSONPath == "" {
+			continue
+		}
+		pp = append(pp, p)
+	}
+
+	return pp
+}
+
+func subresources(spec map[string]interface{}) (scale bool, status bool) {
+	_, scale, _ = unstructured.NestedFieldNoCopy(spec, "subresources", "scale")
+	_, status, _ = unstructured.NestedFieldNoCopy(spec, "subresources", "status")
+
+	return
+}
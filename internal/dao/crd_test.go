@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExtractCRDSpec(t *testing.T) {
+	uu := map[string]struct {
+		crd map[string]interface{}
+		e   CRDSpec
+	}{
+		"bare": {
+			crd: map[string]interface{}{"spec": map[string]interface{}{}},
+			e:   CRDSpec{Columns: []PrinterColumn{}},
+		},
+		"v1beta1": {
+			crd: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"additionalPrinterColumns": []interface{}{
+						map[string]interface{}{"name": "Replicas", "type": "integer", "JSONPath": ".spec.replicas"},
+						map[string]interface{}{"name": "Image", "type": "string", "JSONPath": ".spec.image", "priority": int64(1)},
+						map[string]interface{}{"name": "Bozo", "type": "string"},
+					},
+					"subresources": map[string]interface{}{
+						"scale":  map[string]interface{}{"specReplicasPath": ".spec.replicas"},
+						"status": map[string]interface{}{},
+					},
+				},
+			},
+			e: CRDSpec{
+				Columns: []PrinterColumn{
+					{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+					{Name: "Image", Type: "string", JSONPath: ".spec.image", Priority: 1},
+				},
+				Scale:  true,
+				Status: true,
+			},
+		},
+		"perVersion": {
+			crd: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"versions": []interface{}{
+						map[string]interface{}{
+							"name": "v1alpha1",
+							"additionalPrinterColumns": []interface{}{
+								map[string]interface{}{"name": "Old", "type": "string", "jsonPath": ".spec.old"},
+							},
+						},
+						map[string]interface{}{
+							"name": "v1",
+							"additionalPrinterColumns": []interface{}{
+								map[string]interface{}{"name": "Phase", "type": "string", "jsonPath": ".status.phase"},
+							},
+							"subresources": map[string]interface{}{
+								"status": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+			e: CRDSpec{
+				Columns: []PrinterColumn{
+					{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+				},
+				Status: true,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, extractCRDSpec(u.crd, "v1"))
+		})
+	}
+}
+
+func TestCRDTable(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Fred",
+		"metadata": map[string]interface{}{
+			"name":              "f1",
+			"namespace":         "default",
+			"creationTimestamp": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		},
+		"spec": map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{
+			"started": metav1.NewTime(time.Now().Add(-72 * time.Hour)).UTC().Format(time.RFC3339),
+		},
+	}}
+	cc := []PrinterColumn{
+		{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "Started", Type: "date", JSONPath: ".status.started", Priority: 1},
+	}
+
+	table, err := CRDTable([]runtime.Object{&o}, cc)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(table.ColumnDefinitions))
+	assert.Equal(t, "Name", table.ColumnDefinitions[0].Name)
+	assert.Equal(t, int32(1), table.ColumnDefinitions[3].Priority)
+	assert.Equal(t, "Age", table.ColumnDefinitions[4].Name)
+	assert.Equal(t, 1, len(table.Rows))
+	assert.Equal(t, []interface{}{"f1", "3", "", "3d"}, table.Rows[0].Cells[:4])
+	assert.Contains(t, string(table.Rows[0].Object.Raw), `"name":"f1"`)
+
+	_, err = CRDTable([]runtime.Object{&o}, []PrinterColumn{{Name: "Bozo", JSONPath: ".spec[["}})
+	assert.NotNil(t, err)
+}
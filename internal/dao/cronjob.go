@@ -6,14 +6,16 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
 const maxJobNameSize = 42
 
 var (
-	_ Accessor = (*CronJob)(nil)
-	_ Runnable = (*CronJob)(nil)
+	_ Accessor    = (*CronJob)(nil)
+	_ Runnable    = (*CronJob)(nil)
+	_ Suspendable = (*CronJob)(nil)
 )
 
 // CronJob represents a cronjob K8s resource.
@@ -23,6 +25,10 @@ type CronJob struct {
 
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
+	if err := ensureWritable(c.Factory); err != nil {
+		return err
+	}
+
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{client.GetVerb, client.CreateVerb})
 	if err != nil {
@@ -55,3 +61,38 @@ func (c *CronJob) Run(path string) error {
 
 	return err
 }
+
+// ToggleSuspend suspends or resumes a CronJob.
+func (c *CronJob) ToggleSuspend(path string) (bool, error) {
+	if err := ensureWritable(c.Factory); err != nil {
+		return false, err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{client.GetVerb, client.PatchVerb})
+	if err != nil {
+		return false, err
+	}
+	if !auth {
+		return false, fmt.Errorf("user is not authorized to suspend cronjobs")
+	}
+
+	cj, err := c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	suspend := cj.Spec.Suspend == nil || !*cj.Spec.Suspend
+	_, err = c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Patch(n, types.MergePatchType, suspendPatch(suspend))
+	if err != nil {
+		return false, err
+	}
+
+	return suspend, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func suspendPatch(suspend bool) []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+}
@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspendPatch(t *testing.T) {
+	uu := map[string]struct {
+		suspend bool
+		e       string
+	}{
+		"suspend": {suspend: true, e: `{"spec":{"suspend":true}}`},
+		"resume":  {suspend: false, e: `{"spec":{"suspend":false}}`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(suspendPatch(u.suspend)))
+		})
+	}
+}
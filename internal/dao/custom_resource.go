@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	_ Accessor = (*CustomResource)(nil)
+	_ Scalable = (*CustomResource)(nil)
+)
+
+// CustomResource represents a custom resource exposing a scale subresource.
+type CustomResource struct {
+	Generic
+}
+
+// Scale a custom resource via its scale subresource.
+func (c *CustomResource) Scale(path string, replicas int32) error {
+	if err := ensureWritable(c.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, c.gvr.String()+":scale", []string{client.GetVerb, client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to scale %s", c.gvr.R())
+	}
+
+	dial := dynClientFor(c.Factory, c.gvr, ns)
+	scale, err := dial.Get(n, metav1.GetOptions{}, "scale")
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(scale.Object, int64(replicas), "spec", "replicas"); err != nil {
+		return err
+	}
+	_, err = dial.Update(scale, metav1.UpdateOptions{}, "scale")
+
+	return err
+}
+
+// Replicas returns a custom resource current and desired replicas.
+func (c *CustomResource) Replicas(path string) (int32, int32, error) {
+	ns, n := client.Namespaced(path)
+	scale, err := dynClientFor(c.Factory, c.gvr, ns).Get(n, metav1.GetOptions{}, "scale")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scaleReplicas(scale.Object)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func scaleReplicas(o map[string]interface{}) (int32, int32, error) {
+	desired, _, err := unstructured.NestedInt64(o, "spec", "replicas")
+	if err != nil {
+		return 0, 0, err
+	}
+	current, _, err := unstructured.NestedInt64(o, "status", "replicas")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int32(current), int32(desired), nil
+}
@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScaleReplicas(t *testing.T) {
+	uu := map[string]struct {
+		o                map[string]interface{}
+		current, desired int32
+		err              bool
+	}{
+		"plain": {
+			o: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"replicas": int64(2)},
+			},
+			current: 2,
+			desired: 3,
+		},
+		"noStatus": {
+			o: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(1)},
+			},
+			desired: 1,
+		},
+		"toast": {
+			o: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": "bozo"},
+			},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			current, desired, err := scaleReplicas(u.o)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.current, current)
+			assert.Equal(t, u.desired, desired)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// maxDashboardSamples caps the number of samples tracked per resource.
+	maxDashboardSamples = 60
+
+	// dashboardSampleRate collapses refreshes closer than the rate into a single sample.
+	dashboardSampleRate = 5 * time.Second
+)
+
+var _ Accessor = (*Dashboard)(nil)
+
+// dashboardGVRs tracks the resources summarized on the dashboard.
+var dashboardGVRs = []string{
+	"v1/pods",
+	"apps/v1/deployments",
+	"batch/v1/jobs",
+	"v1/events",
+}
+
+// Dashboard represents a resources error rate dashboard.
+type Dashboard struct {
+	NonResource
+}
+
+// List returns the error rate of the tracked resources.
+func (d *Dashboard) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	series, ok := ctx.Value(internal.KeyDashboard).(*DashboardSeries)
+	if !ok {
+		return nil, fmt.Errorf("expecting *DashboardSeries but got %T", ctx.Value(internal.KeyDashboard))
+	}
+
+	rr := make([]render.DashboardRes, 0, len(dashboardGVRs))
+	for _, gvr := range dashboardGVRs {
+		res, err := d.tally(gvr, ns)
+		if err != nil {
+			return nil, err
+		}
+		rr = append(rr, res)
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, res := range series.Record(ns, rr) {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// tally counts the resources and the ones in error for a given gvr.
+func (d *Dashboard) tally(gvr, ns string) (render.DashboardRes, error) {
+	res := render.DashboardRes{GVR: gvr}
+	oo, err := d.Factory.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		return res, err
+	}
+
+	r, h := dashboardRenderer(gvr)
+	header := r.Header(ns)
+	for _, o := range oo {
+		var row render.Row
+		if err := r.Render(h(o), ns, &row); err != nil {
+			return res, err
+		}
+		res.Total++
+		if !render.Happy(ns, header, row) {
+			res.Errors++
+		}
+	}
+
+	return res, nil
+}
+
+type dashboardRow interface {
+	Header(string) render.Header
+	Render(interface{}, string, *render.Row) error
+}
+
+func dashboardRenderer(gvr string) (dashboardRow, func(runtime.Object) interface{}) {
+	raw := func(o runtime.Object) interface{} { return o }
+	switch gvr {
+	case "v1/pods":
+		return render.Pod{}, func(o runtime.Object) interface{} {
+			return &render.PodWithMetrics{Raw: o.(*unstructured.Unstructured)}
+		}
+	case "apps/v1/deployments":
+		return render.Deployment{}, raw
+	case "batch/v1/jobs":
+		return render.Job{}, raw
+	default:
+		return render.Event{}, raw
+	}
+}
+
+// DashboardSeries tracks the dashboard samples over time.
+type DashboardSeries struct {
+	ns     string
+	last   time.Time
+	totals map[string][]int64
+	rates  map[string][]int64
+	mx     sync.Mutex
+}
+
+// NewDashboardSeries returns a new dashboard series.
+func NewDashboardSeries() *DashboardSeries {
+	return &DashboardSeries{
+		totals: make(map[string][]int64),
+		rates:  make(map[string][]int64),
+	}
+}
+
+// Record adds a sample for each resource and returns them with their trends.
+func (s *DashboardSeries) Record(ns string, rr []render.DashboardRes) []render.DashboardRes {
+	return s.record(ns, rr, time.Now())
+}
+
+func (s *DashboardSeries) record(ns string, rr []render.DashboardRes, at time.Time) []render.DashboardRes {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if ns != s.ns {
+		s.ns, s.last = ns, time.Time{}
+		s.totals, s.rates = make(map[string][]int64), make(map[string][]int64)
+	}
+	replace := !s.last.IsZero() && at.Sub(s.last) < dashboardSampleRate
+	if !replace {
+		s.last = at
+	}
+
+	res := make([]render.DashboardRes, 0, len(rr))
+	for _, r := range rr {
+		s.totals[r.GVR] = addSample(s.totals[r.GVR], r.Total, replace)
+		s.rates[r.GVR] = addSample(s.rates[r.GVR], int64(r.ErrorRate()), replace)
+		r.Totals = append([]int64(nil), s.totals[r.GVR]...)
+		r.Rates = append([]int64(nil), s.rates[r.GVR]...)
+		res = append(res, r)
+	}
+
+	return res
+}
+
+func addSample(ss []int64, v int64, replace bool) []int64 {
+	if replace && len(ss) > 0 {
+		ss[len(ss)-1] = v
+		return ss
+	}
+	ss = append(ss, v)
+	if len(ss) > maxDashboardSamples {
+		ss = ss[len(ss)-maxDashboardSamples:]
+	}
+
+	return ss
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardSeriesRecord(t *testing.T) {
+	s, now := NewDashboardSeries(), time.Now()
+
+	s.record("default", []render.DashboardRes{{GVR: "v1/pods", Total: 4, Errors: 1}}, now)
+	s.record("default", []render.DashboardRes{{GVR: "v1/pods", Total: 4, Errors: 2}}, now.Add(time.Second))
+	rr := s.record("default", []render.DashboardRes{{GVR: "v1/pods", Total: 5, Errors: 0}}, now.Add(10*time.Second))
+
+	assert.Equal(t, 1, len(rr))
+	assert.Equal(t, []int64{4, 5}, rr[0].Totals)
+	assert.Equal(t, []int64{50, 0}, rr[0].Rates)
+
+	rr = s.record("fred", []render.DashboardRes{{GVR: "v1/pods", Total: 2}}, now.Add(20*time.Second))
+	assert.Equal(t, []int64{2}, rr[0].Totals)
+}
+
+func TestDashboardSeriesCap(t *testing.T) {
+	s, now := NewDashboardSeries(), time.Now()
+
+	var rr []render.DashboardRes
+	for i := 0; i < maxDashboardSamples+10; i++ {
+		at := now.Add(time.Duration(i) * dashboardSampleRate)
+		rr = s.record("", []render.DashboardRes{{GVR: "v1/pods", Total: int64(i)}}, at)
+	}
+
+	assert.Equal(t, maxDashboardSamples, len(rr[0].Totals))
+	assert.Equal(t, int64(maxDashboardSamples+9), rr[0].Totals[maxDashboardSamples-1])
+}
+
+func TestDashboardListNoSeries(t *testing.T) {
+	var d Dashboard
+	_, err := d.List(context.Background(), "")
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+var _ Accessor = (*Debug)(nil)
+
+// Debug represents k9s self diagnostics.
+type Debug struct {
+	NonResource
+}
+
+// List returns k9s memory, goroutines, informers, refreshes and api calls stats.
+func (d *Debug) List(_ context.Context, _ string) ([]kruntime.Object, error) {
+	oo := runtimeStats()
+	if s, ok := d.Factory.(InformerStater); ok {
+		for _, st := range s.Informers() {
+			ns := st.Namespace
+			if ns == client.AllNamespaces {
+				ns = client.NamespaceAll
+			}
+			name := ns + ":" + st.GVR
+			if st.Selector != "" {
+				name += "|" + st.Selector
+			}
+			oo = append(oo, render.DebugRes{
+				Kind:    render.DebugInformer,
+				Name:    name,
+				Bytes:   st.Bytes,
+				Count:   st.Objects,
+				Details: fmt.Sprintf("listeners=%d synced=%t", st.Listeners, st.Synced),
+			})
+		}
+	}
+	for _, st := range perf.RefreshStats() {
+		oo = append(oo, render.DebugRes{
+			Kind:    render.DebugRefresh,
+			Name:    st.GVR,
+			Count:   st.Count,
+			Latency: st.Last,
+			Details: fmt.Sprintf("max=%v last=%s ago", st.Max.Round(time.Millisecond), since(st.At)),
+		})
+	}
+	if conn := d.Client(); conn != nil && conn.Config() != nil {
+		seen := make(map[string]struct{})
+		for _, c := range conn.Config().APICalls().List() {
+			name := c.Verb + " " + c.GVR
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			oo = append(oo, render.DebugRes{
+				Kind:    render.DebugAPI,
+				Name:    name,
+				Bytes:   c.Bytes,
+				Latency: c.Latency,
+				Details: fmt.Sprintf("status=%d last=%s ago", c.Status, since(c.At)),
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func runtimeStats() []kruntime.Object {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	return []kruntime.Object{
+		render.DebugRes{
+			Kind:    render.DebugRuntime,
+			Name:    "heap",
+			Bytes:   int64(m.HeapAlloc),
+			Count:   int(m.HeapObjects),
+			Details: fmt.Sprintf("inuse=%dMi", m.HeapInuse/(1024*1024)),
+		},
+		render.DebugRes{
+			Kind:    render.DebugRuntime,
+			Name:    "sys",
+			Bytes:   int64(m.Sys),
+			Details: fmt.Sprintf("gc=%d pause=%v", m.NumGC, time.Duration(m.PauseTotalNs).Round(time.Millisecond)),
+		},
+		render.DebugRes{
+			Kind:  render.DebugRuntime,
+			Name:  "goroutines",
+			Count: runtime.NumGoroutine(),
+		},
+	}
+}
+
+func since(t time.Time) string {
+	return duration.HumanDuration(time.Since(t))
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultGrace indicates a resource default termination grace period.
+const DefaultGrace = -1
+
+// DeleteOptions tracks resource deletion options.
+type DeleteOptions struct {
+	Propagation        metav1.DeletionPropagation
+	GracePeriodSeconds int
+	Force              bool
+}
+
+// DefaultDeleteOptions returns deletion options honoring the resources defaults.
+func DefaultDeleteOptions() DeleteOptions {
+	return DeleteOptions{
+		Propagation:        metav1.DeletePropagationBackground,
+		GracePeriodSeconds: DefaultGrace,
+	}
+}
+
+// String returns a human readable representation of the options.
+func (o DeleteOptions) String() string {
+	grace := "default"
+	if g := o.gracePeriod(); g != nil {
+		grace = fmt.Sprintf("%ds", *g)
+	}
+
+	return fmt.Sprintf("propagation=%s grace=%s force=%t", o.propagation(), grace, o.Force)
+}
+
+func (o DeleteOptions) propagation() metav1.DeletionPropagation {
+	if o.Propagation == "" {
+		return metav1.DeletePropagationBackground
+	}
+
+	return o.Propagation
+}
+
+// gracePeriod mirrors kubectl, ie force deletes immediately while a zero
+// grace period without force is bumped to one second.
+func (o DeleteOptions) gracePeriod() *int64 {
+	var g int64
+	switch {
+	case o.Force:
+	case o.GracePeriodSeconds == 0:
+		g = 1
+	case o.GracePeriodSeconds > 0:
+		g = int64(o.GracePeriodSeconds)
+	default:
+		return nil
+	}
+
+	return &g
+}
+
+func (o DeleteOptions) apiOptions() *metav1.DeleteOptions {
+	p := o.propagation()
+
+	return &metav1.DeleteOptions{
+		PropagationPolicy:  &p,
+		GracePeriodSeconds: o.gracePeriod(),
+	}
+}
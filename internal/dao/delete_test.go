@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteOptions(t *testing.T) {
+	uu := map[string]struct {
+		opts  DeleteOptions
+		p     metav1.DeletionPropagation
+		grace *int64
+		e     string
+	}{
+		"default": {
+			opts: DefaultDeleteOptions(),
+			p:    metav1.DeletePropagationBackground,
+			e:    "propagation=Background grace=default force=false",
+		},
+		"blank": {
+			opts: DeleteOptions{GracePeriodSeconds: DefaultGrace},
+			p:    metav1.DeletePropagationBackground,
+			e:    "propagation=Background grace=default force=false",
+		},
+		"grace": {
+			opts:  DeleteOptions{Propagation: metav1.DeletePropagationForeground, GracePeriodSeconds: 10},
+			p:     metav1.DeletePropagationForeground,
+			grace: int64Ptr(10),
+			e:     "propagation=Foreground grace=10s force=false",
+		},
+		"zeroGrace": {
+			opts:  DeleteOptions{Propagation: metav1.DeletePropagationOrphan},
+			p:     metav1.DeletePropagationOrphan,
+			grace: int64Ptr(1),
+			e:     "propagation=Orphan grace=1s force=false",
+		},
+		"force": {
+			opts:  DeleteOptions{GracePeriodSeconds: 30, Force: true},
+			p:     metav1.DeletePropagationBackground,
+			grace: int64Ptr(0),
+			e:     "propagation=Background grace=0s force=true",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := u.opts.apiOptions()
+			assert.Equal(t, u.p, *o.PropagationPolicy)
+			assert.Equal(t, u.grace, o.GracePeriodSeconds)
+			assert.Equal(t, u.e, u.opts.String())
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
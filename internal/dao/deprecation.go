@@ -0,0 +1,313 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Deprecation)(nil)
+
+const lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+// deprecatedAPI tracks a kind api version deprecation and removal releases.
+type deprecatedAPI struct {
+	apiVersion, kind    string
+	replacement         string
+	deprecated, removed string
+}
+
+// deprecatedAPIs tracks the upstream Kubernetes api versions deprecations.
+var deprecatedAPIs = []deprecatedAPI{
+	{"extensions/v1beta1", "Deployment", "apps/v1", "1.9", "1.16"},
+	{"apps/v1beta1", "Deployment", "apps/v1", "1.9", "1.16"},
+	{"apps/v1beta2", "Deployment", "apps/v1", "1.9", "1.16"},
+	{"extensions/v1beta1", "DaemonSet", "apps/v1", "1.9", "1.16"},
+	{"apps/v1beta2", "DaemonSet", "apps/v1", "1.9", "1.16"},
+	{"extensions/v1beta1", "ReplicaSet", "apps/v1", "1.9", "1.16"},
+	{"apps/v1beta1", "StatefulSet", "apps/v1", "1.9", "1.16"},
+	{"apps/v1beta2", "StatefulSet", "apps/v1", "1.9", "1.16"},
+	{"extensions/v1beta1", "NetworkPolicy", "networking.k8s.io/v1", "1.9", "1.16"},
+	{"extensions/v1beta1", "PodSecurityPolicy", "policy/v1beta1", "1.10", "1.16"},
+	{"extensions/v1beta1", "Ingress", "networking.k8s.io/v1", "1.14", "1.22"},
+	{"networking.k8s.io/v1beta1", "Ingress", "networking.k8s.io/v1", "1.19", "1.22"},
+	{"apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "apiextensions.k8s.io/v1", "1.16", "1.22"},
+	{"admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", "admissionregistration.k8s.io/v1", "1.16", "1.22"},
+	{"admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", "admissionregistration.k8s.io/v1", "1.16", "1.22"},
+	{"apiregistration.k8s.io/v1beta1", "APIService", "apiregistration.k8s.io/v1", "1.19", "1.22"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRole", "rbac.authorization.k8s.io/v1", "1.17", "1.22"},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRoleBinding", "rbac.authorization.k8s.io/v1", "1.17", "1.22"},
+	{"rbac.authorization.k8s.io/v1beta1", "Role", "rbac.authorization.k8s.io/v1", "1.17", "1.22"},
+	{"rbac.authorization.k8s.io/v1beta1", "RoleBinding", "rbac.authorization.k8s.io/v1", "1.17", "1.22"},
+	{"scheduling.k8s.io/v1beta1", "PriorityClass", "scheduling.k8s.io/v1", "1.14", "1.22"},
+	{"storage.k8s.io/v1beta1", "StorageClass", "storage.k8s.io/v1", "1.19", "1.22"},
+	{"storage.k8s.io/v1beta1", "CSIDriver", "storage.k8s.io/v1", "1.19", "1.22"},
+	{"storage.k8s.io/v1beta1", "CSINode", "storage.k8s.io/v1", "1.17", "1.22"},
+	{"storage.k8s.io/v1beta1", "VolumeAttachment", "storage.k8s.io/v1", "1.19", "1.22"},
+	{"certificates.k8s.io/v1beta1", "CertificateSigningRequest", "certificates.k8s.io/v1", "1.19", "1.22"},
+	{"coordination.k8s.io/v1beta1", "Lease", "coordination.k8s.io/v1", "1.14", "1.22"},
+	{"batch/v1beta1", "CronJob", "batch/v1", "1.21", "1.25"},
+	{"policy/v1beta1", "PodDisruptionBudget", "policy/v1", "1.21", "1.25"},
+	{"policy/v1beta1", "PodSecurityPolicy", "", "1.21", "1.25"},
+	{"discovery.k8s.io/v1beta1", "EndpointSlice", "discovery.k8s.io/v1", "1.21", "1.25"},
+	{"node.k8s.io/v1beta1", "RuntimeClass", "node.k8s.io/v1", "1.20", "1.25"},
+	{"autoscaling/v2beta1", "HorizontalPodAutoscaler", "autoscaling/v2", "1.22", "1.25"},
+	{"autoscaling/v2beta2", "HorizontalPodAutoscaler", "autoscaling/v2", "1.23", "1.26"},
+	{"storage.k8s.io/v1beta1", "CSIStorageCapacity", "storage.k8s.io/v1", "1.24", "1.27"},
+}
+
+// deprecatedResources tracks the api groups serving a deprecated resource.
+var deprecatedResources = map[string][]string{
+	"deployments":                     {"apps", "extensions"},
+	"daemonsets":                      {"apps", "extensions"},
+	"replicasets":                     {"apps", "extensions"},
+	"statefulsets":                    {"apps"},
+	"networkpolicies":                 {"networking.k8s.io", "extensions"},
+	"podsecuritypolicies":             {"policy", "extensions"},
+	"ingresses":                       {"networking.k8s.io", "extensions"},
+	"customresourcedefinitions":       {"apiextensions.k8s.io"},
+	"mutatingwebhookconfigurations":   {"admissionregistration.k8s.io"},
+	"validatingwebhookconfigurations": {"admissionregistration.k8s.io"},
+	"apiservices":                     {"apiregistration.k8s.io"},
+	"clusterroles":                    {"rbac.authorization.k8s.io"},
+	"clusterrolebindings":             {"rbac.authorization.k8s.io"},
+	"roles":                           {"rbac.authorization.k8s.io"},
+	"rolebindings":                    {"rbac.authorization.k8s.io"},
+	"priorityclasses":                 {"scheduling.k8s.io"},
+	"storageclasses":                  {"storage.k8s.io"},
+	"csidrivers":                      {"storage.k8s.io"},
+	"csinodes":                        {"storage.k8s.io"},
+	"volumeattachments":               {"storage.k8s.io"},
+	"certificatesigningrequests":      {"certificates.k8s.io"},
+	"leases":                          {"coordination.k8s.io"},
+	"cronjobs":                        {"batch"},
+	"poddisruptionbudgets":            {"policy"},
+	"endpointslices":                  {"discovery.k8s.io"},
+	"runtimeclasses":                  {"node.k8s.io"},
+	"horizontalpodautoscalers":        {"autoscaling"},
+	"csistoragecapacities":            {"storage.k8s.io"},
+}
+
+var versionRx = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// Deprecation represents a scan of live objects created via deprecated api versions.
+type Deprecation struct {
+	NonResource
+}
+
+// List returns the objects last applied or managed via an api version deprecated
+// as of the target version or the cluster version if none is given.
+func (d *Deprecation) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	target, _ := ctx.Value(internal.KeyTargetVer).(string)
+	if target == "" {
+		info, err := d.Client().ServerVersion()
+		if err != nil {
+			return nil, err
+		}
+		target = info.Major + "." + info.Minor
+	}
+	tv, err := ParseVersion(target)
+	if err != nil {
+		return nil, err
+	}
+
+	var oo []runtime.Object
+	for _, gvr := range servedDeprecatedGVRs() {
+		meta, err := MetaAccess.MetaFor(client.NewGVR(gvr))
+		if err != nil {
+			continue
+		}
+		rns := ns
+		if !meta.Namespaced {
+			rns = client.ClusterScope
+		}
+		ll, err := d.Factory.List(gvr, rns, true, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Deprecation scan list failed for %s", gvr)
+			continue
+		}
+		for _, o := range ll {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if res, ok := deprecationFor(gvr, meta.Kind, u, tv); ok {
+				oo = append(oo, res)
+			}
+		}
+	}
+
+	return oo, nil
+}
+
+// Version represents a Kubernetes major.minor release.
+type Version struct {
+	Major, Minor int
+}
+
+// ParseVersion parses a Kubernetes version ie 1.22, v1.22.3 or 1.16+.
+func ParseVersion(s string) (Version, error) {
+	m := versionRx.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid Kubernetes version %q", s)
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+
+	return Version{Major: major, Minor: minor}, nil
+}
+
+// AtLeast returns true if the version is greater or equal to the given one.
+func (v Version) AtLeast(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major > o.Major
+	}
+
+	return v.Minor >= o.Minor
+}
+
+// String returns the version as major.minor.
+func (v Version) String() string {
+	return strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// servedDeprecatedGVRs returns one served gvr per resource having deprecated
+// api versions. Each object is served by all its groups versions so a single
+// listing suffices, preferably from its current group and a stable version.
+func servedDeprecatedGVRs() []string {
+	best := make(map[string]client.GVR)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		if _, ok := deprecatedResources[gvr.R()]; !ok {
+			continue
+		}
+		r := gvrRank(gvr)
+		if r < 0 {
+			continue
+		}
+		if cur, ok := best[gvr.R()]; !ok || r < gvrRank(cur) {
+			best[gvr.R()] = gvr
+		}
+	}
+	gg := make([]string, 0, len(best))
+	for _, gvr := range best {
+		gg = append(gg, gvr.String())
+	}
+	sort.Strings(gg)
+
+	return gg
+}
+
+// gvrRank ranks a gvr by its group preference then version stability.
+// Returns -1 if the group does not serve a deprecated resource.
+func gvrRank(gvr client.GVR) int {
+	for i, g := range deprecatedResources[gvr.R()] {
+		if g != gvr.G() {
+			continue
+		}
+		rank := i * 2
+		if strings.Contains(gvr.V(), "alpha") || strings.Contains(gvr.V(), "beta") {
+			rank++
+		}
+		return rank
+	}
+
+	return -1
+}
+
+// deprecationFor checks the api versions an object was last applied or
+// managed with against the deprecated apis at a target version.
+func deprecationFor(gvr, kind string, u *unstructured.Unstructured, target Version) (render.DeprecationRes, bool) {
+	var (
+		res   render.DeprecationRes
+		found bool
+	)
+	for _, used := range usedAPIVersions(u) {
+		api, ok := lookupDeprecated(used.apiVersion, kind)
+		if !ok {
+			continue
+		}
+		dv, _ := ParseVersion(api.deprecated)
+		if !target.AtLeast(dv) {
+			continue
+		}
+		status := render.APIDeprecated
+		if rv, err := ParseVersion(api.removed); err == nil && target.AtLeast(rv) {
+			status = render.APIRemoved
+		}
+		if found && (res.Status == render.APIRemoved || status != render.APIRemoved) {
+			continue
+		}
+		found = true
+		res = render.DeprecationRes{
+			GVR:         gvr,
+			Kind:        kind,
+			Namespace:   u.GetNamespace(),
+			Name:        u.GetName(),
+			APIVersion:  api.apiVersion,
+			Replacement: api.replacement,
+			Deprecated:  api.deprecated,
+			Removed:     api.removed,
+			Source:      used.source,
+			Status:      status,
+		}
+	}
+
+	return res, found
+}
+
+func lookupDeprecated(apiVersion, kind string) (deprecatedAPI, bool) {
+	for _, a := range deprecatedAPIs {
+		if a.apiVersion == apiVersion && a.kind == kind {
+			return a, true
+		}
+	}
+
+	return deprecatedAPI{}, false
+}
+
+type usedAPIVersion struct {
+	apiVersion, source string
+}
+
+// usedAPIVersions returns the api versions an object was last applied with
+// and managed by.
+func usedAPIVersions(u *unstructured.Unstructured) []usedAPIVersion {
+	var vv []usedAPIVersion
+	if raw, ok := u.GetAnnotations()[lastAppliedKey]; ok {
+		var m struct {
+			APIVersion string `json:"apiVersion"`
+		}
+		if err := json.Unmarshal([]byte(raw), &m); err == nil && m.APIVersion != "" {
+			vv = append(vv, usedAPIVersion{apiVersion: m.APIVersion, source: "last-applied"})
+		}
+	}
+	ff, _, _ := unstructured.NestedSlice(u.Object, "metadata", "managedFields")
+	for _, f := range ff {
+		m, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		v, _, _ := unstructured.NestedString(m, "apiVersion")
+		manager, _, _ := unstructured.NestedString(m, "manager")
+		if v != "" {
+			vv = append(vv, usedAPIVersion{apiVersion: v, source: "managed:" + manager})
+		}
+	}
+
+	return vv
+}
@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseVersion(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   Version
+		err bool
+	}{
+		"plain":  {s: "1.22", e: Version{Major: 1, Minor: 22}},
+		"prefix": {s: "v1.16.3", e: Version{Major: 1, Minor: 16}},
+		"plus":   {s: "1.18+", e: Version{Major: 1, Minor: 18}},
+		"toast":  {s: "fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := ParseVersion(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	uu := map[string]struct {
+		v, o Version
+		e    bool
+	}{
+		"same":  {v: Version{1, 22}, o: Version{1, 22}, e: true},
+		"minor": {v: Version{1, 21}, o: Version{1, 22}},
+		"major": {v: Version{2, 0}, o: Version{1, 22}, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.v.AtLeast(u.o))
+		})
+	}
+}
+
+func TestUsedAPIVersions(t *testing.T) {
+	u := makeDeprecated("extensions/v1beta1", "networking.k8s.io/v1beta1")
+
+	assert.Equal(t, []usedAPIVersion{
+		{apiVersion: "extensions/v1beta1", source: "last-applied"},
+		{apiVersion: "networking.k8s.io/v1beta1", source: "managed:kubectl"},
+	}, usedAPIVersions(u))
+}
+
+func TestDeprecationFor(t *testing.T) {
+	uu := map[string]struct {
+		lastApplied, managed string
+		target               Version
+		ok                   bool
+		apiVersion, status   string
+	}{
+		"stable": {
+			lastApplied: "networking.k8s.io/v1",
+			managed:     "networking.k8s.io/v1",
+			target:      Version{1, 22},
+		},
+		"too-early": {
+			lastApplied: "networking.k8s.io/v1beta1",
+			target:      Version{1, 18},
+		},
+		"deprecated": {
+			lastApplied: "networking.k8s.io/v1beta1",
+			target:      Version{1, 19},
+			ok:          true,
+			apiVersion:  "networking.k8s.io/v1beta1",
+			status:      render.APIDeprecated,
+		},
+		"removed": {
+			lastApplied: "networking.k8s.io/v1",
+			managed:     "extensions/v1beta1",
+			target:      Version{1, 22},
+			ok:          true,
+			apiVersion:  "extensions/v1beta1",
+			status:      render.APIRemoved,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := makeDeprecated(u.lastApplied, u.managed)
+			res, ok := deprecationFor("networking.k8s.io/v1/ingresses", "Ingress", o, u.target)
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, "default", res.Namespace)
+			assert.Equal(t, "fred", res.Name)
+			assert.Equal(t, u.apiVersion, res.APIVersion)
+			assert.Equal(t, u.status, res.Status)
+		})
+	}
+}
+
+// Helpers...
+
+func makeDeprecated(lastApplied, managed string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "fred",
+		},
+	}}
+	if lastApplied != "" {
+		u.SetAnnotations(map[string]string{
+			lastAppliedKey: `{"apiVersion":"` + lastApplied + `","kind":"Ingress"}`,
+		})
+	}
+	if managed != "" {
+		_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+			map[string]interface{}{"manager": "kubectl", "apiVersion": managed},
+		}, "metadata", "managedFields")
+	}
+
+	return &u
+}
@@ -0,0 +1,238 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const pdbGVR = "policy/v1beta1/poddisruptionbudgets"
+
+var _ Accessor = (*Disruption)(nil)
+
+// Disruption represents the eviction checks for the pods covered by a
+// disruption budget or running on a node.
+type Disruption struct {
+	NonResource
+}
+
+// List returns a collection of pod eviction checks.
+func (d *Disruption) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", d.gvr)
+	}
+
+	var (
+		rr  []render.DisruptionRes
+		err error
+	)
+	switch gvr, _ := ctx.Value(internal.KeyGVR).(string); gvr {
+	case "v1/nodes":
+		rr, err = d.nodeEvictions(path)
+	case pdbGVR:
+		rr, err = d.budgetEvictions(path)
+	default:
+		return nil, fmt.Errorf("no eviction check available for %q", gvr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+// budgetEvictions checks whether each pod covered by a budget can currently be evicted.
+func (d *Disruption) budgetEvictions(path string) ([]render.DisruptionRes, error) {
+	o, err := d.Factory.Get(pdbGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var pdb policyv1beta1.PodDisruptionBudget
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pdb); err != nil {
+		return nil, err
+	}
+	sel, ok := budgetSelector(pdb)
+	if !ok {
+		return nil, fmt.Errorf("disruption budget %s selects no pods", path)
+	}
+	pp, err := d.pods(pdb.Namespace, sel)
+	if err != nil {
+		return nil, err
+	}
+	bb, err := d.budgets(pdb.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]render.DisruptionRes, 0, len(pp))
+	for _, po := range pp {
+		rr = append(rr, evictionFor(po, bb, nil))
+	}
+
+	return rr, nil
+}
+
+// nodeEvictions checks whether a node pods can be evicted in turn, as a drain
+// would, consuming budgets as pods get evicted.
+func (d *Disruption) nodeEvictions(node string) ([]render.DisruptionRes, error) {
+	pp, err := d.pods(client.AllNamespaces, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	bb, err := d.budgets(client.AllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rr   []render.DisruptionRes
+		left = make(map[string]int32)
+	)
+	for _, po := range pp {
+		if po.Spec.NodeName != node {
+			continue
+		}
+		rr = append(rr, evictionFor(po, bb, left))
+	}
+
+	return rr, nil
+}
+
+func (d *Disruption) pods(ns string, sel labels.Selector) ([]v1.Pod, error) {
+	oo, err := d.Factory.List("v1/pods", ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return FQN(pp[i].Namespace, pp[i].Name) < FQN(pp[j].Namespace, pp[j].Name)
+	})
+
+	return pp, nil
+}
+
+func (d *Disruption) budgets(ns string) ([]policyv1beta1.PodDisruptionBudget, error) {
+	oo, err := d.Factory.List(pdbGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	bb := make([]policyv1beta1.PodDisruptionBudget, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pdb policyv1beta1.PodDisruptionBudget
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pdb); err != nil {
+			return nil, err
+		}
+		bb = append(bb, pdb)
+	}
+
+	return bb, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// evictionFor checks whether a pod eviction would currently be blocked by a
+// budget. When left is set, budgets are consumed by each allowed eviction.
+func evictionFor(po v1.Pod, bb []policyv1beta1.PodDisruptionBudget, left map[string]int32) render.DisruptionRes {
+	res := render.DisruptionRes{Pod: FQN(po.Namespace, po.Name), Node: po.Spec.NodeName}
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+		res.Reason = "pod is terminated"
+		return res
+	}
+
+	cc := coveringBudgets(po, bb)
+	switch len(cc) {
+	case 0:
+		res.Reason = "no disruption budget"
+		return res
+	case 1:
+	default:
+		res.Budget, res.Blocked = FQN(cc[0].Namespace, cc[0].Name), true
+		res.Reason = fmt.Sprintf("pod is covered by %d disruption budgets", len(cc))
+		return res
+	}
+
+	pdb := cc[0]
+	res.Budget, res.Allowed = FQN(pdb.Namespace, pdb.Name), pdb.Status.PodDisruptionsAllowed
+	remaining, ok := left[res.Budget]
+	if !ok {
+		remaining = res.Allowed
+	}
+	switch {
+	case res.Allowed <= 0:
+		res.Blocked = true
+		res.Reason = fmt.Sprintf("no disruptions allowed (%d/%d healthy)", pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy)
+	case remaining <= 0:
+		res.Blocked = true
+		res.Reason = "budget exhausted by prior evictions"
+	default:
+		res.Reason = fmt.Sprintf("%d/%d healthy", pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy)
+	}
+	if left != nil && !res.Blocked {
+		left[res.Budget] = remaining - 1
+	}
+
+	return res
+}
+
+// coveringBudgets returns the budgets selecting a given pod.
+func coveringBudgets(po v1.Pod, bb []policyv1beta1.PodDisruptionBudget) []policyv1beta1.PodDisruptionBudget {
+	var cc []policyv1beta1.PodDisruptionBudget
+	for _, pdb := range bb {
+		if pdb.Namespace != po.Namespace {
+			continue
+		}
+		if sel, ok := budgetSelector(pdb); ok && sel.Matches(labels.Set(po.Labels)) {
+			cc = append(cc, pdb)
+		}
+	}
+
+	return cc
+}
+
+// budgetSelector returns a budget pod selector. Policy/v1beta1 budgets with
+// a nil or empty selector match no pods.
+func budgetSelector(pdb policyv1beta1.PodDisruptionBudget) (labels.Selector, bool) {
+	if pdb.Spec.Selector == nil {
+		return nil, false
+	}
+	sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || sel.Empty() {
+		return nil, false
+	}
+
+	return sel, true
+}
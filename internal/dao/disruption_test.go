@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEvictionFor(t *testing.T) {
+	pdb := func(n string, allowed int32, sel map[string]string) policyv1beta1.PodDisruptionBudget {
+		b := policyv1beta1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+			Status: policyv1beta1.PodDisruptionBudgetStatus{
+				PodDisruptionsAllowed: allowed,
+				CurrentHealthy:        2,
+				DesiredHealthy:        1,
+			},
+		}
+		if sel != nil {
+			b.Spec.Selector = &metav1.LabelSelector{MatchLabels: sel}
+		}
+		return b
+	}
+	pod := func(n string, ll map[string]string, phase v1.PodPhase) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, Labels: ll},
+			Spec:       v1.PodSpec{NodeName: "n1"},
+			Status:     v1.PodStatus{Phase: phase},
+		}
+	}
+
+	uu := map[string]struct {
+		po             v1.Pod
+		bb             []policyv1beta1.PodDisruptionBudget
+		budget, reason string
+		blocked        bool
+	}{
+		"noBudget": {
+			po:     pod("p1", map[string]string{"app": "fred"}, v1.PodRunning),
+			bb:     []policyv1beta1.PodDisruptionBudget{pdb("b1", 1, map[string]string{"app": "blee"})},
+			reason: "no disruption budget",
+		},
+		"emptySelector": {
+			po:     pod("p1", map[string]string{"app": "fred"}, v1.PodRunning),
+			bb:     []policyv1beta1.PodDisruptionBudget{pdb("b1", 0, map[string]string{})},
+			reason: "no disruption budget",
+		},
+		"allowed": {
+			po:     pod("p1", map[string]string{"app": "fred"}, v1.PodRunning),
+			bb:     []policyv1beta1.PodDisruptionBudget{pdb("b1", 1, map[string]string{"app": "fred"})},
+			budget: "default/b1",
+			reason: "2/1 healthy",
+		},
+		"blocked": {
+			po:      pod("p1", map[string]string{"app": "fred"}, v1.PodRunning),
+			bb:      []policyv1beta1.PodDisruptionBudget{pdb("b1", 0, map[string]string{"app": "fred"})},
+			budget:  "default/b1",
+			reason:  "no disruptions allowed (2/1 healthy)",
+			blocked: true,
+		},
+		"terminated": {
+			po:     pod("p1", map[string]string{"app": "fred"}, v1.PodSucceeded),
+			bb:     []policyv1beta1.PodDisruptionBudget{pdb("b1", 0, map[string]string{"app": "fred"})},
+			reason: "pod is terminated",
+		},
+		"multi": {
+			po: pod("p1", map[string]string{"app": "fred"}, v1.PodRunning),
+			bb: []policyv1beta1.PodDisruptionBudget{
+				pdb("b1", 1, map[string]string{"app": "fred"}),
+				pdb("b2", 1, map[string]string{"app": "fred"}),
+			},
+			budget:  "default/b1",
+			reason:  "pod is covered by 2 disruption budgets",
+			blocked: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res := evictionFor(u.po, u.bb, nil)
+			assert.Equal(t, "default/p1", res.Pod)
+			assert.Equal(t, u.budget, res.Budget)
+			assert.Equal(t, u.reason, res.Reason)
+			assert.Equal(t, u.blocked, res.Blocked)
+		})
+	}
+}
+
+func TestEvictionForConsumesBudget(t *testing.T) {
+	ll := map[string]string{"app": "fred"}
+	bb := []policyv1beta1.PodDisruptionBudget{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "b1"},
+		Spec:       policyv1beta1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: ll}},
+		Status:     policyv1beta1.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1},
+	}}
+
+	left := make(map[string]int32)
+	p1 := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", Labels: ll}}
+	p2 := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p2", Labels: ll}}
+
+	assert.False(t, evictionFor(p1, bb, left).Blocked)
+	res := evictionFor(p2, bb, left)
+	assert.True(t, res.Blocked)
+	assert.Equal(t, "budget exhausted by prior evictions", res.Reason)
+}
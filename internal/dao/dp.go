@@ -26,7 +26,7 @@ var (
 
 // Deployment represents a deployment K8s resource.
 type Deployment struct {
-	Resource
+	Informed
 }
 
 // IsHappy check for happy deployments.
@@ -36,6 +36,10 @@ func (d *Deployment) IsHappy(dp appsv1.Deployment) bool {
 
 // Scale a Deployment.
 func (d *Deployment) Scale(path string, replicas int32) error {
+	if err := ensureWritable(d.Factory); err != nil {
+		return err
+	}
+
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, "apps/v1/deployments:scale", []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -55,8 +59,23 @@ func (d *Deployment) Scale(path string, replicas int32) error {
 	return err
 }
 
+// Replicas returns a Deployment current and desired replicas.
+func (d *Deployment) Replicas(path string) (int32, int32, error) {
+	ns, n := client.Namespaced(path)
+	scale, err := d.Client().DialOrDie().AppsV1().Deployments(ns).GetScale(n, metav1.GetOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scale.Status.Replicas, scale.Spec.Replicas, nil
+}
+
 // Restart a Deployment rollout.
 func (d *Deployment) Restart(path string) error {
+	if err := ensureWritable(d.Factory); err != nil {
+		return err
+	}
+
 	dp, err := d.Load(d.Factory, path)
 	if err != nil {
 		return err
@@ -0,0 +1,383 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const driftCmdTimeout = 30 * time.Second
+
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+type driftManifest struct {
+	source string
+	o      *unstructured.Unstructured
+}
+
+// ResourceDrift returns the differences between a live resource and its
+// manifest. Only the fields set in the manifest are compared.
+func ResourceDrift(f Factory, cfg *config.Drift, gvr client.GVR, path string) (string, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return "", err
+	}
+	mm, err := driftManifests(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	ns, n := client.Namespaced(path)
+	for _, m := range mm {
+		if !m.matches(gvr.G(), meta.Kind, ns, n) {
+			continue
+		}
+		diff, _, err := manifestDrift(f, gvr, path, m)
+		return diff, err
+	}
+
+	return "", fmt.Errorf("no manifest found for %s %s in %s", meta.Kind, path, cfg.Dir)
+}
+
+// NamespaceDrift returns the differences between all the manifests targeting
+// a namespace and their live resources. Manifests without a namespace are
+// checked against the given namespace.
+func NamespaceDrift(f Factory, cfg *config.Drift, ns string) (string, error) {
+	mm, err := driftManifests(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		diffs                   []string
+		checked, drift, missing int
+	)
+	for _, m := range mm {
+		gvr, meta, ok := gvrForKind(m.o.GetAPIVersion(), m.o.GetKind())
+		if !ok {
+			log.Warn().Msgf("Drift skipping %s: unknown resource kind %q", m.source, m.o.GetKind())
+			continue
+		}
+		if !meta.Namespaced || (m.o.GetNamespace() != "" && m.o.GetNamespace() != ns) {
+			continue
+		}
+		checked++
+		diff, state, err := manifestDrift(f, gvr, client.FQN(ns, m.o.GetName()), m)
+		if err != nil {
+			return "", err
+		}
+		switch state {
+		case driftMissing:
+			missing++
+		case driftChanged:
+			drift++
+		default:
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+	if checked == 0 {
+		return "", fmt.Errorf("no manifests found for namespace %s in %s", ns, cfg.Dir)
+	}
+	summary := fmt.Sprintf("%d manifest(s) checked in namespace %s: %d drifted, %d missing", checked, ns, drift, missing)
+	if len(diffs) == 0 {
+		return summary, nil
+	}
+
+	return summary + "\n\n" + strings.Join(diffs, "\n"), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type driftState int
+
+const (
+	driftNone driftState = iota
+	driftChanged
+	driftMissing
+)
+
+func (m driftManifest) matches(group, kind, ns, n string) bool {
+	gv, err := schema.ParseGroupVersion(m.o.GetAPIVersion())
+	if err != nil || gv.Group != group || m.o.GetKind() != kind || m.o.GetName() != n {
+		return false
+	}
+
+	return m.o.GetNamespace() == "" || m.o.GetNamespace() == ns
+}
+
+// manifestDrift diffs a live resource against its manifest.
+func manifestDrift(f Factory, gvr client.GVR, path string, m driftManifest) (string, driftState, error) {
+	live, err := Fetch(f, gvr, path)
+	switch {
+	case apierrors.IsNotFound(err):
+		diff, err := driftDiff(path+" (missing)", m.source, nil, m.o)
+		return diff, driftMissing, err
+	case err != nil:
+		return "", driftNone, err
+	}
+
+	diff, err := driftDiff(path, m.source, live, m.o)
+	if err != nil || diff == "" {
+		return fmt.Sprintf("No drift between %s and %s", path, m.source), driftNone, err
+	}
+
+	return diff, driftChanged, nil
+}
+
+// driftDiff returns a unified diff between a live object, pruned to the
+// fields set in its manifest, and the manifest.
+func driftDiff(from, to string, live, desired *unstructured.Unstructured) (string, error) {
+	var liveRaw string
+	if live != nil {
+		pruned, _ := pruneTo(live.Object, desired.Object).(map[string]interface{})
+		u := unstructured.Unstructured{Object: pruned}
+		// Objects are served at all their versions, only the fields matter.
+		u.SetAPIVersion(desired.GetAPIVersion())
+		raw, err := ToYAML(&u)
+		if err != nil {
+			return "", err
+		}
+		liveRaw = raw
+	}
+	desiredRaw, err := ToYAML(desired)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveRaw),
+		B:        difflib.SplitLines(desiredRaw),
+		FromFile: "live " + from,
+		ToFile:   to,
+		Context:  3,
+	})
+}
+
+// pruneTo strips the live fields not set in the desired object.
+func pruneTo(live, desired interface{}) interface{} {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		m := make(map[string]interface{}, len(d))
+		for k, dv := range d {
+			if lv, ok := l[k]; ok {
+				m[k] = pruneTo(lv, dv)
+			}
+		}
+		return m
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			return live
+		}
+		ll := make([]interface{}, len(l))
+		for i, lv := range l {
+			if i < len(d) {
+				ll[i] = pruneTo(lv, d[i])
+				continue
+			}
+			ll[i] = lv
+		}
+		return ll
+	default:
+		return live
+	}
+}
+
+// driftManifests loads the manifests from a directory or kustomization,
+// optionally at a given git ref.
+func driftManifests(cfg *config.Drift) ([]driftManifest, error) {
+	dir, prefix := cfg.Dir, ""
+	if cfg.Ref != "" {
+		tmp, sub, err := gitExport(cfg.Dir, cfg.Ref)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(tmp); err != nil {
+				log.Error().Err(err).Msgf("Removing drift export %s", tmp)
+			}
+		}()
+		dir, prefix = filepath.Join(tmp, sub), cfg.Ref+":"
+	}
+
+	if isKustomization(dir) {
+		raw, err := kustomizeBuild(dir)
+		if err != nil {
+			return nil, err
+		}
+		uu, err := manifestObjects(string(raw))
+		if err != nil {
+			return nil, err
+		}
+		mm := make([]driftManifest, 0, len(uu))
+		for _, u := range uu {
+			mm = append(mm, driftManifest{source: prefix + "kustomization", o: u})
+		}
+		return mm, nil
+	}
+
+	return dirManifests(dir, prefix)
+}
+
+func dirManifests(dir, prefix string) ([]driftManifest, error) {
+	var mm []driftManifest
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if path != dir && strings.HasPrefix(fi.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		uu, err := manifestObjects(string(raw))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Drift skipping %s", rel)
+			return nil
+		}
+		for _, u := range uu {
+			if u.GetKind() == "" || u.GetName() == "" {
+				continue
+			}
+			mm = append(mm, driftManifest{source: prefix + rel, o: u})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(mm) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", dir)
+	}
+
+	return mm, nil
+}
+
+func isKustomization(dir string) bool {
+	for _, k := range kustomizationFiles {
+		if _, err := os.Stat(filepath.Join(dir, k)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func kustomizeBuild(dir string) ([]byte, error) {
+	if _, err := exec.LookPath("kubectl"); err == nil {
+		return runDriftCmd("kubectl", "kustomize", dir)
+	}
+
+	return runDriftCmd("kustomize", "build", dir)
+}
+
+// gitExport extracts a git ref tree to a temporary directory and returns it
+// along with the directory path relative to the repository root.
+func gitExport(dir, ref string) (string, string, error) {
+	prefix, err := runDriftCmd("git", "-C", dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return "", "", err
+	}
+	top, err := runDriftCmd("git", "-C", dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", "", err
+	}
+	// Archive the whole tree so kustomizations may refer to parent directories.
+	raw, err := runDriftCmd("git", "-C", strings.TrimSpace(string(top)), "archive", "--format=tar", ref)
+	if err != nil {
+		return "", "", err
+	}
+	tmp, err := ioutil.TempDir("", "k9s-drift-")
+	if err != nil {
+		return "", "", err
+	}
+	if err := untar(bytes.NewReader(raw), tmp); err != nil {
+		_ = os.RemoveAll(tmp)
+		return "", "", err
+	}
+
+	return tmp, strings.TrimSpace(string(prefix)), nil
+}
+
+func untar(r io.Reader, dst string) error {
+	root := filepath.Clean(dst) + string(os.PathSeparator)
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dst, h.Name)
+		if !strings.HasPrefix(path, root) {
+			return fmt.Errorf("invalid archive path %q", h.Name)
+		}
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return err
+			}
+			raw, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(path, raw, 0600); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func runDriftCmd(bin string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), driftCmdTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s %s failed: %v %s", bin, strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
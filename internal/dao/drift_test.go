@@ -0,0 +1,222 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPruneTo(t *testing.T) {
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"resourceVersion": "10",
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80), "protocol": "TCP"},
+				map[string]interface{}{"port": int64(443), "protocol": "TCP"},
+			},
+		},
+		"status": map[string]interface{}{"ready": true},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred"},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80)},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred"},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80)},
+				map[string]interface{}{"port": int64(443), "protocol": "TCP"},
+			},
+		},
+	}, pruneTo(live, desired))
+}
+
+func TestDriftDiff(t *testing.T) {
+	desired := makeDriftObj("apps/v1", 1)
+	uu := map[string]struct {
+		live *unstructured.Unstructured
+		e    string
+	}{
+		"same": {
+			live: makeDriftObj("apps/v1", 1),
+		},
+		"version": {
+			live: makeDriftObj("extensions/v1beta1", 1),
+		},
+		"drift": {
+			live: makeDriftObj("apps/v1", 3),
+			e:    "-  replicas: 3\n+  replicas: 1\n"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			diff, err := driftDiff("default/fred", "fred.yml", u.live, desired)
+			assert.Nil(t, err)
+			if u.e == "" {
+				assert.Equal(t, "", diff)
+				return
+			}
+			assert.Contains(t, diff, "--- live default/fred\n+++ fred.yml\n")
+			assert.Contains(t, diff, u.e)
+		})
+	}
+}
+
+func TestDriftDiffMissing(t *testing.T) {
+	diff, err := driftDiff("default/fred (missing)", "fred.yml", nil, makeDriftObj("apps/v1", 1))
+
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "--- live default/fred (missing)")
+	assert.Contains(t, diff, "+  replicas: 1")
+}
+
+func TestDriftManifestMatches(t *testing.T) {
+	m := driftManifest{o: makeDriftObj("apps/v1", 1)}
+	uu := map[string]struct {
+		group, kind, ns, n string
+		e                  bool
+	}{
+		"match":   {group: "apps", kind: "Deployment", ns: "default", n: "fred", e: true},
+		"group":   {group: "extensions", kind: "Deployment", ns: "default", n: "fred"},
+		"kind":    {group: "apps", kind: "StatefulSet", ns: "default", n: "fred"},
+		"ns":      {group: "apps", kind: "Deployment", ns: "blee", n: "fred"},
+		"name":    {group: "apps", kind: "Deployment", ns: "default", n: "zorg"},
+		"cluster": {group: "apps", kind: "Deployment", ns: "", n: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, m.matches(u.group, u.kind, u.ns, u.n))
+		})
+	}
+
+	m.o.SetNamespace("")
+	assert.True(t, m.matches("apps", "Deployment", "blee", "fred"))
+}
+
+func TestDirManifests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-drift-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"web/dp.yml":     "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: web\n",
+		"web/notes.txt":  "blee",
+		"values.yaml":    "replicas: 1\n",
+		".git/blee.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: git\n",
+	}
+	for n, c := range files {
+		assert.Nil(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(n)), 0700))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, n), []byte(c), 0600))
+	}
+
+	mm, err := dirManifests(dir, "main:")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mm))
+	for _, m := range mm {
+		assert.Equal(t, "main:"+filepath.Join("web", "dp.yml"), m.source)
+	}
+	assert.Equal(t, "Deployment", mm[0].o.GetKind())
+	assert.Equal(t, "Service", mm[1].o.GetKind())
+}
+
+func TestUntar(t *testing.T) {
+	uu := map[string]struct {
+		name string
+		err  bool
+	}{
+		"plain":     {name: "k8s/dp.yml"},
+		"traversal": {name: "../dp.yml", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "k9s-drift-test")
+			assert.Nil(t, err)
+			defer os.RemoveAll(dir)
+
+			var buff bytes.Buffer
+			w := tar.NewWriter(&buff)
+			assert.Nil(t, w.WriteHeader(&tar.Header{Name: u.name, Mode: 0600, Size: 4, Typeflag: tar.TypeReg}))
+			_, err = w.Write([]byte("blee"))
+			assert.Nil(t, err)
+			assert.Nil(t, w.Close())
+
+			err = untar(&buff, dir)
+			assert.Equal(t, u.err, err != nil)
+			if u.err {
+				return
+			}
+			raw, err := ioutil.ReadFile(filepath.Join(dir, u.name))
+			assert.Nil(t, err)
+			assert.Equal(t, "blee", string(raw))
+		})
+	}
+}
+
+// Helpers...
+
+func makeDriftObj(apiVersion string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "fred",
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	}}
+}
+
+func TestDriftManifestsGitRef(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir, err := ioutil.TempDir("", "k9s-drift-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	git := func(args ...string) {
+		_, err := runDriftCmd("git", append([]string{"-C", dir, "-c", "user.name=fred", "-c", "user.email=fred@blee.io"}, args...)...)
+		assert.Nil(t, err)
+	}
+	sub := filepath.Join(dir, "prod")
+	assert.Nil(t, os.MkdirAll(sub, 0700))
+	file := filepath.Join(sub, "cm.yml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: fred\n"), 0600))
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "init")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: blee\n"), 0600))
+
+	mm, err := driftManifests(&config.Drift{Dir: sub, Ref: "HEAD"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(mm))
+	assert.Equal(t, "HEAD:cm.yml", mm[0].source)
+	assert.Equal(t, "fred", mm[0].o.GetName())
+}
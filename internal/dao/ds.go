@@ -29,7 +29,7 @@ var (
 
 // DaemonSet represents a K8s daemonset.
 type DaemonSet struct {
-	Resource
+	Informed
 }
 
 // IsHappy check for happy deployments.
@@ -39,6 +39,10 @@ func (d *DaemonSet) IsHappy(ds appsv1.DaemonSet) bool {
 
 // Restart a DaemonSet rollout.
 func (d *DaemonSet) Restart(path string) error {
+	if err := ensureWritable(d.Factory); err != nil {
+		return err
+	}
+
 	ds, err := d.GetInstance(path)
 	if err != nil {
 		return err
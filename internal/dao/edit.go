@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// Fetch retrieves the latest revision of a resource from the api server.
+func Fetch(f Factory, gvr client.GVR, path string) (*unstructured.Unstructured, error) {
+	ns, n := client.Namespaced(path)
+
+	return dynClientFor(f, gvr, ns).Get(n, metav1.GetOptions{})
+}
+
+// Update replaces a resource with the given manifest. When dryRun is set the
+// manifest is validated server side without being persisted.
+func Update(f Factory, gvr client.GVR, raw []byte, dryRun bool) (*unstructured.Unstructured, error) {
+	if !dryRun {
+		if err := ensureWritable(f); err != nil {
+			return nil, err
+		}
+	}
+
+	u, err := toUnstructured(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts metav1.UpdateOptions
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	return dynClientFor(f, gvr, u.GetNamespace()).Update(u, opts)
+}
+
+func toUnstructured(raw []byte) (*unstructured.Unstructured, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, errors.New("empty manifest")
+	}
+
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
+func dynClientFor(f Factory, gvr client.GVR, ns string) dynamic.ResourceInterface {
+	dial := f.Client().DynDialOrDie().Resource(gvr.GVR())
+	if ns == "" || client.IsClusterScoped(ns) {
+		return dial
+	}
+
+	return dial.Namespace(ns)
+}
@@ -0,0 +1,180 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Event)(nil)
+	_ Pageable = (*Event)(nil)
+)
+
+// Event represents an event resource.
+type Event struct {
+	Resource
+}
+
+// ListPage returns a page of events optionally filtered by a field selector.
+func (e *Event) ListPage(ctx context.Context, ns, cont string, limit int64) ([]runtime.Object, string, error) {
+	ll, err := listPage(ctx, e.Factory, e.gvr, ns, cont, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	oo := pageObjects(ll)
+	if dedup, ok := ctx.Value(internal.KeyDedup).(bool); ok && dedup {
+		oo, err = dedupEvents(oo)
+	}
+
+	return oo, ll.GetContinue(), err
+}
+
+// List returns a collection of events optionally filtered by a field selector.
+func (e *Event) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := e.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	if sel, ok := ctx.Value(internal.KeyFields).(string); ok && sel != "" {
+		if oo, err = filterEvents(oo, sel); err != nil {
+			return nil, err
+		}
+	}
+	if dedup, ok := ctx.Value(internal.KeyDedup).(bool); ok && dedup {
+		return dedupEvents(oo)
+	}
+
+	return oo, nil
+}
+
+func filterEvents(oo []runtime.Object, sel string) ([]runtime.Object, error) {
+	fsel, err := fields.ParseSelector(sel)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if fsel.Matches(eventFields(u)) {
+			res = append(res, o)
+		}
+	}
+
+	return res, nil
+}
+
+// dedupEvents rolls up events sharing an involved object, type, reason and
+// message. Groups keep their oldest event identity so rows stay stable.
+func dedupEvents(oo []runtime.Object) ([]runtime.Object, error) {
+	var (
+		ee    []v1.Event
+		index = make(map[string]int, len(oo))
+	)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return nil, err
+		}
+		if ev.Count < 1 {
+			ev.Count = 1
+		}
+		ref := ev.InvolvedObject
+		key := strings.Join([]string{ref.Kind, ref.Namespace, ref.Name, ev.Type, ev.Reason, ev.Message}, "|")
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(ee)
+			ee = append(ee, ev)
+			continue
+		}
+		ee[i] = rollupEvent(ee[i], ev)
+	}
+
+	res := make([]runtime.Object, 0, len(ee))
+	for i := range ee {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ee[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &unstructured.Unstructured{Object: m})
+	}
+
+	return res, nil
+}
+
+// rollupEvent merges an event into its group.
+func rollupEvent(group, ev v1.Event) v1.Event {
+	count := group.Count + ev.Count
+	first, last := group.FirstTimestamp, group.LastTimestamp
+	if ev.FirstTimestamp.Before(&first) {
+		group, first = ev, ev.FirstTimestamp
+	}
+	if last.Before(&ev.LastTimestamp) {
+		last = ev.LastTimestamp
+	}
+	group.Count, group.FirstTimestamp, group.LastTimestamp = count, first, last
+
+	return group
+}
+
+// EventsSelector returns a field selector matching events involving a given object.
+func EventsSelector(kind, ns, n string) string {
+	ss := fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": n,
+	}
+	if ns != "" {
+		ss["involvedObject.namespace"] = ns
+	}
+
+	kk := make([]string, 0, len(ss))
+	for k := range ss {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	sels := make([]fields.Selector, 0, len(kk))
+	for _, k := range kk {
+		sels = append(sels, fields.OneTermEqualSelector(k, ss[k]))
+	}
+
+	return fields.AndSelectors(sels...).String()
+}
+
+func eventFields(u *unstructured.Unstructured) fields.Set {
+	ss := fields.Set{
+		"metadata.name":      u.GetName(),
+		"metadata.namespace": u.GetNamespace(),
+	}
+	obj, ok := u.Object["involvedObject"].(map[string]interface{})
+	if !ok {
+		return ss
+	}
+	for _, k := range []string{"kind", "namespace", "name", "uid", "apiVersion", "fieldPath"} {
+		if v, ok := obj[k].(string); ok {
+			ss["involvedObject."+k] = v
+		}
+	}
+	if v, ok := u.Object["reason"].(string); ok {
+		ss["reason"] = v
+	}
+	if v, ok := u.Object["type"].(string); ok {
+		ss["type"] = v
+	}
+
+	return ss
+}
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestEventsSelector(t *testing.T) {
+	uu := map[string]struct {
+		kind, ns, n string
+		e           string
+	}{
+		"namespaced": {
+			kind: "Pod", ns: "default", n: "p1",
+			e: "involvedObject.kind=Pod,involvedObject.name=p1,involvedObject.namespace=default",
+		},
+		"cluster": {
+			kind: "Node", n: "n1",
+			e: "involvedObject.kind=Node,involvedObject.name=n1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, EventsSelector(u.kind, u.ns, u.n))
+		})
+	}
+}
+
+func TestEventFields(t *testing.T) {
+	ev := makeEvent("Pod", "default", "p1")
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"match":     {sel: EventsSelector("Pod", "default", "p1"), e: true},
+		"otherName": {sel: EventsSelector("Pod", "default", "p2")},
+		"otherKind": {sel: EventsSelector("Deployment", "default", "p1")},
+		"otherNS":   {sel: EventsSelector("Pod", "fred", "p1")},
+		"reason":    {sel: "reason=Killing", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := fields.ParseSelector(u.sel)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, sel.Matches(eventFields(ev)))
+		})
+	}
+}
+
+// Helpers...
+
+func makeEvent(kind, ns, n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"namespace": ns,
+				"name":      n + ".1",
+			},
+			"reason": "Killing",
+			"involvedObject": map[string]interface{}{
+				"kind":      kind,
+				"namespace": ns,
+				"name":      n,
+			},
+		},
+	}
+}
+
+func TestDedupEvents(t *testing.T) {
+	ev := func(n, reason, msg string, count int64, first, last string) runtime.Object {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": n},
+			"involvedObject": map[string]interface{}{
+				"kind":      "Pod",
+				"namespace": "default",
+				"name":      "p1",
+			},
+			"type":           "Warning",
+			"reason":         reason,
+			"message":        msg,
+			"count":          count,
+			"firstTimestamp": first,
+			"lastTimestamp":  last,
+		}}
+	}
+	oo := []runtime.Object{
+		ev("e2", "BackOff", "back-off restarting", 3, "2020-01-01T10:05:00Z", "2020-01-01T10:20:00Z"),
+		ev("e1", "BackOff", "back-off restarting", 2, "2020-01-01T10:00:00Z", "2020-01-01T10:10:00Z"),
+		ev("e3", "Unhealthy", "probe failed", 1, "2020-01-01T10:01:00Z", "2020-01-01T10:01:00Z"),
+	}
+
+	res, err := dedupEvents(oo)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(res))
+
+	u := res[0].(*unstructured.Unstructured)
+	assert.Equal(t, "e1", u.GetName())
+	count, _, _ := unstructured.NestedInt64(u.Object, "count")
+	assert.Equal(t, int64(5), count)
+	first, _, _ := unstructured.NestedString(u.Object, "firstTimestamp")
+	assert.Equal(t, "2020-01-01T10:00:00Z", first)
+	last, _, _ := unstructured.NestedString(u.Object, "lastTimestamp")
+	assert.Equal(t, "2020-01-01T10:20:00Z", last)
+	assert.Equal(t, "e3", res[1].(*unstructured.Unstructured).GetName())
+}
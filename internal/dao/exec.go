@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// execIn runs a command in a container and streams its output.
+func execIn(conn client.Connection, fqn, co string, cmd []string, out io.Writer) error {
+	ns, n := client.Namespaced(fqn)
+	auth, err := conn.CanI(ns, "v1/pods:exec", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec into pod %s", fqn)
+	}
+
+	cfg, err := conn.Config().RESTConfig()
+	if err != nil {
+		return err
+	}
+	req := conn.DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	if err := exec.Stream(remotecommand.StreamOptions{Stdout: out, Stderr: &stderr}); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.New(msg)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Finalizers returns a resource finalizers and deletion timestamp if any.
+func Finalizers(f Factory, gvr client.GVR, path string) ([]string, *metav1.Time, error) {
+	o, err := Fetch(f, gvr, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return o.GetFinalizers(), o.GetDeletionTimestamp(), nil
+}
+
+// RemoveFinalizer patches a given finalizer away from a resource. The patch
+// fails should the resource finalizers change in the meantime.
+func RemoveFinalizer(f Factory, gvr client.GVR, path, finalizer string) error {
+	if err := ensureWritable(f); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	ff, _, err := Finalizers(f, gvr, path)
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, fin := range ff {
+		if fin == finalizer {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("finalizer %s not found on %s", finalizer, path)
+	}
+	raw, err := removeFinalizerPatch(idx, finalizer)
+	if err != nil {
+		return err
+	}
+	_, err = dynClientFor(f, gvr, ns).Patch(n, types.JSONPatchType, raw, metav1.PatchOptions{})
+
+	return err
+}
+
+func removeFinalizerPatch(idx int, finalizer string) ([]byte, error) {
+	path := fmt.Sprintf("/metadata/finalizers/%d", idx)
+
+	return json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": path, "value": finalizer},
+		{"op": "remove", "path": path},
+	})
+}
@@ -0,0 +1,14 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveFinalizerPatch(t *testing.T) {
+	raw, err := removeFinalizerPatch(1, "kubernetes.io/pvc-protection")
+
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"op":"test","path":"/metadata/finalizers/1","value":"kubernetes.io/pvc-protection"},{"op":"remove","path":"/metadata/finalizers/1"}]`, string(raw))
+}
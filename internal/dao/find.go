@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Find)(nil)
+
+// Find represents a search across resources.
+type Find struct {
+	NonResource
+}
+
+// List returns the resources matching the search pattern.
+func (f *Find) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyFind).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a search pattern but got %T", ctx.Value(internal.KeyFind))
+	}
+	gvrs, ok := ctx.Value(internal.KeyFindGVRs).([]string)
+	if !ok {
+		return nil, fmt.Errorf("expecting []string but got %T", ctx.Value(internal.KeyFindGVRs))
+	}
+	rx, err := findRx(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var oo []runtime.Object
+	for _, gvr := range gvrs {
+		meta, err := MetaAccess.MetaFor(client.NewGVR(gvr))
+		if err != nil {
+			log.Debug().Err(err).Msgf("Find skipping %s", gvr)
+			continue
+		}
+		rns := ns
+		if !meta.Namespaced {
+			rns = client.ClusterScope
+		}
+		ll, err := f.Factory.List(gvr, rns, true, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Find list failed for %s", gvr)
+			continue
+		}
+		for _, o := range ll {
+			m, err := apimeta.Accessor(o)
+			if err != nil || !findMatch(rx, m.GetName(), m.GetLabels()) {
+				continue
+			}
+			oo = append(oo, render.FindRes{
+				GVR:       gvr,
+				Kind:      meta.Kind,
+				Namespace: m.GetNamespace(),
+				Name:      m.GetName(),
+				Labels:    m.GetLabels(),
+				Created:   m.GetCreationTimestamp().Time,
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// findRx compiles a case insensitive search pattern, falling back to a
+// literal match when the pattern is not a valid regular expression.
+func findRx(q string) (*regexp.Regexp, error) {
+	if rx, err := regexp.Compile("(?i)" + q); err == nil {
+		return rx, nil
+	}
+
+	return regexp.Compile("(?i)" + regexp.QuoteMeta(q))
+}
+
+func findMatch(rx *regexp.Regexp, name string, ll map[string]string) bool {
+	if rx.MatchString(name) {
+		return true
+	}
+	for k, v := range ll {
+		if rx.MatchString(k + "=" + v) {
+			return true
+		}
+	}
+
+	return false
+}
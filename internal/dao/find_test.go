@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMatch(t *testing.T) {
+	uu := map[string]struct {
+		q      string
+		name   string
+		labels map[string]string
+		e      bool
+	}{
+		"name":     {q: "fred", name: "fred-1234", e: true},
+		"caseless": {q: "FRED", name: "fred-1234", e: true},
+		"regex":    {q: "^fred-\\d+$", name: "fred-1234", e: true},
+		"label":    {q: "app=blee", name: "fred", labels: map[string]string{"app": "blee"}, e: true},
+		"labelKey": {q: "tier", name: "fred", labels: map[string]string{"tier": "web"}, e: true},
+		"literal":  {q: "fred[", name: "fred[0]", e: true},
+		"noMatch":  {q: "zorg", name: "fred", labels: map[string]string{"app": "blee"}},
+		"badRegex": {q: "(", name: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rx, err := findRx(u.q)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, findMatch(rx, u.name, u.labels))
+		})
+	}
+}
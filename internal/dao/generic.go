@@ -16,8 +16,6 @@ import (
 
 var _ Describer = (*Generic)(nil)
 
-var defaultKillGrace int64
-
 // Generic represents a generic resource.
 type Generic struct {
 	NonResource
@@ -88,8 +86,12 @@ func (g *Generic) ToYAML(path string) (string, error) {
 }
 
 // Delete deletes a resource.
-func (g *Generic) Delete(path string, cascade, force bool) error {
-	log.Debug().Msgf("DELETE %q -- %t:%t", path, cascade, force)
+func (g *Generic) Delete(path string, opts DeleteOptions) error {
+	if err := ensureWritable(g.Factory); err != nil {
+		return err
+	}
+
+	log.Debug().Msgf("DELETE %q -- %s", path, opts)
 	ns, n := client.Namespaced(path)
 	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.DeleteVerb})
 	if err != nil {
@@ -99,23 +101,11 @@ func (g *Generic) Delete(path string, cascade, force bool) error {
 		return fmt.Errorf("user is not authorized to delete %s", path)
 	}
 
-	p := metav1.DeletePropagationOrphan
-	if cascade {
-		p = metav1.DeletePropagationBackground
-	}
-	var grace *int64
-	if force {
-		grace = &defaultKillGrace
-	}
-	opts := metav1.DeleteOptions{
-		PropagationPolicy:  &p,
-		GracePeriodSeconds: grace,
-	}
 	if client.IsClusterScoped(ns) {
-		return g.dynClient().Delete(n, &opts)
+		return g.dynClient().Delete(n, opts.apiOptions())
 	}
 
-	return g.dynClient().Namespace(ns).Delete(n, &opts)
+	return g.dynClient().Namespace(ns).Delete(n, opts.apiOptions())
 }
 
 func (g *Generic) dynClient() dynamic.NamespaceableResourceInterface {
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// GRPCServiceUnknown indicates the server does not know the checked service.
+	GRPCServiceUnknown = "SERVICE_UNKNOWN"
+	// GRPCUnimplemented indicates the server does not expose the health service.
+	GRPCUnimplemented = "UNIMPLEMENTED"
+)
+
+// GRPCHealth represents a grpc service serving status.
+type GRPCHealth struct {
+	Service string
+	Status  string
+	Latency time.Duration
+	Err     error
+}
+
+// ProbeGRPCHealth checks the grpc.health.v1 serving status of the given services
+// on a pod port via a temporary port-forward. A blank service name checks the
+// server overall health.
+func ProbeGRPCHealth(f Factory, path string, port int, services []string) ([]GRPCHealth, error) {
+	local, stop, err := forwardTemp(f, path, port)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	return checkGRPCHealth(localhost+":"+strconv.Itoa(local), services)
+}
+
+func checkGRPCHealth(addr string, services []string) ([]GRPCHealth, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if len(services) == 0 {
+		services = []string{""}
+	}
+	hc, hh := healthpb.NewHealthClient(conn), make([]GRPCHealth, 0, len(services))
+	for _, s := range services {
+		h := GRPCHealth{Service: s}
+		start := time.Now()
+		resp, err := hc.Check(ctx, &healthpb.HealthCheckRequest{Service: s})
+		h.Latency = time.Since(start)
+		switch status.Code(err) {
+		case codes.OK:
+			h.Status = resp.GetStatus().String()
+		case codes.NotFound:
+			h.Status = GRPCServiceUnknown
+		case codes.Unimplemented:
+			h.Status = GRPCUnimplemented
+		default:
+			h.Err = err
+		}
+		hh = append(hh, h)
+	}
+
+	return hh, nil
+}
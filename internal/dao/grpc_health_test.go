@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckGRPCHealth(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	assert.Nil(t, err)
+	srv, hs := grpc.NewServer(), health.NewServer()
+	hs.SetServingStatus("fred", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("blee", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Stop()
+
+	uu := map[string]struct {
+		services []string
+		e        []string
+	}{
+		"server": {
+			e: []string{"SERVING"},
+		},
+		"services": {
+			services: []string{"fred", "blee", "zorg"},
+			e:        []string{"SERVING", "NOT_SERVING", GRPCServiceUnknown},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			hh, err := checkGRPCHealth(l.Addr().String(), u.services)
+			assert.Nil(t, err)
+			assert.Equal(t, len(u.e), len(hh))
+			for i, h := range hh {
+				assert.Nil(t, h.Err)
+				assert.Equal(t, u.e[i], h.Status)
+			}
+		})
+	}
+}
+
+func TestCheckGRPCHealthUnimplemented(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	assert.Nil(t, err)
+	srv := grpc.NewServer()
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Stop()
+
+	hh, err := checkGRPCHealth(l.Addr().String(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(hh))
+	assert.Equal(t, GRPCUnimplemented, hh[0].Status)
+}
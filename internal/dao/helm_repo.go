@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	_ Accessor  = (*HelmRepo)(nil)
+	_ Accessor  = (*RepoChart)(nil)
+	_ Installer = (*RepoChart)(nil)
+)
+
+// HelmRepo represents a configured helm repository.
+type HelmRepo struct {
+	NonResource
+}
+
+// List returns all configured helm repositories.
+func (h *HelmRepo) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ee, err := helmRepos(cli.New())
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, render.HelmRepoRes{Entry: e})
+	}
+
+	return oo, nil
+}
+
+// RepoChart represents a chart available in a helm repository.
+type RepoChart struct {
+	NonResource
+}
+
+// List returns the latest version of all charts for a given repository or
+// for all repositories if none is specified. Charts can be narrowed down by a
+// keyword matching their name, description or keywords.
+func (r *RepoChart) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	settings := cli.New()
+	ee, err := helmRepos(settings)
+	if err != nil {
+		return nil, err
+	}
+	path, _ := ctx.Value(internal.KeyPath).(string)
+	q, _ := ctx.Value(internal.KeyChartQuery).(string)
+
+	var oo []runtime.Object
+	for _, e := range ee {
+		if path != "" && path != e.Name {
+			continue
+		}
+		idx, err := repo.LoadIndexFile(filepath.Join(settings.RepositoryCache, helmpath.CacheIndexFile(e.Name)))
+		if err != nil {
+			log.Warn().Err(err).Msgf("No index found for helm repo %q. Try running `helm repo update`", e.Name)
+			continue
+		}
+		idx.SortEntries()
+		for _, cc := range idx.Entries {
+			if len(cc) == 0 || !chartMatches(e.Name, cc[0], q) {
+				continue
+			}
+			oo = append(oo, render.RepoChartRes{Repo: e.Name, Chart: cc[0]})
+		}
+	}
+
+	return oo, nil
+}
+
+// Install installs a repository chart as a new release.
+func (r *RepoChart) Install(path, ns, name, valuesFile string) error {
+	if err := ensureWritable(r.Factory); err != nil {
+		return err
+	}
+
+	var c Chart
+	c.Init(r.Factory, client.NewGVR("charts"))
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+
+	i := action.NewInstall(cfg)
+	i.Namespace, i.ReleaseName = ns, name
+	cp, err := i.ChartPathOptions.LocateChart(path, cli.New())
+	if err != nil {
+		return err
+	}
+	chrt, err := loader.Load(cp)
+	if err != nil {
+		return err
+	}
+	vals := make(map[string]interface{})
+	if valuesFile != "" {
+		raw, err := ioutil.ReadFile(valuesFile)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(raw, &vals); err != nil {
+			return err
+		}
+	}
+	_, err = i.Run(chrt, vals)
+
+	return err
+}
+
+// Helpers...
+
+// chartMatches checks if a repository chart matches a search keyword the way
+// helm search does, ie on its qualified name, description or keywords.
+func chartMatches(name string, c *repo.ChartVersion, q string) bool {
+	if q == "" {
+		return true
+	}
+	q = strings.ToLower(q)
+	if strings.Contains(strings.ToLower(name+"/"+c.Name), q) || strings.Contains(strings.ToLower(c.Description), q) {
+		return true
+	}
+	for _, k := range c.Keywords {
+		if strings.Contains(strings.ToLower(k), q) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func helmRepos(settings *cli.EnvSettings) ([]*repo.Entry, error) {
+	if _, err := os.Stat(settings.RepositoryConfig); os.IsNotExist(err) {
+		return nil, nil
+	}
+	f, err := repo.LoadFile(settings.RepositoryConfig)
+	if err != nil {
+		return nil, err
+	}
+	ee := f.Repositories
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].Name < ee[j].Name
+	})
+
+	return ee, nil
+}
@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+func TestChartMatches(t *testing.T) {
+	c := repo.ChartVersion{
+		Metadata: &chart.Metadata{
+			Name:        "nginx-ingress",
+			Description: "An nginx Ingress controller",
+			Keywords:    []string{"ingress", "Proxy"},
+		},
+	}
+	uu := map[string]struct {
+		q string
+		e bool
+	}{
+		"none":        {e: true},
+		"repo":        {q: "stable/", e: true},
+		"name":        {q: "NGINX", e: true},
+		"description": {q: "controller", e: true},
+		"keyword":     {q: "proxy", e: true},
+		"miss":        {q: "postgres"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, chartMatches("stable", &c, u.q))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*History)(nil)
+	_ Differ   = (*History)(nil)
+)
+
+// History represents a helm chart release history.
+type History struct {
+	NonResource
+}
+
+// List returns all revisions of a given chart release.
+func (h *History) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", h.GVR())
+	}
+
+	rr, err := h.chart().History(path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, render.ChartRes{Release: r})
+	}
+
+	return oo, nil
+}
+
+// Diff returns the manifest differences between two chart release revisions.
+// A zero rev1 diffs rev2 against its predecessor in the release history.
+func (h *History) Diff(path string, rev1, rev2 int) (string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := h.chart().EnsureHelmConfig(ns)
+	if err != nil {
+		return "", err
+	}
+	if rev1 == 0 {
+		rr, err := h.chart().History(path)
+		if err != nil {
+			return "", err
+		}
+		if rev1, err = priorRevision(rr, rev2); err != nil {
+			return "", err
+		}
+	}
+
+	get := action.NewGet(cfg)
+	get.Version = rev1
+	r1, err := get.Run(n)
+	if err != nil {
+		return "", err
+	}
+	get.Version = rev2
+	r2, err := get.Run(n)
+	if err != nil {
+		return "", err
+	}
+
+	return revisionDiff(r1, r2)
+}
+
+func (h *History) chart() *Chart {
+	var c Chart
+	c.Init(h.Factory, client.NewGVR("charts"))
+
+	return &c
+}
+
+// Helpers...
+
+// priorRevision returns the closest revision preceding rev. Revisions may not
+// be contiguous as helm prunes histories past their max size.
+func priorRevision(rr []*release.Release, rev int) (int, error) {
+	var prior int
+	for _, r := range rr {
+		if r.Version < rev && r.Version > prior {
+			prior = r.Version
+		}
+	}
+	if prior == 0 {
+		return 0, fmt.Errorf("no revision prior to %d", rev)
+	}
+
+	return prior, nil
+}
+
+func revisionDiff(from, to *release.Release) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Manifest),
+		B:        difflib.SplitLines(to.Manifest),
+		FromFile: "revision " + strconv.Itoa(from.Version),
+		ToFile:   "revision " + strconv.Itoa(to.Version),
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("No manifest changes between revisions %d and %d", from.Version, to.Version), nil
+	}
+
+	return diff, nil
+}
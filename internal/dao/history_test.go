@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestRevisionDiff(t *testing.T) {
+	uu := map[string]struct {
+		from, to string
+		e        string
+	}{
+		"same": {
+			from: "a: 1\n",
+			to:   "a: 1\n",
+			e:    "No manifest changes between revisions 1 and 2",
+		},
+		"changed": {
+			from: "a: 1\nb: 2\n",
+			to:   "a: 1\nb: 3\n",
+			e:    "--- revision 1\n+++ revision 2\n@@ -1,3 +1,3 @@\n a: 1\n-b: 2\n+b: 3\n \n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			diff, err := revisionDiff(
+				&release.Release{Version: 1, Manifest: u.from},
+				&release.Release{Version: 2, Manifest: u.to},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, diff)
+		})
+	}
+}
+
+func TestPriorRevision(t *testing.T) {
+	rr := []*release.Release{{Version: 4}, {Version: 7}, {Version: 5}, {Version: 9}}
+	uu := map[string]struct {
+		rev, e int
+		err    bool
+	}{
+		"contiguous": {rev: 5, e: 4},
+		"gap":        {rev: 9, e: 7},
+		"oldest":     {rev: 4, err: true},
+		"first":      {rev: 1, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rev, err := priorRevision(rr, u.rev)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, rev)
+		})
+	}
+}
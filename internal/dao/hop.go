@@ -0,0 +1,154 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const maxHops = 100
+
+var _ Accessor = (*Hop)(nil)
+
+// Hop represents the navigation history.
+type Hop struct {
+	NonResource
+}
+
+// List returns the navigation hops.
+func (h *Hop) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	hist, ok := ctx.Value(internal.KeyHops).(*NavHistory)
+	if !ok {
+		return nil, fmt.Errorf("expecting *NavHistory but got %T", ctx.Value(internal.KeyHops))
+	}
+
+	hh := hist.List()
+	oo := make([]runtime.Object, 0, len(hh))
+	for _, res := range hh {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// NavHistory tracks the views visited by the user, browser style.
+type NavHistory struct {
+	hops   []render.HopRes
+	cursor int
+	seq    int
+	mx     sync.RWMutex
+}
+
+// NewNavHistory returns a new navigation history.
+func NewNavHistory() *NavHistory {
+	return &NavHistory{cursor: -1}
+}
+
+// Record adds a new hop, dropping any hops ahead of the current one.
+func (n *NavHistory) Record(h render.HopRes) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.cursor >= 0 && n.hops[n.cursor].SameView(h) {
+		n.refresh(h)
+		return
+	}
+	n.seq++
+	h.ID, h.At, h.Current = strconv.Itoa(n.seq), time.Now(), false
+	n.hops = append(n.hops[:n.cursor+1], h)
+	if len(n.hops) > maxHops {
+		n.hops = n.hops[len(n.hops)-maxHops:]
+	}
+	n.cursor = len(n.hops) - 1
+}
+
+// Update refreshes the filter and sort order of the current hop.
+func (n *NavHistory) Update(h render.HopRes) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.cursor >= 0 && n.hops[n.cursor].SameView(h) {
+		n.refresh(h)
+	}
+}
+
+// Popped tracks the view revealed once the top view is popped off the stack.
+// Landing on the previous hop moves the cursor back, otherwise the view is
+// recorded as a new hop.
+func (n *NavHistory) Popped(h render.HopRes) {
+	n.mx.Lock()
+	if n.cursor > 0 && !n.hops[n.cursor].SameView(h) && n.hops[n.cursor-1].SameView(h) {
+		n.cursor--
+		n.mx.Unlock()
+		return
+	}
+	n.mx.Unlock()
+
+	n.Record(h)
+}
+
+// Back moves to the previous hop if any.
+func (n *NavHistory) Back() (render.HopRes, bool) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.cursor <= 0 {
+		return render.HopRes{}, false
+	}
+	n.cursor--
+
+	return n.hops[n.cursor], true
+}
+
+// Forward moves to the next hop if any.
+func (n *NavHistory) Forward() (render.HopRes, bool) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	if n.cursor+1 >= len(n.hops) {
+		return render.HopRes{}, false
+	}
+	n.cursor++
+
+	return n.hops[n.cursor], true
+}
+
+// Jump moves to the hop with the given id.
+func (n *NavHistory) Jump(id string) (render.HopRes, bool) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	for i, h := range n.hops {
+		if h.ID == id {
+			n.cursor = i
+			return h, true
+		}
+	}
+
+	return render.HopRes{}, false
+}
+
+// List returns all hops.
+func (n *NavHistory) List() []render.HopRes {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	hh := make([]render.HopRes, len(n.hops))
+	copy(hh, n.hops)
+	if n.cursor >= 0 {
+		hh[n.cursor].Current = true
+	}
+
+	return hh
+}
+
+func (n *NavHistory) refresh(h render.HopRes) {
+	c := &n.hops[n.cursor]
+	c.Filter, c.SortColumn, c.SortAsc = h.Filter, h.SortColumn, h.SortAsc
+}
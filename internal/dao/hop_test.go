@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavHistoryRecord(t *testing.T) {
+	n := NewNavHistory()
+	n.Record(render.HopRes{GVR: "apps/v1/deployments", Namespace: "default"})
+	n.Record(render.HopRes{GVR: "v1/pods", Namespace: "default", Labels: "app=fred"})
+	n.Record(render.HopRes{GVR: "v1/pods", Namespace: "default", Labels: "app=fred", Filter: "blee"})
+
+	hh := n.List()
+	assert.Equal(t, 2, len(hh))
+	assert.Equal(t, "blee", hh[1].Filter)
+	assert.True(t, hh[1].Current)
+	assert.False(t, hh[0].Current)
+}
+
+func TestNavHistoryBackForward(t *testing.T) {
+	n := NewNavHistory()
+	_, ok := n.Back()
+	assert.False(t, ok)
+
+	n.Record(render.HopRes{GVR: "v1/namespaces"})
+	n.Record(render.HopRes{GVR: "apps/v1/deployments", Namespace: "default"})
+	n.Record(render.HopRes{GVR: "v1/pods", Namespace: "default"})
+
+	h, ok := n.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "apps/v1/deployments", h.GVR)
+	h, ok = n.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "v1/namespaces", h.GVR)
+	_, ok = n.Back()
+	assert.False(t, ok)
+
+	h, ok = n.Forward()
+	assert.True(t, ok)
+	assert.Equal(t, "apps/v1/deployments", h.GVR)
+
+	n.Record(render.HopRes{GVR: "v1/services", Namespace: "default"})
+	_, ok = n.Forward()
+	assert.False(t, ok)
+	assert.Equal(t, 3, len(n.List()))
+}
+
+func TestNavHistoryPopped(t *testing.T) {
+	n := NewNavHistory()
+	n.Record(render.HopRes{GVR: "apps/v1/deployments", Namespace: "default"})
+	n.Record(render.HopRes{GVR: "v1/pods", Namespace: "default"})
+
+	n.Popped(render.HopRes{GVR: "apps/v1/deployments", Namespace: "default"})
+	hh := n.List()
+	assert.Equal(t, 2, len(hh))
+	assert.True(t, hh[0].Current)
+
+	h, ok := n.Forward()
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", h.GVR)
+
+	n.Popped(render.HopRes{GVR: "v1/services", Namespace: "default"})
+	assert.Equal(t, 3, len(n.List()))
+}
+
+func TestNavHistoryJump(t *testing.T) {
+	n := NewNavHistory()
+	n.Record(render.HopRes{GVR: "v1/namespaces"})
+	n.Record(render.HopRes{GVR: "v1/pods", Namespace: "default"})
+
+	h, ok := n.Jump("1")
+	assert.True(t, ok)
+	assert.Equal(t, "v1/namespaces", h.GVR)
+	assert.True(t, n.List()[0].Current)
+
+	_, ok = n.Jump("10")
+	assert.False(t, ok)
+}
+
+func TestNavHistoryMax(t *testing.T) {
+	n := NewNavHistory()
+	for i := 0; i < maxHops+10; i++ {
+		n.Record(render.HopRes{GVR: "v1/pods", Path: strconv.Itoa(i)})
+	}
+
+	hh := n.List()
+	assert.Equal(t, maxHops, len(hh))
+	assert.True(t, hh[maxHops-1].Current)
+}
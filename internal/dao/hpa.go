@@ -3,13 +3,23 @@ package dao
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
+const hpaStatusGVR = "autoscaling/v2beta2/horizontalpodautoscalers"
+
 var (
 	_ Accessor = (*HorizontalPodAutoscaler)(nil)
 	_ Nuker    = (*HorizontalPodAutoscaler)(nil)
@@ -45,6 +55,131 @@ func (h *HorizontalPodAutoscaler) List(ctx context.Context, ns string) ([]runtim
 	return []runtime.Object{}, nil
 }
 
+// HPAStatus represents an HPA scaling status.
+type HPAStatus struct {
+	HPA         string      `json:"hpa"`
+	Target      string      `json:"target"`
+	MinReplicas int32       `json:"minReplicas"`
+	MaxReplicas int32       `json:"maxReplicas"`
+	Current     int32       `json:"currentReplicas"`
+	Desired     int32       `json:"desiredReplicas"`
+	LastScale   string      `json:"lastScaleTime,omitempty"`
+	Metrics     []HPAMetric `json:"metrics,omitempty"`
+	Conditions  []string    `json:"conditions,omitempty"`
+	Events      []string    `json:"events,omitempty"`
+}
+
+// HPAMetric represents an HPA metric current and target values.
+type HPAMetric struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Current string `json:"current"`
+	Target  string `json:"target"`
+}
+
+// ScaleTarget returns the resource scaled by a given HPA.
+func (h *HorizontalPodAutoscaler) ScaleTarget(path string) (Ref, error) {
+	u, err := fetchUnstructured(h.Factory, "autoscaling/v1/horizontalpodautoscalers", path)
+	if err != nil {
+		return Ref{}, err
+	}
+	ref, _, err := unstructured.NestedStringMap(u.Object, "spec", "scaleTargetRef")
+	if err != nil {
+		return Ref{}, err
+	}
+	gvr, _, ok := gvrForKind(ref["apiVersion"], ref["kind"])
+	if !ok {
+		return Ref{}, fmt.Errorf("no resource found for scale target kind %s", ref["kind"])
+	}
+
+	return Ref{GVR: gvr.String(), FQN: FQN(u.GetNamespace(), ref["name"])}, nil
+}
+
+// Status returns an HPA current vs target metrics, conditions and scaling events.
+func (h *HorizontalPodAutoscaler) Status(path string) (string, error) {
+	u, err := fetchUnstructured(h.Factory, hpaStatusGVR, path)
+	if err != nil {
+		return "", err
+	}
+	var hpa autoscalingv2beta2.HorizontalPodAutoscaler
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &hpa); err != nil {
+		return "", err
+	}
+
+	ref := hpa.Spec.ScaleTargetRef
+	st := HPAStatus{
+		HPA:         path,
+		Target:      ref.Kind + "/" + ref.Name,
+		MaxReplicas: hpa.Spec.MaxReplicas,
+		Current:     hpa.Status.CurrentReplicas,
+		Desired:     hpa.Status.DesiredReplicas,
+		Metrics:     hpaMetrics(hpa.Spec.Metrics, hpa.Status.CurrentMetrics),
+	}
+	if hpa.Spec.MinReplicas != nil {
+		st.MinReplicas = *hpa.Spec.MinReplicas
+	}
+	if t := hpa.Status.LastScaleTime; t != nil {
+		st.LastScale = t.String()
+	}
+	for _, c := range hpa.Status.Conditions {
+		st.Conditions = append(st.Conditions, fmt.Sprintf("%s=%s %s: %s", c.Type, c.Status, c.Reason, c.Message))
+	}
+	if st.Events, err = h.events(&hpa); err != nil {
+		return "", err
+	}
+
+	raw, err := yaml.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func (h *HorizontalPodAutoscaler) events(hpa *autoscalingv2beta2.HorizontalPodAutoscaler) ([]string, error) {
+	oo, err := h.Factory.List("v1/events", hpa.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	sel := fields.ParseSelectorOrDie(EventsSelector("HorizontalPodAutoscaler", hpa.Namespace, hpa.Name))
+	var ee []string
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !sel.Matches(eventFields(u)) {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			return nil, err
+		}
+		ee = append(ee, fmt.Sprintf("%s %s (x%d): %s", evt.Type, evt.Reason, evt.Count, evt.Message))
+	}
+
+	return ee, nil
+}
+
+// ScaledBy returns the name of the HPA managing a given scale target if any.
+func ScaledBy(f Factory, kind, path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	oo, err := f.List("autoscaling/v1/horizontalpodautoscalers", ns, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return "", fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		ref, _, _ := unstructured.NestedStringMap(u.Object, "spec", "scaleTargetRef")
+		if ref["kind"] == kind && ref["name"] == n {
+			return u.GetName(), nil
+		}
+	}
+
+	return "", nil
+}
+
 func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
 	oo, err := h.Factory.List(gvr, ns, true, sel)
 	if err != nil {
@@ -52,3 +187,69 @@ func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]r
 	}
 	return oo, nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// hpaMetrics pairs each HPA metric spec with its current status.
+func hpaMetrics(specs []autoscalingv2beta2.MetricSpec, statuses []autoscalingv2beta2.MetricStatus) []HPAMetric {
+	mm := make([]HPAMetric, 0, len(specs))
+	for i, spec := range specs {
+		var status autoscalingv2beta2.MetricStatus
+		if i < len(statuses) {
+			status = statuses[i]
+		}
+		m := HPAMetric{Type: string(spec.Type), Current: "<unknown>", Target: "<unknown>"}
+		switch spec.Type {
+		case autoscalingv2beta2.ResourceMetricSourceType:
+			m.Name = string(spec.Resource.Name)
+			m.Target = hpaTarget(spec.Resource.Target)
+			if status.Resource != nil {
+				m.Current = hpaValue(status.Resource.Current)
+			}
+		case autoscalingv2beta2.PodsMetricSourceType:
+			m.Name = spec.Pods.Metric.Name
+			m.Target = hpaTarget(spec.Pods.Target)
+			if status.Pods != nil {
+				m.Current = hpaValue(status.Pods.Current)
+			}
+		case autoscalingv2beta2.ObjectMetricSourceType:
+			o := spec.Object.DescribedObject
+			m.Name = o.Kind + "/" + o.Name + ":" + spec.Object.Metric.Name
+			m.Target = hpaTarget(spec.Object.Target)
+			if status.Object != nil {
+				m.Current = hpaValue(status.Object.Current)
+			}
+		case autoscalingv2beta2.ExternalMetricSourceType:
+			m.Name = spec.External.Metric.Name
+			m.Target = hpaTarget(spec.External.Target)
+			if status.External != nil {
+				m.Current = hpaValue(status.External.Current)
+			}
+		}
+		mm = append(mm, m)
+	}
+
+	return mm
+}
+
+func hpaTarget(t autoscalingv2beta2.MetricTarget) string {
+	return hpaQty(t.Value, t.AverageValue, t.AverageUtilization)
+}
+
+func hpaValue(v autoscalingv2beta2.MetricValueStatus) string {
+	return hpaQty(v.Value, v.AverageValue, v.AverageUtilization)
+}
+
+func hpaQty(value, avg *resource.Quantity, util *int32) string {
+	switch {
+	case util != nil:
+		return strconv.Itoa(int(*util)) + "%"
+	case avg != nil:
+		return avg.String() + " (avg)"
+	case value != nil:
+		return value.String()
+	default:
+		return "<unknown>"
+	}
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestHPAMetrics(t *testing.T) {
+	util, cur := int32(80), int32(95)
+	qty := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+	specs := []autoscalingv2beta2.MetricSpec{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name:   v1.ResourceCPU,
+				Target: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: "queue_depth"},
+				Target: autoscalingv2beta2.MetricTarget{AverageValue: qty("30")},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ObjectMetricSourceType,
+			Object: &autoscalingv2beta2.ObjectMetricSource{
+				DescribedObject: autoscalingv2beta2.CrossVersionObjectReference{Kind: "Service", Name: "fred"},
+				Metric:          autoscalingv2beta2.MetricIdentifier{Name: "rps"},
+				Target:          autoscalingv2beta2.MetricTarget{Value: qty("10k")},
+			},
+		},
+	}
+	statuses := []autoscalingv2beta2.MetricStatus{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricStatus{
+				Name:    v1.ResourceCPU,
+				Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: &cur, AverageValue: qty("95m")},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricStatus{
+				Current: autoscalingv2beta2.MetricValueStatus{AverageValue: qty("12")},
+			},
+		},
+	}
+
+	assert.Equal(t, []HPAMetric{
+		{Type: "Resource", Name: "cpu", Current: "95%", Target: "80%"},
+		{Type: "External", Name: "queue_depth", Current: "12 (avg)", Target: "30 (avg)"},
+		{Type: "Object", Name: "Service/fred:rps", Current: "<unknown>", Target: "10k"},
+	}, hpaMetrics(specs, statuses))
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Image)(nil)
+
+const (
+	// ImageFilterLatest only lists images using a latest tag.
+	ImageFilterLatest = "latest"
+	// ImageFilterUnpinned only lists images not pinned by digest.
+	ImageFilterUnpinned = "unpinned"
+
+	latestTag = "latest"
+)
+
+// Image represents the container images in use.
+type Image struct {
+	NonResource
+}
+
+// List returns the container images used by pods, optionally filtered to the
+// latest tagged or unpinned images.
+func (i *Image) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := i.Factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	filter, _ := ctx.Value(internal.KeyImageFilter).(string)
+	var res []runtime.Object
+	for _, img := range ImagesInventory(pp) {
+		switch {
+		case filter == ImageFilterLatest && img.Tag != latestTag:
+			continue
+		case filter == ImageFilterUnpinned && img.Pinned():
+			continue
+		}
+		res = append(res, img)
+	}
+
+	return res, nil
+}
+
+// ImagesInventory aggregates pods containers by image.
+func ImagesInventory(pp []v1.Pod) []render.ImageRes {
+	type image struct {
+		res      render.ImageRes
+		pods     map[string]struct{}
+		nss      map[string]struct{}
+		policies map[string]struct{}
+		digests  map[string]struct{}
+	}
+	ii := make(map[string]*image)
+	add := func(po v1.Pod, co v1.Container, ss []v1.ContainerStatus) {
+		img, ok := ii[co.Image]
+		if !ok {
+			img = &image{
+				pods:     make(map[string]struct{}),
+				nss:      make(map[string]struct{}),
+				policies: make(map[string]struct{}),
+				digests:  make(map[string]struct{}),
+			}
+			img.res.Image = co.Image
+			img.res.Name, img.res.Tag, img.res.Digest = ParseImage(co.Image)
+			ii[co.Image] = img
+		}
+		img.res.Containers++
+		img.pods[FQN(po.Namespace, po.Name)] = struct{}{}
+		img.nss[po.Namespace] = struct{}{}
+		if co.ImagePullPolicy != "" {
+			img.policies[string(co.ImagePullPolicy)] = struct{}{}
+		}
+		for _, s := range ss {
+			if s.Name != co.Name {
+				continue
+			}
+			if d := imageIDDigest(s.ImageID); d != "" {
+				img.digests[d] = struct{}{}
+			}
+		}
+	}
+	for _, po := range pp {
+		for _, co := range po.Spec.InitContainers {
+			add(po, co, po.Status.InitContainerStatuses)
+		}
+		for _, co := range po.Spec.Containers {
+			add(po, co, po.Status.ContainerStatuses)
+		}
+	}
+
+	res := make([]render.ImageRes, 0, len(ii))
+	for _, img := range ii {
+		img.res.Pods, img.res.Namespaces = len(img.pods), len(img.nss)
+		img.res.PullPolicies, img.res.Resolved = sortedKeys(img.policies), sortedKeys(img.digests)
+		res = append(res, img.res)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Image < res[j].Image
+	})
+
+	return res
+}
+
+// ParseImage splits an image reference into its name, tag and digest.
+// Images neither tagged nor pinned use the latest tag.
+func ParseImage(ref string) (name, tag, digest string) {
+	name = ref
+	if i := strings.Index(name, "@"); i != -1 {
+		name, digest = name[:i], name[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i != -1 && !strings.Contains(name[i+1:], "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if tag == "" && digest == "" {
+		tag = latestTag
+	}
+
+	return
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// imageIDDigest extracts the repository digest from a container status image id.
+func imageIDDigest(id string) string {
+	i := strings.LastIndex(id, "@")
+	if i == -1 {
+		return ""
+	}
+
+	return id[i+1:]
+}
+
+// usesImage checks if any of a pod containers runs a given image.
+func usesImage(u *unstructured.Unstructured, img string) bool {
+	for _, f := range []string{"initContainers", "containers"} {
+		cc, _, _ := unstructured.NestedSlice(u.Object, "spec", f)
+		for _, c := range cc {
+			if m, ok := c.(map[string]interface{}); ok && m["image"] == img {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,440 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	dockerHubHost    = "registry-1.docker.io"
+	dockerHubAuthKey = "https://index.docker.io/v1/"
+	registryTimeout  = 30 * time.Second
+
+	// maxRegistryPages caps the tags list pages fetched from a registry.
+	maxRegistryPages = 20
+)
+
+var (
+	dockerHubAliases = []string{"docker.io", "index.docker.io", dockerHubHost}
+
+	manifestMediaTypes = []string{
+		"application/vnd.docker.distribution.manifest.v2+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.oci.image.index.v1+json",
+	}
+
+	challengeRX = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	nextLinkRX  = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+)
+
+// registryClient queries a docker v2 registry api for a given repository.
+type registryClient struct {
+	client       *http.Client
+	base, repo   string
+	user, secret string
+	token        string
+	basic        bool
+	authorized   bool
+	mx           sync.Mutex
+}
+
+func newRegistryClient(host, repo, user, secret string) *registryClient {
+	scheme := "https://"
+	if strings.HasPrefix(host, "localhost") || strings.HasPrefix(host, "127.0.0.1") {
+		scheme = "http://"
+	}
+
+	return &registryClient{
+		client: &http.Client{Timeout: registryTimeout},
+		base:   scheme + host,
+		repo:   repo,
+		user:   user,
+		secret: secret,
+	}
+}
+
+// tags lists the repository tags.
+func (r *registryClient) tags(ctx context.Context) ([]string, error) {
+	var (
+		tt   []string
+		path = "/v2/" + r.repo + "/tags/list"
+	)
+	for i := 0; i < maxRegistryPages && path != ""; i++ {
+		resp, err := r.get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("invalid tags list for %s: %v", r.repo, err)
+		}
+		tt, path = append(tt, page.Tags...), ""
+		if m := nextLinkRX.FindStringSubmatch(resp.Header.Get("Link")); m != nil {
+			path = m[1]
+		}
+	}
+
+	return tt, nil
+}
+
+type registryManifest struct {
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			OS           string `json:"os"`
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// created returns a tag digest and creation time. Multi platforms images
+// report their linux/amd64 image creation time.
+func (r *registryClient) created(ctx context.Context, tag string) (string, time.Time, error) {
+	m, digest, err := r.manifest(ctx, tag)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if len(m.Manifests) > 0 {
+		ref := m.Manifests[0].Digest
+		for _, mm := range m.Manifests {
+			if mm.Platform.OS == "linux" && mm.Platform.Architecture == "amd64" {
+				ref = mm.Digest
+				break
+			}
+		}
+		if m, _, err = r.manifest(ctx, ref); err != nil {
+			return digest, time.Time{}, err
+		}
+	}
+	if m.Config.Digest == "" {
+		return digest, time.Time{}, fmt.Errorf("no config found for %s:%s", r.repo, tag)
+	}
+
+	resp, err := r.get(ctx, "/v2/"+r.repo+"/blobs/"+m.Config.Digest)
+	if err != nil {
+		return digest, time.Time{}, err
+	}
+	defer resp.Body.Close()
+	var cfg struct {
+		Created time.Time `json:"created"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&cfg); err != nil {
+		return digest, time.Time{}, fmt.Errorf("invalid config for %s:%s: %v", r.repo, tag, err)
+	}
+
+	return digest, cfg.Created, nil
+}
+
+func (r *registryClient) manifest(ctx context.Context, ref string) (registryManifest, string, error) {
+	var m registryManifest
+	resp, err := r.get(ctx, "/v2/"+r.repo+"/manifests/"+ref, manifestMediaTypes...)
+	if err != nil {
+		return m, "", err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil {
+		return m, "", fmt.Errorf("invalid manifest for %s:%s: %v", r.repo, ref, err)
+	}
+
+	return m, resp.Header.Get("Docker-Content-Digest"), nil
+}
+
+// resolve resolves an api path or link, either relative or absolute, against
+// the registry base url.
+func (r *registryClient) resolve(ref string) (string, error) {
+	base, err := url.Parse(r.base)
+	if err != nil {
+		return "", fmt.Errorf("invalid registry %s: %v", r.base, err)
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid registry %s link %q: %v", r.base, ref, err)
+	}
+
+	return base.ResolveReference(u).String(), nil
+}
+
+// get issues a registry api call, authorizing it when challenged.
+func (r *registryClient) get(ctx context.Context, path string, accept ...string) (*http.Response, error) {
+	u, err := r.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.do(ctx, u, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := r.authorize(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(ctx, u, accept); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("registry %s returned %s for %s", r.base, resp.Status, path)
+	}
+
+	return resp, nil
+}
+
+func (r *registryClient) do(ctx context.Context, url string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ","))
+	}
+	r.mx.Lock()
+	switch {
+	case r.token != "":
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	case r.basic:
+		req.SetBasicAuth(r.user, r.secret)
+	}
+	r.mx.Unlock()
+
+	return r.client.Do(req)
+}
+
+// authorize answers a registry basic or bearer token challenge once.
+func (r *registryClient) authorize(ctx context.Context, challenge string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.authorized {
+		return nil
+	}
+
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if r.user == "" {
+			return fmt.Errorf("registry %s requires credentials", r.base)
+		}
+		r.basic, r.authorized = true, true
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported registry %s authentication %q", r.base, challenge)
+	}
+
+	u, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("invalid registry %s token realm %q", r.base, params["realm"])
+	}
+	q := u.Query()
+	if s := params["service"]; s != "" {
+		q.Set("service", s)
+	}
+	q.Set("scope", "repository:"+r.repo+":pull")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if r.user != "" {
+		req.SetBasicAuth(r.user, r.secret)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry %s token request returned %s", r.base, resp.Status)
+	}
+	var t struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return err
+	}
+	if r.token = t.Token; r.token == "" {
+		r.token = t.AccessToken
+	}
+	if r.token == "" {
+		return fmt.Errorf("registry %s issued no token", r.base)
+	}
+	r.authorized = true
+
+	return nil
+}
+
+func parseChallenge(c string) (string, map[string]string) {
+	tokens := strings.SplitN(strings.TrimSpace(c), " ", 2)
+	params := make(map[string]string)
+	if len(tokens) == 2 {
+		for _, m := range challengeRX.FindAllStringSubmatch(tokens[1], -1) {
+			params[strings.ToLower(m[1])] = m[2]
+		}
+	}
+
+	return strings.ToLower(tokens[0]), params
+}
+
+// registryRepo splits an image name into its registry host and repository.
+func registryRepo(name string) (string, string) {
+	host, repo := dockerHubHost, name
+	if i := strings.Index(name, "/"); i != -1 {
+		if h := name[:i]; strings.ContainsAny(h, ".:") || h == "localhost" {
+			host, repo = h, name[i+1:]
+		}
+	}
+	if isDockerHub(host) {
+		host = dockerHubHost
+		if !strings.Contains(repo, "/") {
+			repo = "library/" + repo
+		}
+	}
+
+	return host, repo
+}
+
+func isDockerHub(host string) bool {
+	for _, a := range dockerHubAliases {
+		if host == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+type dockerConfig struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+	CredHelpers map[string]string `json:"credHelpers"`
+	CredsStore  string            `json:"credsStore"`
+}
+
+func dockerConfigPath() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".docker", "config.json")
+}
+
+// registryCreds returns a registry credentials from a docker config, either
+// via a credentials helper or the stored auths. No credentials are returned
+// when none are found.
+func registryCreds(path, host string) (string, string, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) || path == "" {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	var cfg dockerConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return "", "", fmt.Errorf("invalid docker config %s: %v", path, err)
+	}
+
+	server := host
+	if isDockerHub(host) {
+		server = dockerHubAuthKey
+	}
+	if h, ok := cfg.CredHelpers[host]; ok {
+		return helperCreds(h, server)
+	}
+	for k, a := range cfg.Auths {
+		if authHost(k) != host && !(isDockerHub(host) && isDockerHub(authHost(k))) {
+			continue
+		}
+		if a.Auth == "" {
+			if a.Username != "" {
+				return a.Username, a.Password, nil
+			}
+			continue
+		}
+		dec, err := base64.StdEncoding.DecodeString(a.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid docker config auth for %s: %v", k, err)
+		}
+		tokens := strings.SplitN(string(dec), ":", 2)
+		if len(tokens) != 2 {
+			return "", "", fmt.Errorf("invalid docker config auth for %s", k)
+		}
+		return tokens[0], tokens[1], nil
+	}
+	if cfg.CredsStore != "" {
+		return helperCreds(cfg.CredsStore, server)
+	}
+
+	return "", "", nil
+}
+
+func authHost(k string) string {
+	k = strings.TrimPrefix(strings.TrimPrefix(k, "https://"), "http://")
+	if i := strings.Index(k, "/"); i != -1 {
+		k = k[:i]
+	}
+
+	return k
+}
+
+// helperCreds fetches a registry credentials from a docker credentials helper.
+// Missing credentials fall back to anonymous access.
+func helperCreds(helper, server string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), registryTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "docker-credential-"+helper, "get")
+	cmd.Stdin = strings.NewReader(server)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		log.Warn().Msgf("Docker credentials helper %s found no credentials for %s: %v %s", helper, server, err, strings.TrimSpace(string(out)+stderr.String()))
+		return "", "", nil
+	}
+	var creds struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return "", "", fmt.Errorf("invalid docker credentials helper %s output: %v", helper, err)
+	}
+
+	return creds.Username, creds.Secret, nil
+}
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryRepo(t *testing.T) {
+	uu := map[string]struct {
+		name, host, repo string
+	}{
+		"official":  {name: "nginx", host: "registry-1.docker.io", repo: "library/nginx"},
+		"hub":       {name: "bitnami/redis", host: "registry-1.docker.io", repo: "bitnami/redis"},
+		"docker.io": {name: "docker.io/nginx", host: "registry-1.docker.io", repo: "library/nginx"},
+		"gcr":       {name: "gcr.io/fred/blee", host: "gcr.io", repo: "fred/blee"},
+		"port":      {name: "localhost:5000/fred", host: "localhost:5000", repo: "fred"},
+		"localhost": {name: "localhost/fred", host: "localhost", repo: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			host, repo := registryRepo(u.name)
+			assert.Equal(t, u.host, host)
+			assert.Equal(t, u.repo, repo)
+		})
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull"`)
+
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm="Registry"`)
+	assert.Equal(t, "basic", scheme)
+	assert.Equal(t, "Registry", params["realm"])
+}
+
+func TestRegistryCreds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-registry-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.json")
+	raw := `{"auths": {
+		"https://index.docker.io/v1/": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("fred:blee")) + `"},
+		"gcr.io": {"username": "_json_key", "password": "zorg"},
+		"quay.io": {}
+	}}`
+	assert.Nil(t, ioutil.WriteFile(path, []byte(raw), 0600))
+
+	uu := map[string]struct {
+		host, user, secret string
+	}{
+		"hub":     {host: "registry-1.docker.io", user: "fred", secret: "blee"},
+		"plain":   {host: "gcr.io", user: "_json_key", secret: "zorg"},
+		"empty":   {host: "quay.io"},
+		"unknown": {host: "ghcr.io"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			user, secret, err := registryCreds(path, u.host)
+			assert.Nil(t, err)
+			assert.Equal(t, u.user, user)
+			assert.Equal(t, u.secret, secret)
+		})
+	}
+
+	user, _, err := registryCreds(filepath.Join(dir, "missing.json"), "gcr.io")
+	assert.Nil(t, err)
+	assert.Equal(t, "", user)
+}
+
+func TestRegistryResolve(t *testing.T) {
+	uu := map[string]struct {
+		ref, e string
+	}{
+		"path":     {ref: "/v2/fred/blee/tags/list", e: "https://reg.io/v2/fred/blee/tags/list"},
+		"query":    {ref: "/v2/fred/blee/tags/list?last=1.1&n=2", e: "https://reg.io/v2/fred/blee/tags/list?last=1.1&n=2"},
+		"absolute": {ref: "https://cdn.reg.io/v2/fred/blee/tags/list?last=1.1", e: "https://cdn.reg.io/v2/fred/blee/tags/list?last=1.1"},
+	}
+
+	r := newRegistryClient("reg.io", "fred/blee", "", "")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := r.resolve(u.ref)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestRegistryClientBasic(t *testing.T) {
+	srv := newFakeRegistry(t, "basic")
+	defer srv.Close()
+
+	r := newRegistryClient("", "fred/blee", "fred", "secret")
+	r.base = srv.URL
+	tt, err := r.tags(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1.0", "1.1", "latest"}, tt)
+
+	r = newRegistryClient("", "fred/blee", "", "")
+	r.base = srv.URL
+	_, err = r.tags(context.Background())
+	assert.NotNil(t, err)
+}
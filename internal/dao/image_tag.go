@@ -0,0 +1,147 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+	"vbom.ml/util/sortorder"
+)
+
+const (
+	// maxImageTags caps the number of tags, most recent versions first, whose
+	// details are fetched from a registry.
+	maxImageTags = 50
+
+	imageTagWorkers = 5
+)
+
+var _ Accessor = (*ImageTag)(nil)
+
+// ImageTag represents an image repository tags.
+type ImageTag struct {
+	NonResource
+}
+
+// List returns the fetched image repository tags.
+func (i *ImageTag) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	tags, ok := ctx.Value(internal.KeyImageTags).(*ImageTags)
+	if !ok {
+		return nil, fmt.Errorf("expecting *ImageTags but got %T", ctx.Value(internal.KeyImageTags))
+	}
+
+	oo := make([]runtime.Object, 0, len(tags.tags))
+	for _, t := range tags.tags {
+		oo = append(oo, t)
+	}
+
+	return oo, nil
+}
+
+// ImageTags tracks the tags available in an image repository.
+type ImageTags struct {
+	// Name tracks the image name without tag or digest.
+	Name string
+	tags []render.ImageTagRes
+}
+
+// FetchImageTags queries an image registry for the image repository tags,
+// using the docker config credentials if any.
+func FetchImageTags(ctx context.Context, image string) (*ImageTags, error) {
+	name, current, _ := ParseImage(image)
+	host, repo := registryRepo(name)
+	user, secret, err := registryCreds(dockerConfigPath(), host)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchImageTags(ctx, newRegistryClient(host, repo, user, secret), name, current)
+}
+
+// Image returns the image reference for a given tag.
+func (t *ImageTags) Image(tag string) string {
+	return t.Name + ":" + tag
+}
+
+func fetchImageTags(ctx context.Context, r *registryClient, name, current string) (*ImageTags, error) {
+	all, err := r.tags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no tags found for %s", name)
+	}
+
+	tt := newestTags(all, current, maxImageTags)
+	res := ImageTags{Name: name, tags: make([]render.ImageTagRes, len(tt))}
+	var wg sync.WaitGroup
+	idx := make(chan int)
+	for w := 0; w < imageTagWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				t := render.ImageTagRes{Tag: tt[i], Current: tt[i] == current}
+				digest, created, err := r.created(ctx, tt[i])
+				if err != nil {
+					log.Warn().Err(err).Msgf("Fetching %s:%s details", name, tt[i])
+				}
+				t.Digest, t.Created = digest, created
+				res.tags[i] = t
+			}
+		}()
+	}
+	for i := range tt {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	return &res, nil
+}
+
+// newestTags returns up to max tags, most recent versions first, always
+// including the current tag.
+func newestTags(tags []string, current string, max int) []string {
+	tt := make([]string, len(tags))
+	copy(tt, tags)
+	sort.Slice(tt, func(i, j int) bool {
+		return sortorder.NaturalLess(tt[j], tt[i])
+	})
+	if len(tt) <= max {
+		return tt
+	}
+
+	res := tt[:max]
+	for _, t := range tt[max:] {
+		if t == current {
+			return append(res[:max-1], t)
+		}
+	}
+
+	return res
+}
+
+// PodController returns the deployment, statefulset or daemonset managing a pod.
+func PodController(f Factory, path string) (Ref, error) {
+	ref, err := Owner(f, "v1/pods", path)
+	if err != nil {
+		return Ref{}, err
+	}
+	if client.NewGVR(ref.GVR).R() == "replicasets" {
+		if ref, err = Owner(f, ref.GVR, ref.FQN); err != nil {
+			return Ref{}, err
+		}
+	}
+	if _, ok := imageControllerGVRs[ref.GVR]; !ok {
+		return Ref{}, fmt.Errorf("%s is not managed by a deployment, statefulset or daemonset", path)
+	}
+
+	return ref, nil
+}
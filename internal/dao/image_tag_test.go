@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewestTags(t *testing.T) {
+	tags := []string{"1.9", "1.10", "1.2", "latest", "1.11"}
+	uu := map[string]struct {
+		current string
+		max     int
+		e       []string
+	}{
+		"all":     {current: "1.2", max: 10, e: []string{"latest", "1.11", "1.10", "1.9", "1.2"}},
+		"capped":  {current: "1.11", max: 2, e: []string{"latest", "1.11"}},
+		"current": {current: "1.2", max: 2, e: []string{"latest", "1.2"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, newestTags(tags, u.current, u.max))
+		})
+	}
+	assert.Equal(t, []string{"1.9", "1.10", "1.2", "latest", "1.11"}, tags)
+}
+
+func TestFetchImageTags(t *testing.T) {
+	srv := newFakeRegistry(t, "bearer")
+	defer srv.Close()
+
+	r := newRegistryClient("", "fred/blee", "", "")
+	r.base = srv.URL
+	tags, err := fetchImageTags(context.Background(), r, "fred/blee", "1.0")
+	assert.Nil(t, err)
+	assert.Equal(t, "fred/blee:1.1", tags.Image("1.1"))
+	assert.Equal(t, []render.ImageTagRes{
+		{Tag: "latest", Digest: "sha256:latest", Created: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Tag: "1.1", Digest: "sha256:1.1", Created: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{Tag: "1.0", Digest: "sha256:1.0", Created: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Current: true},
+	}, tags.tags)
+}
+
+// Helpers...
+
+// newFakeRegistry serves a fred/blee repository guarded by a basic or bearer
+// challenge. The latest tag is a multi platforms image.
+func newFakeRegistry(t *testing.T, auth string) *httptest.Server {
+	created := map[string]string{
+		"cfg-1.0":   "2020-03-01T00:00:00Z",
+		"cfg-1.1":   "2020-04-01T00:00:00Z",
+		"cfg-amd64": "2020-05-01T00:00:00Z",
+		"cfg-arm64": "2019-01-01T00:00:00Z",
+	}
+	manifests := map[string]interface{}{
+		"1.0": map[string]interface{}{"config": map[string]string{"digest": "cfg-1.0"}},
+		"1.1": map[string]interface{}{"config": map[string]string{"digest": "cfg-1.1"}},
+		"latest": map[string]interface{}{"manifests": []interface{}{
+			map[string]interface{}{"digest": "arm64", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+			map[string]interface{}{"digest": "amd64", "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+		}},
+		"arm64": map[string]interface{}{"config": map[string]string{"digest": "cfg-arm64"}},
+		"amd64": map[string]interface{}{"config": map[string]string{"digest": "cfg-amd64"}},
+	}
+
+	var srv *httptest.Server
+	write := func(w http.ResponseWriter, o interface{}) {
+		assert.Nil(t, json.NewEncoder(w).Encode(o))
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "repository:fred/blee:pull", r.URL.Query().Get("scope"))
+		write(w, map[string]string{"token": "zorg"})
+	})
+	mux.HandleFunc("/v2/fred/blee/", func(w http.ResponseWriter, r *http.Request) {
+		switch auth {
+		case "basic":
+			if u, p, ok := r.BasicAuth(); !ok || u != "fred" || p != "secret" {
+				w.Header().Set("WWW-Authenticate", `Basic realm="fred"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		default:
+			if r.Header.Get("Authorization") != "Bearer zorg" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="fake"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		path := r.URL.Path[len("/v2/fred/blee/"):]
+		switch {
+		case path == "tags/list":
+			if r.URL.Query().Get("last") == "" {
+				next := "/v2/fred/blee/tags/list?last=1.1&n=2"
+				// Basic auth registries hand out absolute next links.
+				if auth == "basic" {
+					next = srv.URL + next
+				}
+				w.Header().Set("Link", "<"+next+`>; rel="next"`)
+				write(w, map[string][]string{"tags": {"1.0", "1.1"}})
+				return
+			}
+			write(w, map[string][]string{"tags": {"latest"}})
+		case len(path) > len("manifests/") && path[:len("manifests/")] == "manifests/":
+			ref := path[len("manifests/"):]
+			m, ok := manifests[ref]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", "sha256:"+ref)
+			write(w, m)
+		case len(path) > len("blobs/") && path[:len("blobs/")] == "blobs/":
+			write(w, map[string]string{"created": created[path[len("blobs/"):]]})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	srv = httptest.NewServer(mux)
+
+	return srv
+}
@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseImage(t *testing.T) {
+	uu := map[string]struct {
+		ref, name, tag, digest string
+	}{
+		"plain":      {ref: "nginx", name: "nginx", tag: "latest"},
+		"tagged":     {ref: "nginx:1.19", name: "nginx", tag: "1.19"},
+		"registry":   {ref: "localhost:5000/fred/blee", name: "localhost:5000/fred/blee", tag: "latest"},
+		"regTagged":  {ref: "localhost:5000/fred/blee:v1", name: "localhost:5000/fred/blee", tag: "v1"},
+		"digest":     {ref: "nginx@sha256:abc", name: "nginx", digest: "sha256:abc"},
+		"tagDigest":  {ref: "gcr.io/fred:v1@sha256:abc", name: "gcr.io/fred", tag: "v1", digest: "sha256:abc"},
+		"regDigests": {ref: "localhost:5000/fred@sha256:abc", name: "localhost:5000/fred", digest: "sha256:abc"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			name, tag, digest := ParseImage(u.ref)
+			assert.Equal(t, u.name, name)
+			assert.Equal(t, u.tag, tag)
+			assert.Equal(t, u.digest, digest)
+		})
+	}
+}
+
+func TestImagesInventory(t *testing.T) {
+	pp := []v1.Pod{
+		makeImagePod("ns1", "p1", "sha256:aaa", v1.Container{Name: "c1", Image: "nginx", ImagePullPolicy: v1.PullAlways}),
+		makeImagePod("ns2", "p2", "sha256:bbb",
+			v1.Container{Name: "c1", Image: "nginx", ImagePullPolicy: v1.PullIfNotPresent},
+		),
+		makeImagePod("ns2", "p3", "",
+			v1.Container{Name: "c1", Image: "fred@sha256:ccc"},
+			v1.Container{Name: "c2", Image: "fred@sha256:ccc"},
+		),
+	}
+
+	assert.Equal(t, []render.ImageRes{
+		{
+			Image:        "fred@sha256:ccc",
+			Name:         "fred",
+			Digest:       "sha256:ccc",
+			PullPolicies: []string{},
+			Pods:         1,
+			Namespaces:   1,
+			Containers:   2,
+			Resolved:     []string{},
+		},
+		{
+			Image:        "nginx",
+			Name:         "nginx",
+			Tag:          "latest",
+			PullPolicies: []string{"Always", "IfNotPresent"},
+			Pods:         2,
+			Namespaces:   2,
+			Containers:   2,
+			Resolved:     []string{"sha256:aaa", "sha256:bbb"},
+		},
+	}, ImagesInventory(pp))
+}
+
+func TestUsesImage(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"initContainers": []interface{}{map[string]interface{}{"image": "busybox"}},
+			"containers":     []interface{}{map[string]interface{}{"image": "nginx:1.19"}},
+		},
+	}}
+
+	assert.True(t, usesImage(&u, "busybox"))
+	assert.True(t, usesImage(&u, "nginx:1.19"))
+	assert.False(t, usesImage(&u, "nginx"))
+}
+
+// Helpers...
+
+func makeImagePod(ns, n, digest string, cc ...v1.Container) v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec:       v1.PodSpec{Containers: cc},
+	}
+	if digest != "" {
+		for _, co := range cc {
+			po.Status.ContainerStatuses = append(po.Status.ContainerStatuses, v1.ContainerStatus{
+				Name:    co.Name,
+				ImageID: "docker-pullable://" + co.Image + "@" + digest,
+			})
+		}
+	}
+
+	return po
+}
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor  = (*Informed)(nil)
+	_ Watchable = (*Informed)(nil)
+	_ Pageable  = (*Informed)(nil)
+)
+
+// Informed represents an informer based resource whose listing mirrors its informer cache.
+type Informed struct {
+	Resource
+}
+
+// Watched returns a watched resource and whether it belongs to the listing.
+func (i *Informed) Watched(ctx context.Context, ns string, o runtime.Object) (runtime.Object, bool) {
+	return o, inListing(ctx, ns, o)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// inListing checks if a resource matches a listing namespace, label and field selectors.
+func inListing(ctx context.Context, ns string, o runtime.Object) bool {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return false
+	}
+	if !client.IsAllNamespaces(ns) && m.GetNamespace() != ns {
+		return false
+	}
+	if strField, ok := ctx.Value(internal.KeyFields).(string); ok && strField != "" && !fieldsMatch(strField, o) {
+		return false
+	}
+	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
+	if !ok || strLabel == "" {
+		return true
+	}
+	sel, err := labels.Parse(strLabel)
+	if err != nil {
+		return true
+	}
+
+	return sel.Matches(labels.Set(m.GetLabels()))
+}
+
+// fieldsMatch checks if a resource matches a field selector.
+func fieldsMatch(strField string, o runtime.Object) bool {
+	sel, err := fields.ParseSelector(strField)
+	if err != nil {
+		return true
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return true
+	}
+	set := make(fields.Set, len(sel.Requirements()))
+	for _, r := range sel.Requirements() {
+		v, _, _ := unstructured.NestedFieldNoCopy(u.Object, strings.Split(r.Field, ".")...)
+		set[r.Field] = fmt.Sprintf("%v", v)
+		if v == nil {
+			set[r.Field] = ""
+		}
+	}
+
+	return sel.Matches(set)
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformedWatched(t *testing.T) {
+	uu := map[string]struct {
+		ns, labels, fields string
+		e                  bool
+	}{
+		"all":       {e: true},
+		"ns":        {ns: "default", e: true},
+		"otherNS":   {ns: "fred"},
+		"labels":    {labels: "app=nginx", e: true},
+		"setLabels": {labels: "app in (nginx,blee)", e: true},
+		"badLabels": {labels: "app=blee"},
+		"fields":    {fields: "status.phase=Running,metadata.namespace=default", e: true},
+		"notFields": {fields: "status.phase!=Running"},
+		"noField":   {fields: "spec.fred=blee"},
+	}
+
+	var i Informed
+	o := load(t, "p1")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), internal.KeyLabels, u.labels)
+			ctx = context.WithValue(ctx, internal.KeyFields, u.fields)
+			w, ok := i.Watched(ctx, u.ns, o)
+			assert.Equal(t, o, w)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestPodWatched(t *testing.T) {
+	uu := map[string]struct {
+		ns, fields string
+		e          bool
+	}{
+		"all":       {e: true},
+		"otherNS":   {ns: "fred"},
+		"node":      {fields: "spec.nodeName=gke-k9s-default-pool-0fa2fb89-lbtf", e: true},
+		"otherNode": {fields: "spec.nodeName=fred"},
+	}
+
+	var p Pod
+	o := load(t, "p1")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), internal.KeyFields, u.fields)
+			w, ok := p.Watched(ctx, u.ns, o)
+			assert.Equal(t, &render.PodWithMetrics{Raw: o}, w)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Informer)(nil)
+
+// InformerStater reports active resource informers.
+type InformerStater interface {
+	// Informers returns the active informers stats.
+	Informers() []watch.InformerStat
+}
+
+// Informer represents the active resource informers.
+type Informer struct {
+	NonResource
+}
+
+// List returns the active resource informers.
+func (i *Informer) List(_ context.Context, _ string) ([]runtime.Object, error) {
+	s, ok := i.Factory.(InformerStater)
+	if !ok {
+		return nil, fmt.Errorf("expecting an informer stater but got %T", i.Factory)
+	}
+
+	ss := s.Informers()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, st := range ss {
+		oo = append(oo, render.InformerRes{InformerStat: st})
+	}
+
+	return oo, nil
+}
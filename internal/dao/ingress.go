@@ -0,0 +1,209 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const probeTimeout = 5 * time.Second
+
+var _ Accessor = (*Ingress)(nil)
+
+// Ingress represents a k8s ingress.
+type Ingress struct {
+	Informed
+}
+
+// IngressRoute represents an ingress host/path routed to a service.
+type IngressRoute struct {
+	Host, Path string
+	Service    string
+	Port       intstr.IntOrString
+	Endpoints  []IngressEndpoint
+	// Error tracks why a route backend could not be resolved.
+	Error string
+}
+
+// IngressEndpoint represents a backend pod serving a route.
+type IngressEndpoint struct {
+	Pod  string
+	IP   string
+	Port int32
+}
+
+// URL returns the route url.
+func (r IngressRoute) URL() string {
+	host := r.Host
+	if host == "" {
+		host = "*"
+	}
+	path := r.Path
+	if path == "" {
+		path = "/"
+	}
+
+	return host + path
+}
+
+// GetInstance returns an ingress instance.
+func (i *Ingress) GetInstance(fqn string) (*v1beta1.Ingress, error) {
+	o, err := i.Factory.Get(i.gvr.String(), fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ing v1beta1.Ingress
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ing)
+	if err != nil {
+		return nil, errors.New("expecting Ingress resource")
+	}
+
+	return &ing, nil
+}
+
+// Routes resolves an ingress host/paths to their backend services and endpoints.
+// Routes whose backend can not be resolved are reported broken with no endpoints.
+func (i *Ingress) Routes(fqn string) ([]IngressRoute, error) {
+	ing, err := i.GetInstance(fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := ingressRoutes(ing)
+	for j := range rr {
+		if rr[j].Endpoints, err = i.endpoints(ing.Namespace, rr[j]); err != nil {
+			rr[j].Error = err.Error()
+		}
+	}
+
+	return rr, nil
+}
+
+func (i *Ingress) endpoints(ns string, r IngressRoute) ([]IngressEndpoint, error) {
+	fqn := client.FQN(ns, r.Service)
+	o, err := i.Factory.Get("v1/services", fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var svc v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc); err != nil {
+		return nil, err
+	}
+	sp, ok := servicePort(svc, r.Port)
+	if !ok {
+		return nil, fmt.Errorf("no port %s found on service %s", r.Port.String(), fqn)
+	}
+
+	o, err = i.Factory.Get("v1/endpoints", fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ep v1.Endpoints
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ep); err != nil {
+		return nil, err
+	}
+
+	return routeEndpoints(ep, sp), nil
+}
+
+// ProbeRoute issues an http request for a route via a port-forward on the given
+// backend pod and returns the response status.
+func ProbeRoute(f Factory, r IngressRoute, ep IngressEndpoint) (string, error) {
+	if ep.Pod == "" {
+		return "", fmt.Errorf("endpoint %s is not backed by a pod", ep.IP)
+	}
+
+	port, stop, err := forwardTemp(f, ep.Pod, int(ep.Port))
+	if err != nil {
+		return "", err
+	}
+	defer stop()
+
+	path := r.Path
+	if path == "" {
+		path = "/"
+	}
+	req, err := http.NewRequest(http.MethodGet, "http://"+localhost+":"+strconv.Itoa(port)+path, nil)
+	if err != nil {
+		return "", err
+	}
+	if r.Host != "" {
+		req.Host = r.Host
+	}
+	resp, err := (&http.Client{Timeout: probeTimeout}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return resp.Status, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ingressRoutes(ing *v1beta1.Ingress) []IngressRoute {
+	var rr []IngressRoute
+	if b := ing.Spec.Backend; b != nil {
+		rr = append(rr, IngressRoute{Service: b.ServiceName, Port: b.ServicePort})
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			rr = append(rr, IngressRoute{
+				Host:    rule.Host,
+				Path:    p.Path,
+				Service: p.Backend.ServiceName,
+				Port:    p.Backend.ServicePort,
+			})
+		}
+	}
+
+	return rr
+}
+
+func servicePort(svc v1.Service, port intstr.IntOrString) (v1.ServicePort, bool) {
+	for _, p := range svc.Spec.Ports {
+		if port.Type == intstr.String && p.Name == port.StrVal {
+			return p, true
+		}
+		if port.Type == intstr.Int && p.Port == port.IntVal {
+			return p, true
+		}
+	}
+
+	return v1.ServicePort{}, false
+}
+
+func routeEndpoints(ep v1.Endpoints, sp v1.ServicePort) []IngressEndpoint {
+	var ee []IngressEndpoint
+	for _, s := range ep.Subsets {
+		for _, p := range s.Ports {
+			if p.Name != sp.Name || p.Protocol != sp.Protocol {
+				continue
+			}
+			for _, a := range s.Addresses {
+				e := IngressEndpoint{IP: a.IP, Port: p.Port}
+				if ref := a.TargetRef; ref != nil && ref.Kind == "Pod" {
+					e.Pod = client.FQN(ref.Namespace, ref.Name)
+				}
+				ee = append(ee, e)
+			}
+		}
+	}
+
+	return ee
+}
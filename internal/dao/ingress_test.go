@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestIngressRoutes(t *testing.T) {
+	ing := v1beta1.Ingress{
+		Spec: v1beta1.IngressSpec{
+			Backend: &v1beta1.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)},
+			Rules: []v1beta1.IngressRule{
+				{Host: "fred.com"},
+				{
+					Host: "blee.com",
+					IngressRuleValue: v1beta1.IngressRuleValue{
+						HTTP: &v1beta1.HTTPIngressRuleValue{
+							Paths: []v1beta1.HTTPIngressPath{
+								{Path: "/a", Backend: v1beta1.IngressBackend{ServiceName: "a", ServicePort: intstr.FromString("http")}},
+								{Backend: v1beta1.IngressBackend{ServiceName: "b", ServicePort: intstr.FromInt(8080)}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rr := ingressRoutes(&ing)
+	assert.Equal(t, 3, len(rr))
+	assert.Equal(t, "*/", rr[0].URL())
+	assert.Equal(t, "blee.com/a", rr[1].URL())
+	assert.Equal(t, "a", rr[1].Service)
+	assert.Equal(t, "blee.com/", rr[2].URL())
+}
+
+func TestServicePort(t *testing.T) {
+	svc := v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "https", Port: 443},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		port intstr.IntOrString
+		ok   bool
+		e    string
+	}{
+		"byName":   {port: intstr.FromString("https"), ok: true, e: "https"},
+		"byNumber": {port: intstr.FromInt(80), ok: true, e: "http"},
+		"missing":  {port: intstr.FromInt(8080)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, ok := servicePort(svc, u.port)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, p.Name)
+		})
+	}
+}
+
+func TestRouteEndpoints(t *testing.T) {
+	ep := v1.Endpoints{
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses: []v1.EndpointAddress{
+					{IP: "10.0.0.1", TargetRef: &v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "p1"}},
+					{IP: "10.0.0.2"},
+				},
+				Ports: []v1.EndpointPort{
+					{Name: "http", Port: 8080, Protocol: v1.ProtocolTCP},
+					{Name: "metrics", Port: 9090, Protocol: v1.ProtocolTCP},
+				},
+			},
+		},
+	}
+
+	ee := routeEndpoints(ep, v1.ServicePort{Name: "http", Protocol: v1.ProtocolTCP})
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, IngressEndpoint{Pod: "default/p1", IP: "10.0.0.1", Port: 8080}, ee[0])
+	assert.Equal(t, "", ee[1].Pod)
+}
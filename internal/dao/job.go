@@ -19,7 +19,7 @@ var (
 
 // Job represents a K8s job resource.
 type Job struct {
-	Resource
+	Informed
 }
 
 // TailLogs tail logs for all pods represented by this Job.
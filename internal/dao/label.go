@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxMetaValueSize caps metadata values displayed in changes summaries.
+const maxMetaValueSize = 30
+
+var _ Labeler = (*Generic)(nil)
+
+// MetaPatch represents labels and annotations updates. Nil values remove a key.
+type MetaPatch struct {
+	Labels      map[string]*string
+	Annotations map[string]*string
+}
+
+// IsEmpty returns true if the patch carries no updates.
+func (m MetaPatch) IsEmpty() bool {
+	return len(m.Labels) == 0 && len(m.Annotations) == 0
+}
+
+// String returns a human readable representation of the updates.
+func (m MetaPatch) String() string {
+	var ss []string
+	if len(m.Labels) > 0 {
+		ss = append(ss, "labels "+MetaPatchString(m.Labels))
+	}
+	if len(m.Annotations) > 0 {
+		ss = append(ss, "annotations "+MetaPatchString(m.Annotations))
+	}
+
+	return strings.Join(ss, " and ")
+}
+
+func (m MetaPatch) raw() ([]byte, error) {
+	meta := make(map[string]interface{}, 2)
+	if len(m.Labels) > 0 {
+		meta["labels"] = m.Labels
+	}
+	if len(m.Annotations) > 0 {
+		meta["annotations"] = m.Annotations
+	}
+
+	return json.Marshal(map[string]interface{}{"metadata": meta})
+}
+
+// PatchMeta merges labels and annotations updates into a resource.
+func (g *Generic) PatchMeta(path string, p MetaPatch, dryRun bool) (*unstructured.Unstructured, error) {
+	if !dryRun {
+		if err := ensureWritable(g.Factory); err != nil {
+			return nil, err
+		}
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	raw, err := p.raw()
+	if err != nil {
+		return nil, err
+	}
+	var opts metav1.PatchOptions
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	return dynClientFor(g.Factory, g.gvr, ns).Patch(n, types.MergePatchType, raw, opts)
+}
+
+// MetaChanges lists the labels and annotations changes between two revisions of a resource.
+func MetaChanges(prev, curr *unstructured.Unstructured) []string {
+	cc := mapChanges("label", prev.GetLabels(), curr.GetLabels())
+
+	return append(cc, mapChanges("annotation", prev.GetAnnotations(), curr.GetAnnotations())...)
+}
+
+// ParseMetaPatch parses labels or annotations updates in the form
+// `k1=v1,k2=v2,k3-`. A trailing dash removes the given key.
+func ParseMetaPatch(s string) (map[string]*string, error) {
+	kv := make(map[string]*string)
+	for _, tok := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if strings.HasSuffix(tok, "-") && !strings.Contains(tok, "=") {
+			k := strings.TrimSuffix(tok, "-")
+			if k == "" {
+				return nil, fmt.Errorf("invalid key in %q", tok)
+			}
+			kv[k] = nil
+			continue
+		}
+		tokens := strings.SplitN(tok, "=", 2)
+		if len(tokens) != 2 || tokens[0] == "" {
+			return nil, fmt.Errorf("expecting key=value or key- but got %q", tok)
+		}
+		v := tokens[1]
+		kv[tokens[0]] = &v
+	}
+
+	return kv, nil
+}
+
+// MetaPatchString returns a human readable representation of a labels or annotations update.
+func MetaPatchString(kv map[string]*string) string {
+	ss := make([]string, 0, len(kv))
+	for k, v := range kv {
+		if v == nil {
+			ss = append(ss, k+"-")
+			continue
+		}
+		ss = append(ss, k+"="+*v)
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func mapChanges(kind string, prev, curr map[string]string) []string {
+	var cc []string
+	for k, v := range curr {
+		o, ok := prev[k]
+		switch {
+		case !ok:
+			cc = append(cc, fmt.Sprintf("+%s %s=%s", kind, k, truncateMeta(v)))
+		case o != v:
+			cc = append(cc, fmt.Sprintf("~%s %s=%s->%s", kind, k, truncateMeta(o), truncateMeta(v)))
+		}
+	}
+	for k, v := range prev {
+		if _, ok := curr[k]; !ok {
+			cc = append(cc, fmt.Sprintf("-%s %s=%s", kind, k, truncateMeta(v)))
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+func truncateMeta(s string) string {
+	if len(s) <= maxMetaValueSize {
+		return s
+	}
+
+	return s[:maxMetaValueSize] + "..."
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseMetaPatch(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   string
+		err bool
+	}{
+		"empty":   {},
+		"set":     {s: "app=fred", e: "app=fred"},
+		"multi":   {s: "app=fred, tier=web", e: "app=fred,tier=web"},
+		"remove":  {s: "app-,tier=web", e: "app-,tier=web"},
+		"blank":   {s: "app=", e: "app="},
+		"equal":   {s: "cmd=a=b", e: "cmd=a=b"},
+		"noValue": {s: "app", err: true},
+		"noKey":   {s: "=fred", err: true},
+		"dash":    {s: "-", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kv, err := ParseMetaPatch(u.s)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, MetaPatchString(kv))
+			}
+		})
+	}
+}
+
+func TestMetaPatchRaw(t *testing.T) {
+	v := "fred"
+	p := MetaPatch{Labels: map[string]*string{"app": &v, "tier": nil}}
+	raw, err := p.raw()
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"metadata":{"labels":{"app":"fred","tier":null}}}`, string(raw))
+	assert.Equal(t, "labels app=fred,tier-", p.String())
+	assert.False(t, p.IsEmpty())
+	assert.True(t, MetaPatch{}.IsEmpty())
+}
+
+func TestMetaChanges(t *testing.T) {
+	prev, curr := &unstructured.Unstructured{}, &unstructured.Unstructured{}
+	prev.SetLabels(map[string]string{"app": "fred", "tier": "web", "zone": "a"})
+	prev.SetAnnotations(map[string]string{"note": strings.Repeat("x", 40)})
+	curr.SetLabels(map[string]string{"app": "blee", "zone": "a", "env": "prod"})
+
+	assert.Equal(t, []string{
+		"+label env=prod",
+		"-label tier=web",
+		"~label app=fred->blee",
+		"-annotation note=" + strings.Repeat("x", 30) + "...",
+	}, MetaChanges(prev, curr))
+	assert.Empty(t, MetaChanges(curr, curr))
+}
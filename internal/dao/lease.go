@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const leaseGVR = "coordination.k8s.io/v1/leases"
+
+// Leader represents a leader election lease held by a workload pod.
+type Leader struct {
+	Lease       string `json:"lease"`
+	Pod         string `json:"pod"`
+	Identity    string `json:"identity"`
+	Renewed     string `json:"renewed,omitempty"`
+	Duration    string `json:"duration,omitempty"`
+	Transitions int32  `json:"transitions"`
+	Stale       bool   `json:"stale"`
+}
+
+// DeploymentLeaders returns the leases held by a deployment pods. Leases are
+// looked up in the deployment namespace where leader election records
+// usually live.
+func DeploymentLeaders(f Factory, path string) ([]Leader, error) {
+	var ddp Deployment
+	dp, err := ddp.Load(f, path)
+	if err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("v1/pods", dp.Namespace, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pods := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		pods[u.GetName()] = struct{}{}
+	}
+
+	oo, err = f.List(leaseGVR, dp.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ll := make([]coordinationv1.Lease, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var l coordinationv1.Lease
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &l); err != nil {
+			return nil, err
+		}
+		ll = append(ll, l)
+	}
+
+	return podLeaders(pods, ll, time.Now()), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podLeaders(pods map[string]struct{}, ll []coordinationv1.Lease, now time.Time) []Leader {
+	rr := make([]Leader, 0, len(ll))
+	for i := range ll {
+		l := &ll[i]
+		if l.Spec.HolderIdentity == nil {
+			continue
+		}
+		holder := render.LeaseHolder(*l.Spec.HolderIdentity)
+		if _, ok := pods[holder]; !ok {
+			continue
+		}
+		r := Leader{
+			Lease:    client.FQN(l.Namespace, l.Name),
+			Pod:      client.FQN(l.Namespace, holder),
+			Identity: *l.Spec.HolderIdentity,
+			Stale:    render.LeaseStale(l, now),
+		}
+		if l.Spec.RenewTime != nil {
+			r.Renewed = l.Spec.RenewTime.UTC().Format(time.RFC3339)
+		}
+		if l.Spec.LeaseDurationSeconds != nil {
+			r.Duration = (time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second).String()
+		}
+		if l.Spec.LeaseTransitions != nil {
+			r.Transitions = *l.Spec.LeaseTransitions
+		}
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Lease < rr[j].Lease
+	})
+
+	return rr
+}
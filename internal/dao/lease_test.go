@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodLeaders(t *testing.T) {
+	now := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	pods := map[string]struct{}{"fred-x2kqp": {}, "fred-a1b2c": {}}
+	ll := []coordinationv1.Lease{
+		makeLease("zorg", "fred-x2kqp_0e3a5c1d-4b2f-4d8e-9a1b-2c3d4e5f6a7b", now.Add(-5*time.Second)),
+		makeLease("blee", "fred-a1b2c", now.Add(-time.Minute)),
+		makeLease("node", "node-1", now),
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "free"}},
+	}
+
+	assert.Equal(t, []Leader{
+		{
+			Lease:       "default/blee",
+			Pod:         "default/fred-a1b2c",
+			Identity:    "fred-a1b2c",
+			Renewed:     "2020-01-01T09:59:00Z",
+			Duration:    "15s",
+			Transitions: 2,
+			Stale:       true,
+		},
+		{
+			Lease:       "default/zorg",
+			Pod:         "default/fred-x2kqp",
+			Identity:    "fred-x2kqp_0e3a5c1d-4b2f-4d8e-9a1b-2c3d4e5f6a7b",
+			Renewed:     "2020-01-01T09:59:55Z",
+			Duration:    "15s",
+			Transitions: 2,
+		},
+	}, podLeaders(pods, ll, now))
+}
+
+// Helpers...
+
+func makeLease(n, holder string, renew time.Time) coordinationv1.Lease {
+	duration, transitions := int32(15), int32(2)
+	return coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			LeaseTransitions:     &transitions,
+			RenewTime:            &metav1.MicroTime{Time: renew},
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const npGVR = "networking.k8s.io/v1/networkpolicies"
+
+var _ Accessor = (*NetpolRule)(nil)
+
+// NetpolRule represents the network policy rules applying to a pod.
+type NetpolRule struct {
+	NonResource
+}
+
+// List returns a collection of network policy rules for a given pod.
+func (n *NetpolRule) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", n.gvr)
+	}
+
+	o, err := n.Factory.Get("v1/pods", fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, err
+	}
+	m, err := NewNetpolMatcher(n.Factory, po.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := m.Match(&po)
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+// NetpolMatcher matches pods against a namespace network policies.
+type NetpolMatcher struct {
+	policies []networkingv1.NetworkPolicy
+}
+
+// NewNetpolMatcher returns a matcher for the cached policies in a namespace.
+func NewNetpolMatcher(f Factory, ns string) (*NetpolMatcher, error) {
+	oo, err := f.List(npGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]networkingv1.NetworkPolicy, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var np networkingv1.NetworkPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &np); err != nil {
+			return nil, err
+		}
+		pp = append(pp, np)
+	}
+
+	return &NetpolMatcher{policies: pp}, nil
+}
+
+// Selecting returns the policies selecting a given pod.
+func (m *NetpolMatcher) Selecting(po *v1.Pod) []networkingv1.NetworkPolicy {
+	var pp []networkingv1.NetworkPolicy
+	for _, np := range m.policies {
+		if np.Namespace != po.Namespace {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil {
+			continue
+		}
+		if sel.Matches(labels.Set(po.Labels)) {
+			pp = append(pp, np)
+		}
+	}
+
+	return pp
+}
+
+// Match returns the ingress and egress rules applying to a given pod. A
+// direction no policy isolates yields a single allow all rule.
+func (m *NetpolMatcher) Match(po *v1.Pod) []render.NetpolRuleRes {
+	var (
+		rr              []render.NetpolRuleRes
+		ingress, egress bool
+	)
+	for _, np := range m.Selecting(po) {
+		in, eg := policyTypes(np)
+		if in {
+			ingress = true
+			rr = append(rr, ingressRules(np)...)
+		}
+		if eg {
+			egress = true
+			rr = append(rr, egressRules(np)...)
+		}
+	}
+	if !ingress {
+		rr = append(rr, render.NetpolRuleRes{Namespace: po.Namespace, Direction: render.NetpolIngress})
+	}
+	if !egress {
+		rr = append(rr, render.NetpolRuleRes{Namespace: po.Namespace, Direction: render.NetpolEgress})
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// policyTypes returns the directions a policy isolates. Policies without
+// explicit types always isolate ingress and egress only if they carry rules.
+func policyTypes(np networkingv1.NetworkPolicy) (bool, bool) {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return true, len(np.Spec.Egress) > 0
+	}
+
+	var in, eg bool
+	for _, t := range np.Spec.PolicyTypes {
+		switch t {
+		case networkingv1.PolicyTypeIngress:
+			in = true
+		case networkingv1.PolicyTypeEgress:
+			eg = true
+		}
+	}
+
+	return in, eg
+}
+
+func ingressRules(np networkingv1.NetworkPolicy) []render.NetpolRuleRes {
+	if len(np.Spec.Ingress) == 0 {
+		return []render.NetpolRuleRes{denyRule(np, render.NetpolIngress)}
+	}
+
+	rr := make([]render.NetpolRuleRes, 0, len(np.Spec.Ingress))
+	for i, r := range np.Spec.Ingress {
+		rr = append(rr, render.NetpolRuleRes{
+			Namespace: np.Namespace,
+			Policy:    np.Name,
+			Direction: render.NetpolIngress,
+			Index:     i,
+			Peers:     r.From,
+			Ports:     r.Ports,
+		})
+	}
+
+	return rr
+}
+
+func egressRules(np networkingv1.NetworkPolicy) []render.NetpolRuleRes {
+	if len(np.Spec.Egress) == 0 {
+		return []render.NetpolRuleRes{denyRule(np, render.NetpolEgress)}
+	}
+
+	rr := make([]render.NetpolRuleRes, 0, len(np.Spec.Egress))
+	for i, r := range np.Spec.Egress {
+		rr = append(rr, render.NetpolRuleRes{
+			Namespace: np.Namespace,
+			Policy:    np.Name,
+			Direction: render.NetpolEgress,
+			Index:     i,
+			Peers:     r.To,
+			Ports:     r.Ports,
+		})
+	}
+
+	return rr
+}
+
+func denyRule(np networkingv1.NetworkPolicy, dir string) render.NetpolRuleRes {
+	return render.NetpolRuleRes{
+		Namespace: np.Namespace,
+		Policy:    np.Name,
+		Direction: dir,
+		DenyAll:   true,
+	}
+}
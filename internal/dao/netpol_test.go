@@ -0,0 +1,107 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetpolMatcherMatch(t *testing.T) {
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "fred",
+		Labels:    map[string]string{"app": "fred"},
+	}}
+
+	uu := map[string]struct {
+		pp []networkingv1.NetworkPolicy
+		e  []string
+	}{
+		"none": {
+			e: []string{"|Ingress", "|Egress"},
+		},
+		"otherNS": {
+			pp: []networkingv1.NetworkPolicy{makeNetpol("blee", "np1", nil)},
+			e:  []string{"|Ingress", "|Egress"},
+		},
+		"unselected": {
+			pp: []networkingv1.NetworkPolicy{makeNetpol("default", "np1", map[string]string{"app": "blee"})},
+			e:  []string{"|Ingress", "|Egress"},
+		},
+		"denyIngress": {
+			pp: []networkingv1.NetworkPolicy{makeNetpol("default", "np1", map[string]string{"app": "fred"})},
+			e:  []string{"np1|Ingress", "|Egress"},
+		},
+		"egress": {
+			pp: []networkingv1.NetworkPolicy{func() networkingv1.NetworkPolicy {
+				np := makeNetpol("default", "np1", nil)
+				np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
+				np.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{}, {}}
+				return np
+			}()},
+			e: []string{"np1|Egress", "np1|Egress", "|Ingress"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m := NetpolMatcher{policies: u.pp}
+			rr := m.Match(&po)
+			assert.Equal(t, u.e, netpolRuleKeys(rr))
+		})
+	}
+}
+
+func TestPolicyTypes(t *testing.T) {
+	uu := map[string]struct {
+		spec   networkingv1.NetworkPolicySpec
+		in, eg bool
+	}{
+		"default": {
+			in: true,
+		},
+		"implicitEgress": {
+			spec: networkingv1.NetworkPolicySpec{Egress: []networkingv1.NetworkPolicyEgressRule{{}}},
+			in:   true,
+			eg:   true,
+		},
+		"egressOnly": {
+			spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+			eg:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			in, eg := policyTypes(networkingv1.NetworkPolicy{Spec: u.spec})
+			assert.Equal(t, u.in, in)
+			assert.Equal(t, u.eg, eg)
+		})
+	}
+}
+
+// Helpers...
+
+func makeNetpol(ns, n string, sel map[string]string) networkingv1.NetworkPolicy {
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: sel},
+		},
+	}
+}
+
+func netpolRuleKeys(rr []render.NetpolRuleRes) []string {
+	kk := make([]string, 0, len(rr))
+	for _, r := range rr {
+		kk = append(kk, r.Policy+"|"+r.Direction)
+	}
+
+	return kk
+}
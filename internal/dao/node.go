@@ -3,22 +3,58 @@ package dao
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 var (
-	_ Accessor = (*Node)(nil)
+	_ Accessor  = (*Node)(nil)
+	_ Drainable = (*Node)(nil)
 )
 
+// DrainPollInterval tracks how often a drain checks on pending evictions.
+var DrainPollInterval = 2 * time.Second
+
+// DrainOptions tracks node drain options.
+type DrainOptions struct {
+	GracePeriodSeconds  int
+	DeleteLocalData     bool
+	IgnoreAllDaemonSets bool
+	Force               bool
+}
+
+// DefaultDrainOptions returns drain options honoring the pods grace periods and
+// skipping daemonsets.
+func DefaultDrainOptions() DrainOptions {
+	return DrainOptions{
+		GracePeriodSeconds:  DefaultGrace,
+		IgnoreAllDaemonSets: true,
+	}
+}
+
+// String returns a human readable representation of the options.
+func (o DrainOptions) String() string {
+	return fmt.Sprintf("grace=%d ignoreDaemonSets=%t deleteLocalData=%t force=%t", o.GracePeriodSeconds, o.IgnoreAllDaemonSets, o.DeleteLocalData, o.Force)
+}
+
+// DrainProgressFunc reports the number of pods left to evict.
+type DrainProgressFunc func(remaining int)
+
 // NodeMetricsFunc retrieves node metrics.
 type NodeMetricsFunc func() (*mv1beta1.NodeMetricsList, error)
 
@@ -63,9 +99,129 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return oo, nil
 }
 
+// ToggleCordon marks a node as schedulable or not.
+func (n *Node) ToggleCordon(path string, cordon bool) error {
+	if err := ensureWritable(n.Factory); err != nil {
+		return err
+	}
+
+	dial := n.Client().DialOrDie()
+	no, err := dial.CoreV1().Nodes().Get(path, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	h := drain.NewCordonHelper(no)
+	if !h.UpdateIfRequired(cordon) {
+		if cordon {
+			return fmt.Errorf("node %q is already cordoned", path)
+		}
+		return fmt.Errorf("node %q is already uncordoned", path)
+	}
+	err, patchErr := h.PatchOrReplace(dial)
+	if patchErr != nil {
+		log.Warn().Err(patchErr).Msgf("Cordon patch failed for node %q. Node was updated instead", path)
+	}
+
+	return err
+}
+
+// Drain cordons a node and evicts all its pods while honoring pod disruption budgets.
+// Pods blocked by a disruption budget are retried until the context is canceled.
+func (n *Node) Drain(ctx context.Context, path string, opts DrainOptions, progress DrainProgressFunc) error {
+	if err := ensureWritable(n.Factory); err != nil {
+		return err
+	}
+
+	if err := n.ToggleCordon(path, true); err != nil {
+		log.Debug().Msgf("Drain cordon %q -- %s", path, err)
+	}
+
+	dial := n.Client().DialOrDie()
+	h := drain.Helper{
+		Client:              dial,
+		Force:               opts.Force,
+		GracePeriodSeconds:  opts.GracePeriodSeconds,
+		IgnoreAllDaemonSets: opts.IgnoreAllDaemonSets,
+		DeleteLocalData:     opts.DeleteLocalData,
+		Out:                 ioutil.Discard,
+		ErrOut:              ioutil.Discard,
+	}
+	list, errs := h.GetPodsForDeletion(path)
+	if errs != nil {
+		return utilerrors.NewAggregate(errs)
+	}
+	if w := list.Warnings(); w != "" {
+		log.Warn().Msgf("Drain %q -- %s", path, w)
+	}
+
+	return evictPods(ctx, dial, list.Pods(), opts.GracePeriodSeconds, progress)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func evictPods(ctx context.Context, dial kubernetes.Interface, pods []v1.Pod, grace int, progress DrainProgressFunc) error {
+	pending := make(map[string]v1.Pod, len(pods))
+	for _, po := range pods {
+		pending[FQN(po.Namespace, po.Name)] = po
+	}
+	evicted := make(map[string]v1.Pod, len(pods))
+	for {
+		for fqn, po := range pending {
+			err := evictPod(dial, po.Namespace, po.Name, grace)
+			switch {
+			case err == nil:
+				evicted[fqn] = po
+			case errors.IsNotFound(err):
+			case errors.IsTooManyRequests(err):
+				log.Debug().Msgf("Eviction of %q blocked by disruption budget. Retrying...", fqn)
+				continue
+			default:
+				return fmt.Errorf("eviction failed for pod %q: %s", fqn, err)
+			}
+			delete(pending, fqn)
+		}
+		for fqn, po := range evicted {
+			o, err := dial.CoreV1().Pods(po.Namespace).Get(po.Name, metav1.GetOptions{})
+			if errors.IsNotFound(err) || (err == nil && o.UID != po.UID) {
+				delete(evicted, fqn)
+			}
+		}
+
+		remaining := len(pending) + len(evicted)
+		if progress != nil {
+			progress(remaining)
+		}
+		if remaining == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(DrainPollInterval):
+		}
+	}
+}
+
+// evictPod posts a policy/v1beta1 eviction as policy/v1 is not available in the
+// vendored client-go.
+func evictPod(dial kubernetes.Interface, ns, n string, grace int) error {
+	e := policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      n,
+			Namespace: ns,
+		},
+	}
+	if grace >= 0 {
+		g := int64(grace)
+		e.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &g}
+	}
+
+	return dial.PolicyV1beta1().Evictions(ns).Evict(&e)
+}
+
 // FetchNodes retrieves all nodes.
 func FetchNodes(f Factory, labelsSel string) (*v1.NodeList, error) {
 	var list v1.NodeList
@@ -0,0 +1,166 @@
+package dao
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	shellPodPrefix    = "k9s-shell-"
+	shellPodContainer = "shell"
+)
+
+var (
+	// ShellPodPollInterval tracks how often a node shell checks its pod readiness.
+	ShellPodPollInterval = 500 * time.Millisecond
+
+	// ShellPodTimeout tracks how long a node shell waits for its pod to run.
+	ShellPodTimeout = 1 * time.Minute
+)
+
+// NodeShellOptions tracks node shell pod settings.
+type NodeShellOptions struct {
+	Image     string
+	Namespace string
+	// Template is an optional pod manifest used as the shell pod base.
+	Template string
+}
+
+// NodeShell launches privileged debug pods pinned to a node.
+type NodeShell struct {
+	Factory
+}
+
+// NewNodeShell returns a new node shell.
+func NewNodeShell(f Factory) *NodeShell {
+	return &NodeShell{Factory: f}
+}
+
+// Launch creates a shell pod on the given node and waits for it to run.
+// It returns the shell pod path and container.
+func (n *NodeShell) Launch(node string, opts NodeShellOptions) (string, string, error) {
+	if err := ensureWritable(n.Factory); err != nil {
+		return "", "", err
+	}
+
+	po, err := shellPod(node, opts)
+	if err != nil {
+		return "", "", err
+	}
+
+	auth, err := n.Client().CanI(po.Namespace, "v1/pods", []string{client.CreateVerb})
+	if err != nil {
+		return "", "", err
+	}
+	if !auth {
+		return "", "", fmt.Errorf("user is not authorized to create pods in %s", po.Namespace)
+	}
+
+	dial := n.Client().DialOrDie().CoreV1().Pods(po.Namespace)
+	po, err = dial.Create(po)
+	if err != nil {
+		return "", "", err
+	}
+	path := client.FQN(po.Namespace, po.Name)
+	err = wait.PollImmediate(ShellPodPollInterval, ShellPodTimeout, func() (bool, error) {
+		p, err := dial.Get(po.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case v1.PodRunning:
+			return true, nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return false, fmt.Errorf("shell pod %s terminated with phase %s", path, p.Status.Phase)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		if e := n.Cleanup(path); e != nil {
+			log.Error().Err(e).Msgf("Shell pod %s cleanup failed", path)
+		}
+		return "", "", err
+	}
+
+	return path, po.Spec.Containers[0].Name, nil
+}
+
+// Cleanup deletes a shell pod.
+func (n *NodeShell) Cleanup(path string) error {
+	ns, name := client.Namespaced(path)
+	grace := int64(0)
+
+	return n.Client().DialOrDie().CoreV1().Pods(ns).Delete(name, &metav1.DeleteOptions{GracePeriodSeconds: &grace})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// shellPod returns a privileged pod pinned to a node, based on a user template if any.
+func shellPod(node string, opts NodeShellOptions) (*v1.Pod, error) {
+	po := defaultShellPod(opts.Image)
+	if opts.Template != "" {
+		raw, err := ioutil.ReadFile(opts.Template)
+		if err != nil {
+			return nil, err
+		}
+		po = &v1.Pod{}
+		if err := yaml.Unmarshal(raw, po); err != nil {
+			return nil, fmt.Errorf("invalid shell pod template %s: %v", opts.Template, err)
+		}
+		if len(po.Spec.Containers) == 0 {
+			return nil, fmt.Errorf("shell pod template %s has no containers", opts.Template)
+		}
+	}
+
+	po.Name, po.GenerateName = "", shellPodPrefix+strings.ToLower(node)+"-"
+	if po.Namespace == "" {
+		po.Namespace = opts.Namespace
+	}
+	po.Spec.NodeName = node
+	po.Spec.RestartPolicy = v1.RestartPolicyNever
+	po.Spec.Tolerations = append(po.Spec.Tolerations, v1.Toleration{Operator: v1.TolerationOpExists})
+
+	return po, nil
+}
+
+func defaultShellPod(image string) *v1.Pod {
+	privileged := true
+
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "k9s-shell"},
+		},
+		Spec: v1.PodSpec{
+			HostPID:     true,
+			HostNetwork: true,
+			HostIPC:     true,
+			Containers: []v1.Container{
+				{
+					Name:    shellPodContainer,
+					Image:   image,
+					Command: []string{"sleep", "86400"},
+					Stdin:   true,
+					TTY:     true,
+					SecurityContext: &v1.SecurityContext{
+						Privileged: &privileged,
+					},
+				},
+			},
+		},
+	}
+}
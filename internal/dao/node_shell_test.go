@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestShellPodDefault(t *testing.T) {
+	po, err := shellPod("N1", NodeShellOptions{Image: "busybox", Namespace: "fred"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", po.Namespace)
+	assert.Equal(t, "k9s-shell-n1-", po.GenerateName)
+	assert.Equal(t, "N1", po.Spec.NodeName)
+	assert.Equal(t, v1.RestartPolicyNever, po.Spec.RestartPolicy)
+	assert.True(t, po.Spec.HostPID)
+	assert.Equal(t, "busybox", po.Spec.Containers[0].Image)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, 1, len(po.Spec.Tolerations))
+}
+
+func TestShellPodTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-shell")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	uu := map[string]struct {
+		tpl string
+		err bool
+		ns  string
+	}{
+		"ok": {
+			tpl: "apiVersion: v1\nkind: Pod\nmetadata:\n  name: blee\n  namespace: debug\nspec:\n  containers:\n  - name: dbg\n    image: alpine\n",
+			ns:  "debug",
+		},
+		"noContainers": {
+			tpl: "apiVersion: v1\nkind: Pod\nmetadata:\n  name: blee\n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path := filepath.Join(dir, k+".yml")
+			assert.Nil(t, ioutil.WriteFile(path, []byte(u.tpl), 0600))
+			po, err := shellPod("n1", NodeShellOptions{Image: "busybox", Namespace: "fred", Template: path})
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "", po.Name)
+			assert.Equal(t, u.ns, po.Namespace)
+			assert.Equal(t, "n1", po.Spec.NodeName)
+			assert.Equal(t, "alpine", po.Spec.Containers[0].Image)
+		})
+	}
+}
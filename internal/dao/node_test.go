@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestEvictPods(t *testing.T) {
+	DrainPollInterval = time.Millisecond
+	uu := map[string]struct {
+		pods    []v1.Pod
+		blocked int
+	}{
+		"none": {},
+		"evicted": {
+			pods: []v1.Pod{makePod("p1"), makePod("p2")},
+		},
+		"pdb": {
+			pods:    []v1.Pod{makePod("p1")},
+			blocked: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewSimpleClientset(podsToObjects(u.pods)...)
+			blocked := u.blocked
+			dial.PrependReactor("create", "pods", func(a k8stesting.Action) (bool, runtime.Object, error) {
+				if a.GetSubresource() != "eviction" {
+					return false, nil, nil
+				}
+				if blocked > 0 {
+					blocked--
+					return true, nil, errors.NewTooManyRequests("budget exhausted", 0)
+				}
+				e := a.(k8stesting.CreateAction).GetObject().(*policyv1beta1.Eviction)
+				return true, nil, dial.Tracker().Delete(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, e.Namespace, e.Name)
+			})
+
+			var counts []int
+			err := evictPods(context.Background(), dial, u.pods, -1, func(r int) {
+				counts = append(counts, r)
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, 0, counts[len(counts)-1])
+			assert.Equal(t, u.blocked+1, len(counts))
+		})
+	}
+}
+
+func TestEvictPodsCanceled(t *testing.T) {
+	DrainPollInterval = time.Millisecond
+	pods := []v1.Pod{makePod("p1")}
+	dial := fake.NewSimpleClientset(podsToObjects(pods)...)
+	dial.PrependReactor("create", "pods", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewTooManyRequests("budget exhausted", 0)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := evictPods(ctx, dial, pods, -1, nil)
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+// Helpers...
+
+func makePod(n string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      n,
+			UID:       types.UID("uid-" + n),
+		},
+	}
+}
+
+func podsToObjects(pp []v1.Pod) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(pp))
+	for i := range pp {
+		oo = append(oo, &pp[i])
+	}
+
+	return oo
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Notification)(nil)
+
+// Notification represents raised notifications.
+type Notification struct {
+	NonResource
+}
+
+// List returns the raised notifications.
+func (n *Notification) List(context.Context, string) ([]runtime.Object, error) {
+	nn := notified.list()
+	oo := make([]runtime.Object, 0, len(nn))
+	for _, res := range nn {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// Clear dismisses all raised notifications.
+func (n *Notification) Clear() {
+	notified.reset()
+}
@@ -0,0 +1,424 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+)
+
+const (
+	maxNotifications = 100
+	notifierTick     = 5 * time.Second
+)
+
+// Watcher represents a factory notifying listeners of resource changes.
+type Watcher interface {
+	// CanForResource fetch an informer for a given resource if authorized.
+	CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error)
+
+	// AddListener registers a resource changes listener.
+	AddListener(gvr string, l watch.ResourceListener)
+}
+
+// NotifyListener represents a notifications listener.
+type NotifyListener interface {
+	// Notified notifies a rule raised a notification.
+	Notified(rule config.Notification, n render.NotificationRes)
+}
+
+// Notifier raises notifications when watched resources match configured rules.
+type Notifier struct {
+	rules     []config.Notification
+	cluster   string
+	pending   map[string]*ruleMatch
+	listeners []NotifyListener
+	limiter   *notifyLimiter
+	mx        sync.Mutex
+}
+
+// NewNotifier returns a new notifier.
+func NewNotifier(rules []config.Notification) *Notifier {
+	return &Notifier{
+		rules:   rules,
+		pending: make(map[string]*ruleMatch),
+		limiter: newNotifyLimiter(),
+	}
+}
+
+// SetCluster sets the cluster name reported in notifications.
+func (n *Notifier) SetCluster(cluster string) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.cluster = cluster
+}
+
+// TestFire delivers a sample notification for a given rule.
+func (n *Notifier) TestFire(name string) error {
+	var (
+		rule config.Notification
+		ok   bool
+	)
+	for _, r := range n.rules {
+		if r.Name == name {
+			rule, ok = r, true
+			break
+		}
+	}
+	if !ok {
+		return fmt.Errorf("no notification rule named %q", name)
+	}
+	if len(rule.Command) == 0 && rule.Webhook == "" {
+		return fmt.Errorf("notification %q has no command or webhook to deliver to", name)
+	}
+
+	now := time.Now()
+	p, err := NewNotifyPayload(n.clusterName(), rule, render.NotificationRes{
+		Rule:   rule.Name,
+		GVR:    rule.Resource,
+		Path:   client.FQN("k9s", "test-fire"),
+		Status: rule.Status,
+		Count:  1,
+		First:  now,
+		Last:   now,
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendNotification(rule, p)
+}
+
+// AddListener registers a notifications listener.
+func (n *Notifier) AddListener(l NotifyListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	n.listeners = append(n.listeners, l)
+}
+
+// Init registers the notifier with a watcher and checks lingering conditions
+// until the context is canceled.
+func (n *Notifier) Init(ctx context.Context, w Watcher) {
+	if len(n.rules) == 0 {
+		return
+	}
+	for _, gvr := range n.gvrs() {
+		w.AddListener(gvr, n)
+	}
+	n.arm(w)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(notifierTick):
+				n.check(time.Now())
+			}
+		}
+	}()
+}
+
+// Reset clears out pending conditions and rearms the watcher, ie on context switch.
+func (n *Notifier) Reset(w Watcher) {
+	n.mx.Lock()
+	n.pending = make(map[string]*ruleMatch)
+	n.mx.Unlock()
+	notified.reset()
+
+	if len(n.rules) > 0 {
+		n.arm(w)
+	}
+}
+
+// ResourceChanged evaluates rules against an added or updated resource.
+func (n *Notifier) ResourceChanged(gvr string, o runtime.Object) {
+	path, status, ok := resourceStatus(gvr, o)
+	if !ok {
+		return
+	}
+	n.eval(gvr, path, status, time.Now())
+}
+
+// ResourceDeleted clears out conditions held by a deleted resource.
+func (n *Notifier) ResourceDeleted(gvr string, o runtime.Object) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return
+	}
+	path := client.FQN(m.GetNamespace(), m.GetName())
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	for _, r := range n.rules {
+		if r.Resource != gvr {
+			continue
+		}
+		delete(n.pending, matchKey(r.Name, path))
+		notified.resolve(r.Name, path)
+	}
+}
+
+func (n *Notifier) gvrs() []string {
+	gg := make([]string, 0, len(n.rules))
+	seen := make(map[string]struct{}, len(n.rules))
+	for _, r := range n.rules {
+		if _, ok := seen[r.Resource]; ok {
+			continue
+		}
+		seen[r.Resource] = struct{}{}
+		gg = append(gg, r.Resource)
+	}
+
+	return gg
+}
+
+func (n *Notifier) arm(w Watcher) {
+	for _, gvr := range n.gvrs() {
+		if _, err := w.CanForResource(client.AllNamespaces, gvr, client.MonitorAccess); err != nil {
+			log.Warn().Err(err).Msgf("Notifications disabled for %s", gvr)
+		}
+	}
+}
+
+func (n *Notifier) eval(gvr, path, status string, now time.Time) {
+	n.mx.Lock()
+	var fired []*ruleMatch
+	for _, r := range n.rules {
+		if r.Resource != gvr {
+			continue
+		}
+		key := matchKey(r.Name, path)
+		if r.Status != status {
+			if _, ok := n.pending[key]; ok {
+				delete(n.pending, key)
+				notified.resolve(r.Name, path)
+			}
+			continue
+		}
+		m, ok := n.pending[key]
+		if !ok {
+			m = &ruleMatch{rule: r, gvr: gvr, path: path, status: status, since: now}
+			n.pending[key] = m
+		}
+		if m.due(now) {
+			m.fired = true
+			fired = append(fired, m)
+		}
+	}
+	n.mx.Unlock()
+
+	n.fire(fired, now)
+}
+
+func (n *Notifier) check(now time.Time) {
+	n.mx.Lock()
+	var fired []*ruleMatch
+	for _, m := range n.pending {
+		if m.due(now) {
+			m.fired = true
+			fired = append(fired, m)
+		}
+	}
+	n.mx.Unlock()
+
+	n.fire(fired, now)
+}
+
+func (n *Notifier) fire(mm []*ruleMatch, now time.Time) {
+	if len(mm) == 0 {
+		return
+	}
+
+	n.mx.Lock()
+	ll := make([]NotifyListener, len(n.listeners))
+	copy(ll, n.listeners)
+	cluster := n.cluster
+	n.mx.Unlock()
+
+	for _, m := range mm {
+		res := notified.raise(m.rule.Name, m.gvr, m.path, m.status, now)
+		log.Debug().Msgf("Notification %q raised on %s %s", m.rule.Name, m.gvr, m.path)
+		for _, l := range ll {
+			l.Notified(m.rule, res)
+		}
+		n.deliver(cluster, m.rule, res, now)
+	}
+}
+
+// deliver sends a notification to the rule receivers unless rate limited.
+func (n *Notifier) deliver(cluster string, rule config.Notification, res render.NotificationRes, now time.Time) {
+	if len(rule.Command) == 0 && rule.Webhook == "" {
+		return
+	}
+	if !n.limiter.allow(rule, now) {
+		log.Debug().Msgf("Notification %q delivery rate limited", rule.Name)
+		return
+	}
+	p, err := NewNotifyPayload(cluster, rule, res)
+	if err != nil {
+		log.Error().Err(err).Msg("Notification payload failed")
+		return
+	}
+	go func() {
+		if err := sendNotification(rule, p); err != nil {
+			log.Error().Err(err).Msg("Notification delivery failed")
+		}
+	}()
+}
+
+func (n *Notifier) clusterName() string {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	return n.cluster
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type ruleMatch struct {
+	rule              config.Notification
+	gvr, path, status string
+	since             time.Time
+	fired             bool
+}
+
+// due checks if a match held long enough to raise a notification.
+func (m *ruleMatch) due(now time.Time) bool {
+	return !m.fired && now.Sub(m.since) >= m.rule.Duration()
+}
+
+func matchKey(rule, path string) string {
+	return rule + ":" + path
+}
+
+// resourceStatus returns a watched resource path and status.
+func resourceStatus(gvr string, o runtime.Object) (string, string, bool) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", "", false
+	}
+	path := client.FQN(u.GetNamespace(), u.GetName())
+
+	switch gvr {
+	case "v1/pods":
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return "", "", false
+		}
+		var r render.Pod
+		return path, r.Phase(&po), true
+	case "v1/nodes":
+		var no v1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no); err != nil {
+			return "", "", false
+		}
+		return path, nodeReadiness(no.Status.Conditions), true
+	default:
+		phase, ok, err := unstructured.NestedString(u.Object, "status", "phase")
+		if err != nil || !ok {
+			return "", "", false
+		}
+		return path, phase, true
+	}
+}
+
+func nodeReadiness(cc []v1.NodeCondition) string {
+	for _, c := range cc {
+		if c.Type != v1.NodeReady {
+			continue
+		}
+		if c.Status == v1.ConditionTrue {
+			return string(v1.NodeReady)
+		}
+		return "Not" + string(v1.NodeReady)
+	}
+
+	return "Unknown"
+}
+
+// notified tracks raised notifications.
+var notified = newNotificationLog()
+
+type notificationLog struct {
+	items []render.NotificationRes
+	mx    sync.RWMutex
+}
+
+func newNotificationLog() *notificationLog {
+	return &notificationLog{}
+}
+
+// raise records a notification, bumping its count if already raised.
+func (l *notificationLog) raise(rule, gvr, path, status string, at time.Time) render.NotificationRes {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for i := range l.items {
+		n := &l.items[i]
+		if n.Rule == rule && n.Path == path {
+			n.Status, n.Active, n.Last = status, true, at
+			n.Count++
+			return *n
+		}
+	}
+	n := render.NotificationRes{
+		Rule:   rule,
+		GVR:    gvr,
+		Path:   path,
+		Status: status,
+		Active: true,
+		Count:  1,
+		First:  at,
+		Last:   at,
+	}
+	l.items = append(l.items, n)
+	if len(l.items) > maxNotifications {
+		l.items = l.items[len(l.items)-maxNotifications:]
+	}
+
+	return n
+}
+
+// resolve marks a notification as no longer active.
+func (l *notificationLog) resolve(rule, path string) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for i := range l.items {
+		if l.items[i].Rule == rule && l.items[i].Path == path {
+			l.items[i].Active = false
+		}
+	}
+}
+
+func (l *notificationLog) list() []render.NotificationRes {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	nn := make([]render.NotificationRes, len(l.items))
+	copy(nn, l.items)
+
+	return nn
+}
+
+func (l *notificationLog) reset() {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.items = nil
+}
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestResourceStatus(t *testing.T) {
+	uu := map[string]struct {
+		gvr          string
+		o            map[string]interface{}
+		path, status string
+		ok           bool
+	}{
+		"node-not-ready": {
+			gvr: "v1/nodes",
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "n1"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False"},
+					},
+				},
+			},
+			path:   "n1",
+			status: "NotReady",
+			ok:     true,
+		},
+		"pvc-pending": {
+			gvr: "v1/persistentvolumeclaims",
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "default", "name": "c1"},
+				"status":   map[string]interface{}{"phase": "Pending"},
+			},
+			path:   "default/c1",
+			status: "Pending",
+			ok:     true,
+		},
+		"no-phase": {
+			gvr: "v1/configmaps",
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "default", "name": "cm1"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path, status, ok := resourceStatus(u.gvr, &unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.path, path)
+			assert.Equal(t, u.status, status)
+		})
+	}
+}
+
+func TestNotifierEval(t *testing.T) {
+	notified.reset()
+	defer notified.reset()
+
+	n := NewNotifier([]config.Notification{
+		{Name: "pending", Resource: "v1/persistentvolumeclaims", Status: "Pending", For: "5m"},
+		{Name: "crash", Resource: "v1/pods", Status: "CrashLoopBackOff"},
+	})
+	var l notifyListener
+	n.AddListener(&l)
+
+	now := time.Now()
+	n.eval("v1/pods", "default/p1", "CrashLoopBackOff", now)
+	n.eval("v1/pods", "default/p1", "CrashLoopBackOff", now.Add(time.Second))
+	n.eval("v1/persistentvolumeclaims", "default/c1", "Pending", now)
+	assert.Equal(t, []string{"crash"}, l.rules)
+
+	n.check(now.Add(time.Minute))
+	assert.Equal(t, []string{"crash"}, l.rules)
+	n.check(now.Add(5 * time.Minute))
+	assert.Equal(t, []string{"crash", "pending"}, l.rules)
+
+	n.eval("v1/pods", "default/p1", "Running", now.Add(6*time.Minute))
+	n.eval("v1/pods", "default/p1", "CrashLoopBackOff", now.Add(7*time.Minute))
+	assert.Equal(t, []string{"crash", "pending", "crash"}, l.rules)
+
+	nn := notified.list()
+	assert.Equal(t, 2, len(nn))
+	assert.Equal(t, 2, nn[0].Count)
+	assert.True(t, nn[0].Active)
+	assert.Equal(t, now, nn[0].First)
+	assert.Equal(t, now.Add(7*time.Minute), nn[0].Last)
+}
+
+func TestNotificationLogResolve(t *testing.T) {
+	l, now := newNotificationLog(), time.Now()
+	l.raise("crash", "v1/pods", "default/p1", "CrashLoopBackOff", now)
+	l.raise("crash", "v1/pods", "default/p2", "CrashLoopBackOff", now)
+	l.resolve("crash", "default/p1")
+
+	nn := l.list()
+	assert.Equal(t, 2, len(nn))
+	assert.False(t, nn[0].Active)
+	assert.True(t, nn[1].Active)
+}
+
+// Helpers...
+
+type notifyListener struct {
+	rules []string
+}
+
+func (l *notifyListener) Notified(rule config.Notification, _ render.NotificationRes) {
+	l.rules = append(l.rules, rule.Name)
+}
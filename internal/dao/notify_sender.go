@@ -0,0 +1,156 @@
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+)
+
+const webhookTimeout = 5 * time.Second
+
+// NotifyPayload represents a notification delivered to external receivers.
+type NotifyPayload struct {
+	Cluster   string    `json:"cluster"`
+	Rule      string    `json:"rule"`
+	Resource  string    `json:"resource"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`
+	Count     int       `json:"count"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+	Message   string    `json:"message"`
+}
+
+// NewNotifyPayload returns a payload for a raised notification.
+func NewNotifyPayload(cluster string, rule config.Notification, res render.NotificationRes) (NotifyPayload, error) {
+	ns, n := client.Namespaced(res.Path)
+	p := NotifyPayload{
+		Cluster:   cluster,
+		Rule:      res.Rule,
+		Resource:  res.GVR,
+		Namespace: ns,
+		Name:      n,
+		Reason:    res.Status,
+		Count:     res.Count,
+		FirstSeen: res.First,
+		LastSeen:  res.Last,
+	}
+
+	tpl, err := template.New(rule.Name).Parse(rule.GetMessage())
+	if err != nil {
+		return p, fmt.Errorf("invalid message template for notification %q: %v", rule.Name, err)
+	}
+	var buff strings.Builder
+	if err := tpl.Execute(&buff, p); err != nil {
+		return p, fmt.Errorf("message template failed for notification %q: %v", rule.Name, err)
+	}
+	p.Message = buff.String()
+
+	return p, nil
+}
+
+// Env returns the payload as environment variables.
+func (p NotifyPayload) Env() []string {
+	return []string{
+		"K9S_NOTIFY_CLUSTER=" + p.Cluster,
+		"K9S_NOTIFY_RULE=" + p.Rule,
+		"K9S_NOTIFY_RESOURCE=" + p.Resource,
+		"K9S_NOTIFY_NAMESPACE=" + p.Namespace,
+		"K9S_NOTIFY_NAME=" + p.Name,
+		"K9S_NOTIFY_STATUS=" + p.Reason,
+		"K9S_NOTIFY_COUNT=" + strconv.Itoa(p.Count),
+		"K9S_NOTIFY_MESSAGE=" + p.Message,
+	}
+}
+
+// Body returns the webhook request body for a given format.
+func (p NotifyPayload) Body(format string) ([]byte, error) {
+	if format == config.NotifySlack {
+		return json.Marshal(map[string]string{"text": p.Message})
+	}
+
+	return json.Marshal(p)
+}
+
+// sendNotification delivers a notification to a rule command and webhook if any.
+func sendNotification(rule config.Notification, p NotifyPayload) error {
+	var errs []string
+	if len(rule.Command) > 0 {
+		if err := runNotifyCommand(rule.Command, p); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if rule.Webhook != "" {
+		if err := postNotifyWebhook(rule.Webhook, rule.GetFormat(), p); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("notification %q delivery failed: %s", rule.Name, strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func runNotifyCommand(args []string, p NotifyPayload) error {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), p.Env()...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("command %s failed: %v %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func postNotifyWebhook(url, format string, p NotifyPayload) error {
+	raw, err := p.Body(format)
+	if err != nil {
+		return err
+	}
+	resp, err := (&http.Client{Timeout: webhookTimeout}).Post(url, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("webhook failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+// notifyLimiter throttles rules deliveries.
+type notifyLimiter struct {
+	sent map[string]time.Time
+	mx   sync.Mutex
+}
+
+func newNotifyLimiter() *notifyLimiter {
+	return &notifyLimiter{sent: make(map[string]time.Time)}
+}
+
+// allow checks if a rule may deliver now and records the delivery if so.
+func (l *notifyLimiter) allow(rule config.Notification, now time.Time) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if last, ok := l.sent[rule.Name]; ok && now.Sub(last) < rule.Interval() {
+		return false
+	}
+	l.sent[rule.Name] = now
+
+	return true
+}
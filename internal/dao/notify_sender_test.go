@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotifyPayload(t *testing.T) {
+	res := render.NotificationRes{
+		Rule:   "crash",
+		GVR:    "v1/pods",
+		Path:   "default/p1",
+		Status: "CrashLoopBackOff",
+		Count:  2,
+	}
+	uu := map[string]struct {
+		rule config.Notification
+		msg  string
+		err  bool
+	}{
+		"default": {
+			rule: config.Notification{Name: "crash"},
+			msg:  "[c1] crash: v1/pods default/p1 is CrashLoopBackOff",
+		},
+		"custom": {
+			rule: config.Notification{Name: "crash", Message: "{{.Name}} crashed {{.Count}} times"},
+			msg:  "p1 crashed 2 times",
+		},
+		"toast": {
+			rule: config.Notification{Name: "crash", Message: "{{.Name"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := NewNotifyPayload("c1", u.rule, res)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.msg, p.Message)
+			assert.Equal(t, "default", p.Namespace)
+			assert.Equal(t, "p1", p.Name)
+		})
+	}
+}
+
+func TestNotifyPayloadBody(t *testing.T) {
+	p := NotifyPayload{Cluster: "c1", Rule: "crash", Name: "p1", Message: "boom"}
+
+	raw, err := p.Body(config.NotifySlack)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"text":"boom"}`, string(raw))
+
+	raw, err = p.Body(config.NotifyJSON)
+	assert.Nil(t, err)
+	var m map[string]interface{}
+	assert.Nil(t, json.Unmarshal(raw, &m))
+	assert.Equal(t, "c1", m["cluster"])
+	assert.Equal(t, "boom", m["message"])
+}
+
+func TestNotifyLimiter(t *testing.T) {
+	l, now := newNotifyLimiter(), time.Now()
+	r := config.Notification{Name: "crash", RateLimit: "1m"}
+
+	assert.True(t, l.allow(r, now))
+	assert.False(t, l.allow(r, now.Add(30*time.Second)))
+	assert.True(t, l.allow(r, now.Add(time.Minute)))
+	assert.True(t, l.allow(config.Notification{Name: "none"}, now))
+	assert.True(t, l.allow(config.Notification{Name: "none"}, now))
+}
+
+func TestNotifierTestFire(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+	}))
+	defer srv.Close()
+
+	n := NewNotifier([]config.Notification{
+		{Name: "down", Resource: "v1/nodes", Status: "NotReady", Webhook: srv.URL, Format: config.NotifySlack, Message: "{{.Cluster}} {{.Rule}} {{.Reason}}"},
+		{Name: "quiet", Resource: "v1/nodes", Status: "NotReady"},
+	})
+	n.SetCluster("c1")
+
+	assert.Nil(t, n.TestFire("down"))
+	assert.Equal(t, `{"text":"c1 down NotReady"}`, body)
+	assert.NotNil(t, n.TestFire("quiet"))
+	assert.NotNil(t, n.TestFire("blee"))
+}
@@ -104,7 +104,11 @@ func (f *OpenFaas) List(_ context.Context, ns string) ([]runtime.Object, error)
 }
 
 // Delete removes a function.
-func (f *OpenFaas) Delete(path string, _, _ bool) error {
+func (f *OpenFaas) Delete(path string, _ DeleteOptions) error {
+	if err := ensureWritable(f.Factory); err != nil {
+		return err
+	}
+
 	gw, token, tls := getOpenFAASFlags()
 	ns, n := client.Namespaced(path)
 
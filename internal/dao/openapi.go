@@ -0,0 +1,196 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kubectl/pkg/explain"
+	"k8s.io/kubectl/pkg/util/openapi"
+)
+
+// openAPITTL tracks how long a cluster OpenAPI schema is cached.
+const openAPITTL = 10 * time.Minute
+
+var openAPIs = openAPICache{entries: make(map[string]openAPIEntry)}
+
+type openAPIEntry struct {
+	api     *OpenAPI
+	fetched time.Time
+}
+
+// openAPICache caches parsed OpenAPI schemas per cluster context.
+type openAPICache struct {
+	entries map[string]openAPIEntry
+	mx      sync.Mutex
+}
+
+// OpenAPI represents a cluster OpenAPI schema.
+type OpenAPI struct {
+	resources openapi.Resources
+	enums     map[string][]string
+}
+
+// SchemaField represents a documented schema field.
+type SchemaField struct {
+	Name     string
+	Type     string
+	Doc      string
+	Enums    []string
+	Required bool
+	Schema   proto.Schema
+}
+
+// NewOpenAPI returns a new schema from an OpenAPI document.
+func NewOpenAPI(doc *openapi_v2.Document) (*OpenAPI, error) {
+	rr, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, err
+	}
+	enums := make(map[string][]string)
+	for _, d := range doc.GetDefinitions().GetAdditionalProperties() {
+		indexEnums(enums, d.GetValue(), d.GetName())
+	}
+
+	return &OpenAPI{resources: rr, enums: enums}, nil
+}
+
+// FetchOpenAPI returns the active cluster OpenAPI schema, fetching it at most once per ttl.
+func FetchOpenAPI(f Factory) (*OpenAPI, error) {
+	ctx, err := f.Client().Config().CurrentContextName()
+	if err != nil {
+		return nil, err
+	}
+
+	openAPIs.mx.Lock()
+	defer openAPIs.mx.Unlock()
+	if e, ok := openAPIs.entries[ctx]; ok && time.Since(e.fetched) < openAPITTL {
+		return e.api, nil
+	}
+	dial := f.Client().CachedDiscoveryOrDie()
+	if dial == nil {
+		return nil, client.ErrSnapshot
+	}
+	doc, err := dial.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	api, err := NewOpenAPI(doc)
+	if err != nil {
+		return nil, err
+	}
+	openAPIs.entries[ctx] = openAPIEntry{api: api, fetched: time.Now()}
+
+	return api, nil
+}
+
+// Explain returns a resource schema field given its path, ie spec.containers.
+func Explain(f Factory, gvr client.GVR, path []string) (*OpenAPI, SchemaField, error) {
+	m, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, SchemaField{}, err
+	}
+	api, err := FetchOpenAPI(f)
+	if err != nil {
+		return nil, SchemaField{}, err
+	}
+	gvk := schema.GroupVersionKind{Group: m.Group, Version: m.Version, Kind: m.Kind}
+	s, err := api.Lookup(gvk, path)
+	if err != nil {
+		return nil, SchemaField{}, err
+	}
+	name := m.Kind
+	if len(path) > 0 {
+		name = path[len(path)-1]
+	}
+
+	return api, api.Field(name, s, false), nil
+}
+
+// Lookup returns the schema of a kind field path.
+func (o *OpenAPI) Lookup(gvk schema.GroupVersionKind, path []string) (proto.Schema, error) {
+	s := o.resources.LookupResource(gvk)
+	if s == nil {
+		return nil, fmt.Errorf("no schema found for %s", gvk)
+	}
+
+	return explain.LookupSchemaForField(s, path)
+}
+
+// Field describes a named schema.
+func (o *OpenAPI) Field(name string, s proto.Schema, required bool) SchemaField {
+	doc := s.GetDescription()
+	if r, ok := s.(proto.Reference); ok && doc == "" {
+		doc = r.SubSchema().GetDescription()
+	}
+
+	return SchemaField{
+		Name:     name,
+		Type:     explain.GetTypeName(s),
+		Doc:      doc,
+		Enums:    o.enums[s.GetPath().String()],
+		Required: required,
+		Schema:   s,
+	}
+}
+
+// Fields returns a schema sub fields sorted by name.
+func (o *OpenAPI) Fields(s proto.Schema) []SchemaField {
+	k := kindOf(s)
+	if k == nil {
+		return nil
+	}
+	ff := make([]SchemaField, 0, len(k.Fields))
+	for _, n := range k.Keys() {
+		ff = append(ff, o.Field(n, k.Fields[n], k.IsRequired(n)))
+	}
+
+	return ff
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// kindOf returns the object schema of a field, walking thru references and collections.
+func kindOf(s proto.Schema) *proto.Kind {
+	switch t := s.(type) {
+	case *proto.Kind:
+		return t
+	case *proto.Array:
+		return kindOf(t.SubType)
+	case *proto.Map:
+		return kindOf(t.SubType)
+	case proto.Reference:
+		return kindOf(t.SubSchema())
+	default:
+		return nil
+	}
+}
+
+// indexEnums tracks enumerated values keyed by schema path since proto schemas drop them.
+func indexEnums(enums map[string][]string, s *openapi_v2.Schema, path string) {
+	if s == nil {
+		return
+	}
+	if ee := s.GetEnum(); len(ee) > 0 {
+		vv := make([]string, 0, len(ee))
+		for _, e := range ee {
+			vv = append(vv, strings.TrimSpace(e.GetYaml()))
+		}
+		sort.Strings(vv)
+		enums[path] = vv
+	}
+	for _, p := range s.GetProperties().GetAdditionalProperties() {
+		indexEnums(enums, p.GetValue(), path+"."+p.GetName())
+	}
+	for _, i := range s.GetItems().GetSchema() {
+		indexEnums(enums, i, path)
+	}
+	indexEnums(enums, s.GetAdditionalProperties().GetSchema(), path)
+}
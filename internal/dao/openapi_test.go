@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	tst "k8s.io/kube-openapi/pkg/util/proto/testing"
+)
+
+func TestOpenAPILookup(t *testing.T) {
+	api := loadOpenAPI(t)
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	uu := map[string]struct {
+		path []string
+		e    SchemaField
+		err  bool
+	}{
+		"root": {
+			e: SchemaField{Name: "Pod", Type: "Object", Doc: "Pod is a collection of containers that can run on a host."},
+		},
+		"ref": {
+			path: []string{"spec"},
+			e:    SchemaField{Name: "spec", Type: "Object", Doc: "Specification of the desired behavior of the pod."},
+		},
+		"array": {
+			path: []string{"spec", "containers"},
+			e:    SchemaField{Name: "containers", Type: "[]Object", Doc: "List of containers belonging to the pod."},
+		},
+		"enum": {
+			path: []string{"spec", "restartPolicy"},
+			e: SchemaField{
+				Name:  "restartPolicy",
+				Type:  "string",
+				Doc:   "Restart policy for all containers within the pod.",
+				Enums: []string{"Always", "Never", "OnFailure"},
+			},
+		},
+		"unknown": {
+			path: []string{"spec", "fred"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := api.Lookup(gvk, u.path)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			name := gvk.Kind
+			if len(u.path) > 0 {
+				name = u.path[len(u.path)-1]
+			}
+			f := api.Field(name, s, false)
+			f.Schema = nil
+			assert.Equal(t, u.e, f)
+		})
+	}
+}
+
+func TestOpenAPILookupNoKind(t *testing.T) {
+	_, err := loadOpenAPI(t).Lookup(schema.GroupVersionKind{Version: "v1", Kind: "Fred"}, nil)
+
+	assert.EqualError(t, err, "no schema found for /v1, Kind=Fred")
+}
+
+func TestOpenAPIFields(t *testing.T) {
+	api := loadOpenAPI(t)
+	s, err := api.Lookup(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, []string{"spec", "containers"})
+	assert.Nil(t, err)
+
+	ff := api.Fields(s)
+	assert.Equal(t, 2, len(ff))
+	assert.Equal(t, "image", ff[0].Name)
+	assert.False(t, ff[0].Required)
+	assert.Equal(t, "name", ff[1].Name)
+	assert.True(t, ff[1].Required)
+	assert.Nil(t, api.Fields(ff[1].Schema))
+}
+
+// Helpers...
+
+func loadOpenAPI(t *testing.T) *OpenAPI {
+	doc, err := (&tst.Fake{Path: "testdata/openapi.yml"}).OpenAPISchema()
+	assert.Nil(t, err)
+	api, err := NewOpenAPI(doc)
+	assert.Nil(t, err)
+
+	return api
+}
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// childGVRs tracks the resources managed by a given resource.
+var childGVRs = map[string]string{
+	"apps/v1/deployments":       "apps/v1/replicasets",
+	"apps/v1/replicasets":       "v1/pods",
+	"apps/v1/statefulsets":      "v1/pods",
+	"apps/v1/daemonsets":        "v1/pods",
+	"batch/v1/jobs":             "v1/pods",
+	"v1/replicationcontrollers": "v1/pods",
+	"v1/services":               "v1/pods",
+}
+
+// Owner returns the resource owning a given resource.
+func Owner(f Factory, gvr, path string) (Ref, error) {
+	u, err := fetchUnstructured(f, gvr, path)
+	if err != nil {
+		return Ref{}, err
+	}
+	ref, ok := ownerRef(u.GetOwnerReferences())
+	if !ok {
+		return Ref{}, fmt.Errorf("%s has no owner", path)
+	}
+	ogvr, meta, ok := gvrForKind(ref.APIVersion, ref.Kind)
+	if !ok {
+		return Ref{}, fmt.Errorf("no resource found for owner kind %s", ref.Kind)
+	}
+	ns := u.GetNamespace()
+	if !meta.Namespaced {
+		ns = ""
+	}
+
+	return Ref{GVR: ogvr.String(), FQN: FQN(ns, ref.Name)}, nil
+}
+
+// Children returns the child resource and label selector used to locate the
+// resources managed by a given resource.
+func Children(f Factory, gvr, path string) (string, string, error) {
+	child, ok := childGVRs[gvr]
+	if !ok {
+		return "", "", fmt.Errorf("no children known for %s", gvr)
+	}
+	u, err := fetchUnstructured(f, gvr, path)
+	if err != nil {
+		return "", "", err
+	}
+	sel, err := selectorFor(u)
+	if err != nil {
+		return "", "", err
+	}
+
+	return child, sel, nil
+}
+
+func fetchUnstructured(f Factory, gvr, path string) (*unstructured.Unstructured, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// ownerRef returns the controller reference if any or the first owner.
+func ownerRef(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	if len(refs) == 0 {
+		return metav1.OwnerReference{}, false
+	}
+	for _, r := range refs {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+
+	return refs[0], true
+}
+
+// selectorFor returns a resource label selector. Services and replication
+// controllers specify plain label maps.
+func selectorFor(u *unstructured.Unstructured) (string, error) {
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil {
+		return "", err
+	}
+	if !ok || len(m) == 0 {
+		return "", fmt.Errorf("%s has no selector", u.GetName())
+	}
+
+	_, hasLabels := m["matchLabels"]
+	_, hasExprs := m["matchExpressions"]
+	if !hasLabels && !hasExprs {
+		ss, _, err := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if err != nil {
+			return "", err
+		}
+		return labels.SelectorFromSet(ss).String(), nil
+	}
+
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return "", err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return "", err
+	}
+
+	return sel.String(), nil
+}
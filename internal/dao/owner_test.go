@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOwnerRef(t *testing.T) {
+	yes := true
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    string
+		ok   bool
+	}{
+		"none": {},
+		"first": {
+			refs: []metav1.OwnerReference{{Name: "o1"}, {Name: "o2"}},
+			e:    "o1",
+			ok:   true,
+		},
+		"controller": {
+			refs: []metav1.OwnerReference{{Name: "o1"}, {Name: "o2", Controller: &yes}},
+			e:    "o2",
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ref, ok := ownerRef(u.refs)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, ref.Name)
+		})
+	}
+}
+
+func TestSelectorFor(t *testing.T) {
+	uu := map[string]struct {
+		spec map[string]interface{}
+		e    string
+		err  bool
+	}{
+		"none": {
+			spec: map[string]interface{}{},
+			err:  true,
+		},
+		"plain": {
+			spec: map[string]interface{}{
+				"selector": map[string]interface{}{"app": "fred"},
+			},
+			e: "app=fred",
+		},
+		"matchLabels": {
+			spec: map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{"app": "fred"},
+				},
+			},
+			e: "app=fred",
+		},
+		"matchExpressions": {
+			spec: map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchExpressions": []interface{}{
+						map[string]interface{}{
+							"key":      "tier",
+							"operator": "In",
+							"values":   []interface{}{"web"},
+						},
+					},
+				},
+			},
+			e: "tier in (web)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{"spec": u.spec}}
+			sel, err := selectorFor(&o)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, sel)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PageSize tracks how many resources are fetched per page.
+const PageSize int64 = 500
+
+// Exceeds checks if a resource collection holds more than a given number of resources.
+func Exceeds(ctx context.Context, f Factory, gvr client.GVR, ns string, count int64) (bool, error) {
+	ll, err := listPage(ctx, f, gvr, ns, "", 1)
+	if err != nil {
+		return false, err
+	}
+	if ll.GetContinue() == "" {
+		return false, nil
+	}
+	if c := ll.GetRemainingItemCount(); c != nil {
+		return *c+int64(len(ll.Items)) > count, nil
+	}
+
+	// Api server does not report remaining items, probe for a full page instead.
+	if ll, err = listPage(ctx, f, gvr, ns, "", count); err != nil {
+		return false, err
+	}
+
+	return ll.GetContinue() != "", nil
+}
+
+// ListPage returns a page of resources and the token to fetch the next one if any.
+func (i *Informed) ListPage(ctx context.Context, ns, cont string, limit int64) ([]runtime.Object, string, error) {
+	ll, err := listPage(ctx, i.Factory, i.gvr, ns, cont, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pageObjects(ll), ll.GetContinue(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// listPage lists a page of resources filtered by the context label and field selectors.
+func listPage(ctx context.Context, f Factory, gvr client.GVR, ns, cont string, limit int64) (*unstructured.UnstructuredList, error) {
+	auth, err := f.Client().CanI(ns, gvr.String(), client.ListAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to list %s", gvr)
+	}
+
+	labelSel, _ := ctx.Value(internal.KeyLabels).(string)
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
+	opts := metav1.ListOptions{
+		LabelSelector: labelSel,
+		FieldSelector: fieldSel,
+		Limit:         limit,
+		Continue:      cont,
+	}
+	if client.IsClusterScoped(ns) {
+		return f.Client().DynDialOrDie().Resource(gvr.GVR()).List(opts)
+	}
+
+	return f.Client().DynDialOrDie().Resource(gvr.GVR()).Namespace(ns).List(opts)
+}
+
+func pageObjects(ll *unstructured.UnstructuredList) []runtime.Object {
+	oo := make([]runtime.Object, len(ll.Items))
+	for i := range ll.Items {
+		oo[i] = &ll.Items[i]
+	}
+
+	return oo
+}
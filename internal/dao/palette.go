@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Palette)(nil)
+
+// Palette represents the command palette entries.
+type Palette struct {
+	NonResource
+}
+
+// List returns the palette entries.
+func (p *Palette) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	pp, ok := ctx.Value(internal.KeyPalette).([]render.PaletteRes)
+	if !ok {
+		return nil, fmt.Errorf("expecting []render.PaletteRes but got %T", ctx.Value(internal.KeyPalette))
+	}
+
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, res := range pp {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// PaletteAliases returns palette entries for all resource aliases.
+func PaletteAliases(a *Alias) []render.PaletteRes {
+	m := a.ShortNames()
+	pp := make([]render.PaletteRes, 0, len(m))
+	for cmd, aliases := range m {
+		sort.StringSlice(aliases).Sort()
+		gvr := client.NewGVR(config.ParseAliasArgs(cmd).GVR)
+		res := render.PaletteRes{
+			Kind:        render.PaletteAlias,
+			Name:        strings.Join(aliases, ","),
+			Description: "View " + gvr.R(),
+			Command:     aliases[0],
+		}
+		if meta, err := MetaAccess.MetaFor(gvr); err == nil {
+			res.Description = "View " + meta.Kind
+			if IsCRD(meta) {
+				res.Kind = render.PaletteCRD
+			}
+		}
+		pp = append(pp, res)
+	}
+
+	return pp
+}
@@ -0,0 +1,22 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteAliases(t *testing.T) {
+	a := NewAlias(nil)
+	a.Define("v1/pods", "pods", "po")
+
+	pp := PaletteAliases(a)
+	assert.Equal(t, 1, len(pp))
+	assert.Equal(t, render.PaletteRes{
+		Kind:        render.PaletteAlias,
+		Name:        "po,pods",
+		Description: "View pods",
+		Command:     "po",
+	}, pp[0])
+}
@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Plugin)(nil)
+
+// Plugin tracks K9s plugins and hotkeys.
+type Plugin struct {
+	NonResource
+}
+
+// List returns a collection of plugins and hotkeys.
+func (p *Plugin) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	pp, ok := ctx.Value(internal.KeyPlugins).(config.Plugins)
+	if !ok {
+		return nil, fmt.Errorf("expecting config.Plugins but got %T", ctx.Value(internal.KeyPlugins))
+	}
+	hh, ok := ctx.Value(internal.KeyHotKeys).(config.HotKeys)
+	if !ok {
+		return nil, fmt.Errorf("expecting config.HotKeys but got %T", ctx.Value(internal.KeyHotKeys))
+	}
+
+	oo := make([]runtime.Object, 0, len(pp.Plugin)+len(hh.HotKey))
+	kk := make([]string, 0, len(pp.Plugin))
+	for k := range pp.Plugin {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		v := pp.Plugin[k]
+		oo = append(oo, render.PluginRes{
+			Kind:        render.PluginKind,
+			Name:        k,
+			ShortCut:    v.ShortCut,
+			Scopes:      v.Scopes,
+			Command:     v.Command,
+			Args:        v.Args,
+			Description: v.Description,
+			Disabled:    v.Disabled,
+			Source:      v.Source,
+		})
+	}
+	kk = make([]string, 0, len(hh.HotKey))
+	for k := range hh.HotKey {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		v := hh.HotKey[k]
+		oo = append(oo, render.PluginRes{
+			Kind:        render.HotKeyKind,
+			Name:        k,
+			ShortCut:    v.ShortCut,
+			Command:     v.Command,
+			Description: v.Description,
+			Disabled:    v.Disabled,
+			Source:      config.K9sHotKeys,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a resource.
+func (p *Plugin) Get(_ context.Context, _ string) (runtime.Object, error) {
+	return nil, errors.New("NYI!!")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -31,11 +33,17 @@ var (
 	_ Nuker      = (*Pod)(nil)
 	_ Loggable   = (*Pod)(nil)
 	_ Controller = (*Pod)(nil)
+	_ Evictable  = (*Pod)(nil)
+	_ Watchable  = (*Pod)(nil)
+	_ Pageable   = (*Pod)(nil)
 )
 
 // Pod represents a pod resource.
 type Pod struct {
 	Resource
+
+	pmx *mv1beta1.PodMetricsList
+	mx  sync.RWMutex
 }
 
 // IsHappy check for happy deployments.
@@ -72,34 +80,27 @@ func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
 
 // List returns a collection of nodes.
 func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	sel, ok := ctx.Value(internal.KeyFields).(string)
-	if !ok {
-		return nil, fmt.Errorf("expecting a fieldSelector in context")
-	}
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	nodeName, err := podNodeName(ctx)
 	if err != nil {
 		return nil, err
 	}
-	nodeName := fsel["spec.nodeName"]
 
 	oo, err := p.Resource.List(ctx, ns)
 	if err != nil {
 		return oo, err
 	}
 
-	var pmx *mv1beta1.PodMetricsList
-	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		if pmx, err = client.DialMetrics(p.Client()).FetchPodsMetrics(ns); err != nil {
-			log.Debug().Err(err).Msgf("No pods metrics")
-		}
-	}
-
+	pmx := p.fetchMetrics(ctx, ns)
+	img, _ := ctx.Value(internal.KeyImage).(string)
 	var res []runtime.Object
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
+		if img != "" && !usesImage(u, img) {
+			continue
+		}
 		if nodeName == "" {
 			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
 			continue
@@ -117,6 +118,53 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return res, nil
 }
 
+// ListPage returns a page of pods decorated with their metrics.
+func (p *Pod) ListPage(ctx context.Context, ns, cont string, limit int64) ([]runtime.Object, string, error) {
+	ll, err := listPage(ctx, p.Factory, p.gvr, ns, cont, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	// Metrics are refreshed along with the first page only.
+	if cont == "" {
+		p.fetchMetrics(ctx, ns)
+	}
+
+	oo := pageObjects(ll)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if po, ok := p.Watched(ctx, ns, o); ok {
+			res = append(res, po)
+		}
+	}
+
+	return res, ll.GetContinue(), nil
+}
+
+// Watched returns a watched pod decorated with its last listed metrics.
+func (p *Pod) Watched(ctx context.Context, ns string, o runtime.Object) (runtime.Object, bool) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return o, false
+	}
+	p.mx.RLock()
+	po := render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, p.pmx)}
+	p.mx.RUnlock()
+
+	if !inListing(ctx, ns, o) {
+		return &po, false
+	}
+	if img, _ := ctx.Value(internal.KeyImage).(string); img != "" && !usesImage(u, img) {
+		return &po, false
+	}
+	nodeName, err := podNodeName(ctx)
+	if err != nil || nodeName == "" {
+		return &po, err == nil
+	}
+	n, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName")
+
+	return &po, n == nodeName
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
@@ -158,6 +206,31 @@ func (p *Pod) Pod(fqn string) (string, error) {
 	return fqn, nil
 }
 
+// Evict evicts a pod using the eviction api so disruption budgets are honored.
+// The policy/v1beta1 eviction api is used on purpose as the vendored client-go
+// (kubernetes 1.16) predates policy/v1.
+func (p *Pod) Evict(path string) error {
+	if err := ensureWritable(p.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:eviction", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to evict pod %s", path)
+	}
+
+	err = evictPod(p.Client().DialOrDie(), ns, n, -1)
+	if apierrors.IsTooManyRequests(err) {
+		return fmt.Errorf("eviction of pod %s is blocked by a disruption budget", path)
+	}
+
+	return err
+}
+
 // GetInstance returns a pod instance.
 func (p *Pod) GetInstance(fqn string) (*v1.Pod, error) {
 	o, err := p.Factory.Get(p.gvr.String(), fqn, false, labels.Everything())
@@ -287,6 +360,37 @@ func readLogs(stream io.ReadCloser, c chan<- []byte, opts LogOptions) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// fetchMetrics retrieves and caches the pods metrics for a given namespace.
+func (p *Pod) fetchMetrics(ctx context.Context, ns string) *mv1beta1.PodMetricsList {
+	var (
+		pmx *mv1beta1.PodMetricsList
+		err error
+	)
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		if pmx, err = client.DialMetrics(p.Client()).FetchPodsMetrics(ns); err != nil {
+			log.Debug().Err(err).Msgf("No pods metrics")
+		}
+	}
+	p.mx.Lock()
+	p.pmx = pmx
+	p.mx.Unlock()
+
+	return pmx
+}
+
+func podNodeName(ctx context.Context) (string, error) {
+	sel, ok := ctx.Value(internal.KeyFields).(string)
+	if !ok {
+		return "", fmt.Errorf("expecting a fieldSelector in context")
+	}
+	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	if err != nil {
+		return "", err
+	}
+
+	return fsel["spec.nodeName"], nil
+}
+
 func podMetricsFor(o runtime.Object, mmx *mv1beta1.PodMetricsList) *mv1beta1.PodMetrics {
 	if mmx == nil {
 		return nil
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const debugContainerPrefix = "debugger-"
+
+var (
+	// DebugPollInterval tracks how often a debug container checks its readiness.
+	DebugPollInterval = 500 * time.Millisecond
+
+	// DebugTimeout tracks how long a debug container may take to start.
+	DebugTimeout = 1 * time.Minute
+)
+
+// DebugOptions tracks ephemeral debug container settings.
+type DebugOptions struct {
+	Image   string
+	Command []string
+	// Target is the container whose process namespace is shared, if any.
+	Target string
+}
+
+// Debug injects an ephemeral debug container into a pod and waits for it to run.
+// It returns the debug container name.
+func (p *Pod) Debug(path string, opts DebugOptions) (string, error) {
+	if err := ensureWritable(p.Factory); err != nil {
+		return "", err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:ephemeralcontainers", []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to debug pod %s", path)
+	}
+
+	dial := p.Client().DialOrDie().CoreV1().Pods(ns)
+	ecs, err := dial.GetEphemeralContainers(n, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("ephemeral containers are not enabled on this cluster")
+		}
+		return "", err
+	}
+	ec := debugContainer(opts)
+	ecs.EphemeralContainers = append(ecs.EphemeralContainers, ec)
+	if _, err = dial.UpdateEphemeralContainers(n, ecs); err != nil {
+		return "", err
+	}
+
+	err = wait.PollImmediate(DebugPollInterval, DebugTimeout, func() (bool, error) {
+		po, err := dial.Get(n, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		cs := ephemeralStatus(ec.Name, po.Status)
+		if cs == nil {
+			return false, nil
+		}
+		if cs.State.Terminated != nil {
+			return false, fmt.Errorf("debug container %s terminated -- %s", ec.Name, cs.State.Terminated.Reason)
+		}
+		return cs.State.Running != nil, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return ec.Name, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func debugContainer(opts DebugOptions) v1.EphemeralContainer {
+	return v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     debugContainerPrefix + rand.String(5),
+			Image:                    opts.Image,
+			Command:                  opts.Command,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			Stdin:                    true,
+			TTY:                      true,
+		},
+		TargetContainerName: opts.Target,
+	}
+}
+
+func ephemeralStatus(co string, status v1.PodStatus) *v1.ContainerStatus {
+	for _, c := range status.EphemeralContainerStatuses {
+		if c.Name == co {
+			return &c
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestDebugContainer(t *testing.T) {
+	ec := debugContainer(DebugOptions{Image: "busybox", Command: []string{"sh"}, Target: "fred"})
+
+	assert.True(t, strings.HasPrefix(ec.Name, debugContainerPrefix))
+	assert.Equal(t, len(debugContainerPrefix)+5, len(ec.Name))
+	assert.Equal(t, "busybox", ec.Image)
+	assert.Equal(t, []string{"sh"}, ec.Command)
+	assert.Equal(t, "fred", ec.TargetContainerName)
+	assert.True(t, ec.Stdin)
+	assert.True(t, ec.TTY)
+}
+
+func TestEphemeralStatus(t *testing.T) {
+	st := v1.PodStatus{
+		ContainerStatuses:          []v1.ContainerStatus{{Name: "c1"}},
+		EphemeralContainerStatuses: []v1.ContainerStatus{{Name: "debugger-abcde", RestartCount: 1}},
+	}
+
+	assert.Nil(t, ephemeralStatus("c1", st))
+	cs := ephemeralStatus("debugger-abcde", st)
+	assert.NotNil(t, cs)
+	assert.Equal(t, int32(1), cs.RestartCount)
+}
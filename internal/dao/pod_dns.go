@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultDNSName tracks the name resolved by default, ie the api server service.
+const DefaultDNSName = "kubernetes.default"
+
+var (
+	// dnsTools tracks the resolvers tried in order in a container.
+	dnsTools = [][]string{
+		{"nslookup"},
+		{"getent", "hosts"},
+	}
+
+	missingToolRx = regexp.MustCompile(`executable file not found|no such file or directory|exit code 12[67]`)
+)
+
+// DNSLookup represents a name resolution from within a pod.
+type DNSLookup struct {
+	Name, Pod, Container, Tool string
+	Resolved                   bool
+	Output                     string
+}
+
+// String returns the lookup report.
+func (d DNSLookup) String() string {
+	status := "failed"
+	if d.Resolved {
+		status = "resolved"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name: %s\n", d.Name)
+	fmt.Fprintf(&b, "Pod: %s\n", d.Pod)
+	fmt.Fprintf(&b, "Container: %s\n", d.Container)
+	fmt.Fprintf(&b, "Tool: %s\n", d.Tool)
+	fmt.Fprintf(&b, "Status: %s\n\n", status)
+	b.WriteString(strings.TrimSpace(d.Output))
+
+	return b.String()
+}
+
+// LookupDNS resolves a name from within a pod container using the first resolver
+// tool available. When the container ships none, the lookup runs in an ephemeral
+// debug container sharing the pod network if debug options are given.
+func (p *Pod) LookupDNS(path, co, name string, debug *DebugOptions) (*DNSLookup, error) {
+	l, err := p.lookupIn(path, co, name)
+	if err == nil || debug == nil {
+		return l, err
+	}
+
+	dco, e := p.Debug(path, *debug)
+	if e != nil {
+		return nil, fmt.Errorf("%s. Debug container fallback failed -- %s", err, e)
+	}
+
+	return p.lookupIn(path, dco, name)
+}
+
+func (p *Pod) lookupIn(path, co, name string) (*DNSLookup, error) {
+	for _, tool := range dnsTools {
+		cmd := append(append([]string{}, tool...), name)
+		var out bytes.Buffer
+		err := execIn(p.Client(), path, co, cmd, &out)
+		if err != nil && isMissingTool(err) {
+			continue
+		}
+		l := DNSLookup{
+			Name:      name,
+			Pod:       path,
+			Container: co,
+			Tool:      strings.Join(cmd, " "),
+			Resolved:  err == nil,
+			Output:    out.String(),
+		}
+		if err != nil {
+			l.Output += "\n" + err.Error()
+		}
+		return &l, nil
+	}
+
+	return nil, fmt.Errorf("no dns resolver tool found in container %s", co)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isMissingTool(err error) bool {
+	return err != nil && missingToolRx.MatchString(err.Error())
+}
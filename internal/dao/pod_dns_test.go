@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMissingTool(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"none":     {},
+		"notFound": {err: errors.New(`OCI runtime exec failed: exec: "nslookup": executable file not found in $PATH: unknown`), e: true},
+		"noFile":   {err: errors.New(`exec: "getent": stat getent: no such file or directory`), e: true},
+		"code127":  {err: errors.New("command terminated with exit code 127"), e: true},
+		"nxDomain": {err: errors.New("command terminated with exit code 1")},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isMissingTool(u.err))
+		})
+	}
+}
+
+func TestDNSLookupString(t *testing.T) {
+	l := DNSLookup{
+		Name:      "fred.default",
+		Pod:       "default/p1",
+		Container: "c1",
+		Tool:      "nslookup fred.default",
+		Output:    "** server can't find fred.default: NXDOMAIN\n",
+	}
+
+	assert.Equal(t, `Name: fred.default
+Pod: default/p1
+Container: c1
+Tool: nslookup fred.default
+Status: failed
+
+** server can't find fred.default: NXDOMAIN`, l.String())
+}
@@ -0,0 +1,225 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// ProbeTCP tests a destination accepts tcp connections.
+	ProbeTCP = "tcp"
+	// ProbeHTTP tests a destination answers http requests.
+	ProbeHTTP = "http"
+
+	// ProbeTimeout tracks how long a connectivity probe may take.
+	ProbeTimeout = 5 * time.Second
+)
+
+var (
+	curlOutRx = regexp.MustCompile(`^(\d{3}) ([0-9.]+)$`)
+	wgetOutRx = regexp.MustCompile(`HTTP/[0-9.]+ (\d{3})`)
+)
+
+// ProbeTarget represents a connectivity probe destination.
+type ProbeTarget struct {
+	Protocol string
+	// Dest is the destination as entered ie host:port, po/ns/name:port or svc/ns/name:port.
+	Dest string
+	Host string
+	Port int
+	Path string
+}
+
+// Addr returns the destination address.
+func (t ProbeTarget) Addr() string {
+	return net.JoinHostPort(t.Host, strconv.Itoa(t.Port))
+}
+
+// URL returns the destination url.
+func (t ProbeTarget) URL() string {
+	path := t.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return "http://" + t.Addr() + path
+}
+
+// ProbeResult represents a connectivity probe outcome.
+type ProbeResult struct {
+	Source, Container string
+	Target            ProbeTarget
+	Tool              string
+	OK                bool
+	Code              int
+	Latency           time.Duration
+	// Approx indicates the latency was inferred from the exec round trip.
+	Approx bool
+	Output string
+	Err    error
+}
+
+// String returns the probe report.
+func (r ProbeResult) String() string {
+	status := "failed"
+	if r.OK {
+		status = "ok"
+	}
+	latency := fmt.Sprintf("%dms", r.Latency.Milliseconds())
+	if r.Approx {
+		latency = "~" + latency
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Source: %s\n", r.Source)
+	fmt.Fprintf(&b, "Container: %s\n", r.Container)
+	fmt.Fprintf(&b, "Destination: %s\n", r.Target.Dest)
+	fmt.Fprintf(&b, "Address: %s\n", r.Target.Addr())
+	fmt.Fprintf(&b, "Protocol: %s\n", r.Target.Protocol)
+	fmt.Fprintf(&b, "Tool: %s\n", r.Tool)
+	fmt.Fprintf(&b, "Status: %s\n", status)
+	if r.Code != 0 {
+		fmt.Fprintf(&b, "HTTP Status: %d\n", r.Code)
+	}
+	fmt.Fprintf(&b, "Latency: %s\n", latency)
+	if r.Err != nil {
+		fmt.Fprintf(&b, "Error: %s\n", r.Err)
+	}
+	if out := strings.TrimSpace(r.Output); out != "" {
+		fmt.Fprintf(&b, "\n%s", out)
+	}
+
+	return b.String()
+}
+
+// ResolveProbeTarget resolves a destination to a host and port. Pods resolve to
+// their ip while services resolve to their cluster ip or dns name if headless.
+func ResolveProbeTarget(f Factory, protocol, dest, path string) (ProbeTarget, error) {
+	t := ProbeTarget{Protocol: protocol, Dest: dest, Path: path}
+	ref, p := dest, ""
+	if i := strings.LastIndex(dest, ":"); i > 0 {
+		ref, p = dest[:i], dest[i+1:]
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil || port <= 0 {
+		return t, fmt.Errorf("invalid destination %q. Expecting host:port, po/ns/name:port or svc/ns/name:port", dest)
+	}
+	t.Port = port
+
+	tokens := strings.SplitN(ref, "/", 2)
+	if len(tokens) < 2 {
+		t.Host = strings.Trim(ref, "[]")
+		return t, nil
+	}
+	fqn := tokens[1]
+	switch tokens[0] {
+	case "po", "pod", "pods":
+		var po Pod
+		po.Init(f, client.NewGVR("v1/pods"))
+		pod, err := po.GetInstance(fqn)
+		if err != nil {
+			return t, err
+		}
+		if pod.Status.PodIP == "" {
+			return t, fmt.Errorf("pod %s has no ip", fqn)
+		}
+		t.Host = pod.Status.PodIP
+	case "svc", "service", "services":
+		var s Service
+		s.Init(f, client.NewGVR("v1/services"))
+		svc, err := s.GetInstance(fqn)
+		if err != nil {
+			return t, err
+		}
+		t.Host = svc.Spec.ClusterIP
+		if t.Host == "" || t.Host == "None" {
+			ns, n := client.Namespaced(fqn)
+			t.Host = n + "." + ns + ".svc"
+		}
+	default:
+		return t, fmt.Errorf("unsupported destination kind %q", tokens[0])
+	}
+
+	return t, nil
+}
+
+// Probe tests the connectivity from a pod container to a destination using the
+// first probing tool available in the container.
+func (p *Pod) Probe(path, co string, t ProbeTarget) (*ProbeResult, error) {
+	for _, cmd := range probeCommands(t) {
+		var out bytes.Buffer
+		start := time.Now()
+		err := execIn(p.Client(), path, co, cmd, &out)
+		elapsed := time.Since(start)
+		if isMissingTool(err) {
+			continue
+		}
+		r := ProbeResult{
+			Source:    path,
+			Container: co,
+			Target:    t,
+			Tool:      strings.Join(cmd, " "),
+			Latency:   elapsed,
+			Approx:    true,
+			Output:    out.String(),
+			Err:       err,
+		}
+		parseProbeOutput(&r, cmd[0])
+		return &r, nil
+	}
+
+	return nil, fmt.Errorf("no %s probing tool found in container %s", t.Protocol, co)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func probeCommands(t ProbeTarget) [][]string {
+	secs := strconv.Itoa(int(ProbeTimeout.Seconds()))
+	if t.Protocol == ProbeHTTP {
+		return [][]string{
+			{"curl", "-s", "-o", "/dev/null", "-w", "%{http_code} %{time_total}", "--max-time", secs, t.URL()},
+			{"wget", "-q", "-S", "-O", "/dev/null", "-T", secs, t.URL()},
+		}
+	}
+
+	return [][]string{
+		{"nc", "-z", "-w", secs, t.Host, strconv.Itoa(t.Port)},
+		{"bash", "-c", fmt.Sprintf("timeout %s bash -c '</dev/tcp/%s/%d'", secs, t.Host, t.Port)},
+	}
+}
+
+func parseProbeOutput(r *ProbeResult, tool string) {
+	r.OK = r.Err == nil
+	switch tool {
+	case "curl":
+		m := curlOutRx.FindStringSubmatch(strings.TrimSpace(r.Output))
+		if m == nil {
+			return
+		}
+		r.Code, _ = strconv.Atoi(m[1])
+		if secs, err := strconv.ParseFloat(m[2], 64); err == nil {
+			r.Latency, r.Approx = time.Duration(secs*float64(time.Second)), false
+		}
+		r.Output = ""
+	case "wget":
+		// wget reports response headers on stderr.
+		src := r.Output
+		if r.Err != nil {
+			src += r.Err.Error()
+		}
+		if mm := wgetOutRx.FindAllStringSubmatch(src, -1); len(mm) > 0 {
+			r.Code, _ = strconv.Atoi(mm[len(mm)-1][1])
+		}
+	}
+	if r.Code != 0 {
+		r.OK = r.Code < 400
+	}
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeTargetURL(t *testing.T) {
+	uu := map[string]struct {
+		t ProbeTarget
+		e string
+	}{
+		"root": {t: ProbeTarget{Host: "10.0.0.1", Port: 80}, e: "http://10.0.0.1:80/"},
+		"path": {t: ProbeTarget{Host: "fred.default.svc", Port: 8080, Path: "healthz"}, e: "http://fred.default.svc:8080/healthz"},
+		"ipv6": {t: ProbeTarget{Host: "fd00::1", Port: 80, Path: "/"}, e: "http://[fd00::1]:80/"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.t.URL())
+		})
+	}
+}
+
+func TestResolveProbeTargetHost(t *testing.T) {
+	uu := map[string]struct {
+		dest string
+		host string
+		port int
+		err  bool
+	}{
+		"host":    {dest: "fred.default:80", host: "fred.default", port: 80},
+		"ipv6":    {dest: "[fd00::1]:443", host: "fd00::1", port: 443},
+		"noPort":  {dest: "fred.default", err: true},
+		"badPort": {dest: "fred:blee", err: true},
+		"badKind": {dest: "dp/default/fred:80", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pt, err := ResolveProbeTarget(nil, ProbeTCP, u.dest, "")
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.host, pt.Host)
+			assert.Equal(t, u.port, pt.Port)
+		})
+	}
+}
+
+func TestParseProbeOutput(t *testing.T) {
+	uu := map[string]struct {
+		tool    string
+		out     string
+		err     error
+		ok      bool
+		code    int
+		approx  bool
+		latency time.Duration
+	}{
+		"curlOK":    {tool: "curl", out: "200 0.012", ok: true, code: 200, latency: 12 * time.Millisecond},
+		"curlBad":   {tool: "curl", out: "503 0.5", code: 503, latency: 500 * time.Millisecond},
+		"curlDown":  {tool: "curl", out: "000 0.004", err: errors.New("command terminated with exit code 7"), latency: 4 * time.Millisecond},
+		"wget":      {tool: "wget", out: "  HTTP/1.1 301 Moved\n  HTTP/1.1 200 OK\n", ok: true, code: 200, approx: true, latency: time.Second},
+		"wgetErr":   {tool: "wget", err: errors.New("  HTTP/1.1 404 Not Found\nwget: server returned error"), code: 404, approx: true, latency: time.Second},
+		"ncOK":      {tool: "nc", ok: true, approx: true, latency: time.Second},
+		"ncRefused": {tool: "nc", err: errors.New("command terminated with exit code 1"), approx: true, latency: time.Second},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := ProbeResult{Output: u.out, Err: u.err, Latency: time.Second, Approx: true}
+			parseProbeOutput(&r, u.tool)
+			assert.Equal(t, u.ok, r.OK)
+			assert.Equal(t, u.code, r.Code)
+			assert.Equal(t, u.approx, r.Approx)
+			assert.Equal(t, u.latency, r.Latency)
+		})
+	}
+}
+
+func TestProbeResultString(t *testing.T) {
+	r := ProbeResult{
+		Source:    "default/p1",
+		Container: "c1",
+		Target:    ProbeTarget{Protocol: ProbeHTTP, Dest: "svc/default/fred:80", Host: "10.0.0.1", Port: 80},
+		Tool:      "curl",
+		OK:        true,
+		Code:      200,
+		Latency:   12 * time.Millisecond,
+	}
+
+	assert.Equal(t, `Source: default/p1
+Container: c1
+Destination: svc/default/fred:80
+Address: 10.0.0.1:80
+Protocol: http
+Tool: curl
+Status: ok
+HTTP Status: 200
+Latency: 12ms
+`, r.String())
+}
@@ -25,7 +25,7 @@ type PortForward struct {
 }
 
 // Delete a portforward.
-func (p *PortForward) Delete(path string, cascade, force bool) error {
+func (p *PortForward) Delete(path string, _ DeleteOptions) error {
 	ns, _ := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, "v1/pods:portforward", []string{client.DeleteVerb})
 	if err != nil {
@@ -101,5 +101,6 @@ func BenchConfigFor(benchFile, path string) config.BenchConfig {
 	}
 
 	def.C, def.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
+	def.Duration, def.RampUp = cust.Benchmarks.Defaults.Duration, cust.Benchmarks.Defaults.RampUp
 	return def
 }
@@ -1,9 +1,11 @@
 package dao
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -171,6 +173,40 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 	return portforward.NewOnAddresses(dialer, addrs, ports, p.stopChan, p.readyChan, p.Out, p.ErrOut)
 }
 
+// forwardTemp forwards a pod port to a random local port until stopped.
+func forwardTemp(f Factory, path string, port int) (int, func(), error) {
+	pf := NewPortForwarder(f)
+	fwd, err := pf.Start(path, "", client.PortTunnel{LocalPort: "0", ContainerPort: strconv.Itoa(port)})
+	if err != nil {
+		return 0, nil, err
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fwd.ForwardPorts()
+	}()
+
+	select {
+	case <-pf.readyChan:
+	case err := <-errChan:
+		pf.Stop()
+		return 0, nil, err
+	case <-time.After(probeTimeout):
+		pf.Stop()
+		return 0, nil, fmt.Errorf("port-forward to %s timed out", path)
+	}
+	pp, err := fwd.GetPorts()
+	if err != nil {
+		pf.Stop()
+		return 0, nil, err
+	}
+	if len(pp) == 0 {
+		pf.Stop()
+		return 0, nil, errors.New("no forwarded ports found")
+	}
+
+	return int(pp[0].Local), pf.Stop, nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
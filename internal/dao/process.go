@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Process)(nil)
+
+// Process represents a container processes dao.
+type Process struct {
+	NonResource
+}
+
+// List returns a collection of container processes.
+func (p *Process) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", p.gvr)
+	}
+	co, ok := ctx.Value(internal.KeyContainer).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context container for %q", p.gvr)
+	}
+	cmd, ok := ctx.Value(internal.KeyCommand).([]string)
+	if !ok || len(cmd) == 0 {
+		return nil, fmt.Errorf("no context command for %q", p.gvr)
+	}
+
+	var buff bytes.Buffer
+	if err := execIn(p.Client(), fqn, co, cmd, &buff); err != nil {
+		if buff.Len() == 0 {
+			return nil, err
+		}
+		log.Warn().Err(err).Msgf("Partial process listing for %s:%s", fqn, co)
+	}
+	pp, err := parseProcesses(buff.String())
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseProcesses parses ps or top batch output into processes.
+func parseProcesses(out string) ([]render.ProcessRes, error) {
+	lines := strings.Split(out, "\n")
+	hdr := -1
+	for i, l := range lines {
+		ff := strings.Fields(l)
+		if in(ff, "PID") && columnIndex(ff[len(ff)-1:], "COMMAND", "CMD", "ARGS") == 0 {
+			hdr = i
+			break
+		}
+	}
+	if hdr == -1 {
+		return nil, fmt.Errorf("unable to locate a process listing header")
+	}
+
+	cols := strings.Fields(lines[hdr])
+	pid, cmd := columnIndex(cols, "PID"), len(cols)-1
+	user := columnIndex(cols, "USER")
+	cpu := columnIndex(cols, "%CPU", "CPU%", "CPU")
+	mem := columnIndex(cols, "%MEM", "MEM%", "%VSZ")
+
+	pp := make([]render.ProcessRes, 0, len(lines)-hdr)
+	for _, l := range lines[hdr+1:] {
+		ff := strings.Fields(l)
+		if len(ff) <= cmd {
+			continue
+		}
+		pp = append(pp, render.ProcessRes{
+			PID:     ff[pid],
+			User:    processField(ff, user),
+			CPU:     strings.TrimSuffix(processField(ff, cpu), "%"),
+			MEM:     strings.TrimSuffix(processField(ff, mem), "%"),
+			Command: strings.Join(ff[cmd:], " "),
+		})
+	}
+
+	return pp, nil
+}
+
+// columnIndex returns the index of the first matching column name or -1.
+func columnIndex(cols []string, names ...string) int {
+	for _, n := range names {
+		for i, c := range cols {
+			if c == n {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func processField(ff []string, i int) string {
+	if i == -1 {
+		return render.NAValue
+	}
+
+	return ff[i]
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProcesses(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		e   []render.ProcessRes
+		err bool
+	}{
+		"busyboxTop": {
+			out: `Mem: 1883164K used, 163596K free, 3288K shrd, 96636K buff, 1023808K cached
+CPU:   0% usr   0% sys   0% nic 100% idle   0% io   0% irq   0% sirq
+Load average: 0.08 0.09 0.09 2/563 12
+  PID  PPID USER     STAT   VSZ %VSZ CPU %CPU COMMAND
+    1     0 root     S     1320   0%   1   0% sleep 3600
+    7     0 root     R     1332   0%   0   2% top -b -n 1
+`,
+			e: []render.ProcessRes{
+				{PID: "1", User: "root", CPU: "0", MEM: "0", Command: "sleep 3600"},
+				{PID: "7", User: "root", CPU: "2", MEM: "0", Command: "top -b -n 1"},
+			},
+		},
+		"procpsTop": {
+			out: `top - 10:12:40 up 1 day,  2:03,  0 users,  load average: 0.00, 0.01, 0.05
+Tasks:   2 total,   1 running,   1 sleeping,   0 stopped,   0 zombie
+
+    PID USER      PR  NI    VIRT    RES    SHR S  %CPU  %MEM     TIME+ COMMAND
+      1 nginx     20   0   10624   5900   5000 S   0.3   0.1   0:00.03 nginx: master process
+`,
+			e: []render.ProcessRes{
+				{PID: "1", User: "nginx", CPU: "0.3", MEM: "0.1", Command: "nginx: master process"},
+			},
+		},
+		"busyboxPs": {
+			out: `PID   USER     TIME  COMMAND
+    1 root      0:00 sleep 3600
+`,
+			e: []render.ProcessRes{
+				{PID: "1", User: "root", CPU: render.NAValue, MEM: render.NAValue, Command: "sleep 3600"},
+			},
+		},
+		"noHeader": {
+			out: "sh: top: not found",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp, err := parseProcesses(u.out)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, pp)
+		})
+	}
+}
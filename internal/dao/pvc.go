@@ -0,0 +1,252 @@
+package dao
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*PersistentVolumeClaim)(nil)
+
+// PersistentVolumeClaim represents a k8s pvc.
+type PersistentVolumeClaim struct {
+	Informed
+}
+
+// PVCDiagnosis represents a pvc binding and usage diagnostics.
+type PVCDiagnosis struct {
+	Claim        string    `json:"claim"`
+	Phase        string    `json:"phase"`
+	Volume       string    `json:"volume,omitempty"`
+	StorageClass string    `json:"storageClass,omitempty"`
+	Provisioner  string    `json:"provisioner,omitempty"`
+	Capacity     string    `json:"capacity,omitempty"`
+	AccessModes  []string  `json:"accessModes,omitempty"`
+	Pods         []string  `json:"pods,omitempty"`
+	Node         string    `json:"node,omitempty"`
+	Usage        *PVCUsage `json:"usage,omitempty"`
+	Events       []string  `json:"events,omitempty"`
+}
+
+// PVCUsage represents a volume usage as reported by the kubelet.
+type PVCUsage struct {
+	Used      string `json:"used"`
+	Available string `json:"available"`
+	Capacity  string `json:"capacity"`
+	Percent   int    `json:"percent"`
+}
+
+// GetInstance returns a pvc instance.
+func (p *PersistentVolumeClaim) GetInstance(fqn string) (*v1.PersistentVolumeClaim, error) {
+	o, err := p.Factory.Get(p.gvr.String(), fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var pvc v1.PersistentVolumeClaim
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc)
+	if err != nil {
+		return nil, errors.New("expecting PersistentVolumeClaim resource")
+	}
+
+	return &pvc, nil
+}
+
+// Diagnose returns a pvc binding, attachment and usage report.
+func (p *PersistentVolumeClaim) Diagnose(fqn string) (string, error) {
+	pvc, err := p.GetInstance(fqn)
+	if err != nil {
+		return "", err
+	}
+
+	d := PVCDiagnosis{
+		Claim:  fqn,
+		Phase:  string(pvc.Status.Phase),
+		Volume: pvc.Spec.VolumeName,
+	}
+	if q, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+		d.Capacity = q.String()
+	}
+	for _, m := range pvc.Status.AccessModes {
+		d.AccessModes = append(d.AccessModes, string(m))
+	}
+	if pvc.Spec.StorageClassName != nil {
+		d.StorageClass = *pvc.Spec.StorageClassName
+		if d.Provisioner, err = p.provisioner(d.StorageClass); err != nil {
+			log.Warn().Err(err).Msgf("No storage class %q", d.StorageClass)
+		}
+	}
+	if d.Pods, d.Node, err = p.mountedBy(pvc); err != nil {
+		return "", err
+	}
+	if d.Node != "" {
+		if d.Usage, err = p.usage(d.Node, pvc); err != nil {
+			log.Warn().Err(err).Msgf("No volume stats for %q", fqn)
+		}
+	}
+	if pvc.Status.Phase == v1.ClaimPending {
+		if d.Events, err = p.events(pvc); err != nil {
+			return "", err
+		}
+	}
+
+	raw, err := yaml.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func (p *PersistentVolumeClaim) provisioner(class string) (string, error) {
+	o, err := p.Factory.Get("storage.k8s.io/v1/storageclasses", client.FQN(client.ClusterScope, class), true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var sc storagev1.StorageClass
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sc); err != nil {
+		return "", err
+	}
+
+	return sc.Provisioner, nil
+}
+
+// mountedBy returns the pods mounting a claim and the node they run on.
+func (p *PersistentVolumeClaim) mountedBy(pvc *v1.PersistentVolumeClaim) ([]string, string, error) {
+	oo, err := p.Factory.List("v1/pods", pvc.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		pp   []string
+		node string
+	)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, "", err
+		}
+		if !mountsClaim(po, pvc.Name) {
+			continue
+		}
+		pp = append(pp, client.FQN(po.Namespace, po.Name))
+		if node == "" {
+			node = po.Spec.NodeName
+		}
+	}
+	sort.Strings(pp)
+
+	return pp, node, nil
+}
+
+// usage fetches a claim volume stats from the node kubelet summary.
+func (p *PersistentVolumeClaim) usage(node string, pvc *v1.PersistentVolumeClaim) (*PVCUsage, error) {
+	raw, err := p.Client().DialOrDie().CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(node).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	var s volumeSummary
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+
+	return s.usageFor(pvc.Namespace, pvc.Name)
+}
+
+func (p *PersistentVolumeClaim) events(pvc *v1.PersistentVolumeClaim) ([]string, error) {
+	oo, err := p.Factory.List("v1/events", pvc.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	sel := fields.ParseSelectorOrDie(EventsSelector("PersistentVolumeClaim", pvc.Namespace, pvc.Name))
+	var ee []string
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !sel.Matches(eventFields(u)) {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			return nil, err
+		}
+		ee = append(ee, fmt.Sprintf("%s %s: %s", evt.Type, evt.Reason, evt.Message))
+	}
+
+	return ee, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func mountsClaim(po v1.Pod, claim string) bool {
+	for _, v := range po.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == claim {
+			return true
+		}
+	}
+
+	return false
+}
+
+// volumeSummary represents the volume stats section of a kubelet summary.
+type volumeSummary struct {
+	Pods []struct {
+		Volume []struct {
+			UsedBytes      *uint64 `json:"usedBytes"`
+			AvailableBytes *uint64 `json:"availableBytes"`
+			CapacityBytes  *uint64 `json:"capacityBytes"`
+			PVCRef         *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+func (s volumeSummary) usageFor(ns, n string) (*PVCUsage, error) {
+	for _, p := range s.Pods {
+		for _, v := range p.Volume {
+			if v.PVCRef == nil || v.PVCRef.Namespace != ns || v.PVCRef.Name != n {
+				continue
+			}
+			if v.UsedBytes == nil || v.CapacityBytes == nil || v.AvailableBytes == nil {
+				continue
+			}
+			u := PVCUsage{
+				Used:      bytesToStr(*v.UsedBytes),
+				Available: bytesToStr(*v.AvailableBytes),
+				Capacity:  bytesToStr(*v.CapacityBytes),
+			}
+			if *v.CapacityBytes > 0 {
+				u.Percent = int(*v.UsedBytes * 100 / *v.CapacityBytes)
+			}
+			return &u, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no volume stats for %s", client.FQN(ns, n))
+}
+
+func bytesToStr(b uint64) string {
+	return resource.NewQuantity(int64(b), resource.BinarySI).String()
+}
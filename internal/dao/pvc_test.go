@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestMountsClaim(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+				{Name: "data", VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "fred"},
+				}},
+			},
+		},
+	}
+
+	assert.True(t, mountsClaim(po, "fred"))
+	assert.False(t, mountsClaim(po, "blee"))
+}
+
+func TestVolumeSummaryUsageFor(t *testing.T) {
+	raw := `{"pods": [{"volume": [
+		{"name": "token", "usedBytes": 10},
+		{"name": "data", "usedBytes": 268435456, "availableBytes": 805306368, "capacityBytes": 1073741824,
+		 "pvcRef": {"name": "fred", "namespace": "default"}}
+	]}]}`
+	var s volumeSummary
+	assert.Nil(t, json.Unmarshal([]byte(raw), &s))
+
+	u, err := s.usageFor("default", "fred")
+	assert.Nil(t, err)
+	assert.Equal(t, &PVCUsage{Used: "256Mi", Available: "768Mi", Capacity: "1Gi", Percent: 25}, u)
+
+	_, err = s.usageFor("default", "blee")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,179 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PolicyResolver resolves the effective RBAC rules granted to a subject.
+type PolicyResolver struct {
+	Factory Factory
+}
+
+// NewPolicyResolver returns a new resolver.
+func NewPolicyResolver(f Factory) *PolicyResolver {
+	return &PolicyResolver{Factory: f}
+}
+
+// Resolve returns all the rules granted to a subject by cluster and namespaced
+// bindings. ServiceAccount names may be qualified by their namespace.
+func (r *PolicyResolver) Resolve(kind, name string) (render.Policies, error) {
+	crbs, err := fetchClusterRoleBindings(r.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(r.Factory)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := r.clusterRoles()
+	if err != nil {
+		return nil, err
+	}
+	ros, err := r.roles()
+	if err != nil {
+		return nil, err
+	}
+
+	ss := subjectsFor(kind, name)
+	var pp render.Policies
+	for _, crb := range crbs {
+		if !bound(crb.Subjects, "", ss) {
+			continue
+		}
+		cr, ok := crs[crb.RoleRef.Name]
+		if !ok {
+			continue
+		}
+		pp = append(pp, bindingRules("*", crbGVR+":"+crb.Name, "CR:"+cr.Name, cr.Rules)...)
+	}
+	for _, rb := range rbs {
+		if !bound(rb.Subjects, rb.Namespace, ss) {
+			continue
+		}
+		ref := rbGVR + ":" + FQN(rb.Namespace, rb.Name)
+		switch rb.RoleRef.Kind {
+		case "ClusterRole":
+			if cr, ok := crs[rb.RoleRef.Name]; ok {
+				pp = append(pp, bindingRules(rb.Namespace, ref, "CR:"+cr.Name, cr.Rules)...)
+			}
+		case "Role":
+			if ro, ok := ros[FQN(rb.Namespace, rb.RoleRef.Name)]; ok {
+				pp = append(pp, bindingRules(rb.Namespace, ref, "RO:"+ro.Name, ro.Rules)...)
+			}
+		}
+	}
+
+	return pp, nil
+}
+
+func (r *PolicyResolver) clusterRoles() (map[string]rbacv1.ClusterRole, error) {
+	oo, err := r.Factory.List(crGVR, client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	crs := make(map[string]rbacv1.ClusterRole, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var cr rbacv1.ClusterRole
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cr); err != nil {
+			return nil, err
+		}
+		crs[cr.Name] = cr
+	}
+
+	return crs, nil
+}
+
+func (r *PolicyResolver) roles() (map[string]rbacv1.Role, error) {
+	oo, err := r.Factory.List(rGVR, client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ros := make(map[string]rbacv1.Role, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var ro rbacv1.Role
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ro); err != nil {
+			return nil, err
+		}
+		ros[FQN(ro.Namespace, ro.Name)] = ro
+	}
+
+	return ros, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// subjectsFor returns all the subjects a given subject acts as. ServiceAccounts
+// also inherit bindings granted to their user and groups.
+func subjectsFor(kind, name string) []rbacv1.Subject {
+	if kind != rbacv1.ServiceAccountKind {
+		return []rbacv1.Subject{{Kind: kind, Name: name}}
+	}
+
+	ns, n := client.Namespaced(name)
+	ss := []rbacv1.Subject{{Kind: kind, Namespace: ns, Name: n}}
+	if ns == "" {
+		return ss
+	}
+
+	return append(ss,
+		rbacv1.Subject{Kind: rbacv1.UserKind, Name: strings.Join([]string{"system", "serviceaccount", ns, n}, ":")},
+		rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts"},
+		rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:" + ns},
+		rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:authenticated"},
+	)
+}
+
+// bound returns true if any of the binding subjects matches one of the given subjects.
+func bound(bb []rbacv1.Subject, ns string, ss []rbacv1.Subject) bool {
+	for _, b := range bb {
+		for _, s := range ss {
+			if matchSubject(b, ns, s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchSubject(b rbacv1.Subject, ns string, s rbacv1.Subject) bool {
+	if b.Kind != s.Kind || b.Name != s.Name {
+		return false
+	}
+	if b.Kind != rbacv1.ServiceAccountKind || s.Namespace == "" {
+		return true
+	}
+	if b.Namespace != "" {
+		ns = b.Namespace
+	}
+
+	return ns == s.Namespace
+}
+
+func bindingRules(ns, ref, role string, rules []rbacv1.PolicyRule) render.Policies {
+	pp := parseRules(ns, role, rules)
+	for i := range pp {
+		pp[i].BindingRef = ref
+	}
+
+	return pp
+}
@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestSubjectsFor(t *testing.T) {
+	uu := map[string]struct {
+		kind, name string
+		e          int
+	}{
+		"user":  {kind: rbacv1.UserKind, name: "fred", e: 1},
+		"group": {kind: rbacv1.GroupKind, name: "blee", e: 1},
+		"sa":    {kind: rbacv1.ServiceAccountKind, name: "default/fred", e: 5},
+		"saNS":  {kind: rbacv1.ServiceAccountKind, name: "fred", e: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, len(subjectsFor(u.kind, u.name)))
+		})
+	}
+}
+
+func TestBound(t *testing.T) {
+	sa := subjectsFor(rbacv1.ServiceAccountKind, "default/fred")
+	uu := map[string]struct {
+		bb []rbacv1.Subject
+		ns string
+		ss []rbacv1.Subject
+		e  bool
+	}{
+		"none": {
+			ss: sa,
+		},
+		"sa": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "default", Name: "fred"}},
+			ss: sa,
+			e:  true,
+		},
+		"saOtherNS": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "blee", Name: "fred"}},
+			ss: sa,
+		},
+		"saBindingNS": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "fred"}},
+			ns: "default",
+			ss: sa,
+			e:  true,
+		},
+		"saAnyNS": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "blee", Name: "fred"}},
+			ss: subjectsFor(rbacv1.ServiceAccountKind, "fred"),
+			e:  true,
+		},
+		"saGroup": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:default"}},
+			ss: sa,
+			e:  true,
+		},
+		"saUser": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "system:serviceaccount:default:fred"}},
+			ss: sa,
+			e:  true,
+		},
+		"wrongKind": {
+			bb: []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "fred"}},
+			ss: sa,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, bound(u.bb, u.ns, u.ss))
+		})
+	}
+}
+
+func TestBindingRules(t *testing.T) {
+	rules := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+	}
+	pp := bindingRules("default", rbGVR+":default/rb1", "RO:ro1", rules)
+
+	assert.Equal(t, 1, len(pp))
+	assert.Equal(t, "RO:ro1", pp[0].Binding)
+	assert.Equal(t, rbGVR+":default/rb1", pp[0].BindingRef)
+}
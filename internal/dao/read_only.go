@@ -0,0 +1,18 @@
+package dao
+
+import "errors"
+
+// ErrReadOnly indicates a mutating action was attempted in read-only mode.
+var ErrReadOnly = errors.New("action denied: K9s is running in read-only mode")
+
+// ensureWritable checks mutating calls are allowed on the factory connection.
+func ensureWritable(f Factory) error {
+	if f == nil || f.Client() == nil {
+		return nil
+	}
+	if cfg := f.Client().Config(); cfg != nil && cfg.IsReadOnly() {
+		return ErrReadOnly
+	}
+
+	return nil
+}
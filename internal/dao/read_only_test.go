@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureWritable(t *testing.T) {
+	conn := client.NewTestClient()
+	f := roFactory{conn: conn}
+	assert.Nil(t, ensureWritable(f))
+
+	conn.Config().SetReadOnly(true)
+	assert.Equal(t, ErrReadOnly, ensureWritable(f))
+
+	var g Generic
+	g.Init(f, client.NewGVR("v1/pods"))
+	assert.Equal(t, ErrReadOnly, g.Delete("default/p1", DefaultDeleteOptions()))
+
+	var d Deployment
+	d.Init(f, client.NewGVR("apps/v1/deployments"))
+	assert.Equal(t, ErrReadOnly, d.Scale("default/d1", 2))
+
+	var cr CustomResource
+	cr.Init(f, client.NewGVR("fred.io/v1/freds"))
+	assert.Equal(t, ErrReadOnly, cr.Scale("default/f1", 2))
+
+	_, err := Update(f, client.NewGVR("v1/configmaps"), []byte("kind: ConfigMap"), false)
+	assert.Equal(t, ErrReadOnly, err)
+}
+
+// Helpers...
+
+type roFactory struct {
+	Factory
+
+	conn client.Connection
+}
+
+func (f roFactory) Client() client.Connection {
+	return f.conn
+}
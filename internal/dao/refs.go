@@ -0,0 +1,136 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	cmGVR  = "v1/configmaps"
+	secGVR = "v1/secrets"
+)
+
+// RefWorkloads tracks workloads that may reference configmaps and secrets.
+var RefWorkloads = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+}
+
+// Ref represents a resource reference.
+type Ref struct {
+	GVR string
+	FQN string
+}
+
+// RefIndex tracks which workloads reference a given configmap or secret.
+type RefIndex map[string][]Ref
+
+// IsRefTarget returns true if a resource can be referenced by workloads.
+func IsRefTarget(gvr string) bool {
+	return gvr == cmGVR || gvr == secGVR
+}
+
+// BuildRefIndex indexes all configmaps and secrets references held by workloads
+// pod templates in a given namespace.
+func BuildRefIndex(f Factory, ns string) (RefIndex, error) {
+	idx := make(RefIndex)
+	for _, gvr := range RefWorkloads {
+		oo, err := f.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			m, ok, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+			if err != nil || !ok {
+				continue
+			}
+			var spec v1.PodSpec
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+				return nil, err
+			}
+			idx.index(Ref{GVR: gvr, FQN: FQN(u.GetNamespace(), u.GetName())}, u.GetNamespace(), spec)
+		}
+	}
+
+	return idx, nil
+}
+
+// For returns all workloads referencing a given resource.
+func (r RefIndex) For(gvr, path string) []Ref {
+	return r[refKey(gvr, path)]
+}
+
+func (r RefIndex) index(ref Ref, ns string, spec v1.PodSpec) {
+	seen := make(map[string]struct{})
+	add := func(gvr, n string) {
+		if n == "" {
+			return
+		}
+		k := refKey(gvr, FQN(ns, n))
+		if _, ok := seen[k]; ok {
+			return
+		}
+		seen[k] = struct{}{}
+		r[k] = append(r[k], ref)
+	}
+
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil {
+			add(cmGVR, v.ConfigMap.Name)
+		}
+		if v.Secret != nil {
+			add(secGVR, v.Secret.SecretName)
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil {
+				add(cmGVR, s.ConfigMap.Name)
+			}
+			if s.Secret != nil {
+				add(secGVR, s.Secret.Name)
+			}
+		}
+	}
+
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+	for _, c := range cc {
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil {
+				add(cmGVR, e.ConfigMapRef.Name)
+			}
+			if e.SecretRef != nil {
+				add(secGVR, e.SecretRef.Name)
+			}
+		}
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				add(cmGVR, e.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				add(secGVR, e.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+}
+
+func refKey(gvr, path string) string {
+	ns, n := client.Namespaced(path)
+	return gvr + ":" + FQN(ns, n)
+}
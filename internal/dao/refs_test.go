@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRefIndex(t *testing.T) {
+	spec := v1.PodSpec{
+		Volumes: []v1.Volume{
+			{VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+			{VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "s1"}}},
+			{VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}}},
+			}}}},
+		},
+		InitContainers: []v1.Container{
+			{EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s2"}}}}},
+		},
+		Containers: []v1.Container{
+			{
+				EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+				Env: []v1.EnvVar{
+					{Name: "a", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm3"}}}},
+					{Name: "b", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s3"}}}},
+					{Name: "c", Value: "blee"},
+				},
+			},
+		},
+	}
+	ref := Ref{GVR: "apps/v1/deployments", FQN: "default/dp1"}
+	idx := make(RefIndex)
+	idx.index(ref, "default", spec)
+
+	uu := map[string]struct {
+		gvr, path string
+		e         []Ref
+	}{
+		"volume":      {gvr: cmGVR, path: "default/cm1", e: []Ref{ref}},
+		"secretVol":   {gvr: secGVR, path: "default/s1", e: []Ref{ref}},
+		"projected":   {gvr: cmGVR, path: "default/cm2", e: []Ref{ref}},
+		"initEnvFrom": {gvr: secGVR, path: "default/s2", e: []Ref{ref}},
+		"envKeyRef":   {gvr: cmGVR, path: "default/cm3", e: []Ref{ref}},
+		"secretKey":   {gvr: secGVR, path: "default/s3", e: []Ref{ref}},
+		"otherNS":     {gvr: cmGVR, path: "fred/cm1"},
+		"wrongKind":   {gvr: secGVR, path: "default/cm1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, idx.For(u.gvr, u.path))
+		})
+	}
+}
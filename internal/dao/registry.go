@@ -14,18 +14,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// crdCat tags custom resources metadata.
+const crdCat = "crd"
+
 // MetaAccess tracks resources metadata.
 var MetaAccess = NewMeta()
 
 // Meta represents available resource metas.
 type Meta struct {
 	resMetas ResourceMetas
+	crdSpecs CRDSpecs
 	mx       sync.RWMutex
 }
 
 // NewMeta returns a resource meta.
 func NewMeta() *Meta {
-	return &Meta{resMetas: make(ResourceMetas)}
+	return &Meta{resMetas: make(ResourceMetas), crdSpecs: make(CRDSpecs)}
 }
 
 // AccessorFor returns a client accessor for a resource if registered.
@@ -35,22 +39,62 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 	m := Accessors{
 		client.NewGVR("contexts"):                      &Context{},
 		client.NewGVR("containers"):                    &Container{},
+		client.NewGVR("secretdata"):                    &SecretData{},
+		client.NewGVR("applied"):                       &Apply{},
+		client.NewGVR("access"):                        &Access{},
+		client.NewGVR("netpolrules"):                   &NetpolRule{},
+		client.NewGVR("containerfs"):                   &ContainerFS{},
+		client.NewGVR("processes"):                     &Process{},
+		client.NewGVR("disruptions"):                   &Disruption{},
+		client.NewGVR("terminations"):                  &Termination{},
+		client.NewGVR("conditions"):                    &Condition{},
+		client.NewGVR("tlscerts"):                      &Cert{},
+		client.NewGVR("webhooks"):                      &Webhook{},
+		client.NewGVR("notifications"):                 &Notification{},
+		client.NewGVR("alerts"):                        &Alert{},
+		client.NewGVR("dashboard"):                     &Dashboard{},
+		client.NewGVR("hops"):                          &Hop{},
+		client.NewGVR("palette"):                       &Palette{},
+		client.NewGVR("find"):                          &Find{},
+		client.NewGVR("deprecations"):                  &Deprecation{},
+		client.NewGVR("costs"):                         &Cost{},
+		client.NewGVR("images"):                        &Image{},
+		client.NewGVR("vulns"):                         &Vuln{},
+		client.NewGVR("imagetags"):                     &ImageTag{},
+		client.NewGVR("revisions"):                     &Revision{},
+		client.NewGVR("ordinals"):                      &Ordinal{},
+		client.NewGVR("audits"):                        &Audit{},
+		client.NewGVR("informers"):                     &Informer{},
+		client.NewGVR("debug"):                         &Debug{},
+		client.NewGVR("apicalls"):                      &APICall{},
 		client.NewGVR("screendumps"):                   &ScreenDump{},
 		client.NewGVR("benchmarks"):                    &Benchmark{},
 		client.NewGVR("portforwards"):                  &PortForward{},
 		client.NewGVR("v1/services"):                   &Service{},
+		client.NewGVR("extensions/v1beta1/ingresses"):  &Ingress{},
+		client.NewGVR("v1/persistentvolumeclaims"):     &PersistentVolumeClaim{},
 		client.NewGVR("v1/pods"):                       &Pod{},
+		client.NewGVR("v1/nodes"):                      &Node{},
+		client.NewGVR("v1/events"):                     &Event{},
 		client.NewGVR("apps/v1/deployments"):           &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
 		client.NewGVR("extensions/v1beta1/daemonsets"): &DaemonSet{},
 		client.NewGVR("apps/v1/statefulsets"):          &StatefulSet{},
+		client.NewGVR("apps/v1/replicasets"):           &ReplicaSet{},
 		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
 		client.NewGVR("charts"):                        &Chart{},
+		client.NewGVR("history"):                       &History{},
+		client.NewGVR("chartresources"):                &ChartResource{},
+		client.NewGVR("helmrepos"):                     &HelmRepo{},
+		client.NewGVR("repocharts"):                    &RepoChart{},
 		client.NewGVR("openfaas"):                      &OpenFaas{},
 	}
 
 	r, ok := m[gvr]
+	if !ok && MetaAccess.IsScalable(gvr) {
+		r, ok = &CustomResource{}, true
+	}
 	if !ok {
 		r = &Generic{}
 		log.Debug().Msgf("No DAO registry entry for %q. Using factory!", gvr)
@@ -94,6 +138,21 @@ func (m *Meta) MetaFor(gvr client.GVR) (metav1.APIResource, error) {
 	return meta, nil
 }
 
+// CRDSpecFor returns a custom resource printer columns and subresources.
+func (m *Meta) CRDSpecFor(gvr client.GVR) (CRDSpec, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	spec, ok := m.crdSpecs[gvr]
+	return spec, ok
+}
+
+// IsScalable checks if a custom resource exposes a scale subresource.
+func (m *Meta) IsScalable(gvr client.GVR) bool {
+	spec, ok := m.CRDSpecFor(gvr)
+	return ok && spec.Scale
+}
+
 // IsK8sMeta checks for non resource meta.
 func IsK8sMeta(m metav1.APIResource) bool {
 	for _, c := range m.Categories {
@@ -116,17 +175,28 @@ func IsK9sMeta(m metav1.APIResource) bool {
 	return false
 }
 
+// IsCRD checks for custom resource meta.
+func IsCRD(m metav1.APIResource) bool {
+	for _, c := range m.Categories {
+		if c == crdCat {
+			return true
+		}
+	}
+
+	return false
+}
+
 // LoadResources hydrates server preferred+CRDs resource metadata.
 func (m *Meta) LoadResources(f Factory) error {
 	m.mx.Lock()
 	defer m.mx.Unlock()
 
-	m.resMetas = make(ResourceMetas, 100)
+	m.resMetas, m.crdSpecs = make(ResourceMetas, 100), make(CRDSpecs)
 	if err := loadPreferred(f, m.resMetas); err != nil {
 		return err
 	}
 	loadNonResource(m.resMetas)
-	loadCRDs(f, m.resMetas)
+	loadCRDs(f, m.resMetas, m.crdSpecs)
 
 	return nil
 }
@@ -162,6 +232,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("plugins")] = metav1.APIResource{
+		Name:         "plugins",
+		Kind:         "Plugins",
+		SingularName: "plugin",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("contexts")] = metav1.APIResource{
 		Name:         "contexts",
 		Kind:         "Contexts",
@@ -202,6 +279,209 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("secretdata")] = metav1.APIResource{
+		Name:         "secretdata",
+		Kind:         "SecretData",
+		SingularName: "secretdata",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("applied")] = metav1.APIResource{
+		Name:         "applied",
+		Kind:         "Applied",
+		SingularName: "applied",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("access")] = metav1.APIResource{
+		Name:         "access",
+		Kind:         "Access",
+		SingularName: "access",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("netpolrules")] = metav1.APIResource{
+		Name:         "netpolrules",
+		Kind:         "NetpolRule",
+		SingularName: "netpolrule",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("containerfs")] = metav1.APIResource{
+		Name:         "containerfs",
+		Kind:         "ContainerFS",
+		SingularName: "containerfs",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("processes")] = metav1.APIResource{
+		Name:         "processes",
+		Kind:         "Process",
+		SingularName: "process",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("disruptions")] = metav1.APIResource{
+		Name:         "disruptions",
+		Kind:         "Disruption",
+		SingularName: "disruption",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("terminations")] = metav1.APIResource{
+		Name:         "terminations",
+		Kind:         "Termination",
+		SingularName: "termination",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("conditions")] = metav1.APIResource{
+		Name:         "conditions",
+		Kind:         "Condition",
+		SingularName: "condition",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("tlscerts")] = metav1.APIResource{
+		Name:         "tlscerts",
+		Namespaced:   true,
+		Kind:         "Cert",
+		SingularName: "tlscert",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("webhooks")] = metav1.APIResource{
+		Name:         "webhooks",
+		Kind:         "Webhook",
+		SingularName: "webhook",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("notifications")] = metav1.APIResource{
+		Name:         "notifications",
+		Kind:         "Notification",
+		SingularName: "notification",
+		ShortNames:   []string{"notif"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("alerts")] = metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alert",
+		SingularName: "alert",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("dashboard")] = metav1.APIResource{
+		Name:         "dashboard",
+		Kind:         "Dashboard",
+		SingularName: "dashboard",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("hops")] = metav1.APIResource{
+		Name:         "hops",
+		Kind:         "Hop",
+		SingularName: "hop",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("palette")] = metav1.APIResource{
+		Name:         "palette",
+		Kind:         "Palette",
+		SingularName: "palette",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("images")] = metav1.APIResource{
+		Name:         "images",
+		Namespaced:   true,
+		Kind:         "Image",
+		SingularName: "image",
+		ShortNames:   []string{"img"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("vulns")] = metav1.APIResource{
+		Name:         "vulns",
+		Kind:         "Vuln",
+		SingularName: "vuln",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("imagetags")] = metav1.APIResource{
+		Name:         "imagetags",
+		Kind:         "ImageTag",
+		SingularName: "imagetag",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("revisions")] = metav1.APIResource{
+		Name:         "revisions",
+		Kind:         "Revision",
+		SingularName: "revision",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("ordinals")] = metav1.APIResource{
+		Name:         "ordinals",
+		Kind:         "Ordinal",
+		SingularName: "ordinal",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("costs")] = metav1.APIResource{
+		Name:         "costs",
+		Namespaced:   true,
+		Kind:         "Cost",
+		SingularName: "cost",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:         "deprecations",
+		Namespaced:   true,
+		Kind:         "Deprecation",
+		SingularName: "deprecation",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("find")] = metav1.APIResource{
+		Name:         "find",
+		Namespaced:   true,
+		Kind:         "Find",
+		SingularName: "find",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("audits")] = metav1.APIResource{
+		Name:         "audits",
+		Kind:         "Audit",
+		SingularName: "audit",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("informers")] = metav1.APIResource{
+		Name:         "informers",
+		Kind:         "Informer",
+		SingularName: "informer",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("debug")] = metav1.APIResource{
+		Name:         "debug",
+		Kind:         "Debug",
+		SingularName: "debug",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("apicalls")] = metav1.APIResource{
+		Name:         "apicalls",
+		Kind:         "APICall",
+		SingularName: "apicall",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
@@ -212,6 +492,36 @@ func loadHelm(m ResourceMetas) {
 		Verbs:      []string{"delete"},
 		Categories: []string{"helm"},
 	}
+	m[client.NewGVR("helmrepos")] = metav1.APIResource{
+		Name:         "helmrepos",
+		Kind:         "HelmRepos",
+		SingularName: "helmrepo",
+		ShortNames:   []string{"repos"},
+		Verbs:        []string{},
+		Categories:   []string{"helm"},
+	}
+	m[client.NewGVR("repocharts")] = metav1.APIResource{
+		Name:         "repocharts",
+		Kind:         "RepoCharts",
+		SingularName: "repochart",
+		ShortNames:   []string{"rc"},
+		Verbs:        []string{},
+		Categories:   []string{"helm"},
+	}
+	m[client.NewGVR("chartresources")] = metav1.APIResource{
+		Name:         "chartresources",
+		Kind:         "ChartResources",
+		SingularName: "chartresource",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("history")] = metav1.APIResource{
+		Name:         "history",
+		Kind:         "History",
+		SingularName: "history",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadOpenFaas(m ResourceMetas) {
@@ -250,7 +560,15 @@ func loadRBAC(m ResourceMetas) {
 }
 
 func loadPreferred(f Factory, m ResourceMetas) error {
-	rr, err := f.Client().CachedDiscoveryOrDie().ServerPreferredResources()
+	var (
+		rr  []*metav1.APIResourceList
+		err error
+	)
+	if s, ok := f.Client().(SnapshotLister); ok {
+		rr, err = s.ServerPreferredResources()
+	} else {
+		rr, err = f.Client().CachedDiscoveryOrDie().ServerPreferredResources()
+	}
 	if err != nil {
 		log.Debug().Err(err).Msgf("Failed to load preferred resources")
 	}
@@ -268,7 +586,7 @@ func loadPreferred(f Factory, m ResourceMetas) error {
 	return nil
 }
 
-func loadCRDs(f Factory, m ResourceMetas) {
+func loadCRDs(f Factory, m ResourceMetas, ss CRDSpecs) {
 	const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
 	oo, err := f.List(crdGVR, "", true, labels.Everything())
 	if err != nil {
@@ -282,8 +600,12 @@ func loadCRDs(f Factory, m ResourceMetas) {
 			log.Error().Err(errs[0]).Msgf("Fail to extract CRD meta (%d) errors", len(errs))
 			continue
 		}
+		meta.Categories = append(meta.Categories, crdCat)
 		gvr := client.NewGVRFromMeta(meta)
 		m[gvr] = meta
+		if crd, ok := o.(*unstructured.Unstructured); ok {
+			ss[gvr] = extractCRDSpec(crd.Object, meta.Version)
+		}
 	}
 }
 
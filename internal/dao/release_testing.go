@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const testPodPollInterval = 500 * time.Millisecond
+
+// ReleaseTest runs helm chart release tests.
+type ReleaseTest struct {
+	NonResource
+}
+
+// TestPods returns the test pods defined by a chart release.
+func (r *ReleaseTest) TestPods(path string) ([]string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := r.chart().EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return testPods(rel), nil
+}
+
+// WaitForPod waits until a given test pod is scheduled.
+func (r *ReleaseTest) WaitForPod(ctx context.Context, path string) error {
+	for {
+		if _, err := r.Factory.Get("v1/pods", path, false, labels.Everything()); err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("test pod %s was not found", path)
+		case <-time.After(testPodPollInterval):
+		}
+	}
+}
+
+// Run runs the chart release tests. It returns an error if any test failed.
+func (r *ReleaseTest) Run(path string, timeout time.Duration) error {
+	if err := ensureWritable(r.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	cfg, err := r.chart().EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+
+	t := action.NewReleaseTesting(cfg)
+	t.Namespace, t.Timeout = ns, timeout
+	rel, err := t.Run(n)
+	if err != nil {
+		return err
+	}
+
+	return testFailures(rel)
+}
+
+func (r *ReleaseTest) chart() *Chart {
+	var c Chart
+	c.Init(r.Factory, client.NewGVR("charts"))
+
+	return &c
+}
+
+// Helpers...
+
+func testPods(rel *release.Release) []string {
+	var pp []string
+	for _, h := range rel.Hooks {
+		if h.Kind == "Pod" && isTestHook(h) {
+			pp = append(pp, client.FQN(rel.Namespace, h.Name))
+		}
+	}
+
+	return pp
+}
+
+func testFailures(rel *release.Release) error {
+	var ff []string
+	for _, h := range rel.Hooks {
+		if isTestHook(h) && h.LastRun.Phase == release.HookPhaseFailed {
+			ff = append(ff, h.Name)
+		}
+	}
+	if len(ff) > 0 {
+		return fmt.Errorf("failed tests: %s", strings.Join(ff, ", "))
+	}
+
+	return nil
+}
+
+func isTestHook(h *release.Hook) bool {
+	for _, e := range h.Events {
+		if e == release.HookTest {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/watch"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -20,9 +21,14 @@ type Resource struct {
 	Generic
 }
 
-// List returns a collection of resources.
+// List returns a collection of resources. Label and field selectors are
+// pushed down to the api server when the factory supports it.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
+	strField, _ := ctx.Value(internal.KeyFields).(string)
+	if sl, ok := r.Factory.(SelectorLister); ok && (strLabel != "" || strField != "") {
+		return sl.ListSelected(r.gvr.String(), ns, true, watch.Selector{Labels: strLabel, Fields: strField})
+	}
 	lsel := labels.Everything()
 	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
 		lsel = sel.AsSelector()
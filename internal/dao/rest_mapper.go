@@ -21,6 +21,9 @@ type RestMapper struct {
 // ToRESTMapper map resources to kind, and map kind and version to interfaces for manipulating K8s objects.
 func (r *RestMapper) ToRESTMapper() (meta.RESTMapper, error) {
 	dial := r.CachedDiscoveryOrDie()
+	if dial == nil {
+		return nil, client.ErrSnapshot
+	}
 	mapper := restmapper.NewDeferredDiscoveryRESTMapper(dial)
 	expander := restmapper.NewShortcutExpander(mapper, dial)
 
@@ -0,0 +1,339 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/pmezard/go-difflib/difflib"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+var _ Accessor = (*Revision)(nil)
+
+// Revision represents a workload rollout revisions.
+type Revision struct {
+	NonResource
+}
+
+// List returns a workload pod template revisions, most recent first.
+func (r *Revision) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", r.gvr)
+	}
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("no target resource for %q", r.gvr)
+	}
+
+	rr, err := WorkloadRevisions(r.Factory, client.NewGVR(gvr), path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, rev := range rr {
+		oo = append(oo, rev)
+	}
+
+	return oo, nil
+}
+
+// PreviousDiff returns the manifest differences between the last two observed
+// revisions of a resource.
+func PreviousDiff(f Factory, gvr, path string) (string, error) {
+	t, ok := f.(RevisionTracker)
+	if !ok {
+		return "", errors.New("revisions are not tracked")
+	}
+	prev, ok := t.Previous(gvr, path)
+	if !ok {
+		return "", fmt.Errorf("no prior revision observed for %s", path)
+	}
+	curr, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	return ObjectDiff(prev, curr)
+}
+
+// ObjectDiff returns the manifest differences between two resource revisions.
+func ObjectDiff(from, to runtime.Object) (string, error) {
+	fm, err := meta.Accessor(from)
+	if err != nil {
+		return "", err
+	}
+	tm, err := meta.Accessor(to)
+	if err != nil {
+		return "", err
+	}
+	fromRaw, err := ToYAML(from)
+	if err != nil {
+		return "", err
+	}
+	toRaw, err := ToYAML(to)
+	if err != nil {
+		return "", err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromRaw),
+		B:        difflib.SplitLines(toRaw),
+		FromFile: "resourceVersion " + fm.GetResourceVersion(),
+		ToFile:   "resourceVersion " + tm.GetResourceVersion(),
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("No changes between resource versions %s and %s", fm.GetResourceVersion(), tm.GetResourceVersion()), nil
+	}
+
+	return diff, nil
+}
+
+// WorkloadRevisions returns the pod template revisions of a deployment,
+// statefulset or daemonset, most recent first. Deployments revisions are
+// backed by replicasets while others are backed by controller revisions.
+func WorkloadRevisions(f Factory, gvr client.GVR, path string) ([]render.RevisionRes, error) {
+	if _, ok := imageControllerGVRs[gvr.String()]; !ok {
+		return nil, fmt.Errorf("%s do not track revisions", gvr.R())
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	owner, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	ns, _ := client.Namespaced(path)
+	var rr []render.RevisionRes
+	if gvr.R() == "deployments" {
+		rr, err = replicaSetRevisions(f, ns, owner)
+	} else {
+		rr, err = controllerRevisions(f, ns, owner)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Revision > rr[j].Revision
+	})
+	if len(rr) > 0 {
+		rr[0].Current = true
+	}
+
+	return rr, nil
+}
+
+// RevisionDiff returns the pod template differences between two workload
+// revisions. A zero from revision diffs against the revision prior to the
+// target one.
+func RevisionDiff(f Factory, gvr client.GVR, path string, from, to int64) (string, error) {
+	rr, err := WorkloadRevisions(f, gvr, path)
+	if err != nil {
+		return "", err
+	}
+	idx := revisionIndex(rr, to)
+	if idx < 0 {
+		return "", fmt.Errorf("no revision %d found on %s", to, path)
+	}
+	if from == 0 {
+		if idx == len(rr)-1 {
+			return "", fmt.Errorf("no revision prior to %d", to)
+		}
+		from = rr[idx+1].Revision
+	}
+	fidx := revisionIndex(rr, from)
+	if fidx < 0 {
+		return "", fmt.Errorf("no revision %d found on %s", from, path)
+	}
+
+	return templateDiff(rr[fidx], rr[idx])
+}
+
+// RollbackRevision rolls a workload pod template back to a given revision.
+func RollbackRevision(f Factory, gvr client.GVR, path string, rev int64) (string, error) {
+	if err := ensureWritable(f); err != nil {
+		return "", err
+	}
+
+	ns, _ := client.Namespaced(path)
+	auth, err := f.Client().CanI(ns, gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to rollback %s", path)
+	}
+	m, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return "", err
+	}
+	rb, err := polymorphichelpers.RollbackerFor(schema.GroupKind{Group: gvr.G(), Kind: m.Kind}, f.Client().DialOrDie())
+	if err != nil {
+		return "", err
+	}
+	o, err := Fetch(f, gvr, path)
+	if err != nil {
+		return "", err
+	}
+
+	return rb.Rollback(o, nil, rev, false)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func replicaSetRevisions(f Factory, ns string, owner metav1.Object) ([]render.RevisionRes, error) {
+	oo, err := f.List("apps/v1/replicasets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]render.RevisionRes, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+			return nil, err
+		}
+		if !metav1.IsControlledBy(&rs, owner) {
+			continue
+		}
+		rev, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		tpl := rs.Spec.Template
+		delete(tpl.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		rr = append(rr, render.RevisionRes{
+			Revision:    rev,
+			Name:        rs.Name,
+			ChangeCause: rs.Annotations[changeCauseAnnotation],
+			Created:     rs.CreationTimestamp,
+			Template:    tpl,
+		})
+	}
+
+	return rr, nil
+}
+
+func controllerRevisions(f Factory, ns string, owner metav1.Object) ([]render.RevisionRes, error) {
+	oo, err := f.List("apps/v1/controllerrevisions", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]render.RevisionRes, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var cr appsv1.ControllerRevision
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cr); err != nil {
+			return nil, err
+		}
+		if !metav1.IsControlledBy(&cr, owner) {
+			continue
+		}
+		tpl, err := revisionTemplate(cr.Data.Raw)
+		if err != nil {
+			return nil, err
+		}
+		rr = append(rr, render.RevisionRes{
+			Revision:    cr.Revision,
+			Name:        cr.Name,
+			ChangeCause: cr.Annotations[changeCauseAnnotation],
+			Created:     cr.CreationTimestamp,
+			Template:    tpl,
+		})
+	}
+
+	return rr, nil
+}
+
+// revisionTemplate extracts the pod template from a controller revision
+// strategic merge patch.
+func revisionTemplate(raw []byte) (v1.PodTemplateSpec, error) {
+	var (
+		patch struct {
+			Spec struct {
+				Template map[string]interface{} `json:"template"`
+			} `json:"spec"`
+		}
+		tpl v1.PodTemplateSpec
+	)
+	if err := json.Unmarshal(raw, &patch); err != nil {
+		return tpl, err
+	}
+	delete(patch.Spec.Template, "$patch")
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(patch.Spec.Template, &tpl)
+
+	return tpl, err
+}
+
+func revisionIndex(rr []render.RevisionRes, rev int64) int {
+	for i, r := range rr {
+		if r.Revision == rev {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func templateDiff(from, to render.RevisionRes) (string, error) {
+	fromRaw, err := yaml.Marshal(from.Template)
+	if err != nil {
+		return "", err
+	}
+	toRaw, err := yaml.Marshal(to.Template)
+	if err != nil {
+		return "", err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromRaw)),
+		B:        difflib.SplitLines(string(toRaw)),
+		FromFile: "revision " + strconv.FormatInt(from.Revision, 10),
+		ToFile:   "revision " + strconv.FormatInt(to.Revision, 10),
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("No pod template changes between revisions %d and %d", from.Revision, to.Revision), nil
+	}
+
+	return diff, nil
+}
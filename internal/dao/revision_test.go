@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectDiff(t *testing.T) {
+	uu := map[string]struct {
+		from, to *unstructured.Unstructured
+		e        []string
+	}{
+		"changed": {
+			from: makeDeployment("1", 1),
+			to:   makeDeployment("2", 3),
+			e: []string{
+				"--- resourceVersion 1",
+				"+++ resourceVersion 2",
+				"-  replicas: 1",
+				"+  replicas: 3",
+			},
+		},
+		"same": {
+			from: makeDeployment("1", 1),
+			to:   makeDeployment("1", 1),
+			e:    []string{"No changes between resource versions 1 and 1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			diff, err := ObjectDiff(u.from, u.to)
+			assert.Nil(t, err)
+			for _, l := range u.e {
+				assert.True(t, strings.Contains(diff, l), l)
+			}
+		})
+	}
+}
+
+func TestRevisionTemplate(t *testing.T) {
+	raw := `{"spec":{"template":{"$patch":"replace","metadata":{"labels":{"app":"fred"}},"spec":{"containers":[{"name":"fred","image":"nginx:1.17"}]}}}}`
+
+	tpl, err := revisionTemplate([]byte(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app": "fred"}, tpl.Labels)
+	assert.Equal(t, "nginx:1.17", tpl.Spec.Containers[0].Image)
+
+	_, err = revisionTemplate([]byte("blee"))
+	assert.NotNil(t, err)
+}
+
+func TestTemplateDiff(t *testing.T) {
+	uu := map[string]struct {
+		from, to render.RevisionRes
+		e        []string
+	}{
+		"changed": {
+			from: makeRevision(2, "nginx:1.16"),
+			to:   makeRevision(5, "nginx:1.17"),
+			e: []string{
+				"--- revision 2",
+				"+++ revision 5",
+				"-  - image: nginx:1.16",
+				"+  - image: nginx:1.17",
+			},
+		},
+		"same": {
+			from: makeRevision(2, "nginx:1.17"),
+			to:   makeRevision(5, "nginx:1.17"),
+			e:    []string{"No pod template changes between revisions 2 and 5"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			diff, err := templateDiff(u.from, u.to)
+			assert.Nil(t, err)
+			for _, l := range u.e {
+				assert.True(t, strings.Contains(diff, l), l)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeRevision(rev int64, image string) render.RevisionRes {
+	return render.RevisionRes{
+		Revision: rev,
+		Template: v1.PodTemplateSpec{
+			Spec: v1.PodSpec{Containers: []v1.Container{{Name: "fred", Image: image}}},
+		},
+	}
+}
+
+func makeDeployment(rv string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"namespace":       "default",
+				"name":            "dp1",
+				"resourceVersion": rv,
+			},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+			},
+		},
+	}
+}
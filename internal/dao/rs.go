@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,9 +17,50 @@ import (
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
+var (
+	_ Accessor = (*ReplicaSet)(nil)
+	_ Scalable = (*ReplicaSet)(nil)
+)
+
 // ReplicaSet represents a replicaset K8s resource.
 type ReplicaSet struct {
-	Resource
+	Informed
+}
+
+// Scale a ReplicaSet.
+func (r *ReplicaSet) Scale(path string, replicas int32) error {
+	if err := ensureWritable(r.Factory); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(path)
+	auth, err := r.Client().CanI(ns, "apps/v1/replicasets:scale", []string{client.GetVerb, client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to scale replicasets")
+	}
+
+	scale, err := r.Client().DialOrDie().AppsV1().ReplicaSets(ns).GetScale(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	scale.Spec.Replicas = replicas
+	_, err = r.Client().DialOrDie().AppsV1().ReplicaSets(ns).UpdateScale(n, scale)
+
+	return err
+}
+
+// Replicas returns a ReplicaSet current and desired replicas.
+func (r *ReplicaSet) Replicas(path string) (int32, int32, error) {
+	ns, n := client.Namespaced(path)
+	scale, err := r.Client().DialOrDie().AppsV1().ReplicaSets(ns).GetScale(n, metav1.GetOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scale.Status.Replicas, scale.Spec.Replicas, nil
 }
 
 // Load returns a given instance.
@@ -66,6 +108,10 @@ func controllerInfo(rs *v1.ReplicaSet) (string, string, string, error) {
 
 // Rollback reverses the last deployment.
 func (r *ReplicaSet) Rollback(fqn string) error {
+	if err := ensureWritable(r.Factory); err != nil {
+		return err
+	}
+
 	rs, err := r.Load(r.Factory, fqn)
 	if err != nil {
 		return err
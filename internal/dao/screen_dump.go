@@ -22,7 +22,7 @@ type ScreenDump struct {
 }
 
 // Delete a ScreenDump.
-func (d *ScreenDump) Delete(path string, cascade, force bool) error {
+func (d *ScreenDump) Delete(path string, _ DeleteOptions) error {
 	return os.Remove(path)
 }
 
@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*SecretData)(nil)
+	_ Revealer = (*SecretData)(nil)
+)
+
+// SecretData represents the decoded keys of a secret.
+type SecretData struct {
+	NonResource
+}
+
+// List returns all keys of a given secret.
+func (s *SecretData) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", s.GVR())
+	}
+
+	sec, err := s.load(path)
+	if err != nil {
+		return nil, err
+	}
+	kk := make([]string, 0, len(sec.Data))
+	for k := range sec.Data {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, render.SecretDataRes{Key: k, Size: len(sec.Data[k])})
+	}
+
+	return oo, nil
+}
+
+// Reveal returns the decoded value of a secret key.
+func (s *SecretData) Reveal(path, key string) ([]byte, error) {
+	sec, err := s.load(path)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := sec.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("no key %q found in secret %s", key, path)
+	}
+
+	return v, nil
+}
+
+func (s *SecretData) load(path string) (*v1.Secret, error) {
+	o, err := s.Factory.Get("v1/secrets", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		return nil, err
+	}
+
+	return &sec, nil
+}
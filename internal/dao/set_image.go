@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+)
+
+// imageControllerGVRs tracks the workloads whose pod template images can be set.
+var imageControllerGVRs = map[string]struct{}{
+	"apps/v1/deployments":  {},
+	"apps/v1/statefulsets": {},
+	"apps/v1/daemonsets":   {},
+}
+
+// ContainerImage represents a pod template container image.
+type ContainerImage struct {
+	Name, Image string
+	Init        bool
+}
+
+// TemplateImages returns a workload pod template containers images, init
+// containers last.
+func TemplateImages(f Factory, gvr client.GVR, path string) ([]ContainerImage, error) {
+	u, err := Fetch(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return templateImages(u)
+}
+
+// SetImage patches a workload pod template container image.
+func SetImage(f Factory, ref Ref, co string, init bool, image string) error {
+	if err := ensureWritable(f); err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(ref.FQN)
+	auth, err := f.Client().CanI(ns, ref.GVR, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", ref.FQN)
+	}
+	raw, err := setImagePatch(co, init, image)
+	if err != nil {
+		return err
+	}
+	_, err = dynClientFor(f, client.NewGVR(ref.GVR), ns).Patch(n, types.StrategicMergePatchType, raw, metav1.PatchOptions{})
+
+	return err
+}
+
+// RolloutStatus returns a workload rollout status and whether it is done.
+func RolloutStatus(f Factory, gvr client.GVR, path string) (string, bool, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return "", false, err
+	}
+	viewer, err := polymorphichelpers.StatusViewerFor(schema.GroupKind{Group: gvr.G(), Kind: meta.Kind})
+	if err != nil {
+		return "", false, err
+	}
+	u, err := Fetch(f, gvr, path)
+	if err != nil {
+		return "", false, err
+	}
+
+	return viewer.Status(u, 0)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func templateImages(u *unstructured.Unstructured) ([]ContainerImage, error) {
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no pod template found on %s", u.GetName())
+	}
+	var spec v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+		return nil, err
+	}
+
+	ii := make([]ContainerImage, 0, len(spec.Containers)+len(spec.InitContainers))
+	for _, co := range spec.Containers {
+		ii = append(ii, ContainerImage{Name: co.Name, Image: co.Image})
+	}
+	for _, co := range spec.InitContainers {
+		ii = append(ii, ContainerImage{Name: co.Name, Image: co.Image, Init: true})
+	}
+
+	return ii, nil
+}
+
+func setImagePatch(co string, init bool, image string) ([]byte, error) {
+	field := "containers"
+	if init {
+		field = "initContainers"
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					field: []map[string]string{{"name": co, "image": image}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTemplateImages(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{"name": "init", "image": "busybox:1.31"},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "fred", "image": "nginx:1.17"},
+						map[string]interface{}{"name": "blee", "image": "redis"},
+					},
+				},
+			},
+		},
+	}}
+
+	ii, err := templateImages(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContainerImage{
+		{Name: "fred", Image: "nginx:1.17"},
+		{Name: "blee", Image: "redis"},
+		{Name: "init", Image: "busybox:1.31", Init: true},
+	}, ii)
+
+	_, err = templateImages(&unstructured.Unstructured{Object: map[string]interface{}{}})
+	assert.NotNil(t, err)
+}
+
+func TestSetImagePatch(t *testing.T) {
+	raw, err := setImagePatch("fred", false, "nginx:1.17")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"spec":{"template":{"spec":{"containers":[{"image":"nginx:1.17","name":"fred"}]}}}}`, string(raw))
+
+	raw, err = setImagePatch("init", true, "busybox:1.31")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"spec":{"template":{"spec":{"initContainers":[{"image":"busybox:1.31","name":"init"}]}}}}`, string(raw))
+}
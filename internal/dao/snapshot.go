@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CacheDumper dumps the cached resources.
+type CacheDumper interface {
+	// Cached returns all the cached resources keyed by gvr.
+	Cached() map[string][]runtime.Object
+}
+
+// SnapshotLister lists api resources without a discovery client, ie snapshots.
+type SnapshotLister interface {
+	// ServerPreferredResources returns the api resources.
+	ServerPreferredResources() ([]*metav1.APIResourceList, error)
+}
+
+// NewSnapshot snapshots the cluster resources currently cached.
+func NewSnapshot(f Factory) (*client.Snapshot, error) {
+	d, ok := f.(CacheDumper)
+	if !ok {
+		return nil, errors.New("factory can not dump its cache")
+	}
+
+	cfg := f.Client().Config()
+	s := client.Snapshot{
+		CreatedAt: time.Now(),
+		Resources: snapshotResources(),
+		Objects:   make(map[string][]*unstructured.Unstructured),
+	}
+	var err error
+	if s.Context, err = cfg.CurrentContextName(); err != nil {
+		return nil, err
+	}
+	if s.Cluster, err = cfg.CurrentClusterName(); err != nil {
+		return nil, err
+	}
+	if s.User, err = cfg.CurrentUserName(); err != nil {
+		log.Warn().Err(err).Msg("Snapshot user")
+	}
+	if s.Namespace, err = cfg.CurrentNamespaceName(); err != nil {
+		log.Debug().Err(err).Msg("Snapshot namespace")
+	}
+	if s.Version, err = f.Client().ServerVersion(); err != nil {
+		log.Warn().Err(err).Msg("Snapshot server version")
+	}
+
+	for gvr, oo := range d.Cached() {
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				s.Objects[gvr] = append(s.Objects[gvr], u)
+			}
+		}
+	}
+
+	return &s, nil
+}
+
+// snapshotResources returns the known api resources grouped by group version.
+// K9s own resources are skipped as they get registered on load.
+func snapshotResources() []*metav1.APIResourceList {
+	ll := make(map[string]*metav1.APIResourceList)
+	for _, gvr := range MetaAccess.AllGVRs() {
+		res, err := MetaAccess.MetaFor(gvr)
+		if err != nil || res.Version == "" {
+			continue
+		}
+		gv := gvr.V()
+		if gvr.G() != "" {
+			gv = gvr.G() + "/" + gv
+		}
+		if _, ok := ll[gv]; !ok {
+			ll[gv] = &metav1.APIResourceList{GroupVersion: gv}
+		}
+		ll[gv].APIResources = append(ll[gv].APIResources, res)
+	}
+
+	rr := make([]*metav1.APIResourceList, 0, len(ll))
+	for _, l := range ll {
+		rr = append(rr, l)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].GroupVersion < rr[j].GroupVersion
+	})
+
+	return rr
+}
@@ -26,7 +26,7 @@ var (
 
 // StatefulSet represents a K8s sts.
 type StatefulSet struct {
-	Resource
+	Informed
 }
 
 // IsHappy check for happy sts.
@@ -36,6 +36,10 @@ func (s *StatefulSet) IsHappy(sts appsv1.StatefulSet) bool {
 
 // Scale a StatefulSet.
 func (s *StatefulSet) Scale(path string, replicas int32) error {
+	if err := ensureWritable(s.Factory); err != nil {
+		return err
+	}
+
 	ns, n := client.Namespaced(path)
 	auth, err := s.Client().CanI(ns, "apps/v1/statefulsets:scale", []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -55,8 +59,23 @@ func (s *StatefulSet) Scale(path string, replicas int32) error {
 	return err
 }
 
+// Replicas returns a StatefulSet current and desired replicas.
+func (s *StatefulSet) Replicas(path string) (int32, int32, error) {
+	ns, n := client.Namespaced(path)
+	scale, err := s.Client().DialOrDie().AppsV1().StatefulSets(ns).GetScale(n, metav1.GetOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scale.Status.Replicas, scale.Spec.Replicas, nil
+}
+
 // Restart a StatefulSet rollout.
 func (s *StatefulSet) Restart(path string) error {
+	if err := ensureWritable(s.Factory); err != nil {
+		return err
+	}
+
 	sts, err := s.getStatefulSet(path)
 	if err != nil {
 		return err
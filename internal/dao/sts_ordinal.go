@@ -0,0 +1,340 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	ordinalPoll    = 2 * time.Second
+	ordinalTimeout = 5 * time.Minute
+	missingClaim   = "Missing"
+)
+
+var _ Accessor = (*Ordinal)(nil)
+
+// Ordinal represents a statefulset ordinals.
+type Ordinal struct {
+	NonResource
+}
+
+// List returns a statefulset ordinals along with their rolling restart
+// progress if any.
+func (o *Ordinal) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", o.gvr)
+	}
+
+	rr, err := StatefulSetOrdinals(o.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	restart, _ := ctx.Value(internal.KeyRestart).(*RollingRestart)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		if restart != nil {
+			r.Restart = restart.Status(r.Ordinal)
+		}
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// StatefulSetOrdinals returns a statefulset ordinals along with their pods
+// and volume claims.
+func StatefulSetOrdinals(f Factory, path string) ([]render.OrdinalRes, error) {
+	o, err := f.Get("apps/v1/statefulsets", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var sts appsv1.StatefulSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+		return nil, err
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := f.List("v1/pods", sts.Namespace, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pods := make(map[string]*v1.Pod, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if metav1.IsControlledBy(&po, &sts) {
+			pods[po.Name] = &po
+		}
+	}
+
+	claims := make(map[string]string)
+	if len(sts.Spec.VolumeClaimTemplates) > 0 {
+		oo, err := f.List("v1/persistentvolumeclaims", sts.Namespace, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+			claims[u.GetName()] = phase
+		}
+	}
+
+	return stsOrdinals(&sts, pods, claims), nil
+}
+
+// RestartOrdinal restarts a single statefulset ordinal by deleting its pod
+// and letting the controller recreate it.
+func RestartOrdinal(f Factory, path string, ordinal int) error {
+	ns, n := client.Namespaced(path)
+	var po Pod
+	po.Init(f, client.NewGVR("v1/pods"))
+
+	return po.Delete(client.FQN(ns, ordinalName(n, ordinal)), DefaultDeleteOptions())
+}
+
+// RollingRestart restarts a statefulset ordinals one at a time, highest
+// first, waiting for each pod to be recreated and ready. Ordinals below the
+// rolling update partition are skipped.
+type RollingRestart struct {
+	factory Factory
+	path    string
+	states  map[int]string
+	mx      sync.RWMutex
+}
+
+// NewRollingRestart returns a new rolling restart.
+func NewRollingRestart(f Factory, path string) *RollingRestart {
+	return &RollingRestart{
+		factory: f,
+		path:    path,
+		states:  make(map[int]string),
+	}
+}
+
+// Status returns an ordinal restart progress.
+func (r *RollingRestart) Status(ordinal int) string {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return r.states[ordinal]
+}
+
+// Done checks if no ordinals are awaiting a restart.
+func (r *RollingRestart) Done() bool {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	for _, s := range r.states {
+		if s == render.RestartPending || s == render.RestartRunning {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Run restarts the ordinals and returns the number of restarted pods. It
+// bails out on the first ordinal that fails to come back.
+func (r *RollingRestart) Run(ctx context.Context) (int, error) {
+	if err := ensureWritable(r.factory); err != nil {
+		return 0, err
+	}
+	rr, err := StatefulSetOrdinals(r.factory, r.path)
+	if err != nil {
+		return 0, err
+	}
+
+	plan := r.plan(rr)
+	ns, _ := client.Namespaced(r.path)
+	for i, o := range plan {
+		r.setStatus(o.Ordinal, render.RestartRunning)
+		var uid types.UID
+		if o.Pod != nil {
+			uid = o.Pod.UID
+		}
+		err := RestartOrdinal(r.factory, r.path, o.Ordinal)
+		if err == nil {
+			err = waitOrdinal(ctx, r.factory, client.FQN(ns, o.Name), uid)
+		}
+		if err != nil {
+			r.setStatus(o.Ordinal, render.RestartFailed)
+			return i, fmt.Errorf("ordinal %d restart failed -- %s", o.Ordinal, err)
+		}
+		r.setStatus(o.Ordinal, render.RestartDone)
+	}
+
+	return len(plan), nil
+}
+
+func (r *RollingRestart) plan(rr []render.OrdinalRes) []render.OrdinalRes {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	plan := make([]render.OrdinalRes, 0, len(rr))
+	for _, o := range rr {
+		if o.Partitioned {
+			r.states[o.Ordinal] = render.RestartSkipped
+			continue
+		}
+		r.states[o.Ordinal] = render.RestartPending
+		plan = append(plan, o)
+	}
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].Ordinal > plan[j].Ordinal
+	})
+
+	return plan
+}
+
+func (r *RollingRestart) setStatus(ordinal int, s string) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.states[ordinal] = s
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func stsOrdinals(sts *appsv1.StatefulSet, pods map[string]*v1.Pod, claims map[string]string) []render.OrdinalRes {
+	count := 1
+	if sts.Spec.Replicas != nil {
+		count = int(*sts.Spec.Replicas)
+	}
+	// Pods past the desired replicas linger while scaling down.
+	for n := range pods {
+		if o, ok := ordinalOf(sts.Name, n); ok && o >= count {
+			count = o + 1
+		}
+	}
+
+	partition := stsPartition(sts)
+	rr := make([]render.OrdinalRes, 0, count)
+	for i := 0; i < count; i++ {
+		n := ordinalName(sts.Name, i)
+		po := pods[n]
+		pvcs := make([]render.OrdinalPVC, 0, len(sts.Spec.VolumeClaimTemplates))
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			claim := t.Name + "-" + n
+			status, ok := claims[claim]
+			if !ok {
+				status = missingClaim
+			}
+			pvcs = append(pvcs, render.OrdinalPVC{Name: claim, Status: status})
+		}
+		rr = append(rr, render.OrdinalRes{
+			Ordinal:     i,
+			Namespace:   sts.Namespace,
+			Name:        n,
+			Pod:         po,
+			Updated:     po != nil && po.Labels[appsv1.ControllerRevisionHashLabelKey] == sts.Status.UpdateRevision,
+			Partitioned: i < partition,
+			PVCs:        pvcs,
+		})
+	}
+
+	return rr
+}
+
+// stsPartition returns the ordinal below which rolling updates do not apply.
+func stsPartition(sts *appsv1.StatefulSet) int {
+	s := sts.Spec.UpdateStrategy
+	if s.Type != "" && s.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		return 0
+	}
+	if s.RollingUpdate == nil || s.RollingUpdate.Partition == nil {
+		return 0
+	}
+
+	return int(*s.RollingUpdate.Partition)
+}
+
+func ordinalName(sts string, ordinal int) string {
+	return sts + "-" + strconv.Itoa(ordinal)
+}
+
+func ordinalOf(sts, pod string) (int, bool) {
+	if !strings.HasPrefix(pod, sts+"-") {
+		return 0, false
+	}
+	o, err := strconv.Atoi(strings.TrimPrefix(pod, sts+"-"))
+	if err != nil || o < 0 {
+		return 0, false
+	}
+
+	return o, true
+}
+
+// waitOrdinal waits for an ordinal pod to be recreated and ready.
+func waitOrdinal(ctx context.Context, f Factory, path string, uid types.UID) error {
+	ctx, cancel := context.WithTimeout(ctx, ordinalTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("pod %s not ready after %v", path, ordinalTimeout)
+		case <-time.After(ordinalPoll):
+		}
+
+		u, err := Fetch(f, client.NewGVR("v1/pods"), path)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return err
+		}
+		if po.UID != uid && po.DeletionTimestamp == nil && isPodReady(&po) {
+			return nil
+		}
+	}
+}
+
+func isPodReady(po *v1.Pod) bool {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
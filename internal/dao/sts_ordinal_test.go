@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStsOrdinals(t *testing.T) {
+	sts := makeSts(3, 1)
+	pods := map[string]*v1.Pod{
+		"fred-0": makeOrdinalPod("fred-0", "rev1"),
+		"fred-1": makeOrdinalPod("fred-1", "rev2"),
+		"fred-3": makeOrdinalPod("fred-3", "rev2"),
+	}
+	claims := map[string]string{"data-fred-0": "Bound", "data-fred-1": "Pending"}
+
+	rr := stsOrdinals(sts, pods, claims)
+	assert.Equal(t, 4, len(rr))
+
+	assert.Equal(t, "fred-0", rr[0].Name)
+	assert.True(t, rr[0].Partitioned)
+	assert.False(t, rr[0].Updated)
+	assert.Equal(t, []render.OrdinalPVC{{Name: "data-fred-0", Status: "Bound"}}, rr[0].PVCs)
+
+	assert.False(t, rr[1].Partitioned)
+	assert.True(t, rr[1].Updated)
+	assert.Equal(t, []render.OrdinalPVC{{Name: "data-fred-1", Status: "Pending"}}, rr[1].PVCs)
+
+	assert.Nil(t, rr[2].Pod)
+	assert.False(t, rr[2].Updated)
+	assert.Equal(t, []render.OrdinalPVC{{Name: "data-fred-2", Status: missingClaim}}, rr[2].PVCs)
+
+	assert.Equal(t, 3, rr[3].Ordinal)
+	assert.Equal(t, "default", rr[3].Namespace)
+}
+
+func TestStsPartition(t *testing.T) {
+	assert.Equal(t, 2, stsPartition(makeSts(3, 2)))
+
+	sts := makeSts(3, 2)
+	sts.Spec.UpdateStrategy.Type = appsv1.OnDeleteStatefulSetStrategyType
+	assert.Equal(t, 0, stsPartition(sts))
+
+	sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{}
+	assert.Equal(t, 0, stsPartition(sts))
+}
+
+func TestOrdinalOf(t *testing.T) {
+	uu := map[string]struct {
+		pod string
+		e   int
+		ok  bool
+	}{
+		"ordinal":  {pod: "fred-12", e: 12, ok: true},
+		"other":    {pod: "blee-1"},
+		"prefixed": {pod: "fred-blee-1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, ok := ordinalOf("fred", u.pod)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, o)
+		})
+	}
+}
+
+func TestRollingRestartPlan(t *testing.T) {
+	r := NewRollingRestart(nil, "default/fred")
+	plan := r.plan(stsOrdinals(makeSts(4, 2), nil, nil))
+
+	assert.Equal(t, 2, len(plan))
+	assert.Equal(t, 3, plan[0].Ordinal)
+	assert.Equal(t, 2, plan[1].Ordinal)
+	assert.Equal(t, render.RestartSkipped, r.Status(0))
+	assert.Equal(t, render.RestartPending, r.Status(3))
+	assert.False(t, r.Done())
+
+	r.setStatus(3, render.RestartDone)
+	r.setStatus(2, render.RestartFailed)
+	assert.True(t, r.Done())
+}
+
+// Helpers...
+
+func makeSts(replicas, partition int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred", UID: "fred"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{UpdateRevision: "rev2"},
+	}
+}
+
+func makeOrdinalPod(n, rev string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      n,
+			Labels:    map[string]string{appsv1.ControllerRevisionHashLabelKey: rev},
+		},
+	}
+}
@@ -20,7 +20,7 @@ var (
 
 // Service represents a k8s service.
 type Service struct {
-	Resource
+	Informed
 }
 
 // TailLogs tail logs for all pods represented by this Service.
@@ -35,7 +35,7 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 		Namespace(ns).
 		Name(n).
 		Resource(t.gvr.R()).
-		VersionedParams(&metav1beta1.TableOptions{}, codec).
+		VersionedParams(&metav1beta1.TableOptions{IncludeObject: metav1beta1.IncludeObject}, codec).
 		Do().Get()
 	if err != nil {
 		return nil, err
@@ -63,14 +63,33 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		Namespace(ns).
 		Resource(t.gvr.R()).
 		VersionedParams(&metav1.ListOptions{LabelSelector: labelSel}, codec).
+		Param("includeObject", string(metav1beta1.IncludeObject)).
 		Do().Get()
 	if err != nil {
-		return nil, err
+		spec, ok := MetaAccess.CRDSpecFor(t.gvr)
+		if !ok {
+			return nil, err
+		}
+		log.Warn().Err(err).Msgf("Server side table failed for %q. Using CRD printer columns", t.gvr)
+		return t.crdList(ctx, ns, spec)
 	}
 
 	return []runtime.Object{o}, nil
 }
 
+func (t *Table) crdList(ctx context.Context, ns string, spec CRDSpec) ([]runtime.Object, error) {
+	oo, err := t.Generic.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	table, err := CRDTable(oo, spec.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return []runtime.Object{table}, nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
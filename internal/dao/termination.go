@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Termination)(nil)
+
+// Termination represents a pod containers terminations forensics.
+type Termination struct {
+	NonResource
+}
+
+// List returns a pod containers last terminations and restart trends.
+func (t *Termination) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", t.gvr)
+	}
+
+	var c Container
+	c.Init(t.Factory, client.NewGVR("containers"))
+	po, err := c.fetchPod(fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	oo := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	for i, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, co := range cc {
+			res := render.TerminationRes{Container: co.Name, IsInit: i == 0}
+			if mem := co.Resources.Limits.Memory(); !mem.IsZero() {
+				res.MemLimit = mem.String()
+			}
+			if cs := getContainerStatus(co.Name, po.Status); cs != nil {
+				res.Restarts, res.Terminated = cs.RestartCount, cs.LastTerminationState.Terminated
+			}
+			res.Trend = restarts.add(client.FQN(fqn, co.Name), now, res.Restarts)
+			if res.Events, err = c.events(po, co.Name); err != nil {
+				return nil, err
+			}
+			oo = append(oo, res)
+		}
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// restarts tracks containers restart counts sampled on each refresh.
+var restarts = newRestartHistory()
+
+type restartSample struct {
+	at    time.Time
+	count int32
+}
+
+type restartHistory struct {
+	samples map[string][]restartSample
+	mx      sync.Mutex
+}
+
+func newRestartHistory() *restartHistory {
+	return &restartHistory{samples: make(map[string][]restartSample)}
+}
+
+// add records a container restart count and returns its counts within the
+// metrics window, oldest first.
+func (h *restartHistory) add(key string, at time.Time, count int32) []int64 {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss := append(h.samples[key], restartSample{at: at, count: count})
+	cutoff := at.Add(-client.MetricsWindow)
+	var i int
+	for i < len(ss) && ss[i].at.Before(cutoff) {
+		i++
+	}
+	ss = ss[i:]
+	if len(ss) > maxUsageSamples {
+		ss = ss[len(ss)-maxUsageSamples:]
+	}
+	h.samples[key] = ss
+
+	vv := make([]int64, len(ss))
+	for i, s := range ss {
+		vv[i] = int64(s.count)
+	}
+
+	return vv
+}
@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartHistory(t *testing.T) {
+	h, now := newRestartHistory(), time.Now()
+
+	assert.Equal(t, []int64{1}, h.add("default/p1:c1", now.Add(-2*client.MetricsWindow), 1))
+	assert.Equal(t, []int64{2}, h.add("default/p1:c1", now.Add(-time.Minute), 2))
+	assert.Equal(t, []int64{2, 5}, h.add("default/p1:c1", now, 5))
+	assert.Equal(t, []int64{0}, h.add("default/p1:c2", now, 0))
+}
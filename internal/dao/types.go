@@ -5,8 +5,10 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/watch"
+	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
@@ -16,6 +18,9 @@ import (
 // ResourceMetas represents a collection of resource metadata.
 type ResourceMetas map[client.GVR]metav1.APIResource
 
+// CRDSpecs represents a collection of custom resources specs.
+type CRDSpecs map[client.GVR]CRDSpec
+
 // Accessors represents a collection of dao accessors.
 type Accessors map[client.GVR]Accessor
 
@@ -46,6 +51,33 @@ type Factory interface {
 	Forwarders() watch.Forwarders
 }
 
+// SelectorLister represents a factory listing resources filtered server side.
+type SelectorLister interface {
+	// ListSelected fetch resources matching the given selectors.
+	ListSelected(gvr, ns string, wait bool, sel watch.Selector) ([]runtime.Object, error)
+}
+
+// ResourceWatcher represents a factory notifying resources changes to removable listeners.
+type ResourceWatcher interface {
+	// AddListener registers a resource changes listener.
+	AddListener(gvr string, l watch.ResourceListener)
+
+	// RemoveListener unregisters a resource changes listener.
+	RemoveListener(gvr string, l watch.ResourceListener)
+}
+
+// Watchable represents a resource whose rows can be maintained from watch events.
+type Watchable interface {
+	// Watched returns the resource to render from a watch event and whether it belongs to the listing.
+	Watched(ctx context.Context, ns string, o runtime.Object) (runtime.Object, bool)
+}
+
+// Pageable represents a resource that can be listed a page at a time.
+type Pageable interface {
+	// ListPage returns a page of resources and the token to fetch the next one if any.
+	ListPage(ctx context.Context, ns, cont string, limit int64) ([]runtime.Object, string, error)
+}
+
 // Getter represents a resource getter.
 type Getter interface {
 	// Get return a given resource.
@@ -89,6 +121,9 @@ type Describer interface {
 type Scalable interface {
 	// Scale scales a resource up or down.
 	Scale(path string, replicas int32) error
+
+	// Replicas returns a resource current and desired replicas count.
+	Replicas(path string) (current, desired int32, err error)
 }
 
 // Controller represents a pod controller.
@@ -100,7 +135,7 @@ type Controller interface {
 // Nuker represents a resource deleter.
 type Nuker interface {
 	// Delete removes a resource from the api server.
-	Delete(path string, cascade, force bool) error
+	Delete(path string, opts DeleteOptions) error
 }
 
 // Switchable represents a switchable resource.
@@ -109,12 +144,94 @@ type Switchable interface {
 	Switch(ctx string) error
 }
 
+// Suspendable represents a resource that can be suspended.
+type Suspendable interface {
+	// ToggleSuspend suspends or resumes a resource and returns its new suspended state.
+	ToggleSuspend(path string) (bool, error)
+}
+
 // Restartable represents a restartable resource.
 type Restartable interface {
 	// Restart performs a rollout restart.
 	Restart(path string) error
 }
 
+// Labeler represents a resource which labels and annotations can be updated.
+type Labeler interface {
+	// PatchMeta merges labels and annotations updates into a resource. When dryRun is set
+	// the patch is validated server side without being persisted.
+	PatchMeta(path string, p MetaPatch, dryRun bool) (*unstructured.Unstructured, error)
+}
+
+// Evictable represents a resource that can be evicted.
+type Evictable interface {
+	// Evict gracefully evicts a resource, honoring disruption budgets.
+	Evict(path string) error
+}
+
+// Drainable represents a resource that can be cordoned and drained.
+type Drainable interface {
+	// ToggleCordon marks a resource as schedulable or not.
+	ToggleCordon(path string, cordon bool) error
+
+	// Drain cordons a resource and evicts all its pods.
+	Drain(ctx context.Context, path string, opts DrainOptions, progress DrainProgressFunc) error
+}
+
+// Revealer represents a resource holding sensitive values.
+type Revealer interface {
+	// Reveal returns the clear value for a given key.
+	Reveal(path, key string) ([]byte, error)
+}
+
+// Rollbacker represents a resource that can be rolled back to a previous revision.
+type Rollbacker interface {
+	// History returns all known revisions for a resource.
+	History(path string) ([]*release.Release, error)
+
+	// Rollback rolls a resource back to a given revision.
+	Rollback(path string, rev int) error
+}
+
+// Valuer represents a resource with configurable values.
+type Valuer interface {
+	// GetValues returns the resource values. When all is set, computed values are included.
+	GetValues(path string, all bool) ([]byte, error)
+
+	// SetValues updates the resource with new user supplied values.
+	SetValues(path string, values []byte) error
+}
+
+// Installer represents a resource that can be installed.
+type Installer interface {
+	// Install installs a resource as a named release using an optional values file.
+	Install(path, ns, name, valuesFile string) error
+}
+
+// Uninstaller represents a resource that can be uninstalled.
+type Uninstaller interface {
+	// Uninstall uninstalls a resource, optionally retaining its history.
+	Uninstall(path string, keepHistory, dryRun bool) error
+}
+
+// Manifester represents a resource with rendered manifests.
+type Manifester interface {
+	// Manifest returns the resource rendered manifests.
+	Manifest(path string) (string, error)
+}
+
+// Differ represents a resource with comparable revisions.
+type Differ interface {
+	// Diff returns the differences between two revisions of a resource.
+	Diff(path string, rev1, rev2 int) (string, error)
+}
+
+// RevisionTracker represents a cache tracking previously observed revisions.
+type RevisionTracker interface {
+	// Previous returns the revision observed prior to the current one.
+	Previous(gvr, path string) (runtime.Object, bool)
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+// maxUsageSamples tracks the most samples graphed at once.
+const maxUsageSamples = 120
+
+// UsageSeries represents a resource usage series.
+type UsageSeries struct {
+	Unit  string `json:"unit"`
+	Last  int64  `json:"last"`
+	Min   int64  `json:"min"`
+	Max   int64  `json:"max"`
+	Avg   int64  `json:"avg"`
+	Graph string `json:"graph"`
+}
+
+// UsageHistory represents a node or pod resource usage history.
+type UsageHistory struct {
+	Resource string      `json:"resource"`
+	Window   string      `json:"window"`
+	Samples  int         `json:"samples"`
+	Since    string      `json:"since,omitempty"`
+	CPU      UsageSeries `json:"cpu"`
+	MEM      UsageSeries `json:"memory"`
+	// Prometheus tracks PromQL backed series when a datasource is configured.
+	Prometheus map[string]PromUsage `json:"prometheus,omitempty"`
+}
+
+// PromUsage represents a PromQL backed usage series.
+type PromUsage struct {
+	Last  float64 `json:"last"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Graph string  `json:"graph"`
+}
+
+// Usage returns a node or pod resource usage history.
+// PromQL series are added when a Prometheus datasource is given.
+func Usage(c client.Connection, gvr, fqn string, prom *client.Prometheus, qq []client.PromQuery) (string, error) {
+	mx := client.DialMetrics(c)
+	var err error
+	switch gvr {
+	case "v1/nodes":
+		_, err = mx.FetchNodesMetrics()
+	case "v1/pods":
+		ns, _ := client.Namespaced(fqn)
+		_, err = mx.FetchPodsMetrics(ns)
+	default:
+		return "", fmt.Errorf("no usage history for %s", gvr)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Usage metrics fetch failed for %s", fqn)
+	}
+
+	ss := mx.History.Samples(client.HistoryKey(gvr, fqn))
+	if len(ss) > maxUsageSamples {
+		ss = ss[len(ss)-maxUsageSamples:]
+	}
+	h := UsageHistory{
+		Resource: fqn,
+		Window:   mx.History.Window().String(),
+		Samples:  len(ss),
+		CPU:      UsageSeries{Unit: "millicores", Graph: render.NAValue},
+		MEM:      UsageSeries{Unit: "MB", Graph: render.NAValue},
+	}
+	if len(ss) > 0 {
+		h.Since = ss[0].Timestamp.Format("15:04:05")
+		cpu, mem := make([]int64, len(ss)), make([]int64, len(ss))
+		for i, s := range ss {
+			cpu[i], mem[i] = s.CPU, s.MEM
+		}
+		h.CPU, h.MEM = usageSeries("millicores", cpu), usageSeries("MB", mem)
+	}
+
+	if prom != nil && len(qq) > 0 {
+		h.Prometheus = promUsage(prom, qq, fqn, mx.History.Window())
+	}
+
+	raw, err := yaml.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func promUsage(prom *client.Prometheus, qq []client.PromQuery, fqn string, window time.Duration) map[string]PromUsage {
+	ns, _ := client.Namespaced(fqn)
+	uu := make(map[string]PromUsage, len(qq))
+	for _, q := range qq {
+		vv, err := prom.Series(q, ns, window)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Prometheus series failed for %s", q.Name)
+			continue
+		}
+		if ss, ok := vv[fqn]; ok && len(ss) > 0 {
+			uu[q.Name] = promSeries(ss)
+		}
+	}
+
+	return uu
+}
+
+func promSeries(vv []float64) PromUsage {
+	u := PromUsage{Last: vv[len(vv)-1], Min: vv[0], Max: vv[0]}
+	scaled := make([]int64, len(vv))
+	for i, v := range vv {
+		if v < u.Min {
+			u.Min = v
+		}
+		if v > u.Max {
+			u.Max = v
+		}
+		scaled[i] = int64(math.Round(v * 1000))
+	}
+	u.Graph = render.Sparkline(scaled)
+
+	return u
+}
+
+func usageSeries(unit string, vv []int64) UsageSeries {
+	s := UsageSeries{
+		Unit:  unit,
+		Last:  vv[len(vv)-1],
+		Min:   vv[0],
+		Max:   vv[0],
+		Graph: render.Sparkline(vv),
+	}
+	var sum int64
+	for _, v := range vv {
+		if v < s.Min {
+			s.Min = v
+		}
+		if v > s.Max {
+			s.Max = v
+		}
+		sum += v
+	}
+	s.Avg = sum / int64(len(vv))
+
+	return s
+}
@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageSeries(t *testing.T) {
+	s := usageSeries("MB", []int64{10, 30, 20})
+
+	assert.Equal(t, UsageSeries{Unit: "MB", Last: 20, Min: 10, Max: 30, Avg: 20, Graph: "▁█▄"}, s)
+}
+
+func TestPromSeries(t *testing.T) {
+	u := promSeries([]float64{0.5, 0.25, 1.5})
+
+	assert.Equal(t, PromUsage{Last: 1.5, Min: 0.25, Max: 1.5, Graph: "▂▁█"}, u)
+}
@@ -0,0 +1,319 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*Vuln)(nil)
+
+// vulnSeverities lists the vulnerabilities severities, most severe first.
+var vulnSeverities = []string{
+	render.VulnCritical,
+	render.VulnHigh,
+	render.VulnMedium,
+	render.VulnLow,
+	render.VulnNegligible,
+	render.VulnUnknown,
+}
+
+// ScanParser parses a scanner report for a given image.
+type ScanParser func(image string, raw []byte) ([]render.VulnRes, error)
+
+var scanParsers = map[string]ScanParser{
+	config.ImageScannerTrivy: parseTrivy,
+	config.ImageScannerGrype: parseGrype,
+}
+
+// ImageScanner scans an image for known vulnerabilities.
+type ImageScanner interface {
+	// Scan returns an image vulnerabilities.
+	Scan(ctx context.Context, image string) ([]render.VulnRes, error)
+}
+
+// Vuln represents an images vulnerabilities scan report.
+type Vuln struct {
+	NonResource
+}
+
+// List returns the scanned vulnerabilities sorted by severity.
+func (v *Vuln) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	scan, ok := ctx.Value(internal.KeyImageScan).(*ImageScan)
+	if !ok {
+		return nil, fmt.Errorf("expecting *ImageScan but got %T", ctx.Value(internal.KeyImageScan))
+	}
+
+	oo := make([]runtime.Object, 0, len(scan.vulns))
+	for _, res := range scan.vulns {
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// CommandScanner scans images using an external command such as trivy or grype.
+type CommandScanner struct {
+	cfg   *config.ImageScanner
+	parse ScanParser
+}
+
+// NewCommandScanner returns a new scanner given its configuration.
+func NewCommandScanner(cfg *config.ImageScanner) (*CommandScanner, error) {
+	parse, ok := scanParsers[cfg.Format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported image scanner format %q", cfg.Format)
+	}
+
+	return &CommandScanner{cfg: cfg, parse: parse}, nil
+}
+
+// Scan runs the scanner command on an image and parses its report.
+func (s *CommandScanner) Scan(ctx context.Context, image string) ([]render.VulnRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.GetTimeout())
+	defer cancel()
+
+	args := make([]string, 0, len(s.cfg.Args))
+	for _, a := range s.cfg.Args {
+		args = append(args, strings.Replace(a, config.ImageScannerVar, image, -1))
+	}
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.cfg.Command, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("scanning %s failed: %v %s", image, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return s.parse(image, out)
+}
+
+// ImageScan tracks the vulnerabilities found in a set of images.
+type ImageScan struct {
+	Images []string
+	vulns  []render.VulnRes
+}
+
+// ScanImages scans images and ranks their vulnerabilities by severity.
+func ScanImages(ctx context.Context, s ImageScanner, images []string) (*ImageScan, error) {
+	scan := ImageScan{Images: images}
+	for _, img := range images {
+		vv, err := s.Scan(ctx, img)
+		if err != nil {
+			return nil, err
+		}
+		scan.vulns = append(scan.vulns, vv...)
+	}
+	rankVulns(scan.vulns)
+
+	return &scan, nil
+}
+
+// Count returns the number of vulnerabilities found.
+func (s *ImageScan) Count() int {
+	return len(s.vulns)
+}
+
+// Details returns a vulnerability details as yaml.
+func (s *ImageScan) Details(id string) (string, error) {
+	for _, v := range s.vulns {
+		if v.ID() != id {
+			continue
+		}
+		raw, err := yaml.Marshal(vulnDetail{
+			Image:     v.Image,
+			Target:    v.Target,
+			ID:        v.VulnID,
+			Severity:  v.Severity,
+			Package:   v.Package,
+			Installed: v.Installed,
+			Fixed:     v.Fixed,
+			Title:     v.Title,
+			URL:       v.URL,
+		})
+		if err != nil {
+			return "", err
+		}
+		return string(raw), nil
+	}
+
+	return "", fmt.Errorf("no vulnerability %q found", id)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type vulnDetail struct {
+	Image     string `json:"image"`
+	Target    string `json:"target,omitempty"`
+	ID        string `json:"id"`
+	Severity  string `json:"severity"`
+	Package   string `json:"package"`
+	Installed string `json:"installed,omitempty"`
+	Fixed     string `json:"fixed,omitempty"`
+	Title     string `json:"title,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// rankVulns sorts vulnerabilities by severity and records their rank.
+func rankVulns(vv []render.VulnRes) {
+	sort.SliceStable(vv, func(i, j int) bool {
+		si, sj := severityRank(vv[i].Severity), severityRank(vv[j].Severity)
+		if si != sj {
+			return si < sj
+		}
+		if vv[i].VulnID != vv[j].VulnID {
+			return vv[i].VulnID < vv[j].VulnID
+		}
+		return vv[i].ID() < vv[j].ID()
+	})
+	for i := range vv {
+		vv[i].Rank = i + 1
+	}
+}
+
+func severityRank(s string) int {
+	for i, sev := range vulnSeverities {
+		if s == sev {
+			return i
+		}
+	}
+
+	return len(vulnSeverities)
+}
+
+// normalizeSeverity maps scanners severities to upper case known severities.
+func normalizeSeverity(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if severityRank(s) == len(vulnSeverities) {
+		return render.VulnUnknown
+	}
+
+	return s
+}
+
+type trivyResult struct {
+	Target          string `json:"Target"`
+	Vulnerabilities []struct {
+		VulnerabilityID  string   `json:"VulnerabilityID"`
+		PkgName          string   `json:"PkgName"`
+		InstalledVersion string   `json:"InstalledVersion"`
+		FixedVersion     string   `json:"FixedVersion"`
+		Severity         string   `json:"Severity"`
+		Title            string   `json:"Title"`
+		PrimaryURL       string   `json:"PrimaryURL"`
+		References       []string `json:"References"`
+	} `json:"Vulnerabilities"`
+}
+
+// parseTrivy parses a trivy json report. Older trivy releases report the
+// results array at the top level.
+func parseTrivy(image string, raw []byte) ([]render.VulnRes, error) {
+	var rr []trivyResult
+	if raw = bytes.TrimSpace(raw); bytes.HasPrefix(raw, []byte("[")) {
+		if err := json.Unmarshal(raw, &rr); err != nil {
+			return nil, fmt.Errorf("invalid trivy report for %s: %v", image, err)
+		}
+	} else {
+		var report struct {
+			Results []trivyResult `json:"Results"`
+		}
+		if err := json.Unmarshal(raw, &report); err != nil {
+			return nil, fmt.Errorf("invalid trivy report for %s: %v", image, err)
+		}
+		rr = report.Results
+	}
+
+	var vv []render.VulnRes
+	for _, r := range rr {
+		for _, v := range r.Vulnerabilities {
+			url := v.PrimaryURL
+			if url == "" && len(v.References) > 0 {
+				url = v.References[0]
+			}
+			vv = append(vv, render.VulnRes{
+				Image:     image,
+				VulnID:    v.VulnerabilityID,
+				Severity:  normalizeSeverity(v.Severity),
+				Package:   v.PkgName,
+				Installed: v.InstalledVersion,
+				Fixed:     v.FixedVersion,
+				Title:     v.Title,
+				Target:    r.Target,
+				URL:       url,
+			})
+		}
+	}
+
+	return vv, nil
+}
+
+// parseGrype parses a grype json report.
+func parseGrype(image string, raw []byte) ([]render.VulnRes, error) {
+	var report struct {
+		Matches []struct {
+			Vulnerability struct {
+				ID          string   `json:"id"`
+				Severity    string   `json:"severity"`
+				Description string   `json:"description"`
+				DataSource  string   `json:"dataSource"`
+				URLs        []string `json:"urls"`
+				Fix         struct {
+					Versions []string `json:"versions"`
+				} `json:"fix"`
+				FixedInVersion string `json:"fixedInVersion"`
+			} `json:"vulnerability"`
+			Artifact struct {
+				Name      string `json:"name"`
+				Version   string `json:"version"`
+				Type      string `json:"type"`
+				Locations []struct {
+					Path string `json:"path"`
+				} `json:"locations"`
+			} `json:"artifact"`
+		} `json:"matches"`
+	}
+	if err := json.Unmarshal(raw, &report); err != nil {
+		return nil, fmt.Errorf("invalid grype report for %s: %v", image, err)
+	}
+
+	vv := make([]render.VulnRes, 0, len(report.Matches))
+	for _, m := range report.Matches {
+		v, a := m.Vulnerability, m.Artifact
+		fixed := strings.Join(v.Fix.Versions, ",")
+		if fixed == "" {
+			fixed = v.FixedInVersion
+		}
+		url := v.DataSource
+		if url == "" && len(v.URLs) > 0 {
+			url = v.URLs[0]
+		}
+		target := a.Type
+		if len(a.Locations) > 0 {
+			target = a.Locations[0].Path
+		}
+		vv = append(vv, render.VulnRes{
+			Image:     image,
+			VulnID:    v.ID,
+			Severity:  normalizeSeverity(v.Severity),
+			Package:   a.Name,
+			Installed: a.Version,
+			Fixed:     fixed,
+			Title:     v.Description,
+			Target:    target,
+			URL:       url,
+		})
+	}
+
+	return vv, nil
+}
@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrivy(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   []render.VulnRes
+	}{
+		"report": {
+			raw: `{"SchemaVersion": 2, "Results": [{"Target": "nginx:1.17 (debian 10.3)", "Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2020-1", "PkgName": "openssl", "InstalledVersion": "1.1.1d", "FixedVersion": "1.1.1g",
+				 "Severity": "HIGH", "Title": "blee", "PrimaryURL": "https://avd.aquasec.com/nvd/cve-2020-1"}]},
+				{"Target": "app/go.sum"}]}`,
+			e: []render.VulnRes{
+				{Image: "nginx:1.17", VulnID: "CVE-2020-1", Severity: "HIGH", Package: "openssl", Installed: "1.1.1d", Fixed: "1.1.1g", Title: "blee", Target: "nginx:1.17 (debian 10.3)", URL: "https://avd.aquasec.com/nvd/cve-2020-1"},
+			},
+		},
+		"legacy": {
+			raw: `[{"Target": "nginx:1.17", "Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2020-2", "PkgName": "zlib", "InstalledVersion": "1.2", "Severity": "bozo", "References": ["https://fred.io"]}]}]`,
+			e: []render.VulnRes{
+				{Image: "nginx:1.17", VulnID: "CVE-2020-2", Severity: "UNKNOWN", Package: "zlib", Installed: "1.2", Target: "nginx:1.17", URL: "https://fred.io"},
+			},
+		},
+		"clean": {
+			raw: `{"Results": [{"Target": "nginx:1.17"}]}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv, err := parseTrivy("nginx:1.17", []byte(u.raw))
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, vv)
+		})
+	}
+
+	_, err := parseTrivy("nginx:1.17", []byte("FATAL blee"))
+	assert.NotNil(t, err)
+}
+
+func TestParseGrype(t *testing.T) {
+	raw := `{"matches": [
+		{"vulnerability": {"id": "CVE-2020-1", "severity": "Critical", "dataSource": "https://nvd.nist.gov/cve-2020-1", "fix": {"versions": ["1.1.1g"]}},
+		 "artifact": {"name": "openssl", "version": "1.1.1d", "type": "deb", "locations": [{"path": "/var/lib/dpkg/status"}]}},
+		{"vulnerability": {"id": "GHSA-1", "severity": "Negligible", "fixedInVersion": "0.3", "urls": ["https://github.com/advisories/GHSA-1"]},
+		 "artifact": {"name": "x/text", "version": "0.1", "type": "go-module"}}
+	]}`
+
+	vv, err := parseGrype("fred:1.0", []byte(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, []render.VulnRes{
+		{Image: "fred:1.0", VulnID: "CVE-2020-1", Severity: "CRITICAL", Package: "openssl", Installed: "1.1.1d", Fixed: "1.1.1g", Target: "/var/lib/dpkg/status", URL: "https://nvd.nist.gov/cve-2020-1"},
+		{Image: "fred:1.0", VulnID: "GHSA-1", Severity: "NEGLIGIBLE", Package: "x/text", Installed: "0.1", Fixed: "0.3", Target: "go-module", URL: "https://github.com/advisories/GHSA-1"},
+	}, vv)
+}
+
+func TestScanImages(t *testing.T) {
+	s := fakeScanner{
+		"fred": {
+			{Image: "fred", VulnID: "CVE-3", Severity: render.VulnLow, Package: "a"},
+			{Image: "fred", VulnID: "CVE-2", Severity: render.VulnCritical, Package: "b"},
+		},
+		"blee": {
+			{Image: "blee", VulnID: "CVE-1", Severity: render.VulnUnknown, Package: "c"},
+			{Image: "blee", VulnID: "CVE-4", Severity: render.VulnCritical, Package: "d"},
+		},
+	}
+
+	scan, err := ScanImages(context.Background(), s, []string{"fred", "blee"})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, scan.Count())
+	ee := []struct {
+		rank int
+		id   string
+	}{{1, "CVE-2"}, {2, "CVE-4"}, {3, "CVE-3"}, {4, "CVE-1"}}
+	for i, e := range ee {
+		assert.Equal(t, e.rank, scan.vulns[i].Rank)
+		assert.Equal(t, e.id, scan.vulns[i].VulnID)
+	}
+
+	raw, err := scan.Details("blee||d|CVE-4")
+	assert.Nil(t, err)
+	assert.Equal(t, "id: CVE-4\nimage: blee\npackage: d\nseverity: CRITICAL\n", raw)
+	_, err = scan.Details("blee||d|CVE-5")
+	assert.NotNil(t, err)
+
+	_, err = ScanImages(context.Background(), s, []string{"fred", "zorg"})
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+type fakeScanner map[string][]render.VulnRes
+
+func (f fakeScanner) Scan(_ context.Context, img string) ([]render.VulnRes, error) {
+	vv, ok := f[img]
+	if !ok {
+		return nil, errors.New("no such image")
+	}
+
+	return vv, nil
+}
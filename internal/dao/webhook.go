@@ -0,0 +1,202 @@
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ Accessor = (*Webhook)(nil)
+
+const defaultWebhookPort = 443
+
+// Webhook represents admission webhooks health.
+type Webhook struct {
+	NonResource
+}
+
+// List returns the webhooks health of a given configuration or of all
+// mutating and validating configurations when none is given.
+func (w *Webhook) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	var oo []runtime.Object
+	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
+		gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+		if !ok {
+			return nil, fmt.Errorf("no target resource for %q", w.gvr)
+		}
+		o, err := Fetch(w.Factory, client.NewGVR(gvr), path)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range WebhooksHealth(w.Factory, gvr, o) {
+			oo = append(oo, h)
+		}
+		return oo, nil
+	}
+
+	for _, c := range webhookConfigs(w.Factory) {
+		for _, h := range WebhooksHealth(w.Factory, c.gvr, c.o) {
+			oo = append(oo, h)
+		}
+	}
+
+	return oo, nil
+}
+
+// WebhooksHealth resolves a webhook configuration webhooks backing services
+// endpoints and ca bundles.
+func WebhooksHealth(f Factory, gvr string, o *unstructured.Unstructured) []render.WebhookRes {
+	g := client.NewGVR(gvr)
+	kind, policy, timeout := "validating", "Ignore", int64(30)
+	if g.R() == "mutatingwebhookconfigurations" {
+		kind = "mutating"
+	}
+	// Admission v1 fails closed and times out sooner by default.
+	if g.V() == "v1" {
+		policy, timeout = render.FailurePolicyFail, 10
+	}
+
+	hh, _, _ := unstructured.NestedSlice(o.Object, "webhooks")
+	ww := make([]render.WebhookRes, 0, len(hh))
+	for _, h := range hh {
+		m, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		w := render.WebhookRes{
+			GVR:            gvr,
+			Kind:           kind,
+			Config:         o.GetName(),
+			FailurePolicy:  policy,
+			TimeoutSeconds: int32(timeout),
+		}
+		w.Name, _, _ = unstructured.NestedString(m, "name")
+		if p, ok, _ := unstructured.NestedString(m, "failurePolicy"); ok {
+			w.FailurePolicy = p
+		}
+		if t, ok, _ := unstructured.NestedInt64(m, "timeoutSeconds"); ok {
+			w.TimeoutSeconds = int32(t)
+		}
+		w.SideEffects, _, _ = unstructured.NestedString(m, "sideEffects")
+		w.URL, _, _ = unstructured.NestedString(m, "clientConfig", "url")
+		if svc, ok, _ := unstructured.NestedMap(m, "clientConfig", "service"); ok {
+			ns, _, _ := unstructured.NestedString(svc, "namespace")
+			n, _, _ := unstructured.NestedString(svc, "name")
+			w.Service, w.Port = client.FQN(ns, n), defaultWebhookPort
+			if p, ok, _ := unstructured.NestedInt64(svc, "port"); ok {
+				w.Port = int32(p)
+			}
+			w.Path, _, _ = unstructured.NestedString(svc, "path")
+			w.Ready, w.NotReady, w.ServiceErr = serviceEndpoints(f, w.Service, w.Port)
+		}
+		bundle, _, _ := unstructured.NestedString(m, "clientConfig", "caBundle")
+		w.CAStatus, w.CAExpiry = caBundleStatus(bundle)
+		ww = append(ww, w)
+	}
+
+	return ww
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type webhookConfig struct {
+	gvr string
+	o   *unstructured.Unstructured
+}
+
+// webhookConfigs lists all mutating and validating webhook configurations
+// using their preferred versions.
+func webhookConfigs(f Factory) []webhookConfig {
+	var cc []webhookConfig
+	for _, gg := range webhookGVRs {
+		gvr, ok := preferredGVR(gg)
+		if !ok {
+			continue
+		}
+		oo, err := f.List(gvr, client.ClusterScope, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list %s", gvr)
+			continue
+		}
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				cc = append(cc, webhookConfig{gvr: gvr, o: u})
+			}
+		}
+	}
+
+	return cc
+}
+
+// serviceEndpoints returns a service port ready and not ready endpoints counts.
+func serviceEndpoints(f Factory, fqn string, port int32) (int, int, error) {
+	o, err := f.Get("v1/services", fqn, true, labels.Everything())
+	if err != nil {
+		return 0, 0, fmt.Errorf("service %s not found", fqn)
+	}
+	var svc v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc); err != nil {
+		return 0, 0, err
+	}
+	sp, ok := servicePort(svc, intstr.FromInt(int(port)))
+	if !ok {
+		return 0, 0, fmt.Errorf("no port %d on service %s", port, fqn)
+	}
+
+	o, err = f.Get("v1/endpoints", fqn, true, labels.Everything())
+	if err != nil {
+		return 0, 0, nil
+	}
+	var ep v1.Endpoints
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ep); err != nil {
+		return 0, 0, err
+	}
+
+	var ready, notReady int
+	for _, s := range ep.Subsets {
+		for _, p := range s.Ports {
+			if p.Name != sp.Name || p.Protocol != sp.Protocol {
+				continue
+			}
+			ready, notReady = ready+len(s.Addresses), notReady+len(s.NotReadyAddresses)
+		}
+	}
+
+	return ready, notReady, nil
+}
+
+// caBundleStatus returns a ca bundle status along with its earliest expiry.
+func caBundleStatus(bundle string) (string, time.Time) {
+	if bundle == "" {
+		return render.CAMissing, time.Time{}
+	}
+	raw, err := base64.StdEncoding.DecodeString(bundle)
+	if err != nil {
+		return render.CertInvalid, time.Time{}
+	}
+	cc, err := ParseCerts(raw)
+	if err != nil {
+		return render.CertInvalid, time.Time{}
+	}
+
+	earliest := render.CertRes{Cert: cc[0]}
+	for _, c := range cc[1:] {
+		if c.NotAfter.Before(earliest.Cert.NotAfter) {
+			earliest.Cert = c
+		}
+	}
+
+	return earliest.Status(time.Now()), earliest.Cert.NotAfter
+}
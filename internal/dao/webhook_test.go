@@ -0,0 +1,142 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWebhooksHealth(t *testing.T) {
+	f := webhookFactory{oo: map[string]runtime.Object{
+		"v1/services:default/fred":  makeService("fred", 443),
+		"v1/endpoints:default/fred": makeEndpoints("fred", 8443, 2, 0),
+		"v1/services:default/blee":  makeService("blee", 443),
+		"v1/endpoints:default/blee": makeEndpoints("blee", 8443, 0, 1),
+	}}
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "zorg"},
+		"webhooks": []interface{}{
+			map[string]interface{}{
+				"name": "fred.zorg.io",
+				"clientConfig": map[string]interface{}{
+					"service":  map[string]interface{}{"namespace": "default", "name": "fred", "path": "/mutate"},
+					"caBundle": b64(makeCert(t, "fred-ca", 24*time.Hour*365)),
+				},
+			},
+			map[string]interface{}{
+				"name":           "blee.zorg.io",
+				"failurePolicy":  "Ignore",
+				"timeoutSeconds": int64(5),
+				"clientConfig": map[string]interface{}{
+					"service": map[string]interface{}{"namespace": "default", "name": "blee", "port": int64(443)},
+				},
+			},
+			map[string]interface{}{
+				"name":         "duh.zorg.io",
+				"clientConfig": map[string]interface{}{"service": map[string]interface{}{"namespace": "default", "name": "duh"}},
+			},
+			map[string]interface{}{
+				"name":         "url.zorg.io",
+				"clientConfig": map[string]interface{}{"url": "https://zorg.io/validate"},
+			},
+		},
+	}}
+
+	ww := WebhooksHealth(f, "admissionregistration.k8s.io/v1/mutatingwebhookconfigurations", o)
+	assert.Equal(t, 4, len(ww))
+
+	now := time.Now()
+	assert.Equal(t, "mutating", ww[0].Kind)
+	assert.Equal(t, "default/fred:443/mutate", ww[0].Target())
+	assert.Equal(t, 2, ww[0].Ready)
+	assert.Equal(t, render.CertValid, ww[0].CAStatus)
+	assert.Equal(t, render.FailurePolicyFail, ww[0].FailurePolicy)
+	assert.Equal(t, int32(10), ww[0].TimeoutSeconds)
+	assert.Empty(t, ww[0].Issues(now))
+
+	assert.Equal(t, "Ignore", ww[1].FailurePolicy)
+	assert.Equal(t, int32(5), ww[1].TimeoutSeconds)
+	assert.Equal(t, 0, ww[1].Ready)
+	assert.Equal(t, 1, ww[1].NotReady)
+	assert.Equal(t, render.CAMissing, ww[1].CAStatus)
+	assert.Equal(t, []string{"no ready endpoints"}, ww[1].Issues(now))
+
+	assert.Equal(t, []string{"service default/duh not found"}, ww[2].Issues(now))
+
+	assert.Equal(t, "https://zorg.io/validate", ww[3].Target())
+	assert.Empty(t, ww[3].Issues(now))
+}
+
+func TestCABundleStatus(t *testing.T) {
+	uu := map[string]struct {
+		bundle string
+		e      string
+	}{
+		"missing": {e: render.CAMissing},
+		"invalid": {bundle: "zorg", e: render.CertInvalid},
+		"expired": {bundle: b64(append(makeCert(t, "fred", time.Hour), makeCert(t, "blee", -time.Hour)...)), e: render.CertExpired},
+		"valid":   {bundle: b64(makeCert(t, "fred", 24*time.Hour*365)), e: render.CertValid},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, _ := caBundleStatus(u.bundle)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+// Helpers...
+
+type webhookFactory struct {
+	Factory
+	oo map[string]runtime.Object
+}
+
+func (f webhookFactory) Get(gvr, path string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	o, ok := f.oo[gvr+":"+path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return o, nil
+}
+
+func makeService(n string, port int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "default", "name": n},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{
+				map[string]interface{}{"port": port, "protocol": "TCP"},
+			},
+		},
+	}}
+}
+
+func makeEndpoints(n string, port int64, ready, notReady int) *unstructured.Unstructured {
+	addresses := func(count int) []interface{} {
+		aa := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			aa = append(aa, map[string]interface{}{"ip": "10.0.0.1"})
+		}
+		return aa
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "default", "name": n},
+		"subsets": []interface{}{
+			map[string]interface{}{
+				"addresses":         addresses(ready),
+				"notReadyAddresses": addresses(notReady),
+				"ports":             []interface{}{map[string]interface{}{"port": port, "protocol": "TCP"}},
+			},
+		},
+	}}
+}
@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// LastAppliedKey tracks the kubectl last applied configuration annotation.
+const LastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+// YAMLFilter tracks manifest filtering options.
+type YAMLFilter struct {
+	HideManagedFields bool
+	HideStatus        bool
+	HideDefaults      bool
+	LastApplied       bool
+}
+
+// serverFields tracks metadata fields populated by the api server.
+var serverFields = []string{
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// defaultedFields tracks common api server defaulted values.
+var defaultedFields = map[string]interface{}{
+	"dnsPolicy":                     "ClusterFirst",
+	"restartPolicy":                 "Always",
+	"schedulerName":                 "default-scheduler",
+	"terminationGracePeriodSeconds": float64(30),
+	"terminationMessagePath":        "/dev/termination-log",
+	"terminationMessagePolicy":      "File",
+	"revisionHistoryLimit":          float64(10),
+	"progressDeadlineSeconds":       float64(600),
+	"sessionAffinity":               "None",
+	"podManagementPolicy":           "OrderedReady",
+}
+
+// emptyDefaults tracks fields defaulted to an empty object.
+var emptyDefaults = map[string]struct{}{
+	"securityContext": {},
+	"resources":       {},
+}
+
+// FilterYAML strips out manifest sections based on the given filter.
+func FilterYAML(raw string, f YAMLFilter) (string, error) {
+	if f == (YAMLFilter{}) {
+		return raw, nil
+	}
+
+	var m map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &m); err != nil {
+		return "", err
+	}
+	if f.LastApplied {
+		var err error
+		if m, err = lastApplied(m); err != nil {
+			return "", err
+		}
+	}
+	if f.HideManagedFields {
+		unstructured.RemoveNestedField(m, "metadata", "managedFields")
+	}
+	if f.HideStatus {
+		delete(m, "status")
+	}
+	if f.HideDefaults {
+		for _, k := range serverFields {
+			unstructured.RemoveNestedField(m, "metadata", k)
+		}
+		stripDefaults(m)
+	}
+
+	bb, err := yaml.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func lastApplied(m map[string]interface{}) (map[string]interface{}, error) {
+	raw, ok, err := unstructured.NestedString(m, "metadata", "annotations", LastAppliedKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || raw == "" {
+		return nil, errors.New("no last-applied-configuration found")
+	}
+
+	var la map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &la); err != nil {
+		return nil, err
+	}
+
+	return la, nil
+}
+
+func stripDefaults(o interface{}) {
+	switch v := o.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if d, ok := defaultedFields[k]; ok && d == val {
+				delete(v, k)
+				continue
+			}
+			if _, ok := emptyDefaults[k]; ok {
+				if m, ok := val.(map[string]interface{}); ok && len(m) == 0 {
+					delete(v, k)
+					continue
+				}
+			}
+			stripDefaults(val)
+		}
+	case []interface{}:
+		for _, val := range v {
+			stripDefaults(val)
+		}
+	}
+}
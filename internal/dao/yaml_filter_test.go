@@ -0,0 +1,113 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rawPod = `apiVersion: v1
+kind: Pod
+metadata:
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: '{"apiVersion":"v1","kind":"Pod","metadata":{"name":"p1"}}'
+  creationTimestamp: "2020-01-01T00:00:00Z"
+  managedFields:
+  - manager: kubectl
+  name: p1
+  uid: fred
+spec:
+  containers:
+  - image: nginx
+    name: c1
+    resources: {}
+    terminationMessagePath: /dev/termination-log
+  dnsPolicy: ClusterFirst
+  restartPolicy: Never
+  volumes:
+  - emptyDir: {}
+    name: v1
+status:
+  phase: Running
+`
+
+func TestFilterYAML(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		f   YAMLFilter
+		e   string
+		err string
+	}{
+		"none": {raw: rawPod, e: rawPod},
+		"managed": {
+			raw: rawPod,
+			f:   YAMLFilter{HideManagedFields: true, HideStatus: true},
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: '{"apiVersion":"v1","kind":"Pod","metadata":{"name":"p1"}}'
+  creationTimestamp: "2020-01-01T00:00:00Z"
+  name: p1
+  uid: fred
+spec:
+  containers:
+  - image: nginx
+    name: c1
+    resources: {}
+    terminationMessagePath: /dev/termination-log
+  dnsPolicy: ClusterFirst
+  restartPolicy: Never
+  volumes:
+  - emptyDir: {}
+    name: v1
+`,
+		},
+		"defaults": {
+			raw: rawPod,
+			f:   YAMLFilter{HideManagedFields: true, HideStatus: true, HideDefaults: true},
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  annotations:
+    kubectl.kubernetes.io/last-applied-configuration: '{"apiVersion":"v1","kind":"Pod","metadata":{"name":"p1"}}'
+  name: p1
+spec:
+  containers:
+  - image: nginx
+    name: c1
+  restartPolicy: Never
+  volumes:
+  - emptyDir: {}
+    name: v1
+`,
+		},
+		"lastApplied": {
+			raw: rawPod,
+			f:   YAMLFilter{LastApplied: true},
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  name: p1
+`,
+		},
+		"noLastApplied": {
+			raw: "apiVersion: v1\nkind: Pod\n",
+			f:   YAMLFilter{LastApplied: true},
+			err: "no last-applied-configuration found",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := FilterYAML(u.raw, u.f)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
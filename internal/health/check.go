@@ -43,6 +43,16 @@ func (c *Check) Tally(l Level) int64 {
 	return c.Counts[l]
 }
 
+// ErrorRate returns the percentage of unhealthy resources.
+func (c *Check) ErrorRate() int {
+	total := c.Tally(S1) + c.Tally(S2)
+	if total == 0 {
+		return 0
+	}
+
+	return int(c.Tally(S2) * 100 / total)
+}
+
 // GetObjectKind returns a schema object.
 func (Check) GetObjectKind() schema.ObjectKind {
 	return nil
@@ -24,3 +24,26 @@ func TestCheck(t *testing.T) {
 	assert.Equal(t, int64(10), c.Tally(health.S1))
 	assert.Equal(t, int64(0), c.Tally(health.S2))
 }
+
+func TestCheckErrorRate(t *testing.T) {
+	uu := map[string]struct {
+		ok, toast int64
+		e         int
+	}{
+		"empty":   {e: 0},
+		"healthy": {ok: 10, e: 0},
+		"some":    {ok: 3, toast: 1, e: 25},
+		"all":     {toast: 2, e: 100},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := health.NewCheck("test")
+			c.Set(health.S1, u.ok)
+			c.Set(health.S2, u.toast)
+
+			assert.Equal(t, u.e, c.ErrorRate())
+		})
+	}
+}
@@ -29,4 +29,33 @@ const (
 	KeyToast       ContextKey = "toast"
 	KeyWithMetrics ContextKey = "withMetrics"
 	KeyViewConfig  ContextKey = "viewConfig"
+	KeyPlugins     ContextKey = "plugins"
+	KeyHotKeys     ContextKey = "hotKeys"
+	KeyApplied     ContextKey = "applied"
+	KeyAccess      ContextKey = "access"
+	KeyContainer   ContextKey = "container"
+	KeyCommand     ContextKey = "command"
+	KeyPrometheus  ContextKey = "prometheus"
+	KeyPromQueries ContextKey = "promQueries"
+	KeyFieldCols   ContextKey = "fieldCols"
+	KeyServerTable ContextKey = "serverTable"
+	KeyPaging      ContextKey = "paging"
+	KeyTargetGVR   ContextKey = "targetGVR"
+	KeyTargetVer   ContextKey = "targetVersion"
+	KeyGroupBy     ContextKey = "groupBy"
+	KeyImage       ContextKey = "image"
+	KeyImageFilter ContextKey = "imageFilter"
+	KeyImageScan   ContextKey = "imageScan"
+	KeyImageTags   ContextKey = "imageTags"
+	KeyRestart     ContextKey = "restart"
+	KeyDedup       ContextKey = "dedup"
+	KeyAlerts      ContextKey = "alerts"
+	KeyLastUsed    ContextKey = "lastUsed"
+	KeyCtxHealth   ContextKey = "ctxHealth"
+	KeyHops        ContextKey = "hops"
+	KeyPalette     ContextKey = "palette"
+	KeyFind        ContextKey = "find"
+	KeyFindGVRs    ContextKey = "findGVRs"
+	KeyChartQuery  ContextKey = "chartQuery"
+	KeyDashboard   ContextKey = "dashboard"
 )
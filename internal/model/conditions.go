@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const conditionsCol = "CONDITIONS"
+
+// conditionsAugment appends a status conditions summary column to a table.
+func conditionsAugment(h render.Header, rr render.Rows, oo []runtime.Object, wide bool) render.Header {
+	if h.IndexOf(conditionsCol, true) != -1 {
+		return h
+	}
+	objs, err := fieldObjects(oo)
+	if err != nil {
+		log.Debug().Err(err).Msg("Conditions unavailable on this resource")
+		return h
+	}
+	if len(objs) != len(rr) {
+		return h
+	}
+
+	hh := make(render.Header, len(h), len(h)+1)
+	copy(hh, h)
+	hh = append(hh, render.HeaderColumn{Name: conditionsCol, Wide: wide})
+	for i := range rr {
+		rr[i].Fields = append(rr[i].Fields, render.ConditionsSummary(render.Conditions(objs[i])))
+	}
+
+	return hh
+}
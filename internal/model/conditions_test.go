@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestConditionsAugment(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "f1"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False"},
+				map[string]interface{}{"type": "Synced", "status": "True"},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		h    render.Header
+		oo   []runtime.Object
+		wide bool
+		e    render.Header
+		f    render.Fields
+	}{
+		"generic": {
+			h:  render.Header{render.HeaderColumn{Name: "NAME"}},
+			oo: []runtime.Object{&o},
+			e: render.Header{
+				render.HeaderColumn{Name: "NAME"},
+				render.HeaderColumn{Name: "CONDITIONS"},
+			},
+			f: render.Fields{"f1", "1/2 Ready"},
+		},
+		"wide": {
+			h:    render.Header{render.HeaderColumn{Name: "NAME"}},
+			oo:   []runtime.Object{&o},
+			wide: true,
+			e: render.Header{
+				render.HeaderColumn{Name: "NAME"},
+				render.HeaderColumn{Name: "CONDITIONS", Wide: true},
+			},
+			f: render.Fields{"f1", "1/2 Ready"},
+		},
+		"present": {
+			h:  render.Header{render.HeaderColumn{Name: "NAME"}, render.HeaderColumn{Name: "CONDITIONS"}},
+			oo: []runtime.Object{&o},
+			e:  render.Header{render.HeaderColumn{Name: "NAME"}, render.HeaderColumn{Name: "CONDITIONS"}},
+			f:  render.Fields{"f1"},
+		},
+		"mismatch": {
+			h: render.Header{render.HeaderColumn{Name: "NAME"}},
+			e: render.Header{render.HeaderColumn{Name: "NAME"}},
+			f: render.Fields{"f1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := render.Rows{{ID: "f1", Fields: render.Fields{"f1"}}}
+			assert.Equal(t, u.e, conditionsAugment(u.h, rr, u.oo, u.wide))
+			assert.Equal(t, u.f, rr[0].Fields)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fieldAugment appends JSONPath backed columns to a table.
+func fieldAugment(ctx context.Context, h render.Header, rr render.Rows, oo []runtime.Object) render.Header {
+	ff, ok := ctx.Value(internal.KeyFieldCols).([]config.FieldColumn)
+	if !ok || len(ff) == 0 {
+		return h
+	}
+
+	objs, err := fieldObjects(oo)
+	if err != nil {
+		log.Warn().Err(err).Msg("Field columns unavailable on this resource")
+	}
+	if len(objs) != len(rr) {
+		objs = nil
+	}
+
+	hh := make(render.Header, len(h), len(h)+len(ff))
+	copy(hh, h)
+	for _, f := range ff {
+		hh = append(hh, render.HeaderColumn{Name: f.Name})
+		jp, err := render.NewJSONPath(f.Name, f.Path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Field column %s skipped", f.Name)
+		}
+		for i := range rr {
+			rr[i].Fields = append(rr[i].Fields, fieldCell(jp, objs, i))
+		}
+	}
+
+	return hh
+}
+
+func fieldCell(jp *render.JSONPath, objs []map[string]interface{}, i int) string {
+	if jp == nil || objs == nil {
+		return render.NAValue
+	}
+	v, err := jp.Eval(objs[i])
+	if err != nil {
+		return render.NAValue
+	}
+
+	return v
+}
+
+// fieldObjects converts resources to generic maps suitable for JSONPath evaluation.
+func fieldObjects(oo []runtime.Object) ([]map[string]interface{}, error) {
+	if len(oo) == 1 {
+		if t, ok := oo[0].(*metav1beta1.Table); ok {
+			return tableObjects(t)
+		}
+	}
+
+	objs := make([]map[string]interface{}, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			objs = append(objs, u.Object)
+			continue
+		}
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, m)
+	}
+
+	return objs, nil
+}
+
+func tableObjects(t *metav1beta1.Table) ([]map[string]interface{}, error) {
+	objs := make([]map[string]interface{}, 0, len(t.Rows))
+	for _, r := range t.Rows {
+		var m map[string]interface{}
+		if err := json.Unmarshal(r.Object.Raw, &m); err != nil {
+			return nil, err
+		}
+		objs = append(objs, m)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFieldAugment(t *testing.T) {
+	ff := []config.FieldColumn{
+		{Name: "NODE", Path: ".spec.nodeName"},
+		{Name: "TEAM", Path: "{.metadata.labels.team}"},
+		{Name: "BOZO", Path: ".spec[["},
+	}
+	p1 := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Labels: map[string]string{"team": "blee"}},
+		Spec:       v1.PodSpec{NodeName: "n1"},
+	}
+	p2 := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "p2"},
+		"spec":     map[string]interface{}{"nodeName": "n2"},
+	}}
+
+	uu := map[string]struct {
+		oo []runtime.Object
+		e  []render.Fields
+	}{
+		"objects": {
+			oo: []runtime.Object{&p1, &p2},
+			e: []render.Fields{
+				{"p1", "n1", "blee", render.NAValue},
+				{"p2", "n2", "", render.NAValue},
+			},
+		},
+		"table": {
+			oo: []runtime.Object{&metav1beta1.Table{Rows: []metav1beta1.TableRow{
+				{Object: runtime.RawExtension{Raw: []byte(`{"metadata": {"name": "p1", "labels": {"team": "fred"}}}`)}},
+				{Object: runtime.RawExtension{Raw: []byte(`{"metadata": {"name": "p2"}, "spec": {"nodeName": "n3"}}`)}},
+			}}},
+			e: []render.Fields{
+				{"p1", "", "fred", render.NAValue},
+				{"p2", "n3", "", render.NAValue},
+			},
+		},
+		"mismatch": {
+			oo: []runtime.Object{&p1},
+			e: []render.Fields{
+				{"p1", render.NAValue, render.NAValue, render.NAValue},
+				{"p2", render.NAValue, render.NAValue, render.NAValue},
+			},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), internal.KeyFieldCols, ff)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := render.Header{render.HeaderColumn{Name: "NAME"}}
+			rr := render.Rows{
+				{ID: "default/p1", Fields: render.Fields{"p1"}},
+				{ID: "default/p2", Fields: render.Fields{"p2"}},
+			}
+			hh := fieldAugment(ctx, h, rr, u.oo)
+			assert.Equal(t, 4, len(hh))
+			assert.Equal(t, "TEAM", hh[2].Name)
+			for i := range rr {
+				assert.Equal(t, u.e[i], rr[i].Fields)
+			}
+		})
+	}
+}
+
+func TestFieldAugmentNoCols(t *testing.T) {
+	h := render.Header{render.HeaderColumn{Name: "NAME"}}
+	rr := render.Rows{{ID: "default/p1", Fields: render.Fields{"p1"}}}
+
+	assert.Equal(t, h, fieldAugment(context.Background(), h, rr, nil))
+	assert.Equal(t, render.Fields{"p1"}, rr[0].Fields)
+}
@@ -52,10 +52,11 @@ type FlashListener interface {
 
 // Flash represents a flash message model.
 type Flash struct {
-	msg     LevelMessage
-	cancel  context.CancelFunc
-	delay   time.Duration
-	msgChan chan LevelMessage
+	msg       LevelMessage
+	cancel    context.CancelFunc
+	delay     time.Duration
+	msgChan   chan LevelMessage
+	listeners []FlashListener
 }
 
 // NewFlash returns a new instance.
@@ -66,6 +67,11 @@ func NewFlash(dur time.Duration) *Flash {
 	}
 }
 
+// AddListener registers a flash listener.
+func (f *Flash) AddListener(l FlashListener) {
+	f.listeners = append(f.listeners, l)
+}
+
 // Channel returns the flash channel.
 func (f *Flash) Channel() FlashChan {
 	return f.msgChan
@@ -149,8 +155,14 @@ func (f *Flash) setLevelMessage(msg LevelMessage) {
 
 func (f *Flash) fireFlashChanged() {
 	f.msgChan <- f.msg
+	for _, l := range f.listeners {
+		l.FlashChanged(f.msg.Level, f.msg.Text)
+	}
 }
 
 func (f *Flash) fireCleared() {
 	f.msgChan <- newClearMessage()
+	for _, l := range f.listeners {
+		l.FlashCleared()
+	}
 }
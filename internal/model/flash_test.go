@@ -67,6 +67,31 @@ func TestFlashBurst(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("test-%d", count), m)
 }
 
+func TestFlashListener(t *testing.T) {
+	f := model.NewFlash(time.Second)
+	v := newFlash()
+	go v.listen(f.Channel())
+	var l flashListener
+	f.AddListener(&l)
+
+	f.Err(errors.New("boom"))
+	f.Info("blee")
+
+	assert.Equal(t, []model.FlashLevel{model.FlashErr, model.FlashInfo}, l.levels)
+	assert.Equal(t, []string{"boom", "blee"}, l.msgs)
+}
+
+type flashListener struct {
+	levels []model.FlashLevel
+	msgs   []string
+}
+
+func (l *flashListener) FlashChanged(level model.FlashLevel, msg string) {
+	l.levels, l.msgs = append(l.levels, level), append(l.msgs, msg)
+}
+
+func (l *flashListener) FlashCleared() {}
+
 type flash struct {
 	set, clear int
 	level      model.FlashLevel
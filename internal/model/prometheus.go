@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+// promAugment appends PromQL backed metric columns to a table.
+func promAugment(ctx context.Context, ns string, h render.Header, rr render.Rows) render.Header {
+	prom, ok := ctx.Value(internal.KeyPrometheus).(*client.Prometheus)
+	if !ok || prom == nil {
+		return h
+	}
+	qq, ok := ctx.Value(internal.KeyPromQueries).([]client.PromQuery)
+	if !ok || len(qq) == 0 {
+		return h
+	}
+
+	hh := make(render.Header, len(h), len(h)+len(qq))
+	copy(hh, h)
+	for _, q := range qq {
+		hh = append(hh, render.HeaderColumn{Name: q.Name, Align: tview.AlignRight})
+		vv, err := prom.Values(q, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Prometheus query failed for column %s", q.Name)
+		}
+		for i := range rr {
+			v, ok := vv[rr[i].ID]
+			if !ok {
+				rr[i].Fields = append(rr[i].Fields, render.NAValue)
+				continue
+			}
+			rr[i].Fields = append(rr[i].Fields, fmt.Sprintf("%.2f", v))
+		}
+	}
+
+	return hh
+}
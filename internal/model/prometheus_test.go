@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromAugment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[
+{"metric":{"namespace":"default","pod":"p1"},"value":[1580000000,"1.5"]}]}}`)
+	}))
+	defer srv.Close()
+
+	h := render.Header{render.HeaderColumn{Name: "NAME"}}
+	rr := render.Rows{
+		{ID: "default/p1", Fields: render.Fields{"p1"}},
+		{ID: "default/p2", Fields: render.Fields{"p2"}},
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyPrometheus, client.NewPrometheus(srv.URL))
+	ctx = context.WithValue(ctx, internal.KeyPromQueries, []client.PromQuery{{Name: "RPS", Query: "rps"}})
+
+	hh := promAugment(ctx, "default", h, rr)
+	assert.Equal(t, 2, len(hh))
+	assert.Equal(t, "RPS", hh[1].Name)
+	assert.Equal(t, render.Fields{"p1", "1.50"}, rr[0].Fields)
+	assert.Equal(t, render.Fields{"p2", render.NAValue}, rr[1].Fields)
+}
+
+func TestPromAugmentNoDatasource(t *testing.T) {
+	h := render.Header{render.HeaderColumn{Name: "NAME"}}
+	rr := render.Rows{{ID: "default/p1", Fields: render.Fields{"p1"}}}
+
+	assert.Equal(t, h, promAugment(context.Background(), "default", h, rr))
+	assert.Equal(t, render.Fields{"p1"}, rr[0].Fields)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/health"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -26,6 +27,8 @@ type PulseListener interface {
 
 // Pulse tracks multiple resources health.
 type Pulse struct {
+	*Refresher
+
 	gvr         string
 	namespace   string
 	inUpdate    int32
@@ -38,11 +41,17 @@ type Pulse struct {
 // NewPulse returns a new pulse.
 func NewPulse(gvr string) *Pulse {
 	return &Pulse{
+		Refresher:   NewRefresher(),
 		gvr:         gvr,
 		refreshRate: defaultRefreshRate,
 	}
 }
 
+// SetRefreshRate sets model refresh duration.
+func (p *Pulse) SetRefreshRate(d time.Duration) {
+	p.refreshRate = d
+}
+
 // Watch monitors pulses.
 func (p *Pulse) Watch(ctx context.Context) {
 	p.Refresh(ctx)
@@ -57,8 +66,11 @@ func (p *Pulse) updater(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
+		case <-p.Wake():
+			rate = p.Scale(p.refreshRate)
+			p.refresh(ctx)
 		case <-time.After(rate):
-			rate = p.refreshRate
+			rate = p.Scale(p.refreshRate)
 			p.refresh(ctx)
 		}
 	}
@@ -78,6 +90,7 @@ func (p *Pulse) refresh(ctx context.Context) {
 		return
 	}
 	defer atomic.StoreInt32(&p.inUpdate, 0)
+	defer perf.TrackRefresh(p.gvr, time.Now())
 
 	if err := p.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
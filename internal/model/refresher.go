@@ -0,0 +1,71 @@
+package model
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Visibility tracks how prominently a model view is displayed.
+type Visibility int32
+
+const (
+	// Foreground tracks a focused view.
+	Foreground Visibility = iota
+
+	// Background tracks a displayed view without focus, ie an inactive split pane.
+	Background
+
+	// Hidden tracks a view that is not displayed, ie while a shell runs.
+	Hidden
+)
+
+const (
+	backgroundFactor = 3
+	hiddenFactor     = 10
+)
+
+// Refresher slows down model refreshes for views that are not in focus.
+type Refresher struct {
+	visibility int32
+	wakeChan   chan struct{}
+}
+
+// NewRefresher returns a new refresher for a foreground view.
+func NewRefresher() *Refresher {
+	return &Refresher{wakeChan: make(chan struct{}, 1)}
+}
+
+// Visibility returns the current visibility.
+func (r *Refresher) Visibility() Visibility {
+	return Visibility(atomic.LoadInt32(&r.visibility))
+}
+
+// SetVisibility updates the visibility. A view getting more visible gets
+// woken up so it refreshes right away.
+func (r *Refresher) SetVisibility(v Visibility) {
+	old := Visibility(atomic.SwapInt32(&r.visibility, int32(v)))
+	if v >= old {
+		return
+	}
+	select {
+	case r.wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+// Wake returns a channel signaling the view just got more visible.
+func (r *Refresher) Wake() <-chan struct{} {
+	return r.wakeChan
+}
+
+// Scale returns a refresh rate adjusted to the current visibility.
+func (r *Refresher) Scale(d time.Duration) time.Duration {
+	switch r.Visibility() {
+	case Background:
+		return d * backgroundFactor
+	case Hidden:
+		return d * hiddenFactor
+	default:
+		return d
+	}
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefresherScale(t *testing.T) {
+	uu := map[string]struct {
+		v model.Visibility
+		e time.Duration
+	}{
+		"foreground": {v: model.Foreground, e: 2 * time.Second},
+		"background": {v: model.Background, e: 6 * time.Second},
+		"hidden":     {v: model.Hidden, e: 20 * time.Second},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model.NewRefresher()
+			r.SetVisibility(u.v)
+
+			assert.Equal(t, u.v, r.Visibility())
+			assert.Equal(t, u.e, r.Scale(2*time.Second))
+		})
+	}
+}
+
+func TestRefresherWake(t *testing.T) {
+	r := model.NewRefresher()
+
+	r.SetVisibility(model.Hidden)
+	assert.False(t, woken(r))
+
+	r.SetVisibility(model.Background)
+	assert.True(t, woken(r))
+
+	r.SetVisibility(model.Background)
+	assert.False(t, woken(r))
+
+	r.SetVisibility(model.Foreground)
+	assert.True(t, woken(r))
+}
+
+// Helpers...
+
+func woken(r *model.Refresher) bool {
+	select {
+	case <-r.Wake():
+		return true
+	default:
+		return false
+	}
+}
@@ -14,6 +14,22 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Chart{},
 		Renderer: &render.Chart{},
 	},
+	"helmrepos": {
+		DAO:      &dao.HelmRepo{},
+		Renderer: &render.HelmRepo{},
+	},
+	"repocharts": {
+		DAO:      &dao.RepoChart{},
+		Renderer: &render.RepoChart{},
+	},
+	"chartresources": {
+		DAO:      &dao.ChartResource{},
+		Renderer: &render.ChartResource{},
+	},
+	"history": {
+		DAO:      &dao.History{},
+		Renderer: &render.History{},
+	},
 	"pulses": {
 		DAO: &dao.Pulse{},
 	},
@@ -21,6 +37,118 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.OpenFaas{},
 		Renderer: &render.OpenFaas{},
 	},
+	"secretdata": {
+		DAO:      &dao.SecretData{},
+		Renderer: &render.SecretData{},
+	},
+	"applied": {
+		DAO:      &dao.Apply{},
+		Renderer: &render.Apply{},
+	},
+	"access": {
+		DAO:      &dao.Access{},
+		Renderer: &render.Access{},
+	},
+	"netpolrules": {
+		DAO:      &dao.NetpolRule{},
+		Renderer: &render.NetpolRule{},
+	},
+	"containerfs": {
+		DAO:      &dao.ContainerFS{},
+		Renderer: &render.ContainerFile{},
+	},
+	"processes": {
+		DAO:      &dao.Process{},
+		Renderer: &render.Process{},
+	},
+	"disruptions": {
+		DAO:      &dao.Disruption{},
+		Renderer: &render.Disruption{},
+	},
+	"terminations": {
+		DAO:      &dao.Termination{},
+		Renderer: &render.Termination{},
+	},
+	"conditions": {
+		DAO:      &dao.Condition{},
+		Renderer: &render.Condition{},
+	},
+	"tlscerts": {
+		DAO:      &dao.Cert{},
+		Renderer: &render.Cert{},
+	},
+	"webhooks": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.Webhook{},
+	},
+	"notifications": {
+		DAO:      &dao.Notification{},
+		Renderer: &render.Notification{},
+	},
+	"alerts": {
+		DAO:      &dao.Alert{},
+		Renderer: &render.Alert{},
+	},
+	"dashboard": {
+		DAO:      &dao.Dashboard{},
+		Renderer: &render.Dashboard{},
+	},
+	"hops": {
+		DAO:      &dao.Hop{},
+		Renderer: &render.Hop{},
+	},
+	"palette": {
+		DAO:      &dao.Palette{},
+		Renderer: &render.Palette{},
+	},
+	"images": {
+		DAO:      &dao.Image{},
+		Renderer: &render.Image{},
+	},
+	"vulns": {
+		DAO:      &dao.Vuln{},
+		Renderer: &render.Vuln{},
+	},
+	"imagetags": {
+		DAO:      &dao.ImageTag{},
+		Renderer: &render.ImageTag{},
+	},
+	"revisions": {
+		DAO:      &dao.Revision{},
+		Renderer: &render.Revision{},
+	},
+	"ordinals": {
+		DAO:      &dao.Ordinal{},
+		Renderer: &render.Ordinal{},
+	},
+	"costs": {
+		DAO:      &dao.Cost{},
+		Renderer: &render.Cost{},
+	},
+	"deprecations": {
+		DAO:      &dao.Deprecation{},
+		Renderer: &render.Deprecation{},
+	},
+	"find": {
+		DAO:      &dao.Find{},
+		Renderer: &render.Find{},
+	},
+	"audits": {
+		DAO:      &dao.Audit{},
+		Renderer: &render.Audit{},
+	},
+	"informers": {
+		DAO:      &dao.Informer{},
+		Renderer: &render.Informer{},
+	},
+	"debug": {
+		DAO:      &dao.Debug{},
+		Renderer: &render.Debug{},
+	},
+	"apicalls": {
+		DAO:      &dao.APICall{},
+		Renderer: &render.APICall{},
+	},
 	"containers": {
 		DAO:          &dao.Container{},
 		Renderer:     &render.Container{},
@@ -62,12 +190,17 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
 	},
+	"plugins": {
+		DAO:      &dao.Plugin{},
+		Renderer: &render.Plugin{},
+	},
 
 	// Core...
 	"v1/endpoints": {
 		Renderer: &render.Endpoints{},
 	},
 	"v1/events": {
+		DAO:      &dao.Event{},
 		Renderer: &render.Event{},
 	},
 	"v1/pods": {
@@ -94,6 +227,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PersistentVolume{},
 	},
 	"v1/persistentvolumeclaims": {
+		DAO:      &dao.PersistentVolumeClaim{},
 		Renderer: &render.PersistentVolumeClaim{},
 	},
 
@@ -123,6 +257,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.DaemonSet{},
 	},
 	"extensions/v1beta1/ingresses": {
+		DAO:      &dao.Ingress{},
 		Renderer: &render.Ingress{},
 	},
 	"extensions/v1beta1/networkpolicies": {
@@ -169,6 +304,14 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.StorageClass{},
 	},
 
+	// Coordination...
+	"coordination.k8s.io/v1/leases": {
+		Renderer: &render.Lease{},
+	},
+	"coordination.k8s.io/v1beta1/leases": {
+		Renderer: &render.Lease{},
+	},
+
 	// Policy...
 	"policy/v1beta1/poddisruptionbudgets": {
 		Renderer: &render.PodDisruptionBudget{},
@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
@@ -29,6 +30,8 @@ type TableListener interface {
 
 // Table represents a table model.
 type Table struct {
+	*Refresher
+
 	gvr         client.GVR
 	namespace   string
 	data        *render.TableData
@@ -36,12 +39,16 @@ type Table struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	instance    string
+	pending     map[string]watchEvent
+	pager       *pageState
 	mx          sync.RWMutex
+	pmx         sync.Mutex
 }
 
 // NewTable returns a new table model.
 func NewTable(gvr client.GVR) *Table {
 	return &Table{
+		Refresher:   NewRefresher(),
 		gvr:         gvr,
 		data:        render.NewTableData(),
 		refreshRate: 2 * time.Second,
@@ -73,10 +80,13 @@ func (t *Table) RemoveListener(l TableListener) {
 	}
 }
 
-// Watch initiates model updates.
+// Watch initiates model updates. When supported, rows are maintained from
+// the resource watch events and only relisted periodically. Large resource
+// collections are listed a page at a time and are not watched.
 func (t *Table) Watch(ctx context.Context) {
+	t.initPager(ctx)
 	t.refresh(ctx)
-	go t.updater(ctx)
+	go t.updater(ctx, !t.paged() && t.watch(ctx))
 }
 
 // Refresh updates the table content.
@@ -95,7 +105,7 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 }
 
 // Delete deletes a resource.
-func (t *Table) Delete(ctx context.Context, path string, cascade, force bool) error {
+func (t *Table) Delete(ctx context.Context, path string, opts dao.DeleteOptions) error {
 	meta, err := t.getMeta(ctx)
 	if err != nil {
 		return err
@@ -106,7 +116,7 @@ func (t *Table) Delete(ctx context.Context, path string, cascade, force bool) er
 		return fmt.Errorf("no nuker for %q", meta.DAO.GVR())
 	}
 
-	return nuker.Delete(path, cascade, force)
+	return nuker.Delete(path, opts)
 }
 
 // Describe describes a given resource.
@@ -178,16 +188,26 @@ func (t *Table) Peek() render.TableData {
 	return t.data.Clone()
 }
 
-func (t *Table) updater(ctx context.Context) {
+func (t *Table) updater(ctx context.Context, watched bool) {
 	defer log.Debug().Msgf("Model canceled -- %q", t.gvr)
 
+	var last time.Time
 	rate := initRefreshRate
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-t.Wake():
+			rate = t.Scale(t.refreshRate)
+			last = time.Now()
+			t.refresh(ctx)
 		case <-time.After(rate):
-			rate = t.refreshRate
+			rate = t.Scale(t.refreshRate)
+			if watched && time.Since(last) < relistRate {
+				t.refreshDeltas(ctx)
+				continue
+			}
+			last = time.Now()
 			t.refresh(ctx)
 		}
 	}
@@ -199,7 +219,10 @@ func (t *Table) refresh(ctx context.Context) {
 		return
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
+	defer perf.TrackRefresh(t.gvr.String(), time.Now())
 
+	// A full relist supersedes any pending watch events.
+	t.drain()
 	if err := t.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
 		t.fireTableLoadFailed(err)
@@ -214,17 +237,23 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
 	a.Init(factory, t.gvr)
+	if p, ok := a.(dao.Pageable); ok && t.paged() {
+		return t.listPage(ctx, p)
+	}
 
-	ns := client.CleanseNamespace(t.namespace)
+	return a.List(ctx, t.listNamespace())
+}
+
+func (t *Table) listNamespace() string {
 	if client.IsClusterScoped(t.namespace) {
-		ns = client.AllNamespaces
+		return client.AllNamespaces
 	}
 
-	return a.List(ctx, ns)
+	return client.CleanseNamespace(t.namespace)
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
-	meta := t.resourceMeta()
+	meta := t.listMeta(ctx)
 	var (
 		oo  []runtime.Object
 		err error
@@ -258,6 +287,8 @@ func (t *Table) reconcile(ctx context.Context) error {
 		}
 	}
 
+	header := t.augment(ctx, meta, rows, oo)
+
 	t.mx.Lock()
 	defer t.mx.Unlock()
 	// if labelSelector in place might as well clear the model data.
@@ -266,7 +297,7 @@ func (t *Table) reconcile(ctx context.Context) error {
 		t.data.Clear()
 	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.data.SetHeader(t.namespace, header)
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -275,6 +306,18 @@ func (t *Table) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// augment appends computed columns to the rendered rows and returns the resulting header.
+func (t *Table) augment(ctx context.Context, meta ResourceMeta, rows render.Rows, oo []runtime.Object) render.Header {
+	header := meta.Renderer.Header(t.namespace)
+	if m, err := dao.MetaAccess.MetaFor(t.gvr); err == nil && dao.IsK8sMeta(m) {
+		_, generic := meta.Renderer.(*render.Generic)
+		header = conditionsAugment(header, rows, oo, !generic)
+	}
+	header = promAugment(ctx, t.namespace, header, rows)
+
+	return fieldAugment(ctx, header, rows, oo)
+}
+
 func (t *Table) getMeta(ctx context.Context) (ResourceMeta, error) {
 	meta := t.resourceMeta()
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
@@ -286,6 +329,23 @@ func (t *Table) getMeta(ctx context.Context) (ResourceMeta, error) {
 	return meta, nil
 }
 
+// listMeta returns the resource meta used to list resources, favoring
+// api server rendered tables when requested for this resource.
+func (t *Table) listMeta(ctx context.Context) ResourceMeta {
+	if on, _ := ctx.Value(internal.KeyServerTable).(bool); !on || t.instance != "" {
+		return t.resourceMeta()
+	}
+	if m, err := dao.MetaAccess.MetaFor(t.gvr); err != nil || !dao.IsK8sMeta(m) {
+		log.Warn().Msgf("Server side table unavailable for %q", t.gvr)
+		return t.resourceMeta()
+	}
+
+	return ResourceMeta{
+		DAO:      &dao.Table{},
+		Renderer: &render.Generic{},
+	}
+}
+
 func (t *Table) resourceMeta() ResourceMeta {
 	meta, ok := Registry[t.gvr.String()]
 	if !ok {
@@ -296,7 +356,7 @@ func (t *Table) resourceMeta() ResourceMeta {
 		}
 	}
 	if meta.DAO == nil {
-		meta.DAO = &dao.Resource{}
+		meta.DAO = &dao.Informed{}
 	}
 
 	return meta
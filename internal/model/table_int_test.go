@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -33,7 +34,7 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 17, len(data.Header))
+	assert.Equal(t, 18, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
@@ -98,6 +99,49 @@ func TestTableMeta(t *testing.T) {
 	}
 }
 
+func TestTableListMeta(t *testing.T) {
+	defer func(m *dao.Meta) { dao.MetaAccess = m }(dao.MetaAccess)
+	dao.MetaAccess = dao.NewMeta()
+	dao.MetaAccess.RegisterMeta("v1/pods", metav1.APIResource{Name: "pods", Kind: "Pod"})
+	uu := map[string]struct {
+		gvr, instance string
+		server        bool
+		renderer      Renderer
+	}{
+		"typed": {
+			gvr:      "v1/pods",
+			renderer: &render.Pod{},
+		},
+		"server": {
+			gvr:      "v1/pods",
+			server:   true,
+			renderer: &render.Generic{},
+		},
+		"instance": {
+			gvr:      "v1/pods",
+			instance: "fred/blee",
+			server:   true,
+			renderer: &render.Pod{},
+		},
+		"pseudo": {
+			gvr:      "containers",
+			server:   true,
+			renderer: &render.Container{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR(u.gvr))
+			ta.SetInstance(u.instance)
+			ctx := context.WithValue(context.Background(), internal.KeyServerTable, u.server)
+
+			assert.Equal(t, u.renderer, ta.listMeta(ctx).Renderer)
+		})
+	}
+}
+
 func TestTableHydrate(t *testing.T) {
 	oo := []runtime.Object{
 		&render.PodWithMetrics{Raw: load(t, "p1")},
@@ -106,7 +150,7 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 17, len(rr[0].Fields))
+	assert.Equal(t, 18, len(rr[0].Fields))
 }
 
 func TestTableGenericHydrate(t *testing.T) {
@@ -133,7 +177,7 @@ func TestTableGenericHydrate(t *testing.T) {
 
 	assert.Nil(t, genericHydrate("blee", &tt, rr, &re))
 	assert.Equal(t, 2, len(rr))
-	assert.Equal(t, 3, len(rr[0].Fields))
+	assert.Equal(t, 4, len(rr[0].Fields))
 }
 
 // ----------------------------------------------------------------------------
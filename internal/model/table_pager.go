@@ -0,0 +1,160 @@
+package model
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// pageState tracks a paged listing progress.
+type pageState struct {
+	loaded int64
+	cont   string
+}
+
+// HasMore returns true if more resources can be fetched for a paged listing.
+func (t *Table) HasMore() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.pager != nil && t.pager.cont != ""
+}
+
+// NextPage fetches the next page of resources for a paged listing.
+func (t *Table) NextPage(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping next page...")
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	changed, err := t.nextPage(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Next page failed")
+		t.fireTableLoadFailed(err)
+		return
+	}
+	if changed {
+		t.fireTableChanged(t.Peek())
+	}
+}
+
+func (t *Table) nextPage(ctx context.Context) (bool, error) {
+	t.mx.RLock()
+	var cont string
+	if t.pager != nil {
+		cont = t.pager.cont
+	}
+	t.mx.RUnlock()
+	if cont == "" {
+		return false, nil
+	}
+
+	meta, err := t.getMeta(ctx)
+	if err != nil {
+		return false, err
+	}
+	p, ok := meta.DAO.(dao.Pageable)
+	if !ok {
+		return false, nil
+	}
+	oo, next, err := p.ListPage(ctx, t.listNamespace(), cont, dao.PageSize)
+	// Expired tokens get renewed by the next refresh.
+	if errors.IsResourceExpired(err) {
+		log.Warn().Err(err).Msgf("Page token expired for %q", t.gvr)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	rows := make(render.Rows, len(oo))
+	if err := hydrate(t.namespace, oo, rows, meta.Renderer); err != nil {
+		return false, err
+	}
+	t.augment(ctx, meta, rows, oo)
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	if t.pager == nil || t.pager.cont != cont {
+		return false, nil
+	}
+	t.pager.loaded, t.pager.cont = t.pager.loaded+dao.PageSize, next
+	for _, row := range rows {
+		t.data.Upsert(row)
+	}
+
+	return true, nil
+}
+
+// initPager switches the model to paged listings when the resource
+// collection exceeds the configured threshold.
+func (t *Table) initPager(ctx context.Context) {
+	var ps *pageState
+	defer func() {
+		t.mx.Lock()
+		t.pager = ps
+		t.mx.Unlock()
+	}()
+
+	threshold, _ := ctx.Value(internal.KeyPaging).(int64)
+	if threshold <= 0 || t.instance != "" {
+		return
+	}
+	meta := t.listMeta(ctx)
+	if _, ok := meta.Renderer.(*render.Generic); ok {
+		return
+	}
+	if _, ok := meta.DAO.(dao.Pageable); !ok {
+		return
+	}
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return
+	}
+	exceeds, err := dao.Exceeds(ctx, factory, t.gvr, t.listNamespace(), threshold)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to size %q. Paging disabled", t.gvr)
+		return
+	}
+	if exceeds {
+		log.Debug().Msgf("Paging %q listing", t.gvr)
+		ps = &pageState{}
+	}
+}
+
+func (t *Table) paged() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.pager != nil
+}
+
+// listPage relists the pages loaded so far as a single page.
+func (t *Table) listPage(ctx context.Context, p dao.Pageable) ([]runtime.Object, error) {
+	t.mx.RLock()
+	limit := dao.PageSize
+	if t.pager != nil && t.pager.loaded > limit {
+		limit = t.pager.loaded
+	}
+	t.mx.RUnlock()
+
+	oo, cont, err := p.ListPage(ctx, t.listNamespace(), "", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mx.Lock()
+	if t.pager != nil {
+		t.pager.loaded, t.pager.cont = limit, cont
+	}
+	t.mx.Unlock()
+
+	return oo, nil
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTablePaged(t *testing.T) {
+	Registry["paged"] = ResourceMeta{DAO: newPagedRes(1200), Renderer: &render.Termination{}}
+	defer delete(Registry, "paged")
+
+	ta := NewTable(client.NewGVR("paged"))
+	ta.pager = &pageState{}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
+
+	assert.Nil(t, ta.reconcile(ctx))
+	assert.Equal(t, 500, len(ta.Peek().RowEvents))
+	assert.True(t, ta.HasMore())
+
+	ta.NextPage(ctx)
+	assert.Equal(t, 1000, len(ta.Peek().RowEvents))
+	assert.True(t, ta.HasMore())
+
+	ta.NextPage(ctx)
+	assert.Equal(t, 1200, len(ta.Peek().RowEvents))
+	assert.False(t, ta.HasMore())
+
+	ta.NextPage(ctx)
+	assert.Equal(t, 1200, len(ta.Peek().RowEvents))
+
+	// Refresh relists all loaded pages at once.
+	assert.Nil(t, ta.reconcile(ctx))
+	assert.Equal(t, 1200, len(ta.Peek().RowEvents))
+	assert.Equal(t, int64(1500), ta.pager.loaded)
+	assert.False(t, ta.HasMore())
+}
+
+func TestTableInitPagerDisabled(t *testing.T) {
+	uu := map[string]struct {
+		gvr, instance string
+		threshold     interface{}
+	}{
+		"off":       {gvr: "v1/pods"},
+		"instance":  {gvr: "v1/pods", instance: "default/fred", threshold: int64(10)},
+		"unpagable": {gvr: "containers", threshold: int64(10)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR(u.gvr))
+			ta.SetInstance(u.instance)
+			ta.pager = &pageState{}
+			ctx := context.WithValue(context.Background(), internal.KeyPaging, u.threshold)
+
+			ta.initPager(ctx)
+			assert.False(t, ta.paged())
+		})
+	}
+}
+
+// Helpers...
+
+type pagedRes struct {
+	dao.NonResource
+
+	items []runtime.Object
+}
+
+func newPagedRes(n int) *pagedRes {
+	oo := make([]runtime.Object, n)
+	for i := range oo {
+		oo[i] = render.TerminationRes{Container: fmt.Sprintf("c%d", i)}
+	}
+
+	return &pagedRes{items: oo}
+}
+
+func (p *pagedRes) List(context.Context, string) ([]runtime.Object, error) {
+	return p.items, nil
+}
+
+func (p *pagedRes) ListPage(_ context.Context, _, cont string, limit int64) ([]runtime.Object, string, error) {
+	var start int
+	if cont != "" {
+		var err error
+		if start, err = strconv.Atoi(cont); err != nil {
+			return nil, "", err
+		}
+	}
+	end := start + int(limit)
+	if end >= len(p.items) {
+		return p.items[start:], "", nil
+	}
+
+	return p.items[start:end], strconv.Itoa(end), nil
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// relistRate tracks how often watched tables are fully relisted to refresh
+// computed columns, ie ages or metrics.
+const relistRate = time.Minute
+
+// watchEvent tracks the latest watch event seen for a resource.
+type watchEvent struct {
+	obj     runtime.Object
+	deleted bool
+}
+
+// ResourceChanged notifies a watched resource was added or updated.
+func (t *Table) ResourceChanged(_ string, o runtime.Object) {
+	t.track(o, false)
+}
+
+// ResourceDeleted notifies a watched resource was deleted.
+func (t *Table) ResourceDeleted(_ string, o runtime.Object) {
+	t.track(o, true)
+}
+
+// track coalesces watch events so only a resource latest state gets rendered.
+func (t *Table) track(o runtime.Object, deleted bool) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return
+	}
+
+	t.pmx.Lock()
+	defer t.pmx.Unlock()
+	if t.pending == nil {
+		t.pending = make(map[string]watchEvent)
+	}
+	t.pending[client.FQN(m.GetNamespace(), m.GetName())] = watchEvent{obj: o, deleted: deleted}
+}
+
+func (t *Table) drain() map[string]watchEvent {
+	t.pmx.Lock()
+	defer t.pmx.Unlock()
+
+	ee := t.pending
+	t.pending = nil
+
+	return ee
+}
+
+// watch registers the model for watch events until the context is canceled.
+// Returns false if the model rows can not be maintained incrementally.
+func (t *Table) watch(ctx context.Context) bool {
+	w, ok := ctx.Value(internal.KeyFactory).(dao.ResourceWatcher)
+	if !ok {
+		return false
+	}
+	if _, ok := t.watchable(ctx); !ok {
+		return false
+	}
+
+	w.AddListener(t.gvr.String(), t)
+	go func() {
+		<-ctx.Done()
+		w.RemoveListener(t.gvr.String(), t)
+	}()
+
+	return true
+}
+
+// watchable returns the resource accessor if rows can be rendered from individual watch events.
+func (t *Table) watchable(ctx context.Context) (dao.Watchable, bool) {
+	if t.instance != "" {
+		return nil, false
+	}
+	// Metrics columns are queried for the whole namespace.
+	if qq, _ := ctx.Value(internal.KeyPromQueries).([]client.PromQuery); len(qq) > 0 {
+		return nil, false
+	}
+	meta := t.listMeta(ctx)
+	if _, ok := meta.Renderer.(*render.Generic); ok {
+		return nil, false
+	}
+	w, ok := meta.DAO.(dao.Watchable)
+
+	return w, ok
+}
+
+func (t *Table) refreshDeltas(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping deltas update...")
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	changed, err := t.reconcileDeltas(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Reconcile deltas failed")
+		t.fireTableLoadFailed(err)
+		return
+	}
+	if changed {
+		t.fireTableChanged(t.Peek())
+	}
+}
+
+// reconcileDeltas applies pending watch events to the table rows.
+func (t *Table) reconcileDeltas(ctx context.Context) (bool, error) {
+	ee := t.drain()
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	changed := t.data.Settle()
+	if len(ee) == 0 {
+		return changed, nil
+	}
+
+	meta := t.listMeta(ctx)
+	w, ok := meta.DAO.(dao.Watchable)
+	if !ok {
+		return changed, fmt.Errorf("resource %s is not watchable", t.gvr)
+	}
+
+	ns := t.listNamespace()
+	rows, oo := make(render.Rows, 0, len(ee)), make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		o, ok := w.Watched(ctx, ns, e.obj)
+		var row render.Row
+		if err := meta.Renderer.Render(o, t.namespace, &row); err != nil {
+			return changed, err
+		}
+		if e.deleted || !ok {
+			t.data.Remove(row.ID)
+			continue
+		}
+		rows, oo = append(rows, row), append(oo, o)
+	}
+	t.augment(ctx, meta, rows, oo)
+	for _, row := range rows {
+		t.data.Upsert(row)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTableReconcileDeltas(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+
+	p1 := load(t, "p1")
+	f := makeFactory()
+	f.rows = []runtime.Object{p1}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	assert.Nil(t, ta.reconcile(ctx))
+	assert.Equal(t, 1, len(ta.Peek().RowEvents))
+
+	p2 := p1.DeepCopy()
+	p2.SetName("fred")
+	ta.ResourceChanged("v1/pods", p2)
+	ta.ResourceDeleted("v1/pods", p1)
+
+	changed, err := ta.reconcileDeltas(ctx)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	data := ta.Peek()
+	assert.Equal(t, 1, len(data.RowEvents))
+	assert.Equal(t, "default/fred", data.RowEvents[0].Row.ID)
+	assert.Equal(t, render.EventAdd, data.RowEvents[0].Kind)
+	assert.Equal(t, len(data.Header), len(data.RowEvents[0].Row.Fields))
+
+	changed, err = ta.reconcileDeltas(ctx)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, render.EventUnchanged, ta.Peek().RowEvents[0].Kind)
+
+	changed, err = ta.reconcileDeltas(ctx)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+}
+
+func TestTableRefreshDropsDeltas(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.ResourceChanged("v1/pods", load(t, "p1"))
+	ta.ResourceChanged("v1/pods", load(t, "p1"))
+
+	assert.Equal(t, 1, len(ta.drain()))
+	assert.Nil(t, ta.drain())
+}
+
+func TestTableWatchable(t *testing.T) {
+	uu := map[string]struct {
+		gvr, instance string
+		queries       []client.PromQuery
+		e             bool
+	}{
+		"pods":     {gvr: "v1/pods", e: true},
+		"deploy":   {gvr: "apps/v1/deployments", e: true},
+		"default":  {gvr: "v1/configmaps"},
+		"instance": {gvr: "v1/pods", instance: "default/fred"},
+		"prom":     {gvr: "v1/pods", queries: []client.PromQuery{{Name: "RPS"}}},
+		"pseudo":   {gvr: "containers"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR(u.gvr))
+			ta.SetInstance(u.instance)
+			ctx := context.WithValue(context.Background(), internal.KeyPromQueries, u.queries)
+
+			_, ok := ta.watchable(ctx)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
@@ -11,9 +11,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -31,6 +33,8 @@ type TreeListener interface {
 
 // Tree represents a tree model.
 type Tree struct {
+	*Refresher
+
 	gvr         client.GVR
 	namespace   string
 	root        *xray.TreeNode
@@ -38,11 +42,13 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	instance    string
 }
 
 // NewTree returns a new model.
 func NewTree(gvr client.GVR) *Tree {
 	return &Tree{
+		Refresher:   NewRefresher(),
 		gvr:         gvr,
 		refreshRate: 2 * time.Second,
 	}
@@ -53,6 +59,11 @@ func (t *Tree) ClearFilter() {
 	t.query = ""
 }
 
+// SetInstance scopes the tree to a single resource instance.
+func (t *Tree) SetInstance(path string) {
+	t.instance = path
+}
+
 // SetFilter sets the current filter.
 func (t *Tree) SetFilter(q string) {
 	t.query = q
@@ -167,8 +178,11 @@ func (t *Tree) updater(ctx context.Context) {
 		case <-ctx.Done():
 			t.root = nil
 			return
+		case <-t.Wake():
+			rate = t.Scale(t.refreshRate)
+			t.refresh(ctx)
 		case <-time.After(rate):
-			rate = t.refreshRate
+			rate = t.Scale(t.refreshRate)
 			t.refresh(ctx)
 		}
 	}
@@ -180,6 +194,7 @@ func (t *Tree) refresh(ctx context.Context) {
 		return
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
+	defer perf.TrackRefresh(t.gvr.String(), time.Now())
 
 	if err := t.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
@@ -218,11 +233,12 @@ func (t *Tree) reconcile(ctx context.Context) error {
 			return err
 		}
 	} else {
-		if err := treeHydrate(ctx, ns, oo, meta.TreeRenderer); err != nil {
+		if err := treeHydrate(ctx, ns, t.selectInstance(oo), meta.TreeRenderer); err != nil {
 			return err
 		}
 	}
 
+	root.Rollup()
 	root.Sort()
 	if t.query != "" {
 		t.root = root.Filter(t.query, rxFilter)
@@ -235,6 +251,23 @@ func (t *Tree) reconcile(ctx context.Context) error {
 	return nil
 }
 
+func (t *Tree) selectInstance(oo []runtime.Object) []runtime.Object {
+	if t.instance == "" {
+		return oo
+	}
+	for _, o := range oo {
+		m, err := meta.Accessor(o)
+		if err != nil {
+			continue
+		}
+		if client.FQN(m.GetNamespace(), m.GetName()) == t.instance {
+			return []runtime.Object{o}
+		}
+	}
+
+	return nil
+}
+
 func (t *Tree) resourceMeta() ResourceMeta {
 	meta, ok := Registry[t.gvr.String()]
 	if !ok {
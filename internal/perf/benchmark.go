@@ -1,24 +1,28 @@
 package perf
 
 import (
-	"bytes"
+	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
-	"github.com/rakyll/hey/requester"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	benchFmat = "%s_%s_%d.txt"
-	k9sUA     = "k9s/"
+	benchFmat      = "%s_%s_%d.txt"
+	k9sUA          = "k9s/"
+	requestTimeout = 20 * time.Second
 )
 
 // K9sBenchDir directory to store K9s Benchmark files.
@@ -26,14 +30,19 @@ var K9sBenchDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-bench-%s", config
 
 // Benchmark puts a workload under load.
 type Benchmark struct {
-	canceled bool
 	config   config.BenchConfig
-	worker   *requester.Work
+	request  *http.Request
+	client   *http.Client
+	stats    *Stats
+	previous *Summary
+	cancelFn context.CancelFunc
+	mx       sync.RWMutex
+	canceled bool
 }
 
 // NewBenchmark returns a new benchmark.
 func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, error) {
-	b := Benchmark{config: cfg}
+	b := Benchmark{config: cfg, stats: NewStats()}
 	if err := b.init(base, version); err != nil {
 		return nil, err
 	}
@@ -63,48 +72,190 @@ func (b *Benchmark) init(base, version string) error {
 		req.Header = make(http.Header)
 	}
 	req.Header.Set("User-Agent", ua)
+	b.request = req
 
-	log.Debug().Msgf("Benching %d:%d", b.config.N, b.config.C)
+	if b.config.C <= 0 {
+		b.config.C = config.DefaultC
+	}
+	if b.config.N <= 0 && b.config.Duration <= 0 {
+		b.config.N = config.DefaultN
+	}
+	log.Debug().Msgf("Benching %d:%d for %ds ramping up %ds", b.config.N, b.config.C, b.config.Duration, b.config.RampUp)
 
-	b.worker = &requester.Work{
-		Request:     req,
-		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
-		C:           b.config.C,
-		H2:          b.config.HTTP.HTTP2,
-		Output:      "",
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+			ServerName:         req.Host,
+		},
+		MaxIdleConnsPerHost: b.config.C,
+		ForceAttemptHTTP2:   b.config.HTTP.HTTP2,
+	}
+	if !b.config.HTTP.HTTP2 {
+		tr.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
 	}
+	b.client = &http.Client{Transport: tr, Timeout: requestTimeout}
 
 	return nil
 }
 
+// Stats returns the benchmark live results.
+func (b *Benchmark) Stats() *Stats {
+	return b.stats
+}
+
+// Report returns the benchmark current report.
+func (b *Benchmark) Report() string {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	return b.stats.Report(b.previous)
+}
+
 // Cancel kills the benchmark in progress.
 func (b *Benchmark) Cancel() {
 	if b == nil {
 		return
 	}
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
 	b.canceled = true
-	b.worker.Stop()
+	if b.cancelFn != nil {
+		b.cancelFn()
+	}
 }
 
 // Canceled checks if the benchmark was canceled.
 func (b *Benchmark) Canceled() bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
 	return b.canceled
 }
 
 // Run starts a benchmark,
 func (b *Benchmark) Run(cluster string, done func()) {
-	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	b.worker.Run()
-	if !b.canceled {
-		if err := b.save(cluster, buff); err != nil {
+	ctx, cancel := context.WithCancel(context.Background())
+	if b.config.Duration > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Duration(b.config.Duration)*time.Second)
+	}
+	b.mx.Lock()
+	b.cancelFn = cancel
+	b.previous = b.loadPrevious(cluster)
+	canceled := b.canceled
+	b.mx.Unlock()
+	defer cancel()
+
+	if !canceled {
+		b.stats.Start()
+		b.run(ctx)
+		b.stats.Done()
+	}
+	if !b.Canceled() {
+		if err := b.save(cluster, strings.NewReader(b.Report())); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
 		}
 	}
 	done()
 }
 
+// run spreads the requests across workers, ramping up concurrency if needed.
+func (b *Benchmark) run(ctx context.Context) {
+	var (
+		wg    sync.WaitGroup
+		count int64
+	)
+	ticket := func() bool {
+		return b.config.N <= 0 || atomic.AddInt64(&count, 1) <= int64(b.config.N)
+	}
+
+	var step time.Duration
+	if b.config.RampUp > 0 && b.config.C > 1 {
+		step = time.Duration(b.config.RampUp) * time.Second / time.Duration(b.config.C-1)
+	}
+	for i := 0; i < b.config.C; i++ {
+		if i > 0 && step > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(step):
+			}
+		}
+		if ctx.Err() != nil || (b.config.N > 0 && atomic.LoadInt64(&count) >= int64(b.config.N)) {
+			break
+		}
+		b.stats.SetWorkers(i + 1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil && ticket() {
+				b.hit(ctx)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (b *Benchmark) hit(ctx context.Context) {
+	req := b.request.WithContext(ctx)
+	req.Header = b.request.Header.Clone()
+	if body := b.config.HTTP.Body; body != "" {
+		req.Body = ioutil.NopCloser(strings.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	start := time.Now()
+	resp, err := b.client.Do(req)
+	if err == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		if e := resp.Body.Close(); e != nil {
+			log.Warn().Err(e).Msg("Bench closing response")
+		}
+	}
+	// Requests interrupted by a timeout or a cancel are not accounted for.
+	if ctx.Err() != nil {
+		return
+	}
+	code := 0
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	b.stats.Record(time.Since(start), code, err)
+}
+
+// loadPrevious returns the summary of the latest benchmark run on the same target if any.
+func (b *Benchmark) loadPrevious(cluster string) *Summary {
+	dir := filepath.Join(K9sBenchDir, cluster)
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	ns, n := client.Namespaced(b.config.Name)
+	prefix := fmt.Sprintf("%s_%s_", ns, n)
+	var latest os.FileInfo
+	for _, f := range ff {
+		if !strings.HasPrefix(f.Name(), prefix) {
+			continue
+		}
+		if latest == nil || f.ModTime().After(latest.ModTime()) {
+			latest = f
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	bb, err := ioutil.ReadFile(filepath.Join(dir, latest.Name()))
+	if err != nil {
+		return nil
+	}
+	s, ok := ParseSummary(string(bb))
+	if !ok {
+		return nil
+	}
+
+	return &s
+}
+
 func (b *Benchmark) save(cluster string, r io.Reader) error {
 	dir := filepath.Join(K9sBenchDir, cluster)
 	if err := os.MkdirAll(dir, 0744); err != nil {
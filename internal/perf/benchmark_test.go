@@ -0,0 +1,114 @@
+package perf_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchmarkRun(t *testing.T) {
+	var hits int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "blee", r.Header.Get("X-Fred"))
+		bb, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"fred": "blee"}`, string(bb))
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	perf.K9sBenchDir = dir
+
+	cfg := config.DefaultBenchSpec()
+	cfg.Name, cfg.C, cfg.N = "default/fred", 2, 20
+	cfg.HTTP.Method, cfg.HTTP.Body = "POST", `{"fred": "blee"}`
+	cfg.HTTP.Headers = http.Header{"X-Fred": []string{"blee"}}
+
+	run := func() *perf.Benchmark {
+		b, err := perf.NewBenchmark(srv.URL, "test", cfg)
+		assert.Nil(t, err)
+		done := make(chan struct{})
+		b.Run("zorg", func() { close(done) })
+		<-done
+		return b
+	}
+
+	b := run()
+	assert.Equal(t, int64(20), atomic.LoadInt64(&hits))
+	report := b.Report()
+	assert.Contains(t, report, "[200]\t20 responses")
+	assert.NotContains(t, report, "Previous run")
+
+	b = run()
+	assert.Contains(t, b.Report(), "Previous run")
+	ff, err := ioutil.ReadDir(dir + "/zorg")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ff))
+}
+
+func TestBenchmarkRunDuration(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	perf.K9sBenchDir = dir
+
+	cfg := config.DefaultBenchSpec()
+	cfg.Name, cfg.C, cfg.N, cfg.Duration, cfg.RampUp = "default/fred", 4, 0, 2, 1
+	b, err := perf.NewBenchmark(srv.URL, "test", cfg)
+	assert.Nil(t, err)
+
+	start := time.Now()
+	b.Run("zorg", func() {})
+	assert.True(t, time.Since(start) >= 2*time.Second)
+	assert.Contains(t, b.Report(), "Workers:\t4")
+}
+
+func TestStatsReport(t *testing.T) {
+	s := perf.NewStats()
+	s.Start()
+	for i := 1; i <= 100; i++ {
+		s.Record(time.Duration(i)*time.Millisecond, 200, nil)
+	}
+	s.Record(0, 0, errors.New("boom"))
+	s.Done()
+
+	sum := s.Summary()
+	assert.Equal(t, 50500*time.Microsecond, sum.Average)
+	assert.Equal(t, 50*time.Millisecond, sum.P50)
+	assert.Equal(t, 90*time.Millisecond, sum.P90)
+	assert.Equal(t, 99*time.Millisecond, sum.P99)
+
+	prev := perf.Summary{RPS: sum.RPS / 2, Average: sum.Average * 2}
+	report := s.Report(&prev)
+	assert.Contains(t, report, "Response time histogram")
+	assert.Contains(t, report, "[1]\tboom")
+	assert.Contains(t, report, "(+100.0%)")
+	assert.Contains(t, report, "(-50.0%)")
+
+	parsed, ok := perf.ParseSummary(report)
+	assert.True(t, ok)
+	assert.Equal(t, 50*time.Millisecond, parsed.P50)
+	assert.Equal(t, 99*time.Millisecond, parsed.P99)
+	assert.Equal(t, sum.Average, parsed.Average)
+	assert.Contains(t, report, "Requests/sec:")
+}
+
+func TestParseSummaryToast(t *testing.T) {
+	_, ok := perf.ParseSummary("blee")
+	assert.False(t, ok)
+}
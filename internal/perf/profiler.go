@@ -0,0 +1,70 @@
+package perf
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultProfilerAddr represents the default pprof endpoint address.
+const DefaultProfilerAddr = "localhost:6060"
+
+// Profiler serves k9s pprof profiles over http.
+type Profiler struct {
+	addr   string
+	server *http.Server
+	mx     sync.Mutex
+}
+
+// NewProfiler returns a new profiler for a given address.
+func NewProfiler(addr string) *Profiler {
+	return &Profiler{addr: addr}
+}
+
+// Addr returns the profiler endpoint address.
+func (p *Profiler) Addr() string {
+	return p.addr
+}
+
+// IsActive returns true if the pprof endpoint is served.
+func (p *Profiler) IsActive() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return p.server != nil
+}
+
+// Toggle starts or stops serving the pprof endpoint. Returns true if active.
+func (p *Profiler) Toggle() (bool, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.server != nil {
+		err := p.server.Shutdown(context.Background())
+		p.server = nil
+		return false, err
+	}
+
+	l, err := net.Listen("tcp", p.addr)
+	if err != nil {
+		return false, err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	p.server = &http.Server{Handler: mux}
+	go func(s *http.Server) {
+		if err := s.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msgf("Profiler failed on %s", p.addr)
+		}
+	}(p.server)
+
+	return true, nil
+}
@@ -0,0 +1,62 @@
+package perf
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// RefreshStat tracks a resource model refresh durations.
+type RefreshStat struct {
+	GVR   string
+	Count int
+	Last  time.Duration
+	Max   time.Duration
+	At    time.Time
+}
+
+// refreshStats tracks all models refresh durations.
+type refreshStats struct {
+	stats map[string]RefreshStat
+	mx    sync.RWMutex
+}
+
+var refreshes = refreshStats{stats: make(map[string]RefreshStat)}
+
+// TrackRefresh records a resource model refresh that started at a given time.
+func TrackRefresh(gvr string, start time.Time) {
+	refreshes.track(gvr, start)
+}
+
+// RefreshStats returns the models refresh durations sorted by resource.
+func RefreshStats() []RefreshStat {
+	return refreshes.list()
+}
+
+func (r *refreshStats) track(gvr string, start time.Time) {
+	d := time.Since(start)
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	s := r.stats[gvr]
+	s.GVR, s.Count, s.Last, s.At = gvr, s.Count+1, d, start
+	if d > s.Max {
+		s.Max = d
+	}
+	r.stats[gvr] = s
+}
+
+func (r *refreshStats) list() []RefreshStat {
+	r.mx.RLock()
+	ss := make([]RefreshStat, 0, len(r.stats))
+	for _, s := range r.stats {
+		ss = append(ss, s)
+	}
+	r.mx.RUnlock()
+
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].GVR < ss[j].GVR
+	})
+
+	return ss
+}
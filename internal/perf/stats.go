@@ -0,0 +1,291 @@
+package perf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	barChar  = "■"
+	barWidth = 40
+)
+
+// latencyBuckets tracks the response time histogram buckets upper bounds.
+var latencyBuckets = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+var (
+	summaryRPSRx = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	summaryAvgRx = regexp.MustCompile(`Average:\s+([0-9.]+)\ssecs`)
+	summaryPctRx = regexp.MustCompile(`(\d+)% in ([0-9.]+) secs`)
+)
+
+// Summary represents a benchmark run outcome.
+type Summary struct {
+	Total, Average, P50, P90, P99 time.Duration
+	RPS                           float64
+}
+
+// ParseSummary extracts a summary from a benchmark report.
+func ParseSummary(report string) (Summary, bool) {
+	var s Summary
+	m := summaryRPSRx.FindStringSubmatch(report)
+	if m == nil {
+		return s, false
+	}
+	s.RPS, _ = strconv.ParseFloat(m[1], 64)
+	if m := summaryAvgRx.FindStringSubmatch(report); m != nil {
+		s.Average = toDuration(m[1])
+	}
+	for _, m := range summaryPctRx.FindAllStringSubmatch(report, -1) {
+		switch m[1] {
+		case "50":
+			s.P50 = toDuration(m[2])
+		case "90":
+			s.P90 = toDuration(m[2])
+		case "99":
+			s.P99 = toDuration(m[2])
+		}
+	}
+
+	return s, true
+}
+
+// Stats tracks a benchmark results as requests complete.
+type Stats struct {
+	mx               sync.RWMutex
+	start, end       time.Time
+	workers          int
+	fastest, slowest time.Duration
+	total            time.Duration
+	latencies        []time.Duration
+	buckets          []int
+	codes            map[int]int
+	errors           map[string]int
+}
+
+// NewStats returns a new benchmark stats.
+func NewStats() *Stats {
+	return &Stats{
+		buckets: make([]int, len(latencyBuckets)+1),
+		codes:   make(map[int]int),
+		errors:  make(map[string]int),
+	}
+}
+
+// Start records the benchmark started.
+func (s *Stats) Start() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.start = time.Now()
+}
+
+// Done records the benchmark completed.
+func (s *Stats) Done() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.end = time.Now()
+}
+
+// SetWorkers records the current number of concurrent workers.
+func (s *Stats) SetWorkers(n int) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.workers = n
+}
+
+// Record tracks a request outcome.
+func (s *Stats) Record(d time.Duration, code int, err error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if err != nil {
+		s.errors[err.Error()]++
+		return
+	}
+	s.codes[code]++
+	if len(s.latencies) == 0 || d < s.fastest {
+		s.fastest = d
+	}
+	if d > s.slowest {
+		s.slowest = d
+	}
+	s.total += d
+	s.latencies = append(s.latencies, d)
+	s.buckets[bucketFor(d)]++
+}
+
+// Summary returns the current results summary.
+func (s *Stats) Summary() Summary {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.summary()
+}
+
+// Report returns the benchmark report, comparing it to a previous run if any.
+func (s *Stats) Report(prev *Summary) string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	sum, buff := s.summary(), new(bytes.Buffer)
+	fmt.Fprintf(buff, "\nSummary:\n")
+	fmt.Fprintf(buff, "  Total:\t%.4f secs\n", sum.Total.Seconds())
+	fmt.Fprintf(buff, "  Slowest:\t%.4f secs\n", s.slowest.Seconds())
+	fmt.Fprintf(buff, "  Fastest:\t%.4f secs\n", s.fastest.Seconds())
+	fmt.Fprintf(buff, "  Average:\t%.4f secs\n", sum.Average.Seconds())
+	fmt.Fprintf(buff, "  Requests/sec:\t%.4f\n", sum.RPS)
+	fmt.Fprintf(buff, "  Workers:\t%d\n", s.workers)
+
+	if len(s.latencies) > 0 {
+		fmt.Fprintf(buff, "\nResponse time histogram:\n")
+		s.histogram(buff)
+		fmt.Fprintf(buff, "\nLatency distribution:\n")
+		fmt.Fprintf(buff, "  50%% in %.4f secs\n", sum.P50.Seconds())
+		fmt.Fprintf(buff, "  90%% in %.4f secs\n", sum.P90.Seconds())
+		fmt.Fprintf(buff, "  99%% in %.4f secs\n", sum.P99.Seconds())
+	}
+
+	if len(s.codes) > 0 {
+		fmt.Fprintf(buff, "\nStatus code distribution:\n")
+		cc := make([]int, 0, len(s.codes))
+		for c := range s.codes {
+			cc = append(cc, c)
+		}
+		sort.Ints(cc)
+		for _, c := range cc {
+			fmt.Fprintf(buff, "  [%d]\t%d responses\n", c, s.codes[c])
+		}
+	}
+
+	if len(s.errors) > 0 {
+		fmt.Fprintf(buff, "\nError distribution:\n")
+		ee := make([]string, 0, len(s.errors))
+		for e := range s.errors {
+			ee = append(ee, e)
+		}
+		sort.Strings(ee)
+		for _, e := range ee {
+			fmt.Fprintf(buff, "  [%d]\t%s\n", s.errors[e], e)
+		}
+	}
+
+	if prev != nil {
+		fmt.Fprintf(buff, "\nPrevious run:\n")
+		fmt.Fprintf(buff, "  Req/sec:\t%.4f (%s)\n", prev.RPS, delta(sum.RPS, prev.RPS))
+		fmt.Fprintf(buff, "  Avg:\t%.4f secs (%s)\n", prev.Average.Seconds(), delta(sum.Average.Seconds(), prev.Average.Seconds()))
+		fmt.Fprintf(buff, "  P50:\t%.4f secs (%s)\n", prev.P50.Seconds(), delta(sum.P50.Seconds(), prev.P50.Seconds()))
+		fmt.Fprintf(buff, "  P90:\t%.4f secs (%s)\n", prev.P90.Seconds(), delta(sum.P90.Seconds(), prev.P90.Seconds()))
+		fmt.Fprintf(buff, "  P99:\t%.4f secs (%s)\n", prev.P99.Seconds(), delta(sum.P99.Seconds(), prev.P99.Seconds()))
+	}
+
+	return buff.String()
+}
+
+func (s *Stats) summary() Summary {
+	var sum Summary
+	if s.start.IsZero() {
+		return sum
+	}
+	end := s.end
+	if end.IsZero() {
+		end = time.Now()
+	}
+	sum.Total = end.Sub(s.start)
+
+	n := len(s.latencies)
+	if n == 0 {
+		return sum
+	}
+	if sum.Total > 0 {
+		sum.RPS = float64(n) / sum.Total.Seconds()
+	}
+	sum.Average = s.total / time.Duration(n)
+
+	ll := make([]time.Duration, n)
+	copy(ll, s.latencies)
+	sort.Slice(ll, func(i, j int) bool { return ll[i] < ll[j] })
+	sum.P50, sum.P90, sum.P99 = percentile(ll, 50), percentile(ll, 90), percentile(ll, 99)
+
+	return sum
+}
+
+func (s *Stats) histogram(w *bytes.Buffer) {
+	var max int
+	for _, c := range s.buckets {
+		if c > max {
+			max = c
+		}
+	}
+	for i, c := range s.buckets {
+		if c == 0 {
+			continue
+		}
+		label := ">" + fmt.Sprintf("%.3f", latencyBuckets[len(latencyBuckets)-1].Seconds())
+		if i < len(latencyBuckets) {
+			label = fmt.Sprintf("%.3f", latencyBuckets[i].Seconds())
+		}
+		fmt.Fprintf(w, "  %s [%d]\t|%s\n", label, c, strings.Repeat(barChar, c*barWidth/max))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func bucketFor(d time.Duration) int {
+	for i, b := range latencyBuckets {
+		if d <= b {
+			return i
+		}
+	}
+
+	return len(latencyBuckets)
+}
+
+func percentile(ll []time.Duration, p int) time.Duration {
+	i := len(ll)*p/100 - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return ll[i]
+}
+
+func delta(cur, prev float64) string {
+	if prev == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%+.1f%%", (cur-prev)/prev*100)
+}
+
+func toDuration(secs string) time.Duration {
+	f, err := strconv.ParseFloat(secs, 64)
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(f * float64(time.Second))
+}
@@ -0,0 +1,113 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Access renders an access review matrix to screen.
+type Access struct{}
+
+// ColorerFunc colors a resource row.
+func (Access) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		var allowed, denied int
+		for _, v := range k8sVerbs {
+			col := h.IndexOf(accessVerbCol(v), true)
+			if col == -1 {
+				continue
+			}
+			switch re.Row.Fields[col] {
+			case toVerbIcon(true):
+				allowed++
+			case toVerbIcon(false):
+				denied++
+			}
+		}
+		switch {
+		case allowed > 0 && denied == 0:
+			return AddColor
+		case allowed > 0:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Access) Header(_ string) Header {
+	h := Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "SUBJECT"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "RESOURCE"},
+	}
+	for _, v := range k8sVerbs {
+		h = append(h, HeaderColumn{Name: accessVerbCol(v)})
+	}
+
+	return h
+}
+
+// Render renders an access review to screen.
+func (Access) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(AccessRes)
+	if !ok {
+		return fmt.Errorf("expected AccessRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Kind+":"+res.Subject)
+	r.Fields = Fields{
+		res.Kind,
+		res.Subject,
+		res.Namespace,
+		res.Resource,
+	}
+	for _, v := range k8sVerbs {
+		r.Fields = append(r.Fields, toAccessIcon(res.Checked, res.Allowed, v))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func accessVerbCol(v string) string {
+	if v == "deletecollection" {
+		return "DEL-LIST"
+	}
+
+	return strings.ToUpper(v)
+}
+
+func toAccessIcon(checked, allowed []string, verb string) string {
+	if !hasVerb(checked, verb) {
+		return NAValue
+	}
+
+	return toVerbIcon(hasVerb(allowed, verb))
+}
+
+// AccessRes represents the outcome of access reviews for a subject.
+type AccessRes struct {
+	Kind, Subject       string
+	Namespace, Resource string
+	Checked, Allowed    []string
+}
+
+// GetObjectKind returns a schema object.
+func (AccessRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AccessRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,56 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessRender(t *testing.T) {
+	const (
+		ok   = "[green::b] ✓ [::]"
+		nok  = "[orangered::b] 𐄂 [::]"
+		none = "n/a"
+	)
+
+	uu := map[string]struct {
+		o  render.AccessRes
+		id string
+		e  render.Fields
+	}{
+		"checked": {
+			o: render.AccessRes{
+				Kind:      "User",
+				Subject:   "fred",
+				Namespace: "default",
+				Resource:  "pods",
+				Checked:   []string{"get", "list", "delete"},
+				Allowed:   []string{"get", "list"},
+			},
+			id: "default/User:fred",
+			e:  render.Fields{"User", "fred", "default", "pods", ok, ok, none, none, none, none, nok, none},
+		},
+		"clusterWide": {
+			o: render.AccessRes{
+				Kind:     "Group",
+				Subject:  "blee",
+				Resource: "nodes",
+				Checked:  []string{"*"},
+			},
+			id: "Group:blee",
+			e:  render.Fields{"Group", "blee", "", "nodes", nok, nok, nok, nok, nok, nok, nok, nok},
+		},
+	}
+
+	var a render.Access
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, a.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
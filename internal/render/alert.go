@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// AlertErr tracks an error alert.
+	AlertErr = "ERR"
+	// AlertWarn tracks a warning alert.
+	AlertWarn = "WARN"
+)
+
+// Alert renders an alert inbox entry to screen.
+type Alert struct{}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		levelCol, ackCol := h.IndexOf("LEVEL", true), h.IndexOf("ACK", true)
+		if levelCol == -1 || ackCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch {
+		case re.Row.Fields[ackCol] == "true":
+			return CompletedColor
+		case re.Row.Fields[levelCol] == AlertErr:
+			return ErrColor
+		default:
+			return HighlightColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "LEVEL"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "ACK"},
+		HeaderColumn{Name: "FIRST"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an alert to screen.
+func (Alert) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AlertRes)
+	if !ok {
+		return fmt.Errorf("expected AlertRes, but got %T", o)
+	}
+
+	r.ID = a.ID
+	r.Fields = Fields{
+		a.Source,
+		a.Level,
+		a.Message,
+		strconv.Itoa(a.Count),
+		boolToStr(a.Acked),
+		duration.HumanDuration(time.Since(a.First)) + " ago",
+		timeToAge(a.Last),
+	}
+
+	return nil
+}
+
+// AlertRes represents an alert inbox entry.
+type AlertRes struct {
+	ID      string
+	Source  string
+	Level   string
+	Message string
+	Count   int
+	Acked   bool
+	First   time.Time
+	Last    time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
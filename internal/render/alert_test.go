@@ -0,0 +1,30 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRender(t *testing.T) {
+	now := time.Now()
+	res := render.AlertRes{
+		ID:      "1",
+		Source:  "api",
+		Level:   render.AlertErr,
+		Message: "boom",
+		Count:   3,
+		First:   now.Add(-2 * time.Minute),
+		Last:    now,
+	}
+
+	var (
+		a render.Alert
+		r render.Row
+	)
+	assert.Nil(t, a.Render(res, "", &r))
+	assert.Equal(t, "1", r.ID)
+	assert.Equal(t, render.Fields{"api", render.AlertErr, "boom", "3", "false", "2m ago"}, r.Fields[:6])
+}
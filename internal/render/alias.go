@@ -26,6 +26,7 @@ func (Alias) Header(ns string) Header {
 		HeaderColumn{Name: "RESOURCE"},
 		HeaderColumn{Name: "COMMAND"},
 		HeaderColumn{Name: "APIGROUP"},
+		HeaderColumn{Name: "ARGS"},
 	}
 }
 
@@ -37,13 +38,14 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 		return fmt.Errorf("expected AliasRes, but got %T", o)
 	}
 
-	r.ID = a.GVR
+	r.ID = a.Command()
 	gvr := client.NewGVR(a.GVR)
 	res, grp := gvr.RG()
 	r.Fields = append(r.Fields,
 		res,
 		strings.Join(a.Aliases, ","),
 		grp,
+		a.Args,
 	)
 
 	return nil
@@ -55,9 +57,19 @@ func (Alias) Render(o interface{}, ns string, r *Row) error {
 // AliasRes represents an alias resource.
 type AliasRes struct {
 	GVR     string
+	Args    string
 	Aliases []string
 }
 
+// Command returns the command the aliases expand to.
+func (a AliasRes) Command() string {
+	if a.Args == "" {
+		return a.GVR
+	}
+
+	return a.GVR + " " + a.Args
+}
+
 // GetObjectKind returns a schema object.
 func (AliasRes) GetObjectKind() schema.ObjectKind {
 	return nil
@@ -50,6 +50,7 @@ func TestAliasHeader(t *testing.T) {
 		render.HeaderColumn{Name: "RESOURCE"},
 		render.HeaderColumn{Name: "COMMAND"},
 		render.HeaderColumn{Name: "APIGROUP"},
+		render.HeaderColumn{Name: "ARGS"},
 	}
 
 	var a render.Alias
@@ -67,7 +68,21 @@ func TestAliasRender(t *testing.T) {
 
 	var r render.Row
 	assert.Nil(t, a.Render(o, "fred/v1/blee", &r))
-	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred"}}, r)
+	assert.Equal(t, render.Row{ID: "fred/v1/blee", Fields: render.Fields{"blee", "a,b,c", "fred", ""}}, r)
+}
+
+func TestAliasRenderArgs(t *testing.T) {
+	a := render.Alias{}
+
+	o := render.AliasRes{
+		GVR:     "v1/pods",
+		Args:    "kube-system /coredns",
+		Aliases: []string{"cp"},
+	}
+
+	var r render.Row
+	assert.Nil(t, a.Render(o, "", &r))
+	assert.Equal(t, render.Row{ID: "v1/pods kube-system /coredns", Fields: render.Fields{"pods", "cp", "", "kube-system /coredns"}}, r)
 }
 
 func BenchmarkAlias(b *testing.B) {
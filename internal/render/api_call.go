@@ -0,0 +1,87 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// APICall renders a traced api server call to screen.
+type APICall struct{}
+
+// ColorerFunc colors a resource row.
+func (APICall) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol, slowCol := h.IndexOf("STATUS", true), h.IndexOf("SLOW", true)
+		if statusCol == -1 || slowCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if status, err := strconv.Atoi(re.Row.Fields[statusCol]); err != nil || status >= 400 {
+			return ErrColor
+		}
+		if re.Row.Fields[slowCol] == "true" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (APICall) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "VERB"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS", Align: tview.AlignRight},
+		HeaderColumn{Name: "BYTES", Align: tview.AlignRight},
+		HeaderColumn{Name: "LATENCY(ms)", Align: tview.AlignRight},
+		HeaderColumn{Name: "SLOW"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an api call to screen.
+func (APICall) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(APICallRes)
+	if !ok {
+		return fmt.Errorf("expected APICallRes, but got %T", o)
+	}
+
+	r.ID = strconv.FormatInt(res.ID, 10)
+	r.Fields = Fields{
+		res.Verb,
+		res.GVR,
+		na(res.Namespace),
+		na(res.Name),
+		strconv.Itoa(res.Status),
+		strconv.FormatInt(res.Bytes, 10),
+		strconv.FormatInt(int64(res.Latency/time.Millisecond), 10),
+		boolToStr(res.Slow),
+		timeToAge(res.At),
+	}
+
+	return nil
+}
+
+// APICallRes represents a traced api server call.
+type APICallRes struct {
+	client.APICall
+}
+
+// GetObjectKind returns a schema object.
+func (APICallRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a APICallRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,33 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPICallRender(t *testing.T) {
+	res := render.APICallRes{APICall: client.APICall{
+		ID:        12,
+		Verb:      "get",
+		GVR:       "v1/pods",
+		Namespace: "fred",
+		Name:      "p1",
+		Status:    200,
+		Bytes:     1024,
+		Latency:   1500 * time.Millisecond,
+		Slow:      true,
+		At:        time.Now().Add(-time.Minute),
+	}}
+
+	var (
+		a render.APICall
+		r render.Row
+	)
+	assert.Nil(t, a.Render(res, "", &r))
+	assert.Equal(t, "12", r.ID)
+	assert.Equal(t, render.Fields{"get", "v1/pods", "fred", "p1", "200", "1024", "1500", "true"}, r.Fields[:8])
+}
@@ -0,0 +1,108 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of apply results.
+const (
+	ApplyCreated    = "created"
+	ApplyConfigured = "configured"
+	ApplyUnchanged  = "unchanged"
+	ApplyFailed     = "failed"
+)
+
+// Apply renders a manifest apply result to screen.
+type Apply struct{}
+
+// ColorerFunc colors a resource row.
+func (Apply) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		col := h.IndexOf("RESULT", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[col] {
+		case ApplyCreated:
+			return AddColor
+		case ApplyConfigured:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Apply) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "RESULT"},
+		HeaderColumn{Name: "MESSAGE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "GVR", Wide: true},
+	}
+}
+
+// Render renders an apply result to screen.
+func (a Apply) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ApplyRes)
+	if !ok {
+		return fmt.Errorf("expected ApplyRes, but got %T", o)
+	}
+
+	r.ID = res.GVR + ":" + client.FQN(res.Namespace, res.Name)
+	r.Fields = Fields{
+		res.Kind,
+		res.Namespace,
+		res.Name,
+		res.Result,
+		res.Message,
+		asStatus(a.diagnose(res)),
+		res.GVR,
+	}
+
+	return nil
+}
+
+func (Apply) diagnose(res ApplyRes) error {
+	if res.Result == ApplyFailed {
+		return errors.New(res.Message)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ApplyRes represents the outcome of applying a manifest object.
+type ApplyRes struct {
+	GVR       string
+	Kind      string
+	Namespace string
+	Name      string
+	Result    string
+	Message   string
+}
+
+// GetObjectKind returns a schema object.
+func (ApplyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a ApplyRes) DeepCopyObject() runtime.Object {
+	return a
+}
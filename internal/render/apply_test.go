@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.ApplyRes
+		id string
+		e  render.Fields
+	}{
+		"created": {
+			o:  render.ApplyRes{GVR: "apps/v1/deployments", Kind: "Deployment", Namespace: "default", Name: "fred", Result: render.ApplyCreated},
+			id: "apps/v1/deployments:default/fred",
+			e:  render.Fields{"Deployment", "default", "fred", "created", "", "", "apps/v1/deployments"},
+		},
+		"failed": {
+			o:  render.ApplyRes{Kind: "Zorg", Namespace: "default", Name: "fred", Result: render.ApplyFailed, Message: "unknown resource kind"},
+			id: ":default/fred",
+			e:  render.Fields{"Zorg", "default", "fred", "failed", "unknown resource kind", "unknown resource kind", ""},
+		},
+	}
+
+	var a render.Apply
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, a.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Audit renders an audit trail entry to screen.
+type Audit struct{}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		actionCol, errCol := h.IndexOf("ACTION", true), h.IndexOf("ERROR", true)
+		if actionCol == -1 || errCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch {
+		case re.Row.Fields[errCol] != "":
+			return ErrColor
+		case re.Row.Fields[actionCol] == "delete":
+			return KillColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "TIME"},
+		HeaderColumn{Name: "USER"},
+		HeaderColumn{Name: "KUBE USER", Wide: true},
+		HeaderColumn{Name: "CONTEXT"},
+		HeaderColumn{Name: "ACTION"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "DETAILS"},
+		HeaderColumn{Name: "ERROR"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an audit entry to screen.
+func (Audit) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expected AuditRes, but got %T", o)
+	}
+
+	r.ID = strconv.FormatInt(a.Time.UnixNano(), 10) + ":" + a.Path
+	r.Fields = Fields{
+		a.Time.Format(time.RFC3339),
+		a.User,
+		a.KubeUser,
+		a.Context,
+		a.Action,
+		a.Resource,
+		a.Path,
+		a.Details,
+		a.Error,
+		timeToAge(a.Time),
+	}
+
+	return nil
+}
+
+// AuditRes represents a mutating action performed via K9s.
+type AuditRes struct {
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+	KubeUser string    `json:"kubeUser,omitempty"`
+	Context  string    `json:"context"`
+	Action   string    `json:"action"`
+	Resource string    `json:"resource,omitempty"`
+	Path     string    `json:"name,omitempty"`
+	Details  string    `json:"details,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
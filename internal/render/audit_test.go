@@ -0,0 +1,40 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	at := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	res := render.AuditRes{
+		Time:     at,
+		User:     "fernand",
+		KubeUser: "admin",
+		Context:  "ctx1",
+		Action:   "scale",
+		Resource: "apps/v1/deployments",
+		Path:     "default/d1",
+		Details:  "replicas=3",
+	}
+
+	var (
+		a render.Audit
+		r render.Row
+	)
+	assert.Nil(t, a.Render(res, "", &r))
+	assert.Equal(t, render.Fields{
+		"2020-04-01T10:00:00Z",
+		"fernand",
+		"admin",
+		"ctx1",
+		"scale",
+		"apps/v1/deployments",
+		"default/d1",
+		"replicas=3",
+		"",
+	}, r.Fields[:9])
+}
@@ -0,0 +1,182 @@
+package render
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// CertExpired tracks a certificate past its expiry.
+	CertExpired = "Expired"
+	// CertCritical tracks a certificate expiring within a week.
+	CertCritical = "Critical"
+	// CertExpiring tracks a certificate expiring within a month.
+	CertExpiring = "Expiring"
+	// CertValid tracks a valid certificate.
+	CertValid = "Valid"
+	// CertInvalid tracks a certificate that could not be parsed.
+	CertInvalid = "Invalid"
+
+	// CertCriticalWindow tracks how soon a certificate expiry is critical.
+	CertCriticalWindow = 7 * 24 * time.Hour
+	// CertExpiringWindow tracks how soon a certificate expiry is flagged.
+	CertExpiringWindow = 30 * 24 * time.Hour
+)
+
+// Cert renders a certificate to screen.
+type Cert struct{}
+
+// ColorerFunc colors a resource row.
+func (Cert) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[statusCol] {
+		case CertExpired, CertInvalid:
+			return ErrColor
+		case CertCritical:
+			return HighlightColor
+		case CertExpiring:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Cert) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "KEY"},
+		HeaderColumn{Name: "#"},
+		HeaderColumn{Name: "SUBJECT"},
+		HeaderColumn{Name: "ISSUER"},
+		HeaderColumn{Name: "SANS"},
+		HeaderColumn{Name: "NOT AFTER", Wide: true},
+		HeaderColumn{Name: "EXPIRES"},
+		HeaderColumn{Name: "STATUS"},
+	}
+}
+
+// Render renders a certificate to screen.
+func (Cert) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(CertRes)
+	if !ok {
+		return fmt.Errorf("expected CertRes, but got %T", o)
+	}
+
+	cns, n := client.Namespaced(res.Path)
+	subject, issuer, sans, notAfter, expires := MissingValue, MissingValue, MissingValue, MissingValue, MissingValue
+	if c := res.Cert; c != nil {
+		subject, issuer = na(c.Subject.CommonName), na(c.Issuer.CommonName)
+		if ss := CertSANs(c); len(ss) > 0 {
+			sans = strings.Join(ss, ",")
+		}
+		notAfter = c.NotAfter.Format(time.RFC3339)
+		expires = CertExpiresIn(c.NotAfter, time.Now())
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		cns,
+		n,
+		res.Kind,
+		res.Key,
+		strconv.Itoa(res.Index),
+		subject,
+		issuer,
+		sans,
+		notAfter,
+		expires,
+		res.Status(time.Now()),
+	}
+
+	return nil
+}
+
+// CertSANs returns a certificate subject alternative names.
+func CertSANs(c *x509.Certificate) []string {
+	ss := make([]string, 0, len(c.DNSNames)+len(c.IPAddresses)+len(c.EmailAddresses)+len(c.URIs))
+	ss = append(ss, c.DNSNames...)
+	for _, ip := range c.IPAddresses {
+		ss = append(ss, ip.String())
+	}
+	ss = append(ss, c.EmailAddresses...)
+	for _, u := range c.URIs {
+		ss = append(ss, u.String())
+	}
+
+	return ss
+}
+
+// CertExpiresIn returns a human readable time to expiry.
+func CertExpiresIn(notAfter, now time.Time) string {
+	d := notAfter.Sub(now)
+	if d < 0 {
+		return duration.HumanDuration(-d) + " ago"
+	}
+
+	return "in " + duration.HumanDuration(d)
+}
+
+// CertRes represents a certificate found in a resource.
+type CertRes struct {
+	// GVR tracks the resource holding the certificate.
+	GVR string
+	// Kind tracks a short resource kind ie secret.
+	Kind string
+	// Path tracks the resource holding the certificate.
+	Path string
+	// Key tracks the secret key or webhook holding the certificate.
+	Key string
+	// Index tracks the certificate position in its chain.
+	Index int
+	Cert  *x509.Certificate
+	Err   error
+}
+
+// ID returns the certificate unique identifier.
+func (c CertRes) ID() string {
+	return strings.Join([]string{c.GVR, c.Path, c.Key, strconv.Itoa(c.Index)}, "|")
+}
+
+// Status returns the certificate expiry status at a given time.
+func (c CertRes) Status(now time.Time) string {
+	if c.Cert == nil || c.Err != nil {
+		return CertInvalid
+	}
+	switch d := c.Cert.NotAfter.Sub(now); {
+	case d <= 0:
+		return CertExpired
+	case d <= CertCriticalWindow:
+		return CertCritical
+	case d <= CertExpiringWindow:
+		return CertExpiring
+	default:
+		return CertValid
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (CertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a certificate copy.
+func (c CertRes) DeepCopyObject() runtime.Object {
+	return c
+}
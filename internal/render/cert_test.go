@@ -0,0 +1,90 @@
+package render_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertRender(t *testing.T) {
+	notAfter := time.Now().Add(10 * 24 * time.Hour)
+	res := render.CertRes{
+		GVR:  "v1/secrets",
+		Kind: "secret",
+		Path: "default/fred-tls",
+		Key:  "tls.crt",
+		Cert: &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "fred.example.com"},
+			Issuer:      pkix.Name{CommonName: "blee-ca"},
+			DNSNames:    []string{"fred.example.com", "www.fred.example.com"},
+			IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			NotAfter:    notAfter,
+		},
+	}
+
+	var (
+		c render.Cert
+		r render.Row
+	)
+	assert.Nil(t, c.Render(res, "", &r))
+	assert.Equal(t, "v1/secrets|default/fred-tls|tls.crt|0", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"fred-tls",
+		"secret",
+		"tls.crt",
+		"0",
+		"fred.example.com",
+		"blee-ca",
+		"fred.example.com,www.fred.example.com,10.0.0.1",
+		notAfter.Format(time.RFC3339),
+		"in 9d",
+		render.CertExpiring,
+	}, r.Fields)
+}
+
+func TestCertRenderInvalid(t *testing.T) {
+	res := render.CertRes{GVR: "v1/secrets", Kind: "secret", Path: "default/fred", Key: "ca.crt", Err: errors.New("boom")}
+
+	var (
+		c render.Cert
+		r render.Row
+	)
+	assert.Nil(t, c.Render(res, "", &r))
+	assert.Equal(t, render.CertInvalid, r.Fields[len(r.Fields)-1])
+	assert.Equal(t, render.MissingValue, r.Fields[5])
+}
+
+func TestCertStatus(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		in time.Duration
+		e  string
+	}{
+		"expired":  {in: -time.Hour, e: render.CertExpired},
+		"critical": {in: 3 * 24 * time.Hour, e: render.CertCritical},
+		"expiring": {in: 20 * 24 * time.Hour, e: render.CertExpiring},
+		"valid":    {in: 90 * 24 * time.Hour, e: render.CertValid},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.CertRes{Cert: &x509.Certificate{NotAfter: now.Add(u.in)}}
+			assert.Equal(t, u.e, c.Status(now))
+		})
+	}
+}
+
+func TestCertExpiresIn(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "in 2d", render.CertExpiresIn(now.Add(48*time.Hour), now))
+	assert.Equal(t, "3h ago", render.CertExpiresIn(now.Add(-3*time.Hour), now))
+}
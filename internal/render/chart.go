@@ -35,6 +35,7 @@ func (Chart) Header(_ string) Header {
 		HeaderColumn{Name: "STATUS"},
 		HeaderColumn{Name: "CHART"},
 		HeaderColumn{Name: "APP VERSION"},
+		HeaderColumn{Name: "DESCRIPTION", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
 	}
@@ -55,19 +56,23 @@ func (c Chart) Render(o interface{}, ns string, r *Row) error {
 		h.Release.Info.Status.String(),
 		h.Release.Chart.Metadata.Name + "-" + h.Release.Chart.Metadata.Version,
 		h.Release.Chart.Metadata.AppVersion,
-		asStatus(c.diagnose(h.Release.Info.Status.String())),
+		h.Release.Info.Description,
+		asStatus(c.diagnose(h.Release.Info.Status, h.Release.Info.Description)),
 		toAge(metav1.Time{Time: h.Release.Info.LastDeployed.Time}),
 	}
 
 	return nil
 }
 
-func (c Chart) diagnose(s string) error {
-	if s != "deployed" {
-		return fmt.Errorf("chart is in an invalid state")
+func (c Chart) diagnose(s release.Status, desc string) error {
+	if s == release.StatusDeployed {
+		return nil
+	}
+	if desc == "" {
+		return fmt.Errorf("chart is in an invalid state (%s)", s)
 	}
 
-	return nil
+	return fmt.Errorf("%s: %s", s, desc)
 }
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ChartResource renders a helm chart release object to screen.
+type ChartResource struct{}
+
+// ColorerFunc colors a resource row.
+func (ChartResource) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (ChartResource) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "GVR", Wide: true},
+	}
+}
+
+// Render renders a chart release object to screen.
+func (c ChartResource) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ChartResourceRes)
+	if !ok {
+		return fmt.Errorf("expected ChartResourceRes, but got %T", o)
+	}
+
+	r.ID = res.GVR + ":" + client.FQN(res.Namespace, res.Name)
+	r.Fields = Fields{
+		res.Kind,
+		res.Namespace,
+		res.Name,
+		asStatus(c.diagnose(res)),
+		res.GVR,
+	}
+
+	return nil
+}
+
+func (ChartResource) diagnose(res ChartResourceRes) error {
+	if res.GVR == "" {
+		return fmt.Errorf("unknown resource kind %q", res.Kind)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ChartResourceRes represents an object installed by a chart release.
+type ChartResourceRes struct {
+	GVR       string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// GetObjectKind returns a schema object.
+func (ChartResourceRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ChartResourceRes) DeepCopyObject() runtime.Object {
+	return c
+}
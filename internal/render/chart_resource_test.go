@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChartResourceRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.ChartResourceRes
+		id string
+		e  render.Fields
+	}{
+		"namespaced": {
+			o:  render.ChartResourceRes{GVR: "apps/v1/deployments", Kind: "Deployment", Namespace: "default", Name: "fred"},
+			id: "apps/v1/deployments:default/fred",
+			e:  render.Fields{"Deployment", "default", "fred", "", "apps/v1/deployments"},
+		},
+		"clusterScoped": {
+			o:  render.ChartResourceRes{GVR: "rbac.authorization.k8s.io/v1/clusterroles", Kind: "ClusterRole", Name: "fred"},
+			id: "rbac.authorization.k8s.io/v1/clusterroles:fred",
+			e:  render.Fields{"ClusterRole", "", "fred", "", "rbac.authorization.k8s.io/v1/clusterroles"},
+		},
+		"unknown": {
+			o:  render.ChartResourceRes{Kind: "Zorg", Namespace: "default", Name: "fred"},
+			id: ":default/fred",
+			e:  render.Fields{"Zorg", "default", "fred", `unknown resource kind "Zorg"`, ""},
+		},
+	}
+
+	var c render.ChartResource
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestChartRender(t *testing.T) {
+	uu := map[string]struct {
+		status release.Status
+		desc   string
+		e      render.Fields
+	}{
+		"deployed": {
+			status: release.StatusDeployed,
+			desc:   "Upgrade complete",
+			e:      render.Fields{"default", "fred", "3", "deployed", "fred-1.0.1", "2.0", "Upgrade complete", ""},
+		},
+		"failed": {
+			status: release.StatusFailed,
+			desc:   `Upgrade "fred" failed: timed out waiting for the condition`,
+			e: render.Fields{
+				"default", "fred", "3", "failed", "fred-1.0.1", "2.0",
+				`Upgrade "fred" failed: timed out waiting for the condition`,
+				`failed: Upgrade "fred" failed: timed out waiting for the condition`,
+			},
+		},
+		"pendingNoDesc": {
+			status: release.StatusPendingUpgrade,
+			e:      render.Fields{"default", "fred", "3", "pending-upgrade", "fred-1.0.1", "2.0", "", "chart is in an invalid state (pending-upgrade)"},
+		},
+	}
+
+	var c render.Chart
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := makeRelease(3, u.status)
+			o.Release.Info.Description = u.desc
+			var r render.Row
+			assert.Nil(t, c.Render(o, "", &r))
+			assert.Equal(t, "default/fred", r.ID)
+			assert.Equal(t, u.e, r.Fields[:8])
+		})
+	}
+}
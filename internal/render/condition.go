@@ -0,0 +1,181 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// positiveConditions lists conditions types flagging a healthy resource when true.
+	positiveConditions = []string{"Ready", "Available", "Established", "Synced", "Healthy", "Initialized", "Complete"}
+
+	// negativeConditions lists conditions types suffixes flagging an unhealthy resource when true.
+	negativeConditions = []string{"Failed", "Failure", "Degraded", "Error", "Stalled"}
+)
+
+// Condition renders a resource status condition to screen.
+type Condition struct{}
+
+// ColorerFunc colors a resource row.
+func (Condition) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		if statusCol != -1 && re.Row.Fields[statusCol] == "Unknown" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Condition) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a resource status condition to screen.
+func (Condition) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ConditionRes)
+	if !ok {
+		return fmt.Errorf("expected ConditionRes, but got %T", o)
+	}
+
+	var valid string
+	if res.Failing() {
+		valid = fmt.Sprintf("%s is %s", res.Type, res.Status)
+	}
+	age := MissingValue
+	if !res.LastTransition.IsZero() {
+		age = toAge(metav1.NewTime(res.LastTransition))
+	}
+
+	r.ID = res.Type
+	r.Fields = Fields{
+		res.Type,
+		res.Status,
+		na(res.Reason),
+		na(res.Message),
+		valid,
+		age,
+	}
+
+	return nil
+}
+
+// ConditionRes represents a resource status condition.
+type ConditionRes struct {
+	Type           string
+	Status         string
+	Reason         string
+	Message        string
+	LastTransition time.Time
+}
+
+// Failing returns true if the condition flags an unhealthy resource.
+func (c ConditionRes) Failing() bool {
+	for _, n := range negativeConditions {
+		if strings.HasSuffix(c.Type, n) {
+			return c.Status == "True"
+		}
+	}
+	for _, p := range positiveConditions {
+		if c.Type == p {
+			return c.Status == "False"
+		}
+	}
+
+	return false
+}
+
+// GetObjectKind returns a schema object.
+func (ConditionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a condition copy.
+func (c ConditionRes) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// Conditions extracts the status conditions of a raw resource.
+func Conditions(obj map[string]interface{}) []ConditionRes {
+	status, _ := obj["status"].(map[string]interface{})
+	cc, _ := status["conditions"].([]interface{})
+	rr := make([]ConditionRes, 0, len(cc))
+	for _, c := range cc {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var res ConditionRes
+		res.Type, _ = cond["type"].(string)
+		res.Status, _ = cond["status"].(string)
+		res.Reason, _ = cond["reason"].(string)
+		res.Message, _ = cond["message"].(string)
+		if ts, ok := cond["lastTransitionTime"].(string); ok {
+			res.LastTransition, _ = time.Parse(time.RFC3339, ts)
+		}
+		rr = append(rr, res)
+	}
+
+	return rr
+}
+
+// ConditionsSummary summarizes status conditions as healthy/total
+// followed by the failing conditions if any, ie 1/3 Ready,Synced.
+func ConditionsSummary(cc []ConditionRes) string {
+	if len(cc) == 0 {
+		return ""
+	}
+	ff := make([]string, 0, len(cc))
+	for _, c := range cc {
+		if c.Failing() {
+			ff = append(ff, c.Type)
+		}
+	}
+	s := strconv.Itoa(len(cc)-len(ff)) + "/" + strconv.Itoa(len(cc))
+	if len(ff) == 0 {
+		return s
+	}
+
+	return s + " " + strings.Join(ff, ",")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// diagnoseConditions reports the first failing status condition if any.
+func diagnoseConditions(obj map[string]interface{}) error {
+	for _, c := range Conditions(obj) {
+		if !c.Failing() {
+			continue
+		}
+		switch {
+		case c.Reason != "":
+			return fmt.Errorf("%s: %s", c.Type, c.Reason)
+		case c.Message != "":
+			return fmt.Errorf("%s: %s", c.Type, c.Message)
+		default:
+			return fmt.Errorf("%s condition not met", c.Type)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditions(t *testing.T) {
+	obj := map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Pending", "message": "waiting", "lastTransitionTime": "2020-01-01T10:00:00Z"},
+				map[string]interface{}{"type": "Synced", "status": "True"},
+				"bozo",
+			},
+		},
+	}
+
+	assert.Equal(t, []render.ConditionRes{
+		{Type: "Ready", Status: "False", Reason: "Pending", Message: "waiting", LastTransition: time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Type: "Synced", Status: "True"},
+	}, render.Conditions(obj))
+	assert.Equal(t, 0, len(render.Conditions(map[string]interface{}{})))
+}
+
+func TestConditionsSummary(t *testing.T) {
+	uu := map[string]struct {
+		cc []render.ConditionRes
+		e  string
+	}{
+		"none": {},
+		"happy": {
+			cc: []render.ConditionRes{
+				{Type: "Ready", Status: "True"},
+				{Type: "Degraded", Status: "False"},
+				{Type: "PodScheduled", Status: "True"},
+			},
+			e: "3/3",
+		},
+		"failing": {
+			cc: []render.ConditionRes{
+				{Type: "Ready", Status: "False"},
+				{Type: "ReconcileFailed", Status: "True"},
+				{Type: "Synced", Status: "True"},
+			},
+			e: "1/3 Ready,ReconcileFailed",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ConditionsSummary(u.cc))
+		})
+	}
+}
+
+func TestConditionRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ConditionRes
+		e   render.Fields
+	}{
+		"happy": {
+			res: render.ConditionRes{Type: "Ready", Status: "True"},
+			e:   render.Fields{"Ready", "True", render.NAValue, render.NAValue, "", render.MissingValue},
+		},
+		"failing": {
+			res: render.ConditionRes{Type: "Available", Status: "False", Reason: "MinimumReplicasUnavailable", Message: "blee"},
+			e:   render.Fields{"Available", "False", "MinimumReplicasUnavailable", "blee", "Available is False", render.MissingValue},
+		},
+	}
+
+	var c render.Condition
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Type, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, len(c.Header("")), len(r.Fields))
+		})
+	}
+}
@@ -68,10 +68,13 @@ func (Container) Header(ns string) Header {
 	return Header{
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "IMAGE"},
+		HeaderColumn{Name: "PULL POLICY", Wide: true},
 		HeaderColumn{Name: "READY"},
 		HeaderColumn{Name: "STATE"},
 		HeaderColumn{Name: "INIT"},
 		HeaderColumn{Name: "RESTARTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "LAST REASON"},
+		HeaderColumn{Name: "EXIT CODE", Align: tview.AlignRight},
 		HeaderColumn{Name: "PROBES(L:R)"},
 		HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
@@ -79,6 +82,7 @@ func (Container) Header(ns string) Header {
 		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "FIT", Align: tview.AlignRight, MX: true, Fit: true},
 		HeaderColumn{Name: "PORTS"},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
@@ -94,18 +98,23 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 
 	cur, perc, limit := gatherMetrics(co.Container, co.MX)
 	ready, state, restarts := "false", MissingValue, "0"
+	reason, exitCode := MissingValue, MissingValue
 	if co.Status != nil {
 		ready, state, restarts = boolToStr(co.Status.Ready), ToContainerState(co.Status.State), strconv.Itoa(int(co.Status.RestartCount))
+		reason, exitCode = lastTermination(co.Status.LastTerminationState)
 	}
 
 	r.ID = co.Container.Name
 	r.Fields = Fields{
 		co.Container.Name,
 		co.Container.Image,
+		string(co.Container.ImagePullPolicy),
 		ready,
 		state,
 		boolToStr(co.IsInit),
 		restarts,
+		reason,
+		exitCode,
 		probe(co.Container.LivenessProbe) + ":" + probe(co.Container.ReadinessProbe),
 		cur.cpu,
 		cur.mem,
@@ -113,6 +122,7 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 		perc.mem,
 		limit.cpu,
 		limit.mem,
+		perc.fit,
 		ToContainerPorts(co.Container.Ports),
 		asStatus(c.diagnose(state, ready)),
 		toAge(co.Age),
@@ -136,6 +146,20 @@ func (Container) diagnose(state, ready string) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// lastTermination returns the reason and exit code of a container previous run.
+func lastTermination(s v1.ContainerState) (string, string) {
+	t := s.Terminated
+	if t == nil {
+		return MissingValue, MissingValue
+	}
+	reason := t.Reason
+	if reason == "" {
+		reason = MissingValue
+	}
+
+	return reason, strconv.Itoa(int(t.ExitCode))
+}
+
 func gatherMetrics(co *v1.Container, mx *mv1beta1.ContainerMetrics) (c, p, l metric) {
 	c, p, l = noMetric(), noMetric(), noMetric()
 	if mx == nil {
@@ -149,13 +173,17 @@ func gatherMetrics(co *v1.Container, mx *mv1beta1.ContainerMetrics) (c, p, l met
 		mem: ToMi(mem),
 	}
 
+	var rc, rm int64
 	rcpu, rmem := containerResources(*co)
 	if rcpu != nil {
-		p.cpu = IntToStr(client.ToPercentage(cpu, rcpu.MilliValue()))
+		rc = rcpu.MilliValue()
+		p.cpu = IntToStr(client.ToPercentage(cpu, rc))
 	}
 	if rmem != nil {
-		p.mem = IntToStr(client.ToPercentage(mem, client.ToMB(rmem.Value())))
+		rm = client.ToMB(rmem.Value())
+		p.mem = IntToStr(client.ToPercentage(mem, rm))
 	}
+	p.fit = usageFit(cpu, rc, mem, rm)
 
 	lcpu, lmem := containerLimits(*co)
 	if lcpu != nil {
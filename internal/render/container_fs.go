@@ -0,0 +1,122 @@
+package render
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of container file types.
+const (
+	FileDir   = "dir"
+	FileLink  = "link"
+	FileReg   = "file"
+	FileOther = "other"
+)
+
+// ContainerFile renders a container file system entry to screen.
+type ContainerFile struct{}
+
+// ColorerFunc colors a resource row.
+func (ContainerFile) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("TYPE", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[col] {
+		case FileDir:
+			return HighlightColor
+		case FileLink:
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (ContainerFile) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "PERMISSIONS"},
+		HeaderColumn{Name: "OWNER"},
+		HeaderColumn{Name: "GROUP"},
+		HeaderColumn{Name: "SIZE", Align: tview.AlignRight},
+		HeaderColumn{Name: "MODIFIED"},
+	}
+}
+
+// Render renders a container file to screen.
+func (ContainerFile) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ContainerFileRes)
+	if !ok {
+		return fmt.Errorf("expected ContainerFileRes, but got %T", o)
+	}
+
+	r.ID = res.Path()
+	name := res.Name
+	if res.Link != "" {
+		name += " -> " + res.Link
+	}
+	r.Fields = Fields{
+		name,
+		res.Type(),
+		res.Mode,
+		res.Owner,
+		res.Group,
+		res.Size,
+		res.Modified,
+	}
+
+	return nil
+}
+
+// ContainerFileRes represents a container file system entry.
+type ContainerFileRes struct {
+	Dir, Name, Link    string
+	Mode, Owner, Group string
+	Size, Modified     string
+}
+
+// Path returns the entry full path.
+func (c ContainerFileRes) Path() string {
+	return path.Join(c.Dir, c.Name)
+}
+
+// IsDir checks if the entry is a directory.
+func (c ContainerFileRes) IsDir() bool {
+	return c.Type() == FileDir
+}
+
+// Type returns the entry type.
+func (c ContainerFileRes) Type() string {
+	if c.Mode == "" {
+		return FileOther
+	}
+	switch c.Mode[0] {
+	case 'd':
+		return FileDir
+	case 'l':
+		return FileLink
+	case '-':
+		return FileReg
+	default:
+		return FileOther
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (ContainerFileRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ContainerFileRes) DeepCopyObject() runtime.Object {
+	return c
+}
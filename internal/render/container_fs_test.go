@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerFileRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ContainerFileRes
+		id  string
+		e   render.Fields
+	}{
+		"dir": {
+			res: render.ContainerFileRes{Dir: "/", Name: "etc", Mode: "drwxr-xr-x", Owner: "root", Group: "root", Size: "4096", Modified: "Jan 21 10:02"},
+			id:  "/etc",
+			e:   render.Fields{"etc", "dir", "drwxr-xr-x", "root", "root", "4096", "Jan 21 10:02"},
+		},
+		"link": {
+			res: render.ContainerFileRes{Dir: "/usr/bin", Name: "sh", Link: "/bin/busybox", Mode: "lrwxrwxrwx", Owner: "root", Group: "root", Size: "12", Modified: "Jan 21 2020"},
+			id:  "/usr/bin/sh",
+			e:   render.Fields{"sh -> /bin/busybox", "link", "lrwxrwxrwx", "root", "root", "12", "Jan 21 2020"},
+		},
+		"file": {
+			res: render.ContainerFileRes{Dir: "/etc", Name: "hosts", Mode: "-rw-r--r--", Owner: "root", Group: "root", Size: "213", Modified: "Jan 21 10:02"},
+			id:  "/etc/hosts",
+			e:   render.Fields{"hosts", "file", "-rw-r--r--", "root", "root", "213", "Jan 21 10:02"},
+		},
+	}
+
+	var f render.ContainerFile
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, f.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -29,10 +29,13 @@ func TestContainer(t *testing.T) {
 	assert.Equal(t, render.Fields{
 		"fred",
 		"img",
+		"",
 		"false",
 		"Running",
 		"false",
 		"0",
+		"<none>",
+		"<none>",
 		"off:off",
 		"10",
 		"20",
@@ -40,6 +43,7 @@ func TestContainer(t *testing.T) {
 		"20",
 		"50",
 		"20",
+		"-50",
 		"",
 		"container is not ready",
 	},
@@ -47,6 +51,27 @@ func TestContainer(t *testing.T) {
 	)
 }
 
+func TestContainerLastTermination(t *testing.T) {
+	var c render.Container
+
+	co := makeContainer()
+	co.ImagePullPolicy = v1.PullAlways
+	cs := makeContainerStatus()
+	cs.RestartCount = 3
+	cs.LastTerminationState = v1.ContainerState{
+		Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+	}
+	cres := render.ContainerRes{
+		Container: co,
+		Status:    cs,
+		MX:        makeContainerMetrics(),
+		Age:       makeAge(),
+	}
+	var r render.Row
+	assert.Nil(t, c.Render(cres, "blee", &r))
+	assert.Equal(t, render.Fields{"Always", "false", "Running", "false", "3", "OOMKilled", "137"}, r.Fields[2:9])
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
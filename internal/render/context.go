@@ -3,6 +3,7 @@ package render
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -21,6 +22,9 @@ func (Context) ColorerFunc() ColorerFunc {
 		if r.Kind == EventAdd || r.Kind == EventUpdate {
 			return c
 		}
+		if idx := h.IndexOf("STATUS", true); idx != -1 && r.Row.Fields[idx] == ContextDown {
+			return ErrColor
+		}
 		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
 			return HighlightColor
 		}
@@ -36,6 +40,10 @@ func (Context) Header(ns string) Header {
 		HeaderColumn{Name: "CLUSTER"},
 		HeaderColumn{Name: "AUTHINFO"},
 		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "LAST USED"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "LATENCY"},
+		HeaderColumn{Name: "VERSION"},
 	}
 }
 
@@ -57,18 +65,59 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		lastUsed(ctx.LastUsed),
 	}
+	r.Fields = append(r.Fields, ctx.Health.fields()...)
 
 	return nil
 }
 
 // Helpers...
 
+// lastUsedFmt sorts contexts usage chronologically.
+const lastUsedFmt = "2006-01-02 15:04:05"
+
+func lastUsed(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(lastUsedFmt)
+}
+
+const (
+	// ContextUp tracks a reachable context api server.
+	ContextUp = "UP"
+	// ContextDown tracks an unreachable context api server.
+	ContextDown = "DOWN"
+)
+
+// ContextHealth tracks a context api server reachability.
+type ContextHealth struct {
+	Version string
+	Latency time.Duration
+	Err     error
+	Checked time.Time
+}
+
+func (h *ContextHealth) fields() Fields {
+	if h == nil {
+		return Fields{"", "", ""}
+	}
+	if h.Err != nil {
+		return Fields{ContextDown, "", ""}
+	}
+
+	return Fields{ContextUp, fmt.Sprintf("%dms", h.Latency.Milliseconds()), h.Version}
+}
+
 // NamedContext represents a named cluster context.
 type NamedContext struct {
-	Name    string
-	Context *api.Context
-	Config  ContextNamer
+	Name     string
+	Context  *api.Context
+	Config   ContextNamer
+	LastUsed time.Time
+	Health   *ContextHealth
 }
 
 // ContextNamer represents a named context.
@@ -1,7 +1,9 @@
 package render_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -11,7 +13,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Equal(t, 4, len(c.Header("")))
+	assert.Equal(t, 8, len(c.Header("")))
 }
 
 func TestContextRender(t *testing.T) {
@@ -32,7 +34,46 @@ func TestContextRender(t *testing.T) {
 			},
 			e: render.Row{
 				ID:     "c1",
-				Fields: render.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: render.Fields{"c1", "c1", "u1", "ns1", "", "", "", ""},
+			},
+		},
+		"used": {
+			ctx: &render.NamedContext{
+				Name: "c2",
+				Context: &api.Context{
+					Cluster:  "c2",
+					AuthInfo: "u2",
+				},
+				Config:   &config{},
+				LastUsed: time.Date(2020, 3, 1, 10, 5, 0, 0, time.UTC),
+			},
+			e: render.Row{
+				ID:     "c2",
+				Fields: render.Fields{"c2", "c2", "u2", "", "2020-03-01 10:05:00", "", "", ""},
+			},
+		},
+		"up": {
+			ctx: &render.NamedContext{
+				Name:    "c3",
+				Context: &api.Context{Cluster: "c3"},
+				Config:  &config{},
+				Health:  &render.ContextHealth{Version: "v1.16.2", Latency: 12 * time.Millisecond},
+			},
+			e: render.Row{
+				ID:     "c3",
+				Fields: render.Fields{"c3", "c3", "", "", "", "UP", "12ms", "v1.16.2"},
+			},
+		},
+		"down": {
+			ctx: &render.NamedContext{
+				Name:    "c4",
+				Context: &api.Context{Cluster: "c4"},
+				Config:  &config{},
+				Health:  &render.ContextHealth{Err: errors.New("boom")},
+			},
+			e: render.Row{
+				ID:     "c4",
+				Fields: render.Fields{"c4", "c4", "", "", "", "DOWN", "", ""},
 			},
 		},
 	}
@@ -41,7 +82,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := render.NewRow(4)
+			row := render.NewRow(8)
 			err := r.Render(uc.ctx, "", &row)
 
 			assert.Nil(t, err)
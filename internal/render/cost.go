@@ -0,0 +1,109 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Cost renders pods resources aggregated by a label value to screen.
+type Cost struct{}
+
+// ColorerFunc colors a resource row.
+func (Cost) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		groupCol := h.IndexOf("GROUP", true)
+		if groupCol != -1 && re.Row.Fields[groupCol] == MissingValue {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Cost) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "GROUP"},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "NAMESPACES", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "CPU/R(m)", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU/L(m)", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU(m)", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "MEM/R(Mi)", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM/L(Mi)", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM(Mi)", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
+	}
+}
+
+// Render renders pods resources aggregated by a label value to screen.
+func (Cost) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(CostRes)
+	if !ok {
+		return fmt.Errorf("expected CostRes, but got %T", o)
+	}
+
+	cpu, mem, pcpu, pmem := NAValue, NAValue, NAValue, NAValue
+	if c.HasMetrics {
+		cpu, mem = ToMillicore(c.CPU), ToMi(client.ToMB(c.MEM))
+		pcpu = IntToStr(client.ToPercentage(c.CPU, c.CPUReq))
+		pmem = IntToStr(client.ToPercentage(c.MEM, c.MEMReq))
+	}
+
+	r.ID = c.Selector()
+	r.Fields = Fields{
+		missing(c.Group),
+		strconv.Itoa(c.Pods),
+		strconv.Itoa(c.Namespaces),
+		ToMillicore(c.CPUReq),
+		ToMillicore(c.CPULim),
+		cpu,
+		pcpu,
+		ToMi(client.ToMB(c.MEMReq)),
+		ToMi(client.ToMB(c.MEMLim)),
+		mem,
+		pmem,
+	}
+
+	return nil
+}
+
+// CostRes represents pods resources aggregated by a label value.
+type CostRes struct {
+	// Label tracks the label key pods are grouped by.
+	Label string
+	// Group tracks the label value or blank for unlabeled pods.
+	Group      string
+	Pods       int
+	Namespaces int
+	// CPU tracks millicores and MEM bytes.
+	CPUReq, CPULim, CPU int64
+	MEMReq, MEMLim, MEM int64
+	HasMetrics          bool
+}
+
+// Selector returns a label selector matching the group pods.
+func (c CostRes) Selector() string {
+	if c.Group == "" {
+		return "!" + c.Label
+	}
+
+	return c.Label + "=" + c.Group
+}
+
+// GetObjectKind returns a schema object.
+func (CostRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a cost copy.
+func (c CostRes) DeepCopyObject() runtime.Object {
+	return c
+}
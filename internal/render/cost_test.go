@@ -0,0 +1,50 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostRender(t *testing.T) {
+	uu := map[string]struct {
+		c  render.CostRes
+		id string
+		e  render.Fields
+	}{
+		"metrics": {
+			c: render.CostRes{
+				Label:      "team",
+				Group:      "fred",
+				Pods:       2,
+				Namespaces: 1,
+				CPUReq:     200,
+				CPULim:     400,
+				CPU:        50,
+				MEMReq:     256 * 1024 * 1024,
+				MEMLim:     512 * 1024 * 1024,
+				MEM:        64 * 1024 * 1024,
+				HasMetrics: true,
+			},
+			id: "team=fred",
+			e:  render.Fields{"fred", "2", "1", "200", "400", "50", "25", "256", "512", "64", "25"},
+		},
+		"unlabeled": {
+			c:  render.CostRes{Label: "team", Pods: 1, Namespaces: 1, CPUReq: 100},
+			id: "!team",
+			e:  render.Fields{render.MissingValue, "1", "1", "100", "0", render.NAValue, render.NAValue, "0", "0", render.NAValue, render.NAValue},
+		},
+	}
+
+	var c render.Cost
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.c, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
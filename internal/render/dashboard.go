@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Dashboard renders a resource error rate summary to screen.
+type Dashboard struct{}
+
+// ColorerFunc colors a resource row.
+func (Dashboard) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		errCol := h.IndexOf("ERRORS", true)
+		if errCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if n, err := strconv.Atoi(re.Row.Fields[errCol]); err == nil && n > 0 {
+			return ErrColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Dashboard) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "TOTAL", Align: tview.AlignRight},
+		HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		HeaderColumn{Name: "ERROR RATE", Align: tview.AlignRight},
+		HeaderColumn{Name: "COUNT TREND"},
+		HeaderColumn{Name: "ERROR TREND"},
+	}
+}
+
+// Render renders a dashboard entry to screen.
+func (Dashboard) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DashboardRes)
+	if !ok {
+		return fmt.Errorf("expected DashboardRes, but got %T", o)
+	}
+
+	r.ID = d.GVR
+	r.Fields = Fields{
+		d.GVR,
+		strconv.FormatInt(d.Total, 10),
+		strconv.FormatInt(d.Errors, 10),
+		strconv.Itoa(d.ErrorRate()) + "%",
+		Sparkline(d.Totals),
+		Sparkline(d.Rates),
+	}
+
+	return nil
+}
+
+// DashboardRes represents a resource error rate summary.
+type DashboardRes struct {
+	GVR    string
+	Total  int64
+	Errors int64
+	// Totals tracks the resource count samples.
+	Totals []int64
+	// Rates tracks the error rate percentage samples.
+	Rates []int64
+}
+
+// ErrorRate returns the percentage of resources in error.
+func (d DashboardRes) ErrorRate() int {
+	if d.Total == 0 {
+		return 0
+	}
+	return int(d.Errors * 100 / d.Total)
+}
+
+// GetObjectKind returns a schema object.
+func (DashboardRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DashboardRes) DeepCopyObject() runtime.Object {
+	return d
+}
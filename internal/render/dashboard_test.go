@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardRender(t *testing.T) {
+	res := render.DashboardRes{
+		GVR:    "v1/pods",
+		Total:  8,
+		Errors: 2,
+		Totals: []int64{6, 8},
+		Rates:  []int64{0, 25},
+	}
+
+	var (
+		d render.Dashboard
+		r render.Row
+	)
+	assert.Nil(t, d.Render(res, "", &r))
+	assert.Equal(t, "v1/pods", r.ID)
+	assert.Equal(t, render.Fields{"v1/pods", "8", "2", "25%", "▁█", "▁█"}, r.Fields)
+}
+
+func TestDashboardErrorRate(t *testing.T) {
+	assert.Equal(t, 0, render.DashboardRes{}.ErrorRate())
+	assert.Equal(t, 33, render.DashboardRes{Total: 3, Errors: 1}.ErrorRate())
+}
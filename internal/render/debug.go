@@ -0,0 +1,102 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Debug stat kinds.
+const (
+	DebugRuntime  = "runtime"
+	DebugInformer = "informer"
+	DebugRefresh  = "refresh"
+	DebugAPI      = "api"
+)
+
+// Debug renders a k9s self diagnostic to screen.
+type Debug struct{}
+
+// ColorerFunc colors a resource row.
+func (Debug) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		kindCol := h.IndexOf("KIND", true)
+		if kindCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[kindCol] {
+		case DebugRuntime:
+			return HighlightColor
+		case DebugAPI:
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Debug) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MEMORY", Align: tview.AlignRight},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "LATENCY(ms)", Align: tview.AlignRight},
+		HeaderColumn{Name: "DETAILS"},
+	}
+}
+
+// Render renders a diagnostic to screen.
+func (Debug) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(DebugRes)
+	if !ok {
+		return fmt.Errorf("expected DebugRes, but got %T", o)
+	}
+
+	r.ID = res.Kind + ":" + res.Name
+	r.Fields = Fields{
+		res.Kind,
+		res.Name,
+		"",
+		"",
+		"",
+		res.Details,
+	}
+	if res.Bytes > 0 {
+		r.Fields[2] = toMiB(res.Bytes)
+	}
+	if res.Count > 0 {
+		r.Fields[3] = strconv.Itoa(res.Count)
+	}
+	if res.Latency > 0 {
+		r.Fields[4] = strconv.FormatInt(int64(res.Latency/time.Millisecond), 10)
+	}
+
+	return nil
+}
+
+// DebugRes represents a k9s self diagnostic.
+type DebugRes struct {
+	Kind    string
+	Name    string
+	Bytes   int64
+	Count   int
+	Latency time.Duration
+	Details string
+}
+
+// GetObjectKind returns a schema object.
+func (DebugRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DebugRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,36 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.DebugRes
+		e   render.Fields
+	}{
+		"memory": {
+			res: render.DebugRes{Kind: render.DebugRuntime, Name: "heap", Bytes: 3 * 1024 * 1024 / 2, Count: 10},
+			e:   render.Fields{"runtime", "heap", "1.5Mi", "10", "", ""},
+		},
+		"latency": {
+			res: render.DebugRes{Kind: render.DebugAPI, Name: "GET /api/v1/pods", Latency: 1200 * time.Millisecond, Details: "status=200"},
+			e:   render.Fields{"api", "GET /api/v1/pods", "", "", "1200", "status=200"},
+		},
+	}
+
+	var d render.Debug
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, d.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Kind+":"+u.res.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// APIDeprecated tracks an object using a deprecated api version.
+	APIDeprecated = "Deprecated"
+	// APIRemoved tracks an object using a removed api version.
+	APIRemoved = "Removed"
+)
+
+// Deprecation renders an object using a deprecated api version to screen.
+type Deprecation struct{}
+
+// ColorerFunc colors a resource row.
+func (Deprecation) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[statusCol] == APIRemoved {
+			return ErrColor
+		}
+
+		return HighlightColor
+	}
+}
+
+// Header returns a header row.
+func (Deprecation) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "API VERSION"},
+		HeaderColumn{Name: "REPLACEMENT"},
+		HeaderColumn{Name: "DEPRECATED"},
+		HeaderColumn{Name: "REMOVED"},
+		HeaderColumn{Name: "SOURCE", Wide: true},
+		HeaderColumn{Name: "STATUS"},
+	}
+}
+
+// Render renders an object using a deprecated api version to screen.
+func (Deprecation) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DeprecationRes)
+	if !ok {
+		return fmt.Errorf("expected DeprecationRes, but got %T", o)
+	}
+
+	r.ID = d.GVR + ":" + client.FQN(d.Namespace, d.Name)
+	r.Fields = Fields{
+		d.Namespace,
+		d.Name,
+		d.Kind,
+		d.APIVersion,
+		na(d.Replacement),
+		d.Deprecated,
+		na(d.Removed),
+		d.Source,
+		d.Status,
+	}
+
+	return nil
+}
+
+// DeprecationRes represents an object using a deprecated api version.
+type DeprecationRes struct {
+	// GVR tracks the served resource the object was listed from.
+	GVR             string
+	Kind            string
+	Namespace, Name string
+	// APIVersion tracks the deprecated api version the object uses.
+	APIVersion  string
+	Replacement string
+	// Deprecated and Removed track the Kubernetes releases deprecating and
+	// removing the api version.
+	Deprecated, Removed string
+	// Source tracks where the api version was found ie last-applied or a field manager.
+	Source string
+	Status string
+}
+
+// GetObjectKind returns a schema object.
+func (DeprecationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a deprecation copy.
+func (d DeprecationRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,62 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationRender(t *testing.T) {
+	res := render.DeprecationRes{
+		GVR:         "networking.k8s.io/v1/ingresses",
+		Kind:        "Ingress",
+		Namespace:   "default",
+		Name:        "fred",
+		APIVersion:  "extensions/v1beta1",
+		Replacement: "networking.k8s.io/v1",
+		Deprecated:  "1.14",
+		Removed:     "1.22",
+		Source:      "last-applied",
+		Status:      render.APIRemoved,
+	}
+
+	var (
+		d render.Deprecation
+		r render.Row
+	)
+	assert.Nil(t, d.Render(res, "", &r))
+	assert.Equal(t, "networking.k8s.io/v1/ingresses:default/fred", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"fred",
+		"Ingress",
+		"extensions/v1beta1",
+		"networking.k8s.io/v1",
+		"1.14",
+		"1.22",
+		"last-applied",
+		render.APIRemoved,
+	}, r.Fields)
+}
+
+func TestDeprecationColorer(t *testing.T) {
+	var d render.Deprecation
+	h := d.Header("")
+	uu := map[string]struct {
+		status string
+		e      tcell.Color
+	}{
+		"removed":    {status: render.APIRemoved, e: render.ErrColor},
+		"deprecated": {status: render.APIDeprecated, e: render.HighlightColor},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := render.RowEvent{Row: render.Row{Fields: render.Fields{"", "", "", "", "", "", "", "", u.status}}}
+			assert.Equal(t, u.e, d.ColorerFunc()("", h, re))
+		})
+	}
+}
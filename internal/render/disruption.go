@@ -0,0 +1,99 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of pod eviction statuses.
+const (
+	EvictionAllowed = "ALLOWED"
+	EvictionBlocked = "BLOCKED"
+)
+
+// Disruption renders a pod eviction check to screen.
+type Disruption struct{}
+
+// ColorerFunc colors a resource row.
+func (Disruption) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("STATUS", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[col] == EvictionBlocked {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Disruption) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "PDB"},
+		HeaderColumn{Name: "DISRUPTIONS", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "REASON"},
+	}
+}
+
+// Render renders a pod eviction check to screen.
+func (Disruption) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(DisruptionRes)
+	if !ok {
+		return fmt.Errorf("expected DisruptionRes, but got %T", o)
+	}
+
+	pns, n := client.Namespaced(res.Pod)
+	pdb, allowed := NAValue, NAValue
+	if res.Budget != "" {
+		_, pdb = client.Namespaced(res.Budget)
+		allowed = strconv.Itoa(int(res.Allowed))
+	}
+	status := EvictionAllowed
+	if res.Blocked {
+		status = EvictionBlocked
+	}
+
+	r.ID = res.Pod
+	r.Fields = Fields{
+		pns,
+		n,
+		na(res.Node),
+		pdb,
+		allowed,
+		status,
+		res.Reason,
+	}
+
+	return nil
+}
+
+// DisruptionRes represents a pod eviction check.
+type DisruptionRes struct {
+	Pod, Node, Budget string
+	Allowed           int32
+	Blocked           bool
+	Reason            string
+}
+
+// GetObjectKind returns a schema object.
+func (DisruptionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DisruptionRes) DeepCopyObject() runtime.Object {
+	return d
+}
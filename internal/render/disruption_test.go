@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisruptionRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.DisruptionRes
+		e   render.Fields
+	}{
+		"noBudget": {
+			res: render.DisruptionRes{Pod: "default/p1", Node: "n1", Reason: "no disruption budget"},
+			e:   render.Fields{"default", "p1", "n1", render.NAValue, render.NAValue, render.EvictionAllowed, "no disruption budget"},
+		},
+		"blocked": {
+			res: render.DisruptionRes{Pod: "default/p1", Node: "n1", Budget: "default/pdb1", Blocked: true, Reason: "no disruptions allowed (1/1 healthy)"},
+			e:   render.Fields{"default", "p1", "n1", "pdb1", "0", render.EvictionBlocked, "no disruptions allowed (1/1 healthy)"},
+		},
+	}
+
+	var d render.Disruption
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, d.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Pod, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
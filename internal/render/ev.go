@@ -27,14 +27,28 @@ func (e Event) ColorerFunc() ColorerFunc {
 		if reasonCol == -1 {
 			return DefaultColorer(ns, h, re)
 		}
-		if strings.TrimSpace(re.Row.Fields[reasonCol]) == "Killing" {
-			return KillColor
+		if c, ok := reasonColor(strings.TrimSpace(re.Row.Fields[reasonCol])); ok {
+			return c
 		}
 
 		return DefaultColorer(ns, h, re)
 	}
 }
 
+// reasonColor returns a color based on an event reason category.
+func reasonColor(reason string) (tcell.Color, bool) {
+	switch {
+	case reason == "Killing", reason == "Preempting", strings.HasPrefix(reason, "SuccessfulDelete"):
+		return KillColor, true
+	case strings.HasPrefix(reason, "Scaling"), strings.HasSuffix(reason, "Rescale"):
+		return HighlightColor, true
+	case reason == "Completed", strings.HasPrefix(reason, "SawCompleted"):
+		return CompletedColor, true
+	default:
+		return tcell.ColorDefault, false
+	}
+}
+
 // Header returns a header rbw.
 func (Event) Header(ns string) Header {
 	return Header{
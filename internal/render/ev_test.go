@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,3 +28,36 @@ func BenchmarkEventRender(b *testing.B) {
 		_ = re.Render(&ev, "", &r)
 	}
 }
+
+func TestEventColorer(t *testing.T) {
+	uu := map[string]struct {
+		reason, kind string
+		e            tcell.Color
+	}{
+		"warning": {reason: "BackOff", kind: "Warning", e: render.ErrColor},
+		"killing": {reason: "Killing", kind: "Normal", e: render.KillColor},
+		"scaling": {reason: "ScalingReplicaSet", kind: "Normal", e: render.HighlightColor},
+		"rescale": {reason: "SuccessfulRescale", kind: "Normal", e: render.HighlightColor},
+		"job":     {reason: "SawCompletedJob", kind: "Normal", e: render.CompletedColor},
+		"std":     {reason: "Pulled", kind: "Normal", e: render.StdColor},
+	}
+
+	var ev render.Event
+	h := ev.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			valid := ""
+			if u.kind != "Normal" {
+				valid = "failed event"
+			}
+			re := render.RowEvent{
+				Kind: render.EventUnchanged,
+				Row: render.Row{
+					Fields: render.Fields{"default", "pod:fred", u.kind, u.reason, "kubelet", "1", "blee", valid, "1m"},
+				},
+			}
+			assert.Equal(t, u.e, ev.ColorerFunc()("default", h, re))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Find renders a cross resources search match to screen.
+type Find struct{}
+
+// ColorerFunc colors a resource row.
+func (Find) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return DefaultColorer(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (Find) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a search match to screen.
+func (Find) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(FindRes)
+	if !ok {
+		return fmt.Errorf("expected FindRes, but got %T", o)
+	}
+
+	r.ID = f.GVR + ":" + f.Path()
+	r.Fields = Fields{
+		f.Namespace,
+		f.Name,
+		f.Kind,
+		mapToStr(f.Labels),
+		timeToAge(f.Created),
+	}
+
+	return nil
+}
+
+// FindRes represents a resource matching a search.
+type FindRes struct {
+	GVR       string
+	Kind      string
+	Namespace string
+	Name      string
+	Labels    map[string]string
+	Created   time.Time
+}
+
+// Path returns the matching resource fully qualified name.
+func (f FindRes) Path() string {
+	if f.Namespace == "" {
+		return f.Name
+	}
+
+	return f.Namespace + "/" + f.Name
+}
+
+// GetObjectKind returns a schema object.
+func (FindRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f FindRes) DeepCopyObject() runtime.Object {
+	return f
+}
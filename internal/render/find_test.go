@@ -0,0 +1,40 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.FindRes
+		id  string
+		e   render.Fields
+	}{
+		"namespaced": {
+			res: render.FindRes{GVR: "v1/pods", Kind: "Pod", Namespace: "default", Name: "fred", Labels: map[string]string{"app": "blee"}},
+			id:  "v1/pods:default/fred",
+			e:   render.Fields{"default", "fred", "Pod", "app=blee"},
+		},
+		"cluster": {
+			res: render.FindRes{GVR: "v1/persistentvolumes", Kind: "PersistentVolume", Name: "fred"},
+			id:  "v1/persistentvolumes:fred",
+			e:   render.Fields{"", "fred", "PersistentVolume", ""},
+		},
+	}
+
+	var f render.Find
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.res.Created = time.Now()
+			var r render.Row
+			assert.Nil(t, f.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:4])
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 )
 
@@ -46,8 +48,9 @@ func (g *Generic) Header(ns string) Header {
 			g.ageIndex = i
 			continue
 		}
-		h = append(h, HeaderColumn{Name: strings.ToUpper(c.Name)})
+		h = append(h, HeaderColumn{Name: strings.ToUpper(c.Name), Wide: c.Priority > 0})
 	}
+	h = append(h, HeaderColumn{Name: "VALID", Wide: true})
 	if g.ageIndex > 0 {
 		h = append(h, HeaderColumn{Name: "AGE", Time: true})
 	}
@@ -61,7 +64,11 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 	if !ok {
 		return fmt.Errorf("expecting a TableRow but got %T", o)
 	}
-	nns, err := resourceNS(row.Object.Raw)
+	obj, err := rawObject(row.Object.Raw)
+	if err != nil {
+		return err
+	}
+	nns, err := resourceNS(obj)
 	if err != nil {
 		return err
 	}
@@ -82,8 +89,9 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 		}
 		r.Fields = append(r.Fields, fmt.Sprintf("%v", c))
 	}
+	r.Fields = append(r.Fields, asStatus(diagnoseConditions(obj)))
 	if ageCell != nil {
-		r.Fields = append(r.Fields, fmt.Sprintf("%v", ageCell))
+		r.Fields = append(r.Fields, resourceAge(obj, ageCell))
 	}
 
 	return nil
@@ -92,13 +100,16 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func resourceNS(raw []byte) (string, error) {
+func rawObject(raw []byte) (map[string]interface{}, error) {
 	var obj map[string]interface{}
-	err := json.Unmarshal(raw, &obj)
-	if err != nil {
-		return "", err
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
 	}
 
+	return obj, nil
+}
+
+func resourceNS(obj map[string]interface{}) (string, error) {
 	meta, ok := obj["metadata"].(map[string]interface{})
 	if !ok {
 		return "", errors.New("no metadata found on generic resource")
@@ -115,3 +126,16 @@ func resourceNS(raw []byte) (string, error) {
 	}
 	return nns, nil
 }
+
+// resourceAge favors the object creation timestamp since server side
+// tables report ages in a format that does not sort as a duration.
+func resourceAge(obj map[string]interface{}, cell interface{}) string {
+	meta, _ := obj["metadata"].(map[string]interface{})
+	ts, _ := meta["creationTimestamp"].(string)
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return fmt.Sprintf("%v", cell)
+	}
+
+	return toAge(metav1.NewTime(t))
+}
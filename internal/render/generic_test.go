@@ -22,59 +22,64 @@ func TestGenericRender(t *testing.T) {
 			ns:      "ns1",
 			table:   makeNSGeneric(),
 			eID:     "ns1/c1",
-			eFields: render.Fields{"ns1", "c1", "c2", "c3"},
+			eFields: render.Fields{"ns1", "c1", "c2", "c3", ""},
 			eHeader: render.Header{
 				render.HeaderColumn{Name: "NAMESPACE"},
 				render.HeaderColumn{Name: "A"},
 				render.HeaderColumn{Name: "B"},
 				render.HeaderColumn{Name: "C"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
 			},
 		},
 		"all": {
 			ns:      client.NamespaceAll,
 			table:   makeNSGeneric(),
 			eID:     "ns1/c1",
-			eFields: render.Fields{"ns1", "c1", "c2", "c3"},
+			eFields: render.Fields{"ns1", "c1", "c2", "c3", ""},
 			eHeader: render.Header{
 				render.HeaderColumn{Name: "NAMESPACE"},
 				render.HeaderColumn{Name: "A"},
 				render.HeaderColumn{Name: "B"},
 				render.HeaderColumn{Name: "C"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
 			},
 		},
 		"allNS": {
 			ns:      client.AllNamespaces,
 			table:   makeNSGeneric(),
 			eID:     "ns1/c1",
-			eFields: render.Fields{"ns1", "c1", "c2", "c3"},
+			eFields: render.Fields{"ns1", "c1", "c2", "c3", ""},
 			eHeader: render.Header{
 				render.HeaderColumn{Name: "NAMESPACE"},
 				render.HeaderColumn{Name: "A"},
 				render.HeaderColumn{Name: "B"},
 				render.HeaderColumn{Name: "C"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
 			},
 		},
 		"clusterWide": {
 			ns:      client.ClusterScope,
 			table:   makeNoNSGeneric(),
 			eID:     "-/c1",
-			eFields: render.Fields{"-", "c1", "c2", "c3"},
+			eFields: render.Fields{"-", "c1", "c2", "c3", ""},
 			eHeader: render.Header{
 				render.HeaderColumn{Name: "NAMESPACE"},
 				render.HeaderColumn{Name: "A"},
 				render.HeaderColumn{Name: "B"},
 				render.HeaderColumn{Name: "C"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
 			},
 		},
 		"age": {
 			ns:      client.ClusterScope,
 			table:   makeAgeGeneric(),
 			eID:     "-/c1",
-			eFields: render.Fields{"-", "c1", "c2", "Age"},
+			eFields: render.Fields{"-", "c1", "c2", "", "Age"},
 			eHeader: render.Header{
 				render.HeaderColumn{Name: "NAMESPACE"},
 				render.HeaderColumn{Name: "A"},
 				render.HeaderColumn{Name: "C"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
 				render.HeaderColumn{Name: "AGE", Time: true},
 			},
 		},
@@ -95,6 +100,66 @@ func TestGenericRender(t *testing.T) {
 	}
 }
 
+func TestGenericRenderConditions(t *testing.T) {
+	uu := map[string]struct {
+		status string
+		e      string
+	}{
+		"none": {
+			status: `{}`,
+		},
+		"ready": {
+			status: `{"conditions": [{"type": "Ready", "status": "True"}]}`,
+		},
+		"notReady": {
+			status: `{"conditions": [{"type": "Ready", "status": "False", "reason": "Pending"}]}`,
+			e:      "Ready: Pending",
+		},
+		"degraded": {
+			status: `{"conditions": [{"type": "Available", "status": "True"}, {"type": "Degraded", "status": "True", "message": "blee"}]}`,
+			e:      "Degraded: blee",
+		},
+		"notDegraded": {
+			status: `{"conditions": [{"type": "Degraded", "status": "False"}]}`,
+		},
+		"unknown": {
+			status: `{"conditions": [{"type": "Synced", "status": "False"}]}`,
+			e:      "Synced condition not met",
+		},
+	}
+
+	for k := range uu {
+		var re render.Generic
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			table := makeStatusGeneric(u.status)
+			re.SetTable(table)
+			h := re.Header(client.AllNamespaces)
+			var r render.Row
+			assert.Nil(t, re.Render(table.Rows[0], client.AllNamespaces, &r))
+			assert.Equal(t, u.e, r.Fields[h.IndexOf("VALID", true)])
+			assert.Equal(t, u.e == "", render.Happy(client.AllNamespaces, h, r))
+		})
+	}
+}
+
+func TestGenericHeaderWide(t *testing.T) {
+	var re render.Generic
+	re.SetTable(&metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Image", Priority: 1},
+		},
+	})
+
+	assert.Equal(t, render.Header{
+		render.HeaderColumn{Name: "NAMESPACE"},
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "IMAGE", Wide: true},
+		render.HeaderColumn{Name: "VALID", Wide: true},
+	}, re.Header(client.AllNamespaces))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -179,3 +244,31 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makeStatusGeneric(status string) *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Age"},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+        "kind": "fred",
+        "apiVersion": "fred.io/v1",
+        "metadata": {
+          "namespace": "ns1",
+          "name": "fred",
+          "creationTimestamp": "2020-01-01T10:00:00Z"
+        },
+        "status": ` + status + `}`),
+				},
+				Cells: []interface{}{
+					"fred",
+					"5d",
+				},
+			},
+		},
+	}
+}
@@ -16,6 +16,8 @@ type HeaderColumn struct {
 	Hide      bool
 	Wide      bool
 	MX        bool
+	Gauge     bool
+	Fit       bool
 	Time      bool
 }
 
@@ -0,0 +1,119 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HelmRepo renders a helm repository to screen.
+type HelmRepo struct{}
+
+// ColorerFunc colors a resource row.
+func (HelmRepo) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (HelmRepo) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "URL"},
+	}
+}
+
+// Render renders a helm repository to screen.
+func (HelmRepo) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HelmRepoRes)
+	if !ok {
+		return fmt.Errorf("expected HelmRepoRes, but got %T", o)
+	}
+
+	r.ID = h.Entry.Name
+	r.Fields = Fields{
+		h.Entry.Name,
+		h.Entry.URL,
+	}
+
+	return nil
+}
+
+// RepoChart renders a helm repository chart to screen.
+type RepoChart struct{}
+
+// ColorerFunc colors a resource row.
+func (RepoChart) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (RepoChart) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "APP VERSION"},
+		HeaderColumn{Name: "DESCRIPTION"},
+		HeaderColumn{Name: "KEYWORDS", Wide: true},
+	}
+}
+
+// Render renders a helm repository chart to screen.
+func (RepoChart) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(RepoChartRes)
+	if !ok {
+		return fmt.Errorf("expected RepoChartRes, but got %T", o)
+	}
+
+	r.ID = c.Repo + "/" + c.Chart.Name
+	r.Fields = Fields{
+		r.ID,
+		c.Chart.Version,
+		c.Chart.AppVersion,
+		c.Chart.Description,
+		strings.Join(c.Chart.Keywords, ","),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// HelmRepoRes represents a helm repository resource.
+type HelmRepoRes struct {
+	Entry *repo.Entry
+}
+
+// GetObjectKind returns a schema object.
+func (HelmRepoRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HelmRepoRes) DeepCopyObject() runtime.Object {
+	return h
+}
+
+// RepoChartRes represents the latest version of a helm repository chart.
+type RepoChartRes struct {
+	Repo  string
+	Chart *repo.ChartVersion
+}
+
+// GetObjectKind returns a schema object.
+func (RepoChartRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c RepoChartRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,45 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+func TestHelmRepoRender(t *testing.T) {
+	var (
+		h render.HelmRepo
+		r render.Row
+	)
+	o := render.HelmRepoRes{Entry: &repo.Entry{Name: "stable", URL: "https://kubernetes-charts.storage.googleapis.com"}}
+
+	assert.Nil(t, h.Render(o, "", &r))
+	assert.Equal(t, "stable", r.ID)
+	assert.Equal(t, render.Fields{"stable", "https://kubernetes-charts.storage.googleapis.com"}, r.Fields)
+}
+
+func TestRepoChartRender(t *testing.T) {
+	var (
+		c render.RepoChart
+		r render.Row
+	)
+	o := render.RepoChartRes{
+		Repo: "stable",
+		Chart: &repo.ChartVersion{
+			Metadata: &chart.Metadata{
+				Name:        "redis",
+				Version:     "10.5.7",
+				AppVersion:  "5.0.7",
+				Description: "Open source key-value store",
+				Keywords:    []string{"redis", "database"},
+			},
+		},
+	}
+
+	assert.Nil(t, c.Render(o, "", &r))
+	assert.Equal(t, "stable/redis", r.ID)
+	assert.Equal(t, render.Fields{"stable/redis", "10.5.7", "5.0.7", "Open source key-value store", "redis,database"}, r.Fields)
+}
@@ -1,11 +1,13 @@
 package render
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/rs/zerolog/log"
@@ -58,11 +60,33 @@ func asSelector(s *metav1.LabelSelector) string {
 }
 
 type metric struct {
-	cpu, mem, cpuLim, memLim string
+	cpu, mem, cpuLim, memLim, fit string
 }
 
 func noMetric() metric {
-	return metric{cpu: NAValue, mem: NAValue, cpuLim: NAValue, memLim: NAValue}
+	return metric{cpu: NAValue, mem: NAValue, cpuLim: NAValue, memLim: NAValue, fit: NAValue}
+}
+
+// usageFit returns the signed percentage delta between the peak cpu/mem usage
+// and the requested resources. Resources without requests are skipped.
+func usageFit(cpu, rcpu, mem, rmem int64) string {
+	var (
+		peak int
+		ok   bool
+	)
+	if rcpu > 0 {
+		peak, ok = client.ToPercentage(cpu, rcpu), true
+	}
+	if rmem > 0 {
+		if p := client.ToPercentage(mem, rmem); !ok || p > peak {
+			peak, ok = p, true
+		}
+	}
+	if !ok {
+		return NAValue
+	}
+
+	return fmt.Sprintf("%+d", peak-100)
 }
 
 // ToSelector flattens a map selector to a string selector.
@@ -125,11 +149,35 @@ func PrintPerc(p int) string {
 	return strconv.Itoa(p) + "%"
 }
 
+// AsGauge renders a percentage as a bar gauge of the given width followed by its value.
+// Values over 100% render a full bar.
+func AsGauge(perc, width int) string {
+	var eighths int
+	switch {
+	case perc > 100:
+		eighths = width * 8
+	case perc > 0:
+		eighths = perc * width * 8 / 100
+	}
+
+	var buff strings.Builder
+	buff.WriteString(strings.Repeat(string(gaugeBlocks[8]), eighths/8))
+	if r := eighths % 8; r > 0 {
+		buff.WriteRune(gaugeBlocks[r])
+		eighths += 8 - r
+	}
+	buff.WriteString(strings.Repeat(" ", width-eighths/8))
+
+	return buff.String() + " " + fmt.Sprintf("%3d", perc)
+}
+
 // IntToStr converts an int to a string.
 func IntToStr(p int) string {
 	return strconv.Itoa(int(p))
 }
 
+var gaugeBlocks = []rune(" ▏▎▍▌▋▊▉█")
+
 func missing(s string) string {
 	return check(s, MissingValue)
 }
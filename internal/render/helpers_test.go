@@ -349,3 +349,70 @@ func BenchmarkIntToStr(b *testing.B) {
 		IntToStr(v)
 	}
 }
+
+func TestAsGauge(t *testing.T) {
+	uu := map[string]struct {
+		perc, width int
+		e           string
+	}{
+		"zero": {
+			perc:  0,
+			width: 4,
+			e:     "       0",
+		},
+		"negative": {
+			perc:  -1,
+			width: 4,
+			e:     "      -1",
+		},
+		"half": {
+			perc:  50,
+			width: 4,
+			e:     "██    50",
+		},
+		"partial": {
+			perc:  10,
+			width: 4,
+			e:     "▍     10",
+		},
+		"full": {
+			perc:  100,
+			width: 4,
+			e:     "████ 100",
+		},
+		"over": {
+			perc:  250,
+			width: 4,
+			e:     "████ 250",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AsGauge(u.perc, u.width))
+		})
+	}
+}
+
+func TestUsageFit(t *testing.T) {
+	uu := map[string]struct {
+		cpu, rcpu, mem, rmem int64
+		e                    string
+	}{
+		"none":    {cpu: 10, mem: 10, e: NAValue},
+		"under":   {cpu: 10, rcpu: 100, mem: 20, rmem: 100, e: "-80"},
+		"at":      {cpu: 100, rcpu: 100, e: "+0"},
+		"overCPU": {cpu: 150, rcpu: 100, mem: 10, rmem: 100, e: "+50"},
+		"overMEM": {cpu: 10, rcpu: 100, mem: 300, rmem: 100, e: "+200"},
+		"memOnly": {cpu: 500, mem: 50, rmem: 100, e: "-50"},
+		"cpuOnly": {cpu: 25, rcpu: 100, mem: 500, e: "-75"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, usageFit(u.cpu, u.rcpu, u.mem, u.rmem))
+		})
+	}
+}
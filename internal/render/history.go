@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gdamore/tcell"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// History renders a helm chart release history to screen.
+type History struct{}
+
+// ColorerFunc colors a resource row.
+func (History) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol >= 0 && re.Row.Fields[statusCol] == release.StatusDeployed.String() {
+			return tcell.ColorMediumSpringGreen
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (History) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "REVISION"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "CHART"},
+		HeaderColumn{Name: "APP VERSION"},
+		HeaderColumn{Name: "DESCRIPTION"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "UPDATED", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a chart release revision to screen.
+func (h History) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(ChartRes)
+	if !ok {
+		return fmt.Errorf("expected ChartRes, but got %T", o)
+	}
+	if c.Release == nil {
+		return fmt.Errorf("expected a chart release, but got none")
+	}
+
+	chart, appVersion := MissingValue, MissingValue
+	if ch := c.Release.Chart; ch != nil && ch.Metadata != nil {
+		chart = ch.Metadata.Name + "-" + ch.Metadata.Version
+		appVersion = ch.Metadata.AppVersion
+	}
+	status, desc, updated := release.StatusUnknown, MissingValue, MissingValue
+	if info := c.Release.Info; info != nil {
+		status, desc = info.Status, info.Description
+		updated = toAge(metav1.Time{Time: info.LastDeployed.Time})
+	}
+
+	r.ID = strconv.Itoa(c.Release.Version)
+	r.Fields = Fields{
+		strconv.Itoa(c.Release.Version),
+		status.String(),
+		chart,
+		appVersion,
+		desc,
+		asStatus(h.diagnose(status)),
+		updated,
+	}
+
+	return nil
+}
+
+func (History) diagnose(s release.Status) error {
+	switch s {
+	case release.StatusFailed, release.StatusUnknown:
+		return fmt.Errorf("revision is in an invalid state")
+	default:
+		return nil
+	}
+}
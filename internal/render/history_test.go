@@ -0,0 +1,70 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+)
+
+func TestHistoryRender(t *testing.T) {
+	uu := map[string]struct {
+		status release.Status
+		e      render.Fields
+	}{
+		"deployed": {
+			status: release.StatusDeployed,
+			e:      render.Fields{"3", "deployed", "fred-1.0.1", "2.0", "Upgrade complete", ""},
+		},
+		"failed": {
+			status: release.StatusFailed,
+			e:      render.Fields{"3", "failed", "fred-1.0.1", "2.0", "Upgrade complete", "revision is in an invalid state"},
+		},
+	}
+
+	var h render.History
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, h.Render(makeRelease(3, u.status), "", &r))
+			assert.Equal(t, "3", r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+		})
+	}
+}
+
+func TestHistoryRenderMalformed(t *testing.T) {
+	var (
+		h render.History
+		r render.Row
+	)
+	c := render.ChartRes{Release: &release.Release{Name: "fred", Version: 2}}
+
+	assert.Nil(t, h.Render(c, "", &r))
+	assert.Equal(t, "2", r.ID)
+	assert.Equal(t, render.Fields{"2", "unknown", render.MissingValue, render.MissingValue, render.MissingValue, "revision is in an invalid state"}, r.Fields[:6])
+}
+
+// Helpers...
+
+func makeRelease(rev int, s release.Status) render.ChartRes {
+	return render.ChartRes{
+		Release: &release.Release{
+			Name:      "fred",
+			Namespace: "default",
+			Version:   rev,
+			Info: &release.Info{
+				Status:       s,
+				Description:  "Upgrade complete",
+				LastDeployed: helmtime.Now(),
+			},
+			Chart: &chart.Chart{
+				Metadata: &chart.Metadata{Name: "fred", Version: "1.0.1", AppVersion: "2.0"},
+			},
+		},
+	}
+}
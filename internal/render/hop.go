@@ -0,0 +1,95 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Hop renders a navigation history entry to screen.
+type Hop struct{}
+
+// ColorerFunc colors a resource row.
+func (Hop) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if strings.HasSuffix(re.Row.Fields[0], "(*)") {
+			return HighlightColor
+		}
+
+		return DefaultColorer(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (Hop) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "PATH"},
+		HeaderColumn{Name: "LABELS"},
+		HeaderColumn{Name: "FILTER"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a navigation hop to screen.
+func (Hop) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HopRes)
+	if !ok {
+		return fmt.Errorf("expected HopRes, but got %T", o)
+	}
+
+	res := h.GVR
+	if h.Current {
+		res += "(*)"
+	}
+	r.ID = h.ID
+	r.Fields = Fields{
+		res,
+		h.Namespace,
+		h.Path,
+		h.Labels,
+		h.Filter,
+		timeToAge(h.At),
+	}
+
+	return nil
+}
+
+// HopRes represents a navigation history entry.
+type HopRes struct {
+	ID         string
+	GVR        string
+	Namespace  string
+	Path       string
+	Labels     string
+	Fields     string
+	Filter     string
+	SortColumn string
+	SortAsc    bool
+	At         time.Time
+	Current    bool
+}
+
+// SameView checks if two hops land on the same resource view.
+func (h HopRes) SameView(o HopRes) bool {
+	return h.GVR == o.GVR &&
+		h.Namespace == o.Namespace &&
+		h.Path == o.Path &&
+		h.Labels == o.Labels &&
+		h.Fields == o.Fields
+}
+
+// GetObjectKind returns a schema object.
+func (HopRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HopRes) DeepCopyObject() runtime.Object {
+	return h
+}
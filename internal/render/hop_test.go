@@ -0,0 +1,37 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHopRender(t *testing.T) {
+	res := render.HopRes{
+		ID:        "2",
+		GVR:       "v1/pods",
+		Namespace: "default",
+		Labels:    "app=fred",
+		Filter:    "blee",
+		At:        time.Now(),
+		Current:   true,
+	}
+
+	var (
+		h render.Hop
+		r render.Row
+	)
+	assert.Nil(t, h.Render(res, "", &r))
+	assert.Equal(t, "2", r.ID)
+	assert.Equal(t, render.Fields{"v1/pods(*)", "default", "", "app=fred", "blee"}, r.Fields[:5])
+}
+
+func TestHopSameView(t *testing.T) {
+	h := render.HopRes{GVR: "v1/pods", Namespace: "default", Filter: "blee"}
+
+	assert.True(t, h.SameView(render.HopRes{GVR: "v1/pods", Namespace: "default", Filter: "duh"}))
+	assert.False(t, h.SameView(render.HopRes{GVR: "v1/pods", Namespace: "kube-system"}))
+	assert.False(t, h.SameView(render.HopRes{GVR: "v1/pods", Namespace: "default", Labels: "app=fred"}))
+}
@@ -1,6 +1,7 @@
 package render
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,10 +11,15 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// hpaConditionsAnnotation tracks autoscaling/v1 HPA conditions.
+const hpaConditionsAnnotation = "autoscaling.alpha.kubernetes.io/conditions"
+
 // HorizontalPodAutoscaler renders a K8s HorizontalPodAutoscaler to screen.
 type HorizontalPodAutoscaler struct{}
 
@@ -32,6 +38,9 @@ func (HorizontalPodAutoscaler) Header(ns string) Header {
 		HeaderColumn{Name: "MINPODS", Align: tview.AlignRight},
 		HeaderColumn{Name: "MAXPODS", Align: tview.AlignRight},
 		HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DESIRED", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "LAST SCALE", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
 	}
@@ -65,6 +74,7 @@ func (h HorizontalPodAutoscaler) renderV1(raw *unstructured.Unstructured, _ stri
 		return err
 	}
 
+	status, err := hpaStatus(hpaConditionsV1(hpa.Annotations))
 	r.ID = client.MetaFQN(hpa.ObjectMeta)
 	r.Fields = Fields{
 		hpa.Namespace,
@@ -74,7 +84,10 @@ func (h HorizontalPodAutoscaler) renderV1(raw *unstructured.Unstructured, _ stri
 		strconv.Itoa(int(*hpa.Spec.MinReplicas)),
 		strconv.Itoa(int(hpa.Spec.MaxReplicas)),
 		strconv.Itoa(int(hpa.Status.CurrentReplicas)),
-		"",
+		strconv.Itoa(int(hpa.Status.DesiredReplicas)),
+		status,
+		lastScale(hpa.Status.LastScaleTime),
+		asStatus(err),
 		toAge(hpa.ObjectMeta.CreationTimestamp),
 	}
 
@@ -88,6 +101,11 @@ func (h HorizontalPodAutoscaler) renderV2b1(raw *unstructured.Unstructured, _ st
 		return err
 	}
 
+	cc := make([]hpaCondition, 0, len(hpa.Status.Conditions))
+	for _, c := range hpa.Status.Conditions {
+		cc = append(cc, hpaCondition{Type: string(c.Type), Status: c.Status, Reason: c.Reason, Message: c.Message})
+	}
+	status, err := hpaStatus(cc)
 	r.ID = client.MetaFQN(hpa.ObjectMeta)
 	r.Fields = Fields{
 		hpa.Namespace,
@@ -97,7 +115,10 @@ func (h HorizontalPodAutoscaler) renderV2b1(raw *unstructured.Unstructured, _ st
 		strconv.Itoa(int(*hpa.Spec.MinReplicas)),
 		strconv.Itoa(int(hpa.Spec.MaxReplicas)),
 		strconv.Itoa(int(hpa.Status.CurrentReplicas)),
-		"",
+		strconv.Itoa(int(hpa.Status.DesiredReplicas)),
+		status,
+		lastScale(hpa.Status.LastScaleTime),
+		asStatus(err),
 		toAge(hpa.ObjectMeta.CreationTimestamp),
 	}
 
@@ -111,6 +132,11 @@ func (h HorizontalPodAutoscaler) renderV2b2(raw *unstructured.Unstructured, _ st
 		return err
 	}
 
+	cc := make([]hpaCondition, 0, len(hpa.Status.Conditions))
+	for _, c := range hpa.Status.Conditions {
+		cc = append(cc, hpaCondition{Type: string(c.Type), Status: c.Status, Reason: c.Reason, Message: c.Message})
+	}
+	status, err := hpaStatus(cc)
 	r.ID = client.MetaFQN(hpa.ObjectMeta)
 	r.Fields = Fields{
 		hpa.Namespace,
@@ -120,7 +146,10 @@ func (h HorizontalPodAutoscaler) renderV2b2(raw *unstructured.Unstructured, _ st
 		strconv.Itoa(int(*hpa.Spec.MinReplicas)),
 		strconv.Itoa(int(hpa.Spec.MaxReplicas)),
 		strconv.Itoa(int(hpa.Status.CurrentReplicas)),
-		"",
+		strconv.Itoa(int(hpa.Status.DesiredReplicas)),
+		status,
+		lastScale(hpa.Status.LastScaleTime),
+		asStatus(err),
 		toAge(hpa.ObjectMeta.CreationTimestamp),
 	}
 
@@ -130,6 +159,62 @@ func (h HorizontalPodAutoscaler) renderV2b2(raw *unstructured.Unstructured, _ st
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// hpaCondition represents a version agnostic HPA condition.
+type hpaCondition struct {
+	Type    string             `json:"type"`
+	Status  v1.ConditionStatus `json:"status"`
+	Reason  string             `json:"reason"`
+	Message string             `json:"message"`
+}
+
+// hpaConditionsV1 extracts autoscaling/v1 HPA conditions from its annotations.
+func hpaConditionsV1(annotations map[string]string) []hpaCondition {
+	raw, ok := annotations[hpaConditionsAnnotation]
+	if !ok {
+		return nil
+	}
+	var cc []hpaCondition
+	if err := json.Unmarshal([]byte(raw), &cc); err != nil {
+		return nil
+	}
+
+	return cc
+}
+
+// hpaStatus returns the reason an HPA is not scaling if any.
+func hpaStatus(cc []hpaCondition) (string, error) {
+	if len(cc) == 0 {
+		return MissingValue, nil
+	}
+
+	var limited string
+	for _, c := range cc {
+		switch c.Type {
+		case "AbleToScale", "ScalingActive":
+			if c.Status == v1.ConditionFalse {
+				return c.Reason, fmt.Errorf("%s: %s", c.Type, c.Message)
+			}
+		case "ScalingLimited":
+			if c.Status == v1.ConditionTrue {
+				limited = c.Reason
+			}
+		}
+	}
+	if limited != "" {
+		return limited, nil
+	}
+
+	return "Active", nil
+}
+
+func lastScale(t *metav1.Time) string {
+	if t == nil {
+		return MissingValue
+	}
+
+	return toAgeHuman(toAge(*t))
+}
+
 func toMetricsV1(spec autoscalingv1.HorizontalPodAutoscalerSpec, status autoscalingv1.HorizontalPodAutoscalerStatus) string {
 	current := "<unknown>"
 	if status.CurrentCPUUtilizationPercentage != nil {
@@ -13,5 +13,6 @@ func TestHorizontalPodAutoscalerRender(t *testing.T) {
 	c.Render(load(t, "hpa"), "", &r)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	assert.Equal(t, render.Fields{"default", "nginx", "nginx", "<unknown>/10%", "1", "10"}, r.Fields[:6])
+	assert.Equal(t, render.Fields{"default", "nginx", "nginx", "<unknown>/10%", "1", "10", "0", "0", "FailedGetScale", "<none>"}, r.Fields[:10])
+	assert.Contains(t, r.Fields[10], "AbleToScale: the HPA controller was unable to get the target's current scale")
 }
@@ -0,0 +1,117 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Image renders a container image usage to screen.
+type Image struct{}
+
+// ColorerFunc colors a resource row.
+func (Image) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		tagCol, digestCol := h.IndexOf("TAG", true), h.IndexOf("DIGEST", true)
+		switch {
+		case tagCol != -1 && re.Row.Fields[tagCol] == "latest":
+			return HighlightColor
+		case digestCol != -1 && strings.Contains(re.Row.Fields[digestCol], ","):
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Image) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "IMAGE"},
+		HeaderColumn{Name: "TAG"},
+		HeaderColumn{Name: "DIGEST"},
+		HeaderColumn{Name: "PINNED"},
+		HeaderColumn{Name: "PULL POLICY"},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "NAMESPACES", Align: tview.AlignRight},
+		HeaderColumn{Name: "CONTAINERS", Align: tview.AlignRight, Wide: true},
+	}
+}
+
+// Render renders a container image usage to screen.
+func (Image) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(ImageRes)
+	if !ok {
+		return fmt.Errorf("expected ImageRes, but got %T", o)
+	}
+
+	dd := i.Resolved
+	if i.Pinned() {
+		dd = []string{i.Digest}
+	}
+	digests := make([]string, 0, len(dd))
+	for _, d := range dd {
+		digests = append(digests, shortDigest(d))
+	}
+
+	r.ID = i.Image
+	r.Fields = Fields{
+		i.Name,
+		na(i.Tag),
+		missing(strings.Join(digests, ",")),
+		boolToStr(i.Pinned()),
+		missing(strings.Join(i.PullPolicies, ",")),
+		strconv.Itoa(i.Pods),
+		strconv.Itoa(i.Namespaces),
+		strconv.Itoa(i.Containers),
+	}
+
+	return nil
+}
+
+// ImageRes represents a container image usage.
+type ImageRes struct {
+	// Image tracks the image reference as specified by containers.
+	Image        string
+	Name, Tag    string
+	Digest       string
+	PullPolicies []string
+	Pods         int
+	Namespaces   int
+	Containers   int
+	// Resolved tracks the digests nodes pulled for the image.
+	Resolved []string
+}
+
+// Pinned returns true if the image is referenced by digest.
+func (i ImageRes) Pinned() bool {
+	return i.Digest != ""
+}
+
+// GetObjectKind returns a schema object.
+func (ImageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an image copy.
+func (i ImageRes) DeepCopyObject() runtime.Object {
+	return i
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func shortDigest(d string) string {
+	const maxHex = 12
+	tokens := strings.SplitN(d, ":", 2)
+	if len(tokens) != 2 || len(tokens[1]) <= maxHex {
+		return d
+	}
+
+	return tokens[0] + ":" + tokens[1][:maxHex]
+}
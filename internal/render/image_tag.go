@@ -0,0 +1,79 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ImageTag renders an image repository tag to screen.
+type ImageTag struct{}
+
+// ColorerFunc colors a resource row.
+func (ImageTag) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		currentCol := h.IndexOf("CURRENT", true)
+		if currentCol != -1 && re.Row.Fields[currentCol] == "true" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (ImageTag) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "TAG"},
+		HeaderColumn{Name: "DIGEST"},
+		HeaderColumn{Name: "CURRENT"},
+		HeaderColumn{Name: "CREATED", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an image repository tag to screen.
+func (ImageTag) Render(o interface{}, ns string, r *Row) error {
+	t, ok := o.(ImageTagRes)
+	if !ok {
+		return fmt.Errorf("expected ImageTagRes, but got %T", o)
+	}
+
+	created, age := MissingValue, MissingValue
+	if !t.Created.IsZero() {
+		created, age = t.Created.Format(time.RFC3339), timeToAge(t.Created)
+	}
+
+	r.ID = t.Tag
+	r.Fields = Fields{
+		t.Tag,
+		missing(shortDigest(t.Digest)),
+		boolToStr(t.Current),
+		created,
+		age,
+	}
+
+	return nil
+}
+
+// ImageTagRes represents an image repository tag.
+type ImageTagRes struct {
+	Tag     string
+	Digest  string
+	Created time.Time
+	// Current tracks whether the container runs this tag.
+	Current bool
+}
+
+// GetObjectKind returns a schema object.
+func (ImageTagRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an image tag copy.
+func (t ImageTagRes) DeepCopyObject() runtime.Object {
+	return t
+}
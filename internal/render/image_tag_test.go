@@ -0,0 +1,27 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageTagRender(t *testing.T) {
+	var r render.Row
+	err := render.ImageTag{}.Render(render.ImageTagRes{
+		Tag:     "1.17",
+		Digest:  "sha256:0123456789abcdef",
+		Created: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+		Current: true,
+	}, "", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1.17", r.ID)
+	assert.Equal(t, render.Fields{"1.17", "sha256:0123456789ab", "true", "2020-05-01T10:00:00Z"}, r.Fields[:4])
+
+	err = render.ImageTag{}.Render(render.ImageTagRes{Tag: "blee"}, "", &r)
+	assert.Nil(t, err)
+	assert.Equal(t, render.Fields{"blee", render.MissingValue, "false", render.MissingValue, render.MissingValue}, r.Fields)
+}
@@ -0,0 +1,52 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRender(t *testing.T) {
+	uu := map[string]struct {
+		i render.ImageRes
+		e render.Fields
+	}{
+		"latest": {
+			i: render.ImageRes{
+				Image:        "nginx",
+				Name:         "nginx",
+				Tag:          "latest",
+				PullPolicies: []string{"Always"},
+				Pods:         2,
+				Namespaces:   1,
+				Containers:   3,
+				Resolved:     []string{"sha256:0123456789abcdef", "sha256:fedcba9876543210"},
+			},
+			e: render.Fields{"nginx", "latest", "sha256:0123456789ab,sha256:fedcba987654", "false", "Always", "2", "1", "3"},
+		},
+		"pinned": {
+			i: render.ImageRes{
+				Image:      "fred@sha256:abc",
+				Name:       "fred",
+				Digest:     "sha256:abc",
+				Pods:       1,
+				Namespaces: 1,
+				Containers: 1,
+				Resolved:   []string{"sha256:abc"},
+			},
+			e: render.Fields{"fred", render.NAValue, "sha256:abc", "true", render.MissingValue, "1", "1", "1"},
+		},
+	}
+
+	var i render.Image
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, i.Render(u.i, "", &r))
+			assert.Equal(t, u.i.Image, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Informer renders an active resource informer to screen.
+type Informer struct{}
+
+// ColorerFunc colors a resource row.
+func (Informer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		syncCol := h.IndexOf("SYNCED", true)
+		if syncCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[syncCol] != "true" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Informer) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "OBJECTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEMORY", Align: tview.AlignRight},
+		HeaderColumn{Name: "LISTENERS", Align: tview.AlignRight},
+		HeaderColumn{Name: "SYNCED"},
+		HeaderColumn{Name: "IDLE"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an informer to screen.
+func (Informer) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(InformerRes)
+	if !ok {
+		return fmt.Errorf("expected InformerRes, but got %T", o)
+	}
+
+	ns := res.Namespace
+	if ns == client.AllNamespaces {
+		ns = client.NamespaceAll
+	}
+	r.ID = res.Namespace + ":" + res.GVR
+	if res.Selector != "" {
+		r.ID += "|" + res.Selector
+	}
+	r.Fields = Fields{
+		res.GVR,
+		ns,
+		na(res.Selector),
+		strconv.Itoa(res.Objects),
+		toMiB(res.Bytes),
+		strconv.Itoa(res.Listeners),
+		boolToStr(res.Synced),
+		duration.HumanDuration(time.Since(res.LastUsed)),
+		timeToAge(res.Started),
+	}
+
+	return nil
+}
+
+// InformerRes represents an active resource informer.
+type InformerRes struct {
+	watch.InformerStat
+}
+
+// GetObjectKind returns a schema object.
+func (InformerRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i InformerRes) DeepCopyObject() runtime.Object {
+	return i
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// toMiB shows a memory size in a single unit so the column sorts naturally.
+func toMiB(b int64) string {
+	return fmt.Sprintf("%.1fMi", float64(b)/(1024*1024))
+}
@@ -0,0 +1,30 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformerRender(t *testing.T) {
+	res := render.InformerRes{InformerStat: watch.InformerStat{
+		GVR:       "v1/pods",
+		Objects:   12,
+		Bytes:     3 * 1024 * 1024 / 2,
+		Listeners: 1,
+		Synced:    true,
+		Started:   time.Now().Add(-time.Hour),
+		LastUsed:  time.Now().Add(-2 * time.Minute),
+	}}
+
+	var (
+		i render.Informer
+		r render.Row
+	)
+	assert.Nil(t, i.Render(res, "", &r))
+	assert.Equal(t, ":v1/pods", r.ID)
+	assert.Equal(t, render.Fields{"v1/pods", "all", "n/a", "12", "1.5Mi", "1", "true", "2m"}, r.Fields[:8])
+}
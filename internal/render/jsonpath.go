@@ -0,0 +1,37 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// JSONPath represents a field expression evaluated against a resource.
+type JSONPath struct {
+	jp *jsonpath.JSONPath
+}
+
+// NewJSONPath compiles a field expression, ie .spec.nodeName or {.metadata.labels.team}.
+func NewJSONPath(name, expr string) (*JSONPath, error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, fmt.Errorf("invalid field expression %s: %v", expr, err)
+	}
+
+	return &JSONPath{jp: jp}, nil
+}
+
+// Eval returns the expression value for a given resource or blank if missing.
+func (j *JSONPath) Eval(o map[string]interface{}) (string, error) {
+	var buff bytes.Buffer
+	if err := j.jp.Execute(&buff, o); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
@@ -0,0 +1,48 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPathEval(t *testing.T) {
+	o := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "blee", "app.kubernetes.io/name": "fred"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1"},
+				map[string]interface{}{"name": "c2"},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		expr, e string
+		err     bool
+	}{
+		"plain":   {expr: ".metadata.labels.team", e: "blee"},
+		"braces":  {expr: "{.metadata.labels.team}", e: "blee"},
+		"escaped": {expr: `.metadata.labels['app\.kubernetes\.io/name']`, e: "fred"},
+		"range":   {expr: ".spec.containers[*].name", e: "c1 c2"},
+		"missing": {expr: ".spec.nodeName", e: ""},
+		"toast":   {expr: ".spec[[", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			jp, err := render.NewJSONPath(k, u.expr)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			v, err := jp.Eval(o)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
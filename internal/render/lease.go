@@ -0,0 +1,128 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// holderIDRX matches the random suffix leader election appends to a holder hostname.
+var holderIDRX = regexp.MustCompile(`_[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Lease renders a K8s Lease to screen.
+type Lease struct{}
+
+// ColorerFunc colors a resource row.
+func (Lease) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		holderCol := h.IndexOf("HOLDER", true)
+		if c == StdColor && holderCol != -1 && re.Row.Fields[holderCol] == MissingValue {
+			return CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Lease) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "HOLDER"},
+		HeaderColumn{Name: "DURATION", Align: tview.AlignRight},
+		HeaderColumn{Name: "RENEWED", Align: tview.AlignRight},
+		HeaderColumn{Name: "TRANSITIONS", Align: tview.AlignRight},
+		HeaderColumn{Name: "STALE"},
+		HeaderColumn{Name: "IDENTITY", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (l Lease) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Lease, but got %T", o)
+	}
+	var lease coordinationv1.Lease
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &lease)
+	if err != nil {
+		return err
+	}
+
+	var identity string
+	if lease.Spec.HolderIdentity != nil {
+		identity = *lease.Spec.HolderIdentity
+	}
+	duration, renewed, transitions := MissingValue, MissingValue, "0"
+	if d := lease.Spec.LeaseDurationSeconds; d != nil {
+		duration = (time.Duration(*d) * time.Second).String()
+	}
+	if t := lease.Spec.RenewTime; t != nil {
+		renewed = toAgeHuman(toAge(metav1.Time{Time: t.Time}))
+	}
+	if t := lease.Spec.LeaseTransitions; t != nil {
+		transitions = strconv.Itoa(int(*t))
+	}
+	stale := LeaseStale(&lease, time.Now())
+
+	r.ID = client.MetaFQN(lease.ObjectMeta)
+	r.Fields = Fields{
+		lease.Namespace,
+		lease.Name,
+		missing(LeaseHolder(identity)),
+		duration,
+		renewed,
+		transitions,
+		boolToStr(stale),
+		missing(identity),
+		mapToStr(lease.Labels),
+		asStatus(l.diagnose(stale)),
+		toAge(lease.ObjectMeta.CreationTimestamp),
+	}
+
+	return nil
+}
+
+func (Lease) diagnose(stale bool) error {
+	if stale {
+		return fmt.Errorf("lease was not renewed within its duration")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// LeaseHolder decodes a lease holder identity into the holder host or pod
+// name by stripping the leader election id suffix.
+func LeaseHolder(identity string) string {
+	return holderIDRX.ReplaceAllString(identity, "")
+}
+
+// LeaseStale checks if a held lease was not renewed within its duration.
+func LeaseStale(l *coordinationv1.Lease, now time.Time) bool {
+	if l.Spec.HolderIdentity == nil || *l.Spec.HolderIdentity == "" {
+		return false
+	}
+	if l.Spec.RenewTime == nil || l.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := l.Spec.RenewTime.Add(time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second)
+
+	return now.After(expiry)
+}
@@ -0,0 +1,60 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLeaseRender(t *testing.T) {
+	c := render.Lease{}
+	r := render.NewRow(11)
+	assert.Nil(t, c.Render(load(t, "lease"), "", &r))
+
+	assert.Equal(t, "default/fred-controller", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred-controller", "fred-7d9c5b6f4-x2kqp", "15s"}, r.Fields[:4])
+	assert.Equal(t, render.Fields{"3", "true", "fred-7d9c5b6f4-x2kqp_0e3a5c1d-4b2f-4d8e-9a1b-2c3d4e5f6a7b"}, r.Fields[5:8])
+	assert.Equal(t, "lease was not renewed within its duration", r.Fields[9])
+}
+
+func TestLeaseHolder(t *testing.T) {
+	uu := map[string]struct {
+		identity, e string
+	}{
+		"election": {identity: "fred-x2kqp_0e3a5c1d-4b2f-4d8e-9a1b-2c3d4e5f6a7b", e: "fred-x2kqp"},
+		"plain":    {identity: "node-1", e: "node-1"},
+		"other":    {identity: "fred_blee", e: "fred_blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.LeaseHolder(u.identity))
+		})
+	}
+}
+
+func TestLeaseStale(t *testing.T) {
+	now := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	holder, duration := "fred", int32(15)
+	uu := map[string]struct {
+		spec coordinationv1.LeaseSpec
+		e    bool
+	}{
+		"fresh":     {spec: coordinationv1.LeaseSpec{HolderIdentity: &holder, LeaseDurationSeconds: &duration, RenewTime: &metav1.MicroTime{Time: now.Add(-10 * time.Second)}}},
+		"expired":   {spec: coordinationv1.LeaseSpec{HolderIdentity: &holder, LeaseDurationSeconds: &duration, RenewTime: &metav1.MicroTime{Time: now.Add(-20 * time.Second)}}, e: true},
+		"neverSeen": {spec: coordinationv1.LeaseSpec{HolderIdentity: &holder}, e: true},
+		"released":  {spec: coordinationv1.LeaseSpec{LeaseDurationSeconds: &duration}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.LeaseStale(&coordinationv1.Lease{Spec: u.spec}, now))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of network policy rule directions.
+const (
+	NetpolIngress = "Ingress"
+	NetpolEgress  = "Egress"
+
+	netpolAll  = "all"
+	netpolNone = "none"
+)
+
+// NetpolRule renders a pod network policy rule to screen.
+type NetpolRule struct{}
+
+// ColorerFunc colors a resource row.
+func (NetpolRule) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("PEERS", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[col] {
+		case netpolNone:
+			return ErrColor
+		case netpolAll:
+			return AddColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (NetpolRule) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "DIRECTION"},
+		HeaderColumn{Name: "PEERS"},
+		HeaderColumn{Name: "PORTS"},
+	}
+}
+
+// Render renders a network policy rule to screen.
+func (NetpolRule) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(NetpolRuleRes)
+	if !ok {
+		return fmt.Errorf("expected NetpolRuleRes, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{client.FQN(res.Namespace, res.Policy), res.Direction, strconv.Itoa(res.Index)}, PolicyRefSeparator)
+	policy, peers, ports := res.Policy, netpolPeers(res.Peers), netpolPorts(res.Ports)
+	switch {
+	case policy == "":
+		policy = NAValue
+	case res.DenyAll:
+		peers, ports = netpolNone, netpolNone
+	}
+	r.Fields = Fields{
+		policy,
+		res.Direction,
+		peers,
+		ports,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func netpolPeers(pp []networkingv1.NetworkPolicyPeer) string {
+	if len(pp) == 0 {
+		return netpolAll
+	}
+
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.IPBlock != nil {
+			s := p.IPBlock.CIDR
+			if len(p.IPBlock.Except) > 0 {
+				s += "[except " + strings.Join(p.IPBlock.Except, ",") + "]"
+			}
+			ss = append(ss, s)
+			continue
+		}
+		var tt []string
+		if p.NamespaceSelector != nil {
+			tt = append(tt, "ns:"+selectorToStr(p.NamespaceSelector))
+		}
+		if p.PodSelector != nil {
+			tt = append(tt, "po:"+selectorToStr(p.PodSelector))
+		}
+		ss = append(ss, strings.Join(tt, "+"))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func selectorToStr(sel *metav1.LabelSelector) string {
+	s, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil || s.Empty() {
+		return netpolAll
+	}
+
+	return s.String()
+}
+
+func netpolPorts(pp []networkingv1.NetworkPolicyPort) string {
+	if len(pp) == 0 {
+		return netpolAll
+	}
+
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		proto, port := "TCP", netpolAll
+		if p.Protocol != nil {
+			proto = string(*p.Protocol)
+		}
+		if p.Port != nil {
+			port = p.Port.String()
+		}
+		ss = append(ss, proto+":"+port)
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// NetpolRuleRes represents a network policy rule applying to a pod.
+type NetpolRuleRes struct {
+	Namespace, Policy string
+	Direction         string
+	Index             int
+	DenyAll           bool
+	Peers             []networkingv1.NetworkPolicyPeer
+	Ports             []networkingv1.NetworkPolicyPort
+}
+
+// GetObjectKind returns a schema object.
+func (NetpolRuleRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NetpolRuleRes) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,61 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNetpolRuleRender(t *testing.T) {
+	udp, port := v1.ProtocolUDP, intstr.FromInt(53)
+	uu := map[string]struct {
+		o  render.NetpolRuleRes
+		id string
+		e  render.Fields
+	}{
+		"unselected": {
+			o:  render.NetpolRuleRes{Namespace: "default", Direction: render.NetpolIngress},
+			id: "default/|Ingress|0",
+			e:  render.Fields{"n/a", "Ingress", "all", "all"},
+		},
+		"denyAll": {
+			o:  render.NetpolRuleRes{Namespace: "default", Policy: "deny", Direction: render.NetpolEgress, DenyAll: true},
+			id: "default/deny|Egress|0",
+			e:  render.Fields{"deny", "Egress", "none", "none"},
+		},
+		"rule": {
+			o: render.NetpolRuleRes{
+				Namespace: "default",
+				Policy:    "dns",
+				Direction: render.NetpolEgress,
+				Index:     1,
+				Peers: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+					{
+						NamespaceSelector: &metav1.LabelSelector{},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dns"}},
+					},
+				},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &port}, {}},
+			},
+			id: "default/dns|Egress|1",
+			e:  render.Fields{"dns", "Egress", "10.0.0.0/8[except 10.1.0.0/16] ns:all+po:app=dns", "UDP:53,TCP:all"},
+		},
+	}
+
+	var n render.NetpolRule
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, n.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -40,8 +40,8 @@ func (Node) Header(_ string) Header {
 		HeaderColumn{Name: "EXTERNAL-IP", Wide: true},
 		HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%CPU", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%MEM", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%CPU", Align: tview.AlignRight, MX: true, Gauge: true},
+		HeaderColumn{Name: "%MEM", Align: tview.AlignRight, MX: true, Gauge: true},
 		HeaderColumn{Name: "ACPU", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "AMEM", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
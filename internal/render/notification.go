@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Notification renders a raised notification to screen.
+type Notification struct{}
+
+// ColorerFunc colors a resource row.
+func (Notification) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		activeCol := h.IndexOf("ACTIVE", true)
+		if activeCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[activeCol] == "true" {
+			return ErrColor
+		}
+
+		return CompletedColor
+	}
+}
+
+// Header returns a header row.
+func (Notification) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "RULE"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "ACTIVE"},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "FIRST"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a notification to screen.
+func (Notification) Render(o interface{}, ns string, r *Row) error {
+	n, ok := o.(NotificationRes)
+	if !ok {
+		return fmt.Errorf("expected NotificationRes, but got %T", o)
+	}
+
+	r.ID = n.Rule + ":" + n.Path
+	r.Fields = Fields{
+		n.Rule,
+		n.GVR,
+		n.Path,
+		n.Status,
+		boolToStr(n.Active),
+		strconv.Itoa(n.Count),
+		duration.HumanDuration(time.Since(n.First)) + " ago",
+		timeToAge(n.Last),
+	}
+
+	return nil
+}
+
+// NotificationRes represents a notification raised by a rule on a resource.
+type NotificationRes struct {
+	Rule   string    `json:"rule"`
+	GVR    string    `json:"resource"`
+	Path   string    `json:"name"`
+	Status string    `json:"status"`
+	Active bool      `json:"active"`
+	Count  int       `json:"count"`
+	First  time.Time `json:"firstSeen"`
+	Last   time.Time `json:"lastSeen"`
+}
+
+// GetObjectKind returns a schema object.
+func (NotificationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NotificationRes) DeepCopyObject() runtime.Object {
+	return n
+}
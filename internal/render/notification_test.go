@@ -0,0 +1,52 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationRender(t *testing.T) {
+	now := time.Now()
+	res := render.NotificationRes{
+		Rule:   "crash",
+		GVR:    "v1/pods",
+		Path:   "default/p1",
+		Status: "CrashLoopBackOff",
+		Active: true,
+		Count:  2,
+		First:  now.Add(-10 * time.Minute),
+		Last:   now,
+	}
+
+	var (
+		n render.Notification
+		r render.Row
+	)
+	assert.Nil(t, n.Render(res, "", &r))
+	assert.Equal(t, "crash:default/p1", r.ID)
+	assert.Equal(t, render.Fields{"crash", "v1/pods", "default/p1", "CrashLoopBackOff", "true", "2", "10m ago"}, r.Fields[:7])
+}
+
+func TestNotificationColorer(t *testing.T) {
+	var n render.Notification
+	h := n.Header("")
+	uu := map[string]struct {
+		active string
+		e      tcell.Color
+	}{
+		"active":   {active: "true", e: render.ErrColor},
+		"resolved": {active: "false", e: render.CompletedColor},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := render.RowEvent{Row: render.Row{Fields: render.Fields{"crash", "v1/pods", "default/p1", "CrashLoopBackOff", u.active, "1", "", ""}}}
+			assert.Equal(t, u.e, n.ColorerFunc()("", h, re))
+		})
+	}
+}
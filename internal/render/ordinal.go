@@ -0,0 +1,151 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// RestartPending represents an ordinal awaiting its restart.
+	RestartPending = "Pending"
+
+	// RestartRunning represents an ordinal being restarted.
+	RestartRunning = "Restarting"
+
+	// RestartDone represents a restarted ordinal.
+	RestartDone = "Restarted"
+
+	// RestartSkipped represents an ordinal guarded by the rolling update partition.
+	RestartSkipped = "Skipped"
+
+	// RestartFailed represents an ordinal that failed to restart.
+	RestartFailed = "Failed"
+)
+
+// Ordinal renders a statefulset ordinal to screen.
+type Ordinal struct{}
+
+// ColorerFunc colors a resource row.
+func (Ordinal) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		restartCol := h.IndexOf("RESTART", true)
+		if restartCol != -1 {
+			switch re.Row.Fields[restartCol] {
+			case RestartRunning:
+				return HighlightColor
+			case RestartFailed:
+				return ErrColor
+			}
+		}
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		partCol := h.IndexOf("PARTITIONED", true)
+		if partCol != -1 && re.Row.Fields[partCol] == "true" {
+			return CompletedColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Ordinal) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "ORDINAL", Align: tview.AlignRight},
+		HeaderColumn{Name: "POD"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "UPDATED"},
+		HeaderColumn{Name: "PARTITIONED"},
+		HeaderColumn{Name: "PVCS"},
+		HeaderColumn{Name: "RESTART"},
+		HeaderColumn{Name: "NODE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a statefulset ordinal to screen.
+func (Ordinal) Render(i interface{}, ns string, r *Row) error {
+	res, ok := i.(OrdinalRes)
+	if !ok {
+		return fmt.Errorf("expected OrdinalRes, but got %T", i)
+	}
+
+	ready, status, node, age := MissingValue, MissingValue, MissingValue, MissingValue
+	var err error
+	if po := res.Pod; po != nil {
+		var p Pod
+		cr, _, _ := p.Statuses(po.Status.ContainerStatuses)
+		ready = strconv.Itoa(cr) + "/" + strconv.Itoa(len(po.Status.ContainerStatuses))
+		status = p.Phase(po)
+		node = na(po.Spec.NodeName)
+		age = toAge(po.CreationTimestamp)
+		err = p.diagnose(status, cr, len(po.Status.ContainerStatuses))
+	} else {
+		err = fmt.Errorf("pod %s is missing", res.Name)
+	}
+
+	pvcs := make([]string, 0, len(res.PVCs))
+	for _, pvc := range res.PVCs {
+		pvcs = append(pvcs, pvc.Name+":"+pvc.Status)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Name)
+	r.Fields = Fields{
+		strconv.Itoa(res.Ordinal),
+		res.Name,
+		ready,
+		status,
+		boolToStr(res.Updated),
+		boolToStr(res.Partitioned),
+		missing(strings.Join(pvcs, ",")),
+		missing(res.Restart),
+		node,
+		asStatus(err),
+		age,
+	}
+
+	return nil
+}
+
+// OrdinalPVC represents a statefulset ordinal volume claim.
+type OrdinalPVC struct {
+	Name   string
+	Status string
+}
+
+// OrdinalRes represents a statefulset ordinal along with its pod and claims.
+type OrdinalRes struct {
+	Ordinal   int
+	Namespace string
+	Name      string
+	// Pod is nil when the ordinal pod does not exist.
+	Pod *v1.Pod
+	// Updated tracks whether the pod runs the statefulset update revision.
+	Updated bool
+	// Partitioned tracks whether the ordinal is below the rolling update partition.
+	Partitioned bool
+	PVCs        []OrdinalPVC
+	// Restart tracks the ordinal rolling restart progress if any.
+	Restart string
+}
+
+// GetObjectKind returns a schema object.
+func (OrdinalRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns an ordinal copy.
+func (o OrdinalRes) DeepCopyObject() runtime.Object {
+	return o
+}
@@ -0,0 +1,41 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOrdinalRender(t *testing.T) {
+	var r render.Row
+	err := render.Ordinal{}.Render(render.OrdinalRes{
+		Ordinal:   1,
+		Namespace: "default",
+		Name:      "fred-1",
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "fred-1", CreationTimestamp: metav1.Now()},
+			Spec:       v1.PodSpec{NodeName: "n1"},
+			Status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+		},
+		Updated: true,
+		PVCs:    []render.OrdinalPVC{{Name: "data-fred-1", Status: "Bound"}},
+		Restart: render.RestartDone,
+	}, "", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "default/fred-1", r.ID)
+	assert.Equal(t, render.Fields{"1", "fred-1", "1/1", "Running", "true", "false", "data-fred-1:Bound", "Restarted", "n1", ""}, r.Fields[:10])
+
+	err = render.Ordinal{}.Render(render.OrdinalRes{Ordinal: 2, Namespace: "default", Name: "fred-2", Partitioned: true}, "", &r)
+	assert.Nil(t, err)
+	assert.Equal(t, render.Fields{"2", "fred-2", render.MissingValue, render.MissingValue, "false", "true", render.MissingValue, render.MissingValue, render.MissingValue}, r.Fields[:9])
+	assert.Equal(t, "pod fred-2 is missing", r.Fields[9])
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// PaletteAlias tracks a resource alias entry.
+	PaletteAlias = "alias"
+	// PaletteCRD tracks a custom resource alias entry.
+	PaletteCRD = "crd"
+	// PaletteRecent tracks a recent command entry.
+	PaletteRecent = "recent"
+	// PaletteAction tracks a key action entry.
+	PaletteAction = "action"
+)
+
+// Palette renders a command palette entry to screen.
+type Palette struct{}
+
+// ColorerFunc colors a resource row.
+func (Palette) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		kindCol := h.IndexOf("KIND", true)
+		if kindCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[kindCol] {
+		case PaletteRecent:
+			return HighlightColor
+		case PaletteAction:
+			return tcell.ColorMediumSpringGreen
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Palette) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "DESCRIPTION"},
+	}
+}
+
+// Render renders a palette entry to screen.
+func (Palette) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(PaletteRes)
+	if !ok {
+		return fmt.Errorf("expected PaletteRes, but got %T", o)
+	}
+
+	r.ID = p.Kind + ":" + p.Command
+	r.Fields = Fields{
+		p.Name,
+		p.Kind,
+		p.Description,
+	}
+
+	return nil
+}
+
+// PaletteRes represents a command palette entry.
+type PaletteRes struct {
+	Kind        string
+	Name        string
+	Description string
+	Command     string
+}
+
+// GetObjectKind returns a schema object.
+func (PaletteRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PaletteRes) DeepCopyObject() runtime.Object {
+	return p
+}
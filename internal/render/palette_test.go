@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.PaletteRes
+		id  string
+		e   render.Fields
+	}{
+		"alias": {
+			res: render.PaletteRes{Kind: render.PaletteAlias, Name: "po,pod,pods", Description: "View Pod", Command: "po"},
+			id:  "alias:po",
+			e:   render.Fields{"po,pod,pods", "alias", "View Pod"},
+		},
+		"action": {
+			res: render.PaletteRes{Kind: render.PaletteAction, Name: "Describe", Description: "Press d", Command: "d"},
+			id:  "action:d",
+			e:   render.Fields{"Describe", "action", "Press d"},
+		},
+	}
+
+	var p render.Palette
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.res, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
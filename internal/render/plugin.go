@@ -0,0 +1,123 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// PluginKind represents a plugin custom action.
+	PluginKind = "plugin"
+
+	// HotKeyKind represents a hotkey custom action.
+	HotKeyKind = "hotkey"
+)
+
+// Plugin renders plugins and hotkeys to screen.
+type Plugin struct{}
+
+// ColorerFunc colors a resource row.
+func (Plugin) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		enabledCol := h.IndexOf("ENABLED", true)
+		if enabledCol >= 0 && strings.TrimSpace(re.Row.Fields[enabledCol]) == "false" {
+			return tcell.ColorGray
+		}
+
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (Plugin) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SHORTCUT"},
+		HeaderColumn{Name: "SCOPES"},
+		HeaderColumn{Name: "COMMAND"},
+		HeaderColumn{Name: "DESCRIPTION"},
+		HeaderColumn{Name: "ENABLED"},
+		HeaderColumn{Name: "VALID"},
+		HeaderColumn{Name: "SOURCE", Wide: true},
+	}
+}
+
+// Render renders a plugin or hotkey to screen.
+func (p Plugin) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(PluginRes)
+	if !ok {
+		return fmt.Errorf("expected PluginRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Kind, res.Name)
+	r.Fields = Fields{
+		res.Kind,
+		res.Name,
+		res.ShortCut,
+		strings.Join(res.Scopes, ","),
+		strings.TrimSpace(res.Command + " " + strings.Join(res.Args, " ")),
+		res.Description,
+		boolToStr(!res.Disabled),
+		asStatus(p.diagnose(res)),
+		res.Source,
+	}
+
+	return nil
+}
+
+func (Plugin) diagnose(res PluginRes) error {
+	if res.Command == "" {
+		return errors.New("missing command")
+	}
+	if !isKeyName(res.ShortCut) {
+		return fmt.Errorf("unknown shortcut %q", res.ShortCut)
+	}
+
+	return nil
+}
+
+func isKeyName(s string) bool {
+	for _, n := range tcell.KeyNames {
+		if n == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PluginRes represents a plugin or hotkey resource.
+type PluginRes struct {
+	Kind        string
+	Name        string
+	ShortCut    string
+	Scopes      []string
+	Command     string
+	Args        []string
+	Description string
+	Disabled    bool
+	Source      string
+}
+
+// GetObjectKind returns a schema object.
+func (PluginRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PluginRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,100 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.PluginRes
+		e render.Row
+	}{
+		"plugin": {
+			o: render.PluginRes{
+				Kind:        render.PluginKind,
+				Name:        "fred",
+				ShortCut:    "Ctrl-L",
+				Scopes:      []string{"po", "dp"},
+				Command:     "kubectl",
+				Args:        []string{"logs", "$NAME"},
+				Description: "Logs",
+				Source:      "plugin.yml",
+			},
+			e: render.Row{
+				ID:     "plugin/fred",
+				Fields: render.Fields{"plugin", "fred", "Ctrl-L", "po,dp", "kubectl logs $NAME", "Logs", "true", "", "plugin.yml"},
+			},
+		},
+		"disabledHotKey": {
+			o: render.PluginRes{
+				Kind:     render.HotKeyKind,
+				Name:     "pods",
+				ShortCut: "Ctrl-P",
+				Command:  "pods",
+				Disabled: true,
+			},
+			e: render.Row{
+				ID:     "hotkey/pods",
+				Fields: render.Fields{"hotkey", "pods", "Ctrl-P", "", "pods", "", "false", "", ""},
+			},
+		},
+		"badShortcut": {
+			o: render.PluginRes{
+				Kind:     render.PluginKind,
+				Name:     "zorg",
+				ShortCut: "Zorg",
+				Command:  "ls",
+			},
+			e: render.Row{
+				ID:     "plugin/zorg",
+				Fields: render.Fields{"plugin", "zorg", "Zorg", "", "ls", "", "true", `unknown shortcut "Zorg"`, ""},
+			},
+		},
+		"noCommand": {
+			o: render.PluginRes{
+				Kind:     render.PluginKind,
+				Name:     "blee",
+				ShortCut: "Ctrl-L",
+			},
+			e: render.Row{
+				ID:     "plugin/blee",
+				Fields: render.Fields{"plugin", "blee", "Ctrl-L", "", "", "", "true", "missing command", ""},
+			},
+		},
+	}
+
+	var p render.Plugin
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.o, "", &r))
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
+
+func TestPluginColorer(t *testing.T) {
+	var p render.Plugin
+	h := p.Header("")
+	uu := map[string]struct {
+		f render.Fields
+		e tcell.Color
+	}{
+		"enabled":  {f: render.Fields{"plugin", "fred", "Ctrl-L", "", "k", "", "true", "", ""}, e: tcell.ColorMediumSpringGreen},
+		"disabled": {f: render.Fields{"plugin", "fred", "Ctrl-L", "", "k", "", "false", "", ""}, e: tcell.ColorGray},
+		"invalid":  {f: render.Fields{"plugin", "fred", "Ctrl-L", "", "", "", "true", "missing command", ""}, e: render.ErrColor},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: u.f}}
+			assert.Equal(t, u.e, p.ColorerFunc()("", h, re))
+		})
+	}
+}
@@ -63,10 +63,11 @@ func (Pod) Header(ns string) Header {
 		HeaderColumn{Name: "STATUS"},
 		HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
-		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true, Gauge: true},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true, Gauge: true},
+		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true, Gauge: true},
+		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true, Gauge: true},
+		HeaderColumn{Name: "FIT", Align: tview.AlignRight, MX: true, Fit: true},
 		HeaderColumn{Name: "IP"},
 		HeaderColumn{Name: "NODE"},
 		HeaderColumn{Name: "QOS", Wide: true},
@@ -106,6 +107,7 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		perc.mem,
 		perc.cpuLim,
 		perc.memLim,
+		perc.fit,
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
@@ -166,6 +168,7 @@ func (*Pod) gatherPodMX(pod *v1.Pod, mx *mv1beta1.PodMetrics) (c, p metric) {
 		mem:    IntToStr(client.ToPercentage(client.ToMB(mem.Value()), client.ToMB(rm.Value()))),
 		cpuLim: IntToStr(client.ToPercentage(cpu.MilliValue(), lc.MilliValue())),
 		memLim: IntToStr(client.ToPercentage(client.ToMB(mem.Value()), client.ToMB(lm.Value()))),
+		fit:    usageFit(cpu.MilliValue(), rc.MilliValue(), client.ToMB(mem.Value()), client.ToMB(rm.Value())),
 	}
 
 	return
@@ -55,6 +55,9 @@ func (Policy) Render(o interface{}, gvr string, r *Row) error {
 	}
 
 	r.ID = client.FQN(p.Namespace, p.Resource)
+	if p.BindingRef != "" {
+		r.ID = p.BindingRef + PolicyRefSeparator + r.ID
+	}
 	r.Fields = append(r.Fields,
 		p.Namespace,
 		cleanseResource(p.Resource),
@@ -78,6 +81,9 @@ func cleanseResource(r string) string {
 	return n
 }
 
+// PolicyRefSeparator separates a policy binding reference from its rule.
+const PolicyRefSeparator = "|"
+
 // PolicyRes represents a rback policy rule.
 type PolicyRes struct {
 	Namespace, Binding string
@@ -85,6 +91,7 @@ type PolicyRes struct {
 	ResourceName       string
 	NonResourceURL     string
 	Verbs              []string
+	BindingRef         string
 }
 
 // NewPolicyRes returns a new policy.
@@ -40,3 +40,19 @@ func TestPolicyRender(t *testing.T) {
 		"",
 	}, r.Fields)
 }
+
+func TestPolicyRenderBindingRef(t *testing.T) {
+	var p render.Policy
+
+	var r render.Row
+	o := render.PolicyRes{
+		Namespace:  "blee",
+		Binding:    "RO:fred",
+		Resource:   "res",
+		Verbs:      []string{"get"},
+		BindingRef: "rbac.authorization.k8s.io/v1/rolebindings:blee/fred",
+	}
+
+	assert.Nil(t, p.Render(o, "fred", &r))
+	assert.Equal(t, "rbac.authorization.k8s.io/v1/rolebindings:blee/fred|blee/res", r.ID)
+}
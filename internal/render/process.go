@@ -0,0 +1,62 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Process renders a container process to screen.
+type Process struct{}
+
+// ColorerFunc colors a resource row.
+func (Process) ColorerFunc() ColorerFunc {
+	return DefaultColorer
+}
+
+// Header returns a header row.
+func (Process) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "PID", Align: tview.AlignRight},
+		HeaderColumn{Name: "USER"},
+		HeaderColumn{Name: "%CPU", Align: tview.AlignRight},
+		HeaderColumn{Name: "%MEM", Align: tview.AlignRight},
+		HeaderColumn{Name: "COMMAND"},
+	}
+}
+
+// Render renders a container process to screen.
+func (Process) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ProcessRes)
+	if !ok {
+		return fmt.Errorf("expected ProcessRes, but got %T", o)
+	}
+
+	r.ID = res.PID
+	r.Fields = Fields{
+		res.PID,
+		res.User,
+		res.CPU,
+		res.MEM,
+		res.Command,
+	}
+
+	return nil
+}
+
+// ProcessRes represents a container process.
+type ProcessRes struct {
+	PID, User, CPU, MEM, Command string
+}
+
+// GetObjectKind returns a schema object.
+func (ProcessRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p ProcessRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,20 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessRender(t *testing.T) {
+	var (
+		p render.Process
+		r render.Row
+	)
+	res := render.ProcessRes{PID: "1", User: "root", CPU: "0.3", MEM: "1.2", Command: "nginx: master process"}
+
+	assert.Nil(t, p.Render(res, "", &r))
+	assert.Equal(t, "1", r.ID)
+	assert.Equal(t, render.Fields{"1", "root", "0.3", "1.2", "nginx: master process"}, r.Fields)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,7 +15,18 @@ type PersistentVolumeClaim struct{}
 
 // ColorerFunc colors a resource row.
 func (p PersistentVolumeClaim) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		col := h.IndexOf("STATUS", true)
+		if col == -1 || re.Kind == EventDelete {
+			return c
+		}
+		if re.Row.Fields[col] == string(v1.ClaimPending) {
+			return HighlightColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header rbw.
@@ -27,6 +39,7 @@ func (PersistentVolumeClaim) Header(ns string) Header {
 		HeaderColumn{Name: "CAPACITY"},
 		HeaderColumn{Name: "ACCESS MODES"},
 		HeaderColumn{Name: "STORAGECLASS"},
+		HeaderColumn{Name: "VOLUMEMODE", Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
@@ -73,6 +86,7 @@ func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
 		capacity,
 		accessModes,
 		class,
+		PersistentVolume{}.volumeMode(pvc.Spec.VolumeMode),
 		mapToStr(pvc.Labels),
 		asStatus(p.diagnose(string(phase))),
 		toAge(pvc.ObjectMeta.CreationTimestamp),
@@ -14,4 +14,5 @@ func TestPersistentVolumeClaimRender(t *testing.T) {
 
 	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
 	assert.Equal(t, render.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", "RWO", "standard"}, r.Fields[:7])
+	assert.Equal(t, render.MissingValue, r.Fields[7])
 }
@@ -0,0 +1,87 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Revision renders a workload rollout revision to screen.
+type Revision struct{}
+
+// ColorerFunc colors a resource row.
+func (Revision) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		currentCol := h.IndexOf("CURRENT", true)
+		if currentCol != -1 && re.Row.Fields[currentCol] == "true" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Revision) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "REVISION"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CURRENT"},
+		HeaderColumn{Name: "IMAGES"},
+		HeaderColumn{Name: "CHANGE-CAUSE", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a workload rollout revision to screen.
+func (Revision) Render(o interface{}, ns string, r *Row) error {
+	rev, ok := o.(RevisionRes)
+	if !ok {
+		return fmt.Errorf("expected RevisionRes, but got %T", o)
+	}
+
+	ii := make([]string, 0, len(rev.Template.Spec.Containers))
+	for _, co := range rev.Template.Spec.Containers {
+		ii = append(ii, co.Image)
+	}
+
+	r.ID = strconv.FormatInt(rev.Revision, 10)
+	r.Fields = Fields{
+		r.ID,
+		rev.Name,
+		boolToStr(rev.Current),
+		missing(strings.Join(ii, ",")),
+		missing(rev.ChangeCause),
+		toAge(rev.Created),
+	}
+
+	return nil
+}
+
+// RevisionRes represents a workload pod template revision backed by a
+// replicaset or a controller revision.
+type RevisionRes struct {
+	Revision    int64
+	Name        string
+	ChangeCause string
+	Created     metav1.Time
+	Template    v1.PodTemplateSpec
+	// Current tracks whether the workload rolls out this revision.
+	Current bool
+}
+
+// GetObjectKind returns a schema object.
+func (RevisionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a revision copy.
+func (r RevisionRes) DeepCopyObject() runtime.Object {
+	return r
+}
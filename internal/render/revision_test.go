@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRevisionRender(t *testing.T) {
+	var r render.Row
+	err := render.Revision{}.Render(render.RevisionRes{
+		Revision: 3,
+		Name:     "fred-7d9c",
+		Template: v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{
+			{Name: "fred", Image: "nginx:1.17"},
+			{Name: "blee", Image: "redis"},
+		}}},
+		Current: true,
+	}, "", &r)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "3", r.ID)
+	assert.Equal(t, render.Fields{"3", "fred-7d9c", "true", "nginx:1.17,redis", render.MissingValue}, r.Fields[:5])
+}
@@ -0,0 +1,69 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretMask represents a masked secret value.
+const SecretMask = "********"
+
+// SecretData renders a decoded secret key to screen.
+type SecretData struct{}
+
+// ColorerFunc colors a resource row.
+func (SecretData) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorLightSkyBlue
+	}
+}
+
+// Header returns a header row.
+func (SecretData) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "KEY"},
+		HeaderColumn{Name: "VALUE"},
+		HeaderColumn{Name: "SIZE", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a secret key to screen. Values are always masked.
+func (SecretData) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(SecretDataRes)
+	if !ok {
+		return fmt.Errorf("expected SecretDataRes, but got %T", o)
+	}
+
+	r.ID = res.Key
+	r.Fields = Fields{
+		res.Key,
+		SecretMask,
+		strconv.Itoa(res.Size),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SecretDataRes represents a decoded secret key.
+type SecretDataRes struct {
+	Key  string
+	Size int
+}
+
+// GetObjectKind returns a schema object.
+func (SecretDataRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SecretDataRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -0,0 +1,21 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretDataRender(t *testing.T) {
+	var (
+		re render.SecretData
+		r  render.Row
+	)
+
+	assert.Nil(t, re.Render(render.SecretDataRes{Key: "password", Size: 6}, "", &r))
+	assert.Equal(t, "password", r.ID)
+	assert.Equal(t, render.Fields{"password", render.SecretMask, "6"}, r.Fields)
+
+	assert.NotNil(t, re.Render("blee", "", &r))
+}
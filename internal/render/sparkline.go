@@ -0,0 +1,31 @@
+package render
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders a series of values as a sparkline scaled to the series range.
+func Sparkline(vv []int64) string {
+	if len(vv) == 0 {
+		return ""
+	}
+
+	min, max := vv[0], vv[0]
+	for _, v := range vv {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	rr := make([]rune, len(vv))
+	for i, v := range vv {
+		var idx int64
+		if max > min {
+			idx = (v - min) * int64(len(sparkTicks)-1) / (max - min)
+		}
+		rr[i] = sparkTicks[idx]
+	}
+
+	return string(rr)
+}
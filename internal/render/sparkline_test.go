@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		e  string
+	}{
+		"empty": {
+			e: "",
+		},
+		"flat": {
+			vv: []int64{5, 5, 5},
+			e:  "▁▁▁",
+		},
+		"ramp": {
+			vv: []int64{0, 1, 2, 3, 4, 5, 6, 7},
+			e:  "▁▂▃▄▅▆▇█",
+		},
+		"spike": {
+			vv: []int64{10, 10, 80, 10},
+			e:  "▁▁█▁",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv))
+		})
+	}
+}
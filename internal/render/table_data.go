@@ -47,25 +47,13 @@ func (t *TableData) SetHeader(ns string, h Header) {
 func (t *TableData) Update(rows Rows) {
 	empty := len(t.RowEvents) == 0
 	kk := make(map[string]struct{}, len(rows))
-	var blankDelta DeltaRow
 	for _, row := range rows {
 		kk[row.ID] = struct{}{}
 		if empty {
 			t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
 			continue
 		}
-
-		if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-			delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
-			if delta.IsBlank() {
-				t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
-				t.RowEvents[index].Row = row
-			} else {
-				t.RowEvents[index] = NewDeltaRowEvent(row, delta)
-			}
-			continue
-		}
-		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
+		t.Upsert(row)
 	}
 
 	if !empty {
@@ -73,6 +61,45 @@ func (t *TableData) Update(rows Rows) {
 	}
 }
 
+// Upsert adds a new row or computes the deltas of an existing one.
+func (t *TableData) Upsert(row Row) {
+	index, ok := t.RowEvents.FindIndex(row.ID)
+	if !ok {
+		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
+		return
+	}
+	delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
+	if delta.IsBlank() {
+		var blankDelta DeltaRow
+		t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
+		t.RowEvents[index].Row = row
+		return
+	}
+	t.RowEvents[index] = NewDeltaRowEvent(row, delta)
+}
+
+// Remove deletes a row given its id.
+func (t *TableData) Remove(id string) {
+	t.RowEvents = t.RowEvents.Delete(id)
+}
+
+// Settle marks all rows as unchanged and reports whether any row changed state.
+func (t *TableData) Settle() bool {
+	var (
+		blankDelta DeltaRow
+		settled    bool
+	)
+	for i := range t.RowEvents {
+		if t.RowEvents[i].Kind == EventUnchanged {
+			continue
+		}
+		t.RowEvents[i].Kind, t.RowEvents[i].Deltas = EventUnchanged, blankDelta
+		settled = true
+	}
+
+	return settled
+}
+
 // Delete removes items in cache that are no longer valid.
 func (t *TableData) Delete(newKeys map[string]struct{}) {
 	var victims []string
@@ -394,3 +394,86 @@ func TestTableDataDelete(t *testing.T) {
 	}
 
 }
+
+func TestTableDataUpsert(t *testing.T) {
+	uu := map[string]struct {
+		row render.Row
+		e   render.RowEvents
+	}{
+		"add": {
+			row: render.Row{ID: "C", Fields: render.Fields{"10", "2", "3"}},
+			e: render.RowEvents{
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+				{Kind: render.EventAdd, Row: render.Row{ID: "C", Fields: render.Fields{"10", "2", "3"}}},
+			},
+		},
+		"update": {
+			row: render.Row{ID: "B", Fields: render.Fields{"1", "2", "3"}},
+			e: render.RowEvents{
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+				{
+					Kind:   render.EventUpdate,
+					Row:    render.Row{ID: "B", Fields: render.Fields{"1", "2", "3"}},
+					Deltas: render.DeltaRow{"0", "", ""},
+				},
+			},
+		},
+		"same": {
+			row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}},
+			e: render.RowEvents{
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+				{Kind: render.EventUnchanged, Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			table := render.TableData{
+				RowEvents: render.RowEvents{
+					{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+					{Kind: render.EventUnchanged, Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+				},
+			}
+			table.Upsert(u.row)
+			assert.Equal(t, u.e, table.RowEvents)
+		})
+	}
+}
+
+func TestTableDataRemove(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+			{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+		},
+	}
+
+	table.Remove("A")
+	table.Remove("Z")
+	assert.Equal(t, render.RowEvents{
+		{Row: render.Row{ID: "B", Fields: render.Fields{"0", "2", "3"}}},
+	}, table.RowEvents)
+}
+
+func TestTableDataSettle(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Kind: render.EventAdd, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+			{
+				Kind:   render.EventUpdate,
+				Row:    render.Row{ID: "B", Fields: render.Fields{"1", "2", "3"}},
+				Deltas: render.DeltaRow{"0", "", ""},
+			},
+		},
+	}
+
+	assert.True(t, table.Settle())
+	assert.Equal(t, render.RowEvents{
+		{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2", "3"}}},
+		{Kind: render.EventUnchanged, Row: render.Row{ID: "B", Fields: render.Fields{"1", "2", "3"}}},
+	}, table.RowEvents)
+	assert.False(t, table.Settle())
+}
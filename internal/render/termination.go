@@ -0,0 +1,116 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// OOMKilled tracks a container killed for exceeding its memory limit.
+const OOMKilled = "OOMKilled"
+
+// Termination renders a container last termination to screen.
+type Termination struct{}
+
+// ColorerFunc colors a resource row.
+func (Termination) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		reasonCol, codeCol := h.IndexOf("LAST REASON", true), h.IndexOf("EXIT CODE", true)
+		if reasonCol == -1 || codeCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch {
+		case re.Row.Fields[reasonCol] == OOMKilled:
+			return ErrColor
+		case re.Row.Fields[codeCol] != MissingValue && re.Row.Fields[codeCol] != "0":
+			return HighlightColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Termination) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "INIT"},
+		HeaderColumn{Name: "RESTARTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "TREND"},
+		HeaderColumn{Name: "LAST REASON"},
+		HeaderColumn{Name: "EXIT CODE", Align: tview.AlignRight},
+		HeaderColumn{Name: "FINISHED"},
+		HeaderColumn{Name: "RAN"},
+		HeaderColumn{Name: "MEM/L", Align: tview.AlignRight},
+		HeaderColumn{Name: "EVENTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "LAST EVENT"},
+	}
+}
+
+// Render renders a container last termination to screen.
+func (Termination) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(TerminationRes)
+	if !ok {
+		return fmt.Errorf("expected TerminationRes, but got %T", o)
+	}
+
+	reason, code, finished, ran := MissingValue, MissingValue, MissingValue, MissingValue
+	if t := res.Terminated; t != nil {
+		reason, code = lastTermination(v1.ContainerState{Terminated: t})
+		if !t.FinishedAt.IsZero() {
+			finished = duration.HumanDuration(time.Since(t.FinishedAt.Time)) + " ago"
+			if !t.StartedAt.IsZero() {
+				ran = duration.HumanDuration(t.FinishedAt.Sub(t.StartedAt.Time))
+			}
+		}
+	}
+	lastEvent := MissingValue
+	if len(res.Events) > 0 {
+		lastEvent = res.Events[len(res.Events)-1]
+	}
+
+	r.ID = res.Container
+	r.Fields = Fields{
+		res.Container,
+		boolToStr(res.IsInit),
+		strconv.Itoa(int(res.Restarts)),
+		Sparkline(res.Trend),
+		reason,
+		code,
+		finished,
+		ran,
+		na(res.MemLimit),
+		strconv.Itoa(len(res.Events)),
+		lastEvent,
+	}
+
+	return nil
+}
+
+// TerminationRes represents a container last termination and restart trend.
+type TerminationRes struct {
+	Container  string
+	IsInit     bool
+	Restarts   int32
+	Trend      []int64
+	Terminated *v1.ContainerStateTerminated
+	MemLimit   string
+	Events     []string
+}
+
+// GetObjectKind returns a schema object.
+func (TerminationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TerminationRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,69 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTerminationRender(t *testing.T) {
+	finished := time.Now().Add(-5 * time.Minute)
+	res := render.TerminationRes{
+		Container: "fred",
+		Restarts:  3,
+		Trend:     []int64{1, 2, 3},
+		Terminated: &v1.ContainerStateTerminated{
+			Reason:     render.OOMKilled,
+			ExitCode:   137,
+			StartedAt:  metav1.Time{Time: finished.Add(-90 * time.Second)},
+			FinishedAt: metav1.Time{Time: finished},
+		},
+		MemLimit: "64Mi",
+		Events:   []string{"Normal Pulled (x3): pulled", "Warning BackOff (x10): back-off restarting failed container"},
+	}
+
+	var (
+		tr render.Termination
+		r  render.Row
+	)
+	assert.Nil(t, tr.Render(res, "", &r))
+	assert.Equal(t, "fred", r.ID)
+	assert.Equal(t, render.Fields{
+		"fred",
+		"false",
+		"3",
+		"▁▄█",
+		render.OOMKilled,
+		"137",
+		"5m ago",
+		"90s",
+		"64Mi",
+		"2",
+		"Warning BackOff (x10): back-off restarting failed container",
+	}, r.Fields)
+}
+
+func TestTerminationRenderNone(t *testing.T) {
+	var (
+		tr render.Termination
+		r  render.Row
+	)
+	assert.Nil(t, tr.Render(render.TerminationRes{Container: "fred", IsInit: true}, "", &r))
+	assert.Equal(t, render.Fields{
+		"fred",
+		"true",
+		"0",
+		"",
+		render.MissingValue,
+		render.MissingValue,
+		render.MissingValue,
+		render.MissingValue,
+		render.NAValue,
+		"0",
+		render.MissingValue,
+	}, r.Fields)
+}
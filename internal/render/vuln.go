@@ -0,0 +1,120 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// VulnCritical tracks a critical vulnerability.
+	VulnCritical = "CRITICAL"
+	// VulnHigh tracks a high severity vulnerability.
+	VulnHigh = "HIGH"
+	// VulnMedium tracks a medium severity vulnerability.
+	VulnMedium = "MEDIUM"
+	// VulnLow tracks a low severity vulnerability.
+	VulnLow = "LOW"
+	// VulnNegligible tracks a negligible vulnerability.
+	VulnNegligible = "NEGLIGIBLE"
+	// VulnUnknown tracks a vulnerability of unknown severity.
+	VulnUnknown = "UNKNOWN"
+)
+
+// Vuln renders an image vulnerability to screen.
+type Vuln struct{}
+
+// ColorerFunc colors a resource row.
+func (Vuln) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		sevCol := h.IndexOf("SEVERITY", true)
+		if sevCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[sevCol] {
+		case VulnCritical:
+			return ErrColor
+		case VulnHigh:
+			return HighlightColor
+		case VulnMedium:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Vuln) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "#", Align: tview.AlignRight},
+		HeaderColumn{Name: "IMAGE"},
+		HeaderColumn{Name: "ID"},
+		HeaderColumn{Name: "SEVERITY"},
+		HeaderColumn{Name: "PACKAGE"},
+		HeaderColumn{Name: "INSTALLED"},
+		HeaderColumn{Name: "FIXED"},
+		HeaderColumn{Name: "TITLE"},
+		HeaderColumn{Name: "TARGET", Wide: true},
+		HeaderColumn{Name: "URL", Wide: true},
+	}
+}
+
+// Render renders an image vulnerability to screen.
+func (Vuln) Render(o interface{}, ns string, r *Row) error {
+	v, ok := o.(VulnRes)
+	if !ok {
+		return fmt.Errorf("expected VulnRes, but got %T", o)
+	}
+
+	r.ID = v.ID()
+	r.Fields = Fields{
+		strconv.Itoa(v.Rank),
+		v.Image,
+		v.VulnID,
+		v.Severity,
+		v.Package,
+		missing(v.Installed),
+		na(v.Fixed),
+		missing(v.Title),
+		missing(v.Target),
+		missing(v.URL),
+	}
+
+	return nil
+}
+
+// VulnRes represents a vulnerability found in an image.
+type VulnRes struct {
+	// Rank tracks the vulnerability position once sorted by severity.
+	Rank      int
+	Image     string
+	VulnID    string
+	Severity  string
+	Package   string
+	Installed string
+	Fixed     string
+	Title     string
+	Target    string
+	URL       string
+}
+
+// ID returns a unique vulnerability identifier.
+func (v VulnRes) ID() string {
+	return strings.Join([]string{v.Image, v.Target, v.Package, v.VulnID}, "|")
+}
+
+// GetObjectKind returns a schema object.
+func (VulnRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a vulnerability copy.
+func (v VulnRes) DeepCopyObject() runtime.Object {
+	return v
+}
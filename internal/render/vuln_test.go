@@ -0,0 +1,76 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulnRender(t *testing.T) {
+	uu := map[string]struct {
+		v render.VulnRes
+		e render.Fields
+	}{
+		"fixed": {
+			v: render.VulnRes{
+				Rank:      1,
+				Image:     "nginx:1.17",
+				VulnID:    "CVE-2020-1234",
+				Severity:  render.VulnCritical,
+				Package:   "openssl",
+				Installed: "1.1.1d",
+				Fixed:     "1.1.1g",
+				Title:     "openssl: blee",
+				Target:    "nginx:1.17 (debian 10.3)",
+				URL:       "https://avd.aquasec.com/nvd/cve-2020-1234",
+			},
+			e: render.Fields{"1", "nginx:1.17", "CVE-2020-1234", "CRITICAL", "openssl", "1.1.1d", "1.1.1g", "openssl: blee", "nginx:1.17 (debian 10.3)", "https://avd.aquasec.com/nvd/cve-2020-1234"},
+		},
+		"unfixed": {
+			v: render.VulnRes{
+				Rank:     12,
+				Image:    "fred",
+				VulnID:   "CVE-2020-4321",
+				Severity: render.VulnUnknown,
+				Package:  "zlib",
+			},
+			e: render.Fields{"12", "fred", "CVE-2020-4321", "UNKNOWN", "zlib", render.MissingValue, render.NAValue, render.MissingValue, render.MissingValue, render.MissingValue},
+		},
+	}
+
+	var v render.Vuln
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, v.Render(u.v, "", &r))
+			assert.Equal(t, u.v.ID(), r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
+
+func TestVulnColorer(t *testing.T) {
+	h := render.Vuln{}.Header("")
+	sevCol := h.IndexOf("SEVERITY", true)
+	uu := map[string]struct {
+		sev string
+		e   interface{}
+	}{
+		"critical": {sev: render.VulnCritical, e: render.ErrColor},
+		"high":     {sev: render.VulnHigh, e: render.HighlightColor},
+		"medium":   {sev: render.VulnMedium, e: render.ModColor},
+		"low":      {sev: render.VulnLow, e: render.StdColor},
+	}
+
+	f := render.Vuln{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff := make(render.Fields, len(h))
+			ff[sevCol] = u.sev
+			assert.Equal(t, u.e, f("", h, render.RowEvent{Row: render.Row{Fields: ff}}))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// FailurePolicyFail tracks a webhook rejecting requests when unreachable.
+	FailurePolicyFail = "Fail"
+	// CAMissing tracks a webhook without a ca bundle.
+	CAMissing = "Missing"
+)
+
+// Webhook renders an admission webhook health to screen.
+type Webhook struct{}
+
+// ColorerFunc colors a resource row.
+func (Webhook) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if Happy(ns, h, re.Row) {
+			return StdColor
+		}
+		policyCol := h.IndexOf("FAILURE POLICY", true)
+		if policyCol != -1 && re.Row.Fields[policyCol] == FailurePolicyFail {
+			return ErrColor
+		}
+
+		return HighlightColor
+	}
+}
+
+// Header returns a header row.
+func (Webhook) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "CONFIGURATION"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "TARGET"},
+		HeaderColumn{Name: "ENDPOINTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "CA"},
+		HeaderColumn{Name: "CA EXPIRES", Wide: true},
+		HeaderColumn{Name: "FAILURE POLICY"},
+		HeaderColumn{Name: "TIMEOUT", Align: tview.AlignRight},
+		HeaderColumn{Name: "SIDE EFFECTS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders an admission webhook health to screen.
+func (Webhook) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(WebhookRes)
+	if !ok {
+		return fmt.Errorf("expected WebhookRes, but got %T", o)
+	}
+
+	endpoints := NAValue
+	if res.Service != "" {
+		endpoints = fmt.Sprintf("%d/%d", res.Ready, res.Ready+res.NotReady)
+	}
+	expires := MissingValue
+	if !res.CAExpiry.IsZero() {
+		expires = CertExpiresIn(res.CAExpiry, time.Now())
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Config,
+		res.Name,
+		res.Kind,
+		res.Target(),
+		endpoints,
+		res.CAStatus,
+		expires,
+		res.FailurePolicy,
+		strconv.Itoa(int(res.TimeoutSeconds)) + "s",
+		na(res.SideEffects),
+		strings.Join(res.Issues(time.Now()), ","),
+	}
+
+	return nil
+}
+
+// WebhookRes represents an admission webhook health.
+type WebhookRes struct {
+	// GVR tracks the webhook configuration resource.
+	GVR string
+	// Kind tracks the webhook type ie mutating or validating.
+	Kind           string
+	Config, Name   string
+	Service        string
+	Port           int32
+	Path           string
+	URL            string
+	Ready          int
+	NotReady       int
+	ServiceErr     error
+	CAStatus       string
+	CAExpiry       time.Time
+	FailurePolicy  string
+	TimeoutSeconds int32
+	SideEffects    string
+}
+
+// ID returns the webhook unique identifier.
+func (w WebhookRes) ID() string {
+	return w.Config + "/" + w.Name
+}
+
+// Target returns the webhook service or url.
+func (w WebhookRes) Target() string {
+	if w.Service == "" {
+		return na(w.URL)
+	}
+
+	return w.Service + ":" + strconv.Itoa(int(w.Port)) + w.Path
+}
+
+// Issues returns the webhook health issues if any.
+func (w WebhookRes) Issues(now time.Time) []string {
+	var ii []string
+	switch {
+	case w.ServiceErr != nil:
+		ii = append(ii, w.ServiceErr.Error())
+	case w.Service != "" && w.Ready == 0:
+		ii = append(ii, "no ready endpoints")
+	}
+	switch w.CAStatus {
+	case CertExpired, CertInvalid:
+		ii = append(ii, "ca bundle "+strings.ToLower(w.CAStatus))
+	case CertCritical:
+		ii = append(ii, "ca bundle expires "+CertExpiresIn(w.CAExpiry, now))
+	}
+
+	return ii
+}
+
+// GetObjectKind returns a schema object.
+func (WebhookRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a webhook copy.
+func (w WebhookRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,74 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRender(t *testing.T) {
+	expiry := time.Now().Add(3 * 24 * time.Hour)
+	res := render.WebhookRes{
+		Kind:           "validating",
+		Config:         "zorg",
+		Name:           "fred.zorg.io",
+		Service:        "default/fred",
+		Port:           443,
+		Path:           "/validate",
+		NotReady:       2,
+		CAStatus:       render.CertCritical,
+		CAExpiry:       expiry,
+		FailurePolicy:  render.FailurePolicyFail,
+		TimeoutSeconds: 10,
+		SideEffects:    "None",
+	}
+
+	var (
+		w render.Webhook
+		r render.Row
+	)
+	assert.Nil(t, w.Render(res, "", &r))
+	assert.Equal(t, "zorg/fred.zorg.io", r.ID)
+	assert.Equal(t, render.Fields{
+		"zorg",
+		"fred.zorg.io",
+		"validating",
+		"default/fred:443/validate",
+		"0/2",
+		render.CertCritical,
+		"in 2d23h",
+		render.FailurePolicyFail,
+		"10s",
+		"None",
+		"no ready endpoints,ca bundle expires in 2d23h",
+	}, r.Fields)
+}
+
+func TestWebhookIssues(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		w render.WebhookRes
+		e []string
+	}{
+		"url": {
+			w: render.WebhookRes{URL: "https://fred", CAStatus: render.CAMissing},
+		},
+		"healthy": {
+			w: render.WebhookRes{Service: "default/fred", Ready: 1, CAStatus: render.CertValid},
+		},
+		"noService": {
+			w: render.WebhookRes{Service: "default/fred", ServiceErr: errors.New("service default/fred not found"), CAStatus: render.CertExpired},
+			e: []string{"service default/fred not found", "ca bundle expired"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.Issues(now))
+		})
+	}
+}
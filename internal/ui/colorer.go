@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// colorRule represents a compiled view colorer rule.
+type colorRule struct {
+	column string
+	rx     *regexp.Regexp
+	color  tcell.Color
+}
+
+// colorRules represents a collection of view colorer rules.
+type colorRules []colorRule
+
+func newColorRules(cc []config.ColorRule) colorRules {
+	rr := make(colorRules, 0, len(cc))
+	for _, c := range cc {
+		rx, err := regexp.Compile(c.Match)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid colorer rule for column %s", c.Column)
+			continue
+		}
+		rr = append(rr, colorRule{column: c.Column, rx: rx, color: c.Color.Color()})
+	}
+
+	return rr
+}
+
+// Colorer wraps a colorer so rows matching a rule take on the rule color.
+// Deleted rows retain their regular color.
+func (rr colorRules) Colorer(f render.ColorerFunc) render.ColorerFunc {
+	if len(rr) == 0 {
+		return f
+	}
+
+	return func(ns string, h render.Header, re render.RowEvent) tcell.Color {
+		if re.Kind == render.EventDelete {
+			return f(ns, h, re)
+		}
+		for _, r := range rr {
+			idx := h.IndexOf(r.column, true)
+			if idx < 0 || idx >= len(re.Row.Fields) {
+				continue
+			}
+			if r.rx.MatchString(strings.TrimSpace(re.Row.Fields[idx])) {
+				return r.color
+			}
+		}
+
+		return f(ns, h, re)
+	}
+}
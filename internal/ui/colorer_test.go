@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorRules(t *testing.T) {
+	rr := newColorRules([]config.ColorRule{
+		{Column: "STATUS", Match: "^Evicted$", Color: "gray"},
+		{Column: "STATUS", Match: "[[", Color: "red"},
+		{Column: "BOZO", Match: ".*", Color: "red"},
+		{Column: "NAME", Match: "^canary-", Color: "#ffa500"},
+	})
+	assert.Equal(t, 3, len(rr))
+
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "STATUS"},
+	}
+	colorer := rr.Colorer(func(string, render.Header, render.RowEvent) tcell.Color {
+		return tcell.ColorWhite
+	})
+
+	uu := map[string]struct {
+		re render.RowEvent
+		e  tcell.Color
+	}{
+		"evicted": {
+			re: render.RowEvent{Row: render.Row{Fields: render.Fields{"p1", "Evicted"}}},
+			e:  tcell.ColorGray,
+		},
+		"running": {
+			re: render.RowEvent{Row: render.Row{Fields: render.Fields{"p1", "Running"}}},
+			e:  tcell.ColorWhite,
+		},
+		"canary": {
+			re: render.RowEvent{Row: render.Row{Fields: render.Fields{"canary-p1", "Running"}}},
+			e:  tcell.NewHexColor(0xffa500),
+		},
+		"deleted": {
+			re: render.RowEvent{Kind: render.EventDelete, Row: render.Row{Fields: render.Fields{"p1", "Evicted"}}},
+			e:  tcell.ColorWhite,
+		},
+		"short": {
+			re: render.RowEvent{Row: render.Row{Fields: render.Fields{"p1"}}},
+			e:  tcell.ColorWhite,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, colorer("", h, u.re))
+		})
+	}
+}
+
+func TestColorRulesNone(t *testing.T) {
+	var rr colorRules
+
+	assert.Equal(t, tcell.ColorWhite, rr.Colorer(func(string, render.Header, render.RowEvent) tcell.Color {
+		return tcell.ColorWhite
+	})("", render.Header{}, render.RowEvent{}))
+}
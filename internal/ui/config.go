@@ -3,8 +3,10 @@ package ui
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
@@ -23,8 +25,11 @@ type Configurator struct {
 	Config     *config.Config
 	Styles     *config.Styles
 	CustomView *config.CustomView
+	Plugins    config.Plugins
+	HotKeys    config.HotKeys
 	BenchFile  string
 	skinFile   string
+	toggles    map[string]bool
 }
 
 // HasSkin returns true if a skin file was located.
@@ -79,6 +84,124 @@ func (c *Configurator) RefreshCustomViews() {
 	}
 }
 
+// PluginsWatcher watches for plugins and hotkeys config file changes.
+func (c *Configurator) PluginsWatcher(ctx context.Context, s synchronizer, notifyFn func()) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case evt := <-w.Events:
+				if !isPluginsFile(evt.Name) {
+					continue
+				}
+				s.QueueUpdateDraw(func() {
+					c.RefreshPlugins()
+					if notifyFn != nil {
+						notifyFn()
+					}
+				})
+			case err := <-w.Errors:
+				log.Info().Err(err).Msg("Plugins watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msgf("PluginsWatcher Done `%s!!", config.K9sHome)
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing Plugins watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	log.Debug().Msgf("PluginsWatcher watching `%s", config.K9sHome)
+	c.RefreshPlugins()
+	for _, dir := range config.PluginsDirs() {
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		if err := w.Add(dir); err != nil {
+			log.Warn().Err(err).Msgf("Unable to watch plugins dir %s", dir)
+		}
+	}
+	return w.Add(config.K9sHome)
+}
+
+// RefreshPlugins load plugins and hotkeys configuration changes.
+func (c *Configurator) RefreshPlugins() {
+	c.Plugins = config.NewPlugins()
+	if err := c.Plugins.Load(); err != nil {
+		log.Warn().Err(err).Msgf("Plugins configuration issues detected")
+	}
+
+	c.HotKeys = config.NewHotKeys()
+	if err := c.HotKeys.Load(); err != nil {
+		log.Debug().Msgf("No hotkeys configuration file found -- %s", config.K9sHotKeys)
+	}
+
+	for k, disabled := range c.toggles {
+		kind, name := client.Namespaced(k)
+		if err := c.setDisabled(kind, name, disabled); err != nil {
+			delete(c.toggles, k)
+		}
+	}
+}
+
+// ToggleAction enables or disables a plugin or hotkey for the current session.
+// It returns true if the action is now enabled.
+func (c *Configurator) ToggleAction(kind, name string) (bool, error) {
+	var disabled bool
+	switch kind {
+	case render.PluginKind:
+		disabled = !c.Plugins.Plugin[name].Disabled
+	case render.HotKeyKind:
+		disabled = !c.HotKeys.HotKey[name].Disabled
+	}
+	if err := c.setDisabled(kind, name, disabled); err != nil {
+		return false, err
+	}
+	if c.toggles == nil {
+		c.toggles = make(map[string]bool)
+	}
+	c.toggles[client.FQN(kind, name)] = disabled
+
+	return !disabled, nil
+}
+
+func (c *Configurator) setDisabled(kind, name string, disabled bool) error {
+	switch kind {
+	case render.PluginKind:
+		p, ok := c.Plugins.Plugin[name]
+		if !ok {
+			return fmt.Errorf("no plugin named %q", name)
+		}
+		p.Disabled = disabled
+		c.Plugins.Plugin[name] = p
+	case render.HotKeyKind:
+		h, ok := c.HotKeys.HotKey[name]
+		if !ok {
+			return fmt.Errorf("no hotkey named %q", name)
+		}
+		h.Disabled = disabled
+		c.HotKeys.HotKey[name] = h
+	default:
+		return fmt.Errorf("unknown action kind %q", kind)
+	}
+
+	return nil
+}
+
+func isPluginsFile(path string) bool {
+	if path == config.K9sPlugins || path == config.K9sHotKeys {
+		return true
+	}
+
+	return config.InList(config.PluginsDirs(), filepath.Dir(path))
+}
+
 // StylesWatcher watches for skin file changes.
 func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error {
 	if !c.HasSkin() {
@@ -130,6 +253,15 @@ func (c *Configurator) RefreshStyles(context string) {
 	} else {
 		c.Styles.Reset()
 	}
+	if c.Config != nil {
+		if skin := c.Config.K9s.GetSkin(); skin != "" {
+			if err := c.Styles.Load(skin); err == nil {
+				c.updateStyles(skin)
+				return
+			}
+			log.Warn().Msgf("Cluster skin file not found -- %s", skin)
+		}
+	}
 	if err := c.Styles.Load(clusterSkins); err != nil {
 		log.Info().Msgf("No context specific skin file found -- %s", clusterSkins)
 	} else {
@@ -26,3 +26,63 @@ func TestConfiguratorRefreshStyle(t *testing.T) {
 	assert.Equal(t, tcell.ColorGhostWhite, render.StdColor)
 	assert.Equal(t, tcell.ColorWhiteSmoke, render.ErrColor)
 }
+
+func TestConfiguratorRefreshClusterStyle(t *testing.T) {
+	config.K9sStylesFile = filepath.Join("..", "config", "testdata", "empty_skin.yml")
+
+	skin, err := filepath.Abs(filepath.Join("..", "config", "testdata", "black_and_wtf.yml"))
+	assert.Nil(t, err)
+	k := config.NewK9s()
+	k.CurrentCluster = "c1"
+	k.Clusters["c1"] = &config.Cluster{Skin: skin}
+	cfg := ui.Configurator{Config: &config.Config{K9s: k}}
+	cfg.RefreshStyles("c1")
+
+	assert.True(t, cfg.HasSkin())
+	assert.Equal(t, tcell.ColorGhostWhite, render.StdColor)
+}
+
+func TestConfiguratorRefreshPlugins(t *testing.T) {
+	config.K9sPlugins = filepath.Join("..", "config", "testdata", "plugin.yml")
+	config.K9sHotKeys = filepath.Join("..", "config", "testdata", "hot_key.yml")
+
+	cfg := ui.Configurator{}
+	cfg.RefreshPlugins()
+
+	assert.Equal(t, 1, len(cfg.Plugins.Plugin))
+	assert.Equal(t, 2, len(cfg.HotKeys.HotKey))
+
+	config.K9sPlugins = filepath.Join("..", "config", "testdata", "blee.yml")
+	cfg.RefreshPlugins()
+
+	assert.Equal(t, 0, len(cfg.Plugins.Plugin))
+	assert.Equal(t, 2, len(cfg.HotKeys.HotKey))
+}
+
+func TestConfiguratorToggleAction(t *testing.T) {
+	config.K9sPlugins = filepath.Join("..", "config", "testdata", "plugin.yml")
+	config.K9sHotKeys = filepath.Join("..", "config", "testdata", "hot_key.yml")
+
+	cfg := ui.Configurator{}
+	cfg.RefreshPlugins()
+
+	enabled, err := cfg.ToggleAction(render.PluginKind, "blah")
+	assert.Nil(t, err)
+	assert.False(t, enabled)
+	assert.True(t, cfg.Plugins.Plugin["blah"].Disabled)
+
+	enabled, err = cfg.ToggleAction(render.HotKeyKind, "pods")
+	assert.Nil(t, err)
+	assert.False(t, enabled)
+
+	cfg.RefreshPlugins()
+	assert.True(t, cfg.Plugins.Plugin["blah"].Disabled)
+	assert.True(t, cfg.HotKeys.HotKey["pods"].Disabled)
+
+	enabled, err = cfg.ToggleAction(render.PluginKind, "blah")
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	_, err = cfg.ToggleAction(render.PluginKind, "zorg")
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,12 @@ type (
 
 // ShowConfirm pops a confirmation dialog.
 func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel cancelFunc) {
+	ShowConfirmGuarded(pages, title, msg, "", ack, cancel)
+}
+
+// ShowConfirmGuarded pops a confirmation dialog requiring the expected text
+// to be typed in before the action is acknowledged.
+func ShowConfirmGuarded(pages *ui.Pages, title, msg, expect string, ack confirmFunc, cancel cancelFunc) {
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -21,17 +27,22 @@ func ShowConfirm(pages *ui.Pages, title, msg string, ack confirmFunc, cancel can
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
 		SetLabelColor(tcell.ColorAqua).
 		SetFieldTextColor(tcell.ColorOrange)
+	typed := addGuard(f, expect)
+	modal := tview.NewModalForm(" <"+title+"> ", f)
 	f.AddButton("Cancel", func() {
 		dismissConfirm(pages)
 		cancel()
 	})
 	f.AddButton("OK", func() {
+		if !typed() {
+			modal.SetText(guardMismatch(msg, expect))
+			return
+		}
 		ack()
 		dismissConfirm(pages)
 		cancel()
 	})
 
-	modal := tview.NewModalForm(" <"+title+"> ", f)
 	modal.SetText(msg)
 	modal.SetDoneFunc(func(int, string) {
 		dismissConfirm(pages)
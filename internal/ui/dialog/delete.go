@@ -1,6 +1,9 @@
 package dialog
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -8,14 +11,43 @@ import (
 
 const deleteKey = "delete"
 
+// Propagations lists the supported deletion propagation policies.
+var Propagations = []string{"Background", "Foreground", "Orphan"}
+
+// DeleteOptions tracks resource deletion options.
+type DeleteOptions struct {
+	Propagation        string
+	GracePeriodSeconds int
+	Force              bool
+}
+
+// String returns a human readable representation of the options.
+func (o DeleteOptions) String() string {
+	grace := "default"
+	if o.GracePeriodSeconds >= 0 {
+		grace = fmt.Sprintf("%ds", o.GracePeriodSeconds)
+	}
+
+	return fmt.Sprintf("Propagation: %s, Grace Period: %s, Force: %t", o.Propagation, grace, o.Force)
+}
+
 type (
-	okFunc     func(cascade, force bool)
+	okFunc     func(DeleteOptions)
 	cancelFunc func()
 )
 
 // ShowDelete pops a resource deletion dialog.
 func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	cascade, force := true, false
+	ShowDeleteGuarded(pages, msg, "", ok, cancel)
+}
+
+// ShowDeleteGuarded pops a resource deletion dialog requiring the expected
+// text to be typed in before the deletion proceeds.
+func ShowDeleteGuarded(pages *ui.Pages, msg, expect string, ok okFunc, cancel cancelFunc) {
+	opts := DeleteOptions{
+		Propagation:        Propagations[0],
+		GracePeriodSeconds: defaultGrace,
+	}
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -23,25 +55,42 @@ func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
 		SetButtonTextColor(tview.Styles.PrimaryTextColor).
 		SetLabelColor(tcell.ColorAqua).
 		SetFieldTextColor(tcell.ColorOrange)
-	f.AddCheckbox("Cascade:", cascade, func(checked bool) {
-		cascade = checked
+	confirm := tview.NewModalForm("<Delete>", f)
+	echo := func() {
+		confirm.SetText(deleteMsg(msg, opts))
+	}
+
+	f.AddDropDown("Propagation:", Propagations, 0, func(option string, _ int) {
+		opts.Propagation = option
+		echo()
+	})
+	f.AddInputField("Grace Period:", strconv.Itoa(opts.GracePeriodSeconds), 5, nil, func(v string) {
+		if grace, err := strconv.Atoi(v); err == nil {
+			opts.GracePeriodSeconds = grace
+			echo()
+		}
 	})
-	f.AddCheckbox("Force:", force, func(checked bool) {
-		force = checked
+	f.AddCheckbox("Force:", opts.Force, func(checked bool) {
+		opts.Force = checked
+		echo()
 	})
+	typed := addGuard(f, expect)
 	f.AddButton("Cancel", func() {
 		dismissDelete(pages)
 		cancel()
 	})
 	f.AddButton("OK", func() {
-		ok(cascade, force)
+		if !typed() {
+			confirm.SetText(guardMismatch(deleteMsg(msg, opts), expect))
+			return
+		}
+		ok(opts)
 		dismissDelete(pages)
 		cancel()
 	})
-	f.SetFocus(2)
+	f.SetFocus(3)
 
-	confirm := tview.NewModalForm("<Delete>", f)
-	confirm.SetText(msg)
+	echo()
 	confirm.SetDoneFunc(func(int, string) {
 		dismissDelete(pages)
 		cancel()
@@ -53,3 +102,7 @@ func ShowDelete(pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
 func dismissDelete(pages *ui.Pages) {
 	pages.RemovePage(deleteKey)
 }
+
+func deleteMsg(msg string, opts DeleteOptions) string {
+	return msg + "\n" + opts.String()
+}
@@ -11,9 +11,8 @@ import (
 func TestDeleteDialog(t *testing.T) {
 	p := ui.NewPages()
 
-	okFunc := func(c, f bool) {
-		assert.True(t, c)
-		assert.True(t, f)
+	okFunc := func(opts DeleteOptions) {
+		assert.Equal(t, "Background", opts.Propagation)
 	}
 	caFunc := func() {
 		assert.True(t, true)
@@ -26,3 +25,26 @@ func TestDeleteDialog(t *testing.T) {
 	dismissDelete(p)
 	assert.Nil(t, p.GetPrimitive(deleteKey))
 }
+
+func TestDeleteOptionsString(t *testing.T) {
+	uu := map[string]struct {
+		opts DeleteOptions
+		e    string
+	}{
+		"default": {
+			opts: DeleteOptions{Propagation: "Background", GracePeriodSeconds: defaultGrace},
+			e:    "Propagation: Background, Grace Period: default, Force: false",
+		},
+		"custom": {
+			opts: DeleteOptions{Propagation: "Orphan", GracePeriodSeconds: 0, Force: true},
+			e:    "Propagation: Orphan, Grace Period: 0s, Force: true",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.opts.String())
+		})
+	}
+}
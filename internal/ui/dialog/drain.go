@@ -0,0 +1,111 @@
+package dialog
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	drainKey         = "drain"
+	drainProgressKey = "drain-progress"
+	defaultGrace     = -1
+)
+
+type drainFunc func(dao.DrainOptions)
+
+// ShowDrain pops a node drain dialog.
+func ShowDrain(pages *ui.Pages, msg string, ok drainFunc, cancel cancelFunc) {
+	opts := dao.DefaultDrainOptions()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddInputField("Grace Period:", strconv.Itoa(opts.GracePeriodSeconds), 5, nil, func(v string) {
+		if grace, err := strconv.Atoi(v); err == nil {
+			opts.GracePeriodSeconds = grace
+		}
+	})
+	f.AddCheckbox("Ignore DaemonSets:", opts.IgnoreAllDaemonSets, func(checked bool) {
+		opts.IgnoreAllDaemonSets = checked
+	})
+	f.AddCheckbox("Delete Local Data:", opts.DeleteLocalData, func(checked bool) {
+		opts.DeleteLocalData = checked
+	})
+	f.AddCheckbox("Force:", opts.Force, func(checked bool) {
+		opts.Force = checked
+	})
+	f.AddButton("Cancel", func() {
+		dismissDrain(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissDrain(pages)
+		cancel()
+		ok(opts)
+	})
+	f.SetFocus(4)
+
+	confirm := tview.NewModalForm("<Drain>", f)
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		dismissDrain(pages)
+		cancel()
+	})
+	pages.AddPage(drainKey, confirm, false, false)
+	pages.ShowPage(drainKey)
+}
+
+func dismissDrain(pages *ui.Pages) {
+	pages.RemovePage(drainKey)
+}
+
+// DrainProgress tracks a node drain in flight.
+type DrainProgress struct {
+	pages *ui.Pages
+	modal *tview.ModalForm
+	node  string
+}
+
+// ShowDrainProgress pops a node drain progress dialog. Cancel aborts the drain.
+func ShowDrainProgress(pages *ui.Pages, node string, cancel cancelFunc) *DrainProgress {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor)
+
+	p := DrainProgress{pages: pages, node: node}
+	f.AddButton("Cancel", func() {
+		p.Dismiss()
+		cancel()
+	})
+
+	p.modal = tview.NewModalForm("<Draining>", f)
+	p.modal.SetText(fmt.Sprintf("Draining node %s...", node))
+	p.modal.SetDoneFunc(func(int, string) {
+		p.Dismiss()
+		cancel()
+	})
+	pages.AddPage(drainProgressKey, p.modal, false, false)
+	pages.ShowPage(drainProgressKey)
+
+	return &p
+}
+
+// Update refreshes the count of pods left to evict.
+func (p *DrainProgress) Update(remaining int) {
+	p.modal.SetText(fmt.Sprintf("Draining node %s -- %d pod(s) remaining...", p.node, remaining))
+}
+
+// Dismiss closes the progress dialog.
+func (p *DrainProgress) Dismiss() {
+	p.pages.RemovePage(drainProgressKey)
+}
@@ -0,0 +1,44 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(opts dao.DrainOptions) {
+		assert.Equal(t, -1, opts.GracePeriodSeconds)
+		assert.True(t, opts.IgnoreAllDaemonSets)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowDrain(p, "Yo", okFunc, caFunc)
+
+	d := p.GetPrimitive(drainKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissDrain(p)
+	assert.Nil(t, p.GetPrimitive(drainKey))
+}
+
+func TestDrainProgressDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	var canceled bool
+	d := ShowDrainProgress(p, "n1", func() {
+		canceled = true
+	})
+	assert.NotNil(t, p.GetPrimitive(drainProgressKey))
+
+	d.Update(2)
+	d.Dismiss()
+	assert.Nil(t, p.GetPrimitive(drainProgressKey))
+	assert.False(t, canceled)
+}
@@ -0,0 +1,48 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const dryRunKey = "dry-run"
+
+// ShowDryRun pops an edit dry-run dialog offering to apply the changes or to
+// resume editing. Apply is omitted when no apply func is provided.
+func ShowDryRun(pages *ui.Pages, title, msg string, apply, retry confirmFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddButton("Cancel", func() {
+		dismissDryRun(pages)
+		cancel()
+	})
+	f.AddButton("Edit", func() {
+		dismissDryRun(pages)
+		retry()
+	})
+	if apply != nil {
+		f.AddButton("Apply", func() {
+			dismissDryRun(pages)
+			apply()
+		})
+	}
+
+	modal := tview.NewModalForm(" <"+title+"> ", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissDryRun(pages)
+		cancel()
+	})
+	pages.AddPage(dryRunKey, modal, false, false)
+	pages.ShowPage(dryRunKey)
+}
+
+func dismissDryRun(pages *ui.Pages) {
+	pages.RemovePage(dryRunKey)
+}
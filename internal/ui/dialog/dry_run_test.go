@@ -0,0 +1,22 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	noop := func() {}
+	ShowDryRun(p, "Yo", "Hello", noop, noop, noop)
+
+	d := p.GetPrimitive(dryRunKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissDryRun(p)
+	assert.Nil(t, p.GetPrimitive(dryRunKey))
+}
@@ -0,0 +1,50 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const finalizerKey = "finalizer"
+
+type finalizerFunc func(finalizer string)
+
+// ShowFinalizers pops a dialog listing a resource finalizers and offering to
+// remove one of them. Remove is omitted when no finalizers are set.
+func ShowFinalizers(pages *ui.Pages, msg string, finalizers []string, remove finalizerFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddButton("Close", func() {
+		dismissFinalizers(pages)
+		cancel()
+	})
+	if len(finalizers) > 0 {
+		sel := finalizers[0]
+		f.AddDropDown("Finalizer:", finalizers, 0, func(option string, _ int) {
+			sel = option
+		})
+		f.AddButton("Remove", func() {
+			dismissFinalizers(pages)
+			remove(sel)
+		})
+	}
+
+	modal := tview.NewModalForm("<Finalizers>", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissFinalizers(pages)
+		cancel()
+	})
+	pages.AddPage(finalizerKey, modal, false, false)
+	pages.ShowPage(finalizerKey)
+}
+
+func dismissFinalizers(pages *ui.Pages) {
+	pages.RemovePage(finalizerKey)
+}
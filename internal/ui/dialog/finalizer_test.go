@@ -0,0 +1,32 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinalizersDialog(t *testing.T) {
+	uu := map[string]struct {
+		ff []string
+	}{
+		"none": {},
+		"some": {ff: []string{"fred", "blee"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := ui.NewPages()
+			ShowFinalizers(p, "Yo", u.ff, func(string) {}, func() {})
+
+			d := p.GetPrimitive(finalizerKey).(*tview.ModalForm)
+			assert.NotNil(t, d)
+
+			dismissFinalizers(p)
+			assert.Nil(t, p.GetPrimitive(finalizerKey))
+		})
+	}
+}
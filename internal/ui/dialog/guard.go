@@ -0,0 +1,28 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+)
+
+const guardFieldWidth = 30
+
+// addGuard adds an input field to a form when an expected text must be typed
+// to confirm an action. It returns a check reporting whether it was typed.
+func addGuard(f *tview.Form, expect string) func() bool {
+	if expect == "" {
+		return func() bool { return true }
+	}
+
+	var typed string
+	f.AddInputField(fmt.Sprintf("Type %s:", expect), "", guardFieldWidth, nil, func(changed string) {
+		typed = changed
+	})
+
+	return func() bool { return typed == expect }
+}
+
+func guardMismatch(msg, expect string) string {
+	return fmt.Sprintf("%s\n\nPlease type %q to confirm!", msg, expect)
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddGuard(t *testing.T) {
+	f := tview.NewForm()
+	assert.True(t, addGuard(f, "")())
+	assert.Equal(t, 0, f.GetFormItemCount())
+
+	typed := addGuard(f, "fred")
+	assert.Equal(t, 1, f.GetFormItemCount())
+	assert.False(t, typed())
+
+	f.GetFormItem(0).(*tview.InputField).SetText("fre")
+	assert.False(t, typed())
+	f.GetFormItem(0).(*tview.InputField).SetText("fred")
+	assert.True(t, typed())
+}
+
+func TestDeleteGuardedDialog(t *testing.T) {
+	p := ui.NewPages()
+	ShowDeleteGuarded(p, "Yo", "fred", func(DeleteOptions) {}, func() {})
+
+	d := p.GetPrimitive(deleteKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissDelete(p)
+	assert.Nil(t, p.GetPrimitive(deleteKey))
+}
@@ -0,0 +1,54 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const uninstallKey = "uninstall"
+
+type uninstallFunc func(keepHistory, dryRun bool)
+
+// ShowUninstall pops a chart release uninstall dialog. A dry run only dialog
+// does not let the user opt out of the dry run.
+func ShowUninstall(pages *ui.Pages, msg string, dryRunOnly bool, ok uninstallFunc, cancel cancelFunc) {
+	keepHistory, dryRun := false, dryRunOnly
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddCheckbox("Keep History:", keepHistory, func(checked bool) {
+		keepHistory = checked
+	})
+	if !dryRunOnly {
+		f.AddCheckbox("Dry Run:", dryRun, func(checked bool) {
+			dryRun = checked
+		})
+	}
+	f.AddButton("Cancel", func() {
+		dismissUninstall(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		ok(keepHistory, dryRun)
+		dismissUninstall(pages)
+	})
+	f.SetFocus(f.GetFormItemCount())
+
+	confirm := tview.NewModalForm("<Uninstall>", f)
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		dismissUninstall(pages)
+		cancel()
+	})
+	pages.AddPage(uninstallKey, confirm, false, false)
+	pages.ShowPage(uninstallKey)
+}
+
+func dismissUninstall(pages *ui.Pages) {
+	pages.RemovePage(uninstallKey)
+}
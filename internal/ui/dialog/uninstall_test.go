@@ -0,0 +1,28 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUninstallDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(k, d bool) {
+		assert.False(t, k)
+		assert.False(t, d)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowUninstall(p, "Yo", false, okFunc, caFunc)
+
+	d := p.GetPrimitive(uninstallKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissUninstall(p)
+	assert.Nil(t, p.GetPrimitive(uninstallKey))
+}
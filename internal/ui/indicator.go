@@ -19,6 +19,7 @@ type StatusIndicator struct {
 	app       *App
 	styles    *config.Styles
 	permanent string
+	alerts    int
 	cancel    context.CancelFunc
 }
 
@@ -77,10 +78,27 @@ func (s *StatusIndicator) ClusterInfoChanged(prev, cur model.ClusterMeta) {
 	})
 }
 
+// AlertsChanged notifies the count of unacknowledged alerts changed.
+func (s *StatusIndicator) AlertsChanged(count int) {
+	s.app.QueueUpdateDraw(func() {
+		s.alerts = count
+		s.SetText(s.withBadge(s.permanent))
+	})
+}
+
 // SetPermanent sets permanent title to be reset to after updates
 func (s *StatusIndicator) SetPermanent(info string) {
 	s.permanent = info
-	s.SetText(info)
+	s.SetText(s.withBadge(info))
+}
+
+// withBadge decorates a title with the unacknowledged alerts count if any.
+func (s *StatusIndicator) withBadge(info string) string {
+	if s.alerts == 0 {
+		return info
+	}
+
+	return fmt.Sprintf("%s [orangered::b]alerts:%d[white::-]", info, s.alerts)
 }
 
 // Reset clears out the logo view and resets colors.
@@ -10,11 +10,15 @@ func initKeys() {
 	tcell.KeyNames[tcell.Key(KeyHelp)] = "?"
 	tcell.KeyNames[tcell.Key(KeySlash)] = "/"
 	tcell.KeyNames[tcell.Key(KeySpace)] = "space"
+	tcell.KeyNames[tcell.Key(KeyLeftBracket)] = "["
+	tcell.KeyNames[tcell.Key(KeyRightBracket)] = "]"
 
 	initNumbKeys()
 	initStdKeys()
 	initShiftKeys()
 	initShiftNumKeys()
+	tcell.KeyNames[tcell.Key(KeyAsterisk)] = "*"
+	tcell.KeyNames[tcell.Key(KeyBang)] = "!"
 }
 
 // Defines numeric keys for container actions
@@ -73,10 +77,14 @@ const (
 	KeyX
 	KeyY
 	KeyZ
-	KeyHelp  = 63
-	KeySlash = 47
-	KeyColon = 58
-	KeySpace = 32
+	KeyHelp         = 63
+	KeySlash        = 47
+	KeyColon        = 58
+	KeySpace        = 32
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
+	KeyAsterisk     = 42
+	KeyBang         = 33
 )
 
 // Define Shift Keys
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"sort"
+
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -11,7 +13,9 @@ type SelectTable struct {
 
 	model      Tabular
 	selectedFn func(string) string
+	endFn      func()
 	marks      map[string]struct{}
+	anchor     string
 }
 
 // SetModel sets the table model.
@@ -47,16 +51,21 @@ func (s *SelectTable) GetSelectedItems() []string {
 	for item := range s.marks {
 		items = append(items, item)
 	}
+	sort.Strings(items)
 
 	return items
 }
 
 // GetSelectedItem returns the currently selected item name.
 func (s *SelectTable) GetSelectedItem() string {
-	if s.GetSelectedRowIndex() == 0 || s.model.Empty() {
+	return s.itemAt(s.GetSelectedRowIndex())
+}
+
+func (s *SelectTable) itemAt(r int) string {
+	if r <= 0 || r >= s.GetRowCount() || s.model.Empty() {
 		return ""
 	}
-	sel, ok := s.GetCell(s.GetSelectedRowIndex(), 0).GetReference().(string)
+	sel, ok := s.GetCell(r, 0).GetReference().(string)
 	if !ok {
 		return ""
 	}
@@ -77,6 +86,11 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetEndFn defines a function called when the selection reaches the last row.
+func (s *SelectTable) SetEndFn(f func()) {
+	s.endFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	r, _ := s.GetSelection()
@@ -104,6 +118,9 @@ func (s *SelectTable) selectionChanged(r, c int) {
 	}
 	cell := s.GetCell(r, c)
 	s.SetSelectedStyle(tcell.ColorBlack, cell.Color, tcell.AttrBold)
+	if s.endFn != nil && r > 0 && r == s.GetRowCount()-1 {
+		s.endFn()
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -111,6 +128,69 @@ func (s *SelectTable) ClearMarks() {
 	for k := range s.marks {
 		delete(s.marks, k)
 	}
+	s.anchor = ""
+}
+
+// MarkCount returns the number of marked items.
+func (s *SelectTable) MarkCount() int {
+	return len(s.marks)
+}
+
+// MarkRange marks all rows between the last toggled row and the selected row.
+func (s *SelectTable) MarkRange() bool {
+	from, to := s.rowOf(s.anchor), s.GetSelectedRowIndex()
+	if from <= 0 || to <= 0 {
+		return false
+	}
+	if from > to {
+		from, to = to, from
+	}
+	for r := from; r <= to; r++ {
+		if item := s.itemAt(r); item != "" {
+			s.marks[item] = struct{}{}
+		}
+	}
+	s.anchor = s.GetSelectedItem()
+
+	return true
+}
+
+// InvertMarks toggles the marks of all rows.
+func (s *SelectTable) InvertMarks() {
+	for r := 1; r < s.GetRowCount(); r++ {
+		item := s.itemAt(r)
+		if item == "" {
+			continue
+		}
+		if _, ok := s.marks[item]; ok {
+			delete(s.marks, item)
+		} else {
+			s.marks[item] = struct{}{}
+		}
+	}
+}
+
+func (s *SelectTable) rowOf(item string) int {
+	if item == "" {
+		return -1
+	}
+	for r := 1; r < s.GetRowCount(); r++ {
+		if s.itemAt(r) == item {
+			return r
+		}
+	}
+
+	return -1
+}
+
+// MarkAll marks all the given items.
+func (s *SelectTable) MarkAll(items []string) {
+	for _, item := range items {
+		if s.selectedFn != nil {
+			item = s.selectedFn(item)
+		}
+		s.marks[item] = struct{}{}
+	}
 }
 
 // DeleteMark delete a marked item.
@@ -129,6 +209,7 @@ func (s *SelectTable) ToggleMark() {
 	} else {
 		s.marks[sel] = struct{}{}
 	}
+	s.anchor = sel
 
 	cell := s.GetCell(s.GetSelectedRowIndex(), 0)
 	s.SetSelectedStyle(
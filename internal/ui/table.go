@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -16,6 +17,8 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const gaugeWidth = 10
+
 type (
 	// ColorerFunc represents a row colorer.
 	ColorerFunc func(ns string, evt render.RowEvent) tcell.Color
@@ -39,9 +42,13 @@ type Table struct {
 	viewSetting *config.ViewSetting
 	sortCol     SortColumn
 	colorerFn   render.ColorerFunc
+	colorRules  colorRules
 	decorateFn  DecorateFunc
 	wide        bool
+	gauges      bool
 	toast       bool
+	fuzzy       bool
+	ctxName     string
 	header      render.Header
 	hasMetrics  bool
 }
@@ -89,6 +96,7 @@ func (t *Table) GVR() client.GVR { return t.gvr }
 // ViewSettingsChanged notifies listener the view configuration changed.
 func (t *Table) ViewSettingsChanged(settings config.ViewSetting) {
 	t.viewSetting = &settings
+	t.colorRules = newColorRules(settings.Colors)
 	t.Refresh()
 }
 
@@ -123,6 +131,12 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// ToggleGauges toggles gauge display for metrics percentage cols.
+func (t *Table) ToggleGauges() {
+	t.gauges = !t.gauges
+	t.Refresh()
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() KeyActions {
 	return t.actions
@@ -172,6 +186,21 @@ func (t *Table) SetColorerFn(f render.ColorerFunc) {
 	t.colorerFn = f
 }
 
+// SetFuzzy makes plain filters match names fuzzily.
+func (t *Table) SetFuzzy(b bool) {
+	t.fuzzy = b
+}
+
+// SetContextName tags the table title with a kubeconfig context.
+func (t *Table) SetContextName(n string) {
+	t.ctxName = n
+}
+
+// SortCol returns the sort column name and order.
+func (t *Table) SortCol() (string, bool) {
+	return t.sortCol.name, t.sortCol.asc
+}
+
 // SetSortCol sets in sort column index and order.
 func (t *Table) SetSortCol(name string, asc bool) {
 	t.sortCol.name, t.sortCol.asc = name, asc
@@ -179,10 +208,10 @@ func (t *Table) SetSortCol(name string, asc bool) {
 
 // Update table content.
 func (t *Table) Update(data render.TableData) {
-	t.header = data.Header
 	if t.decorateFn != nil {
 		data = t.decorateFn(data)
 	}
+	t.header = data.Header
 	t.doUpdate(t.filtered(data))
 	t.UpdateTitle()
 }
@@ -196,7 +225,7 @@ func (t *Table) doUpdate(data render.TableData) {
 
 	var cols []string
 	if t.viewSetting != nil {
-		cols = t.viewSetting.Columns
+		cols = t.viewSetting.ColumnNames()
 	}
 	if len(cols) == 0 {
 		cols = t.header.Columns(t.wide)
@@ -241,6 +270,7 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
+	color = t.colorRules.Colorer(color)
 
 	marked := t.IsMarked(re.Row.ID)
 	var col int
@@ -257,9 +287,23 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 			continue
 		}
 
+		var delta string
 		if !re.Deltas.IsBlank() && !h.IsAgeCol(c) {
-			field += Deltas(re.Deltas[c], field)
+			delta = Deltas(re.Deltas[c], field)
+		}
+		gaugeColor := tcell.ColorDefault
+		if t.gauges && h[c].Gauge {
+			if perc, err := strconv.Atoi(field); err == nil {
+				field = render.AsGauge(perc, gaugeWidth)
+				gaugeColor = t.styles.Table().Gauge.ColorFor(perc)
+			}
 		}
+		if h[c].Fit {
+			if fit, err := strconv.Atoi(field); err == nil {
+				gaugeColor = t.styles.Table().Fit.ColorFor(fit)
+			}
+		}
+		field += delta
 
 		if h[c].Decorator != nil {
 			field = h[c].Decorator(field)
@@ -272,6 +316,9 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
 		fgColor := color(t.GetModel().GetNamespace(), t.header, ore)
+		if gaugeColor != tcell.ColorDefault && fgColor != render.ErrColor {
+			fgColor = gaugeColor
+		}
 		cell.SetTextColor(fgColor)
 		if marked && fgColor != render.ErrColor {
 			cell.SetTextColor(t.styles.Table().MarkColor.Color())
@@ -311,6 +358,19 @@ func (t *Table) ClearMarks() {
 	t.Refresh()
 }
 
+// MarkFiltered marks all rows matching the current filter and returns the marked count.
+func (t *Table) MarkFiltered() int {
+	data := t.GetFilteredData()
+	ids := make([]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		ids = append(ids, re.Row.ID)
+	}
+	t.MarkAll(ids)
+	t.Refresh()
+
+	return len(ids)
+}
+
 // Refresh update the table data.
 func (t *Table) Refresh() {
 	data := t.model.Peek()
@@ -352,7 +412,7 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if t.toast {
 		filtered = filterToast(data)
 	}
-	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.String()) {
+	if t.cmdBuff.Empty() || IsSelector(t.cmdBuff.String()) {
 		return filtered
 	}
 
@@ -360,6 +420,9 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if IsFuzzySelector(q) {
 		return fuzzyFilter(q[2:], t.NameColIndex(), filtered)
 	}
+	if t.fuzzy {
+		return fuzzyFilter(q, t.NameColIndex(), filtered)
+	}
 
 	filtered, err := rxFilter(t.cmdBuff.String(), filtered)
 	if err != nil {
@@ -396,6 +459,9 @@ func (t *Table) styleTitle() string {
 	}
 
 	base := strings.Title(t.gvr.R())
+	if t.ctxName != "" {
+		base += "@" + t.ctxName
+	}
 	ns := t.GetModel().GetNamespace()
 	if client.IsClusterWide(ns) || ns == client.NotNamespaced {
 		ns = client.NamespaceAll
@@ -416,7 +482,13 @@ func (t *Table) styleTitle() string {
 	} else {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
+	if p, ok := t.GetModel().(Pager); ok && p.HasMore() {
+		title += SkinTitle(MoreFmt, t.styles.Frame())
+	}
 
+	if n := t.MarkCount(); n > 0 {
+		title += SkinTitle(fmt.Sprintf(MarksFmt, n), t.styles.Frame())
+	}
 	buff := t.cmdBuff.String()
 	if buff == "" {
 		return title
@@ -424,6 +496,9 @@ func (t *Table) styleTitle() string {
 	if IsLabelSelector(buff) {
 		buff = TrimLabelSelector(buff)
 	}
+	if IsFieldSelector(buff) {
+		buff = strings.TrimSpace(buff[2:])
+	}
 
 	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.styles.Frame())
 }
@@ -20,6 +20,12 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// MarksFmt represents a marked rows count title.
+	MarksFmt = "<[count:bg:b]%d marked[fg:bg:-]> "
+
+	// MoreFmt represents a paged view with more rows to fetch title.
+	MoreFmt = "<[count:bg:b]more[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
@@ -40,6 +46,9 @@ var (
 	// LableRx identifies a label query
 	LableRx = regexp.MustCompile(`\A\-l`)
 
+	// FieldRx identifies a field query.
+	FieldRx = regexp.MustCompile(`\A\-f\s+field=`)
+
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
 )
 
@@ -69,12 +78,25 @@ func IsLabelSelector(s string) bool {
 	return LableRx.MatchString(s)
 }
 
+// IsFieldSelector checks if query is a field query.
+func IsFieldSelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return FieldRx.MatchString(s)
+}
+
+// IsSelector checks if query is a label or field query handled by the api server.
+func IsSelector(s string) bool {
+	return IsLabelSelector(s) || IsFieldSelector(s)
+}
+
 // IsFuzzySelector checks if query is fuzzy.
 func IsFuzzySelector(s string) bool {
 	if s == "" {
 		return false
 	}
-	return fuzzyRx.MatchString(s)
+	return fuzzyRx.MatchString(s) && !IsFieldSelector(s)
 }
 
 // TrimLabelSelector extracts label query.
@@ -82,6 +104,11 @@ func TrimLabelSelector(s string) string {
 	return strings.TrimSpace(s[2:])
 }
 
+// TrimFieldSelector extracts field query.
+func TrimFieldSelector(s string) string {
+	return strings.TrimSpace(FieldRx.ReplaceAllString(s, ""))
+}
+
 // SkinTitle decorates a title.
 func SkinTitle(fmat string, style config.Frame) string {
 	bgColor := style.Title.BgColor
@@ -25,6 +25,30 @@ func TestIsLabelSelector(t *testing.T) {
 	}
 }
 
+func TestIsFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel          string
+		field, fuzzy bool
+	}{
+		"cool":    {sel: "-f field=spec.nodeName=n1", field: true},
+		"noSpace": {sel: "-ffield=spec.nodeName=n1", fuzzy: true},
+		"fuzzy":   {sel: "-f fred", fuzzy: true},
+		"label":   {sel: "-l app=fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.field, IsFieldSelector(u.sel))
+			assert.Equal(t, u.fuzzy, IsFuzzySelector(u.sel))
+		})
+	}
+}
+
+func TestTrimFieldSelector(t *testing.T) {
+	assert.Equal(t, "spec.nodeName=n1,status.phase!=Running", TrimFieldSelector("-f  field=spec.nodeName=n1,status.phase!=Running "))
+}
+
 func TestTrimLabelSelector(t *testing.T) {
 	uu := map[string]struct {
 		sel, e string
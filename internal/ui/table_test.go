@@ -8,6 +8,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -52,6 +53,58 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableMarkFiltered(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	v.SelectRow(1, true)
+
+	v.SearchBuff().Set("zorg")
+	assert.Equal(t, 1, v.MarkFiltered())
+	assert.Equal(t, []string{"r2"}, v.GetSelectedItems())
+
+	v.SearchBuff().Clear()
+	assert.Equal(t, 2, v.MarkFiltered())
+	assert.Equal(t, []string{"r1", "r2"}, v.GetSelectedItems())
+
+	v.ClearMarks()
+	assert.Equal(t, []string{"r1"}, v.GetSelectedItems())
+}
+
+func TestTableMarkRange(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+
+	v.SelectRow(2, true)
+	assert.False(t, v.MarkRange())
+
+	v.ToggleMark()
+	v.SelectRow(1, true)
+	assert.True(t, v.MarkRange())
+	assert.Equal(t, []string{"r1", "r2"}, v.GetSelectedItems())
+	assert.Equal(t, 2, v.MarkCount())
+}
+
+func TestTableInvertMarks(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	v.SelectRow(1, true)
+
+	v.ToggleMark()
+	v.InvertMarks()
+	assert.Equal(t, []string{"r2"}, v.GetSelectedItems())
+	v.InvertMarks()
+	assert.Equal(t, []string{"r1"}, v.GetSelectedItems())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -72,7 +125,7 @@ func (t *testModel) Watch(context.Context)           {}
 func (t *testModel) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return nil, nil
 }
-func (t *testModel) Delete(ctx context.Context, path string, c, f bool) error {
+func (t *testModel) Delete(ctx context.Context, path string, opts dao.DeleteOptions) error {
 	return nil
 }
 func (t *testModel) Describe(context.Context, string) (string, error) {
@@ -81,8 +134,9 @@ func (t *testModel) Describe(context.Context, string) (string, error) {
 func (t *testModel) ToYAML(ctx context.Context, path string) (string, error) {
 	return "", nil
 }
-func (t *testModel) InNamespace(string) bool      { return true }
-func (t *testModel) SetRefreshRate(time.Duration) {}
+func (t *testModel) InNamespace(string) bool        { return true }
+func (t *testModel) SetRefreshRate(time.Duration)   {}
+func (t *testModel) SetVisibility(model.Visibility) {}
 
 func makeTableData() render.TableData {
 	t := render.NewTableData()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,6 +48,15 @@ type Lister interface {
 	Describe(ctx context.Context, path string) (string, error)
 }
 
+// Pager represents a model listing resources a page at a time.
+type Pager interface {
+	// HasMore returns true if more resources can be fetched.
+	HasMore() bool
+
+	// NextPage fetches the next page of resources.
+	NextPage(context.Context)
+}
+
 // Tabular represents a tabular model.
 type Tabular interface {
 	Namespaceable
@@ -67,9 +77,12 @@ type Tabular interface {
 	// SetRefreshRate sets the model watch loop rate.
 	SetRefreshRate(time.Duration)
 
+	// SetVisibility adapts the model watch loop rate to the view visibility.
+	SetVisibility(model.Visibility)
+
 	// AddListener registers a model listener.
 	AddListener(model.TableListener)
 
 	// Delete a resource.
-	Delete(ctx context.Context, path string, cascade, force bool) error
+	Delete(ctx context.Context, path string, opts dao.DeleteOptions) error
 }
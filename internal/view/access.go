@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// whoCanTimeout bounds the time spent reviewing subjects access.
+const whoCanTimeout = time.Minute
+
+// Access represents an access reviews matrix view.
+type Access struct {
+	ResourceViewer
+}
+
+// NewAccess returns a new access reviews view.
+func NewAccess(gvr client.GVR) ResourceViewer {
+	a := Access{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Access{}.ColorerFunc())
+	a.GetTable().SetEnterFn(a.showPolicies)
+	a.SetBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Access) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (a *Access) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Subject", a.GetTable().SortColCmd("SUBJECT", true), false),
+	})
+}
+
+func (a *Access) showPolicies(app *App, _ ui.Tabular, _, path string) {
+	i := strings.Index(path, ":")
+	if i == -1 {
+		return
+	}
+	kind := path[:i]
+	if j := strings.LastIndex(kind, "/"); j != -1 {
+		kind = kind[j+1:]
+	}
+	if err := app.inject(NewPolicy(app, kind, path[i+1:])); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+type accessFunc func() ([]render.AccessRes, error)
+
+func showAccess(app *App, path string, review accessFunc) {
+	app.Flash().Infof("Reviewing access for %s...", path)
+	go func() {
+		rr, err := review()
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			v := NewAccess(client.NewGVR("access"))
+			v.SetContextFn(func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, internal.KeyPath, path)
+				return context.WithValue(ctx, internal.KeyAccess, rr)
+			})
+			if err := app.inject(v); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func showCanI(app *App, verbs []string, gvr client.GVR) {
+	showAccess(app, strings.Join(verbs, ",")+" "+gvr.R(), func() ([]render.AccessRes, error) {
+		return dao.CanI(app.factory, gvr.String(), verbs)
+	})
+}
+
+func showWhoCan(app *App, gvr client.GVR, path string) {
+	showAccess(app, gvr.R()+" "+path, func() ([]render.AccessRes, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), whoCanTimeout)
+		defer cancel()
+		return dao.WhoCan(ctx, app.factory, gvr.String(), path, dao.AccessVerbs)
+	})
+}
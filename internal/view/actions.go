@@ -1,7 +1,9 @@
 package view
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -50,12 +52,10 @@ func inScope(scopes, aliases []string) bool {
 }
 
 func hotKeyActions(r Runner, aa ui.KeyActions) {
-	hh := config.NewHotKeys()
-	if err := hh.Load(); err != nil {
-		return
-	}
-
-	for k, hk := range hh.HotKey {
+	for k, hk := range r.App().HotKeys.HotKey {
+		if hk.Disabled {
+			continue
+		}
 		key, err := asKey(hk.ShortCut)
 		if err != nil {
 			log.Warn().Err(err).Msg("HOT-KEY Unable to map hotkey shortcut to a key")
@@ -68,30 +68,63 @@ func hotKeyActions(r Runner, aa ui.KeyActions) {
 		}
 		aa[key] = ui.NewSharedKeyAction(
 			hk.Description,
-			gotoCmd(r, hk.Command, ""),
+			gotoCmd(r, hk),
 			false)
 	}
 }
 
-func gotoCmd(r Runner, cmd, path string) ui.ActionHandler {
+func gotoCmd(r Runner, hk config.HotKey) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
-		log.Debug().Msgf("YO! %q -- %q", cmd, path)
+		cmd, path, filter, err := hotKeyArgs(r, hk)
+		if err != nil {
+			log.Error().Err(err).Msg("HOT-KEY Args match failed")
+			r.App().Flash().Err(err)
+			return nil
+		}
 		if err := r.App().gotoResource(cmd, path, true); err != nil {
 			log.Error().Err(err).Msgf("Command fail")
 			r.App().Flash().Err(err)
+			return nil
 		}
+		if filter != "" && !r.App().filterView(filter) {
+			r.App().Flash().Warnf("Unable to apply filter %q", filter)
+		}
+
 		return nil
 	}
 }
 
-func pluginActions(r Runner, aa ui.KeyActions) {
-	pp := config.NewPlugins()
-	if err := pp.Load(); err != nil {
-		return
+// hotKeyArgs resolves a hotkey command, path and filter using the current view env.
+func hotKeyArgs(r Runner, hk config.HotKey) (string, string, string, error) {
+	cmd, ns, path, filter := hk.Command, hk.Namespace, hk.Path, hk.Filter
+	if r.EnvFn() != nil {
+		env := r.EnvFn()()
+		sns, _ := client.Namespaced(r.GetSelectedItem())
+		var err error
+		if ns, err = env.substitute(sns, ns); err != nil {
+			return "", "", "", err
+		}
+		if path, err = env.substitute(sns, path); err != nil {
+			return "", "", "", err
+		}
+		if filter, err = env.substitute(sns, filter); err != nil {
+			return "", "", "", err
+		}
+	}
+	if ns != "" {
+		cmd += " " + ns
 	}
 
-	for k, plugin := range pp.Plugin {
-		if !inScope(plugin.Scopes, r.Aliases()) {
+	return cmd, path, filter, nil
+}
+
+func pluginActions(r Runner, aa ui.KeyActions) {
+	readOnly := r.App().Config.K9s.GetReadOnly()
+	for k, plugin := range r.App().Plugins.Plugin {
+		if plugin.Disabled || !inScope(plugin.Scopes, r.Aliases()) {
+			continue
+		}
+		if readOnly && plugin.Dangerous {
 			continue
 		}
 		key, err := asKey(plugin.ShortCut)
@@ -111,6 +144,25 @@ func pluginActions(r Runner, aa ui.KeyActions) {
 	}
 }
 
+// customActions injects plugins and hotkeys actions and returns the added keys.
+func customActions(r Runner, aa ui.KeyActions) []tcell.Key {
+	before := make(map[tcell.Key]struct{}, len(aa))
+	for k := range aa {
+		before[k] = struct{}{}
+	}
+	pluginActions(r, aa)
+	hotKeyActions(r, aa)
+
+	kk := make([]tcell.Key, 0, len(aa)-len(before))
+	for k := range aa {
+		if _, ok := before[k]; !ok {
+			kk = append(kk, k)
+		}
+	}
+
+	return kk
+}
+
 func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := r.GetSelectedItem()
@@ -135,9 +187,12 @@ func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
 				return nil
 			}
 		}
+		details := strings.TrimSpace(bin + " " + strings.Join(aa, " "))
 		if run(r.App(), shellOpts{clear: true, binary: bin, background: bg, args: aa}) {
+			r.App().audit("plugin", "", path, details, nil)
 			r.App().Flash().Info("Plugin command launched successfully!")
 		} else {
+			r.App().audit("plugin", "", path, details, errors.New("plugin command failed"))
 			r.App().Flash().Info("Plugin command failed!")
 		}
 
@@ -0,0 +1,84 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Alert represents the alerts inbox view.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new alerts inbox view.
+func NewAlert(gvr client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Alert{}.ColorerFunc())
+	a.GetTable().SetEnterFn(a.showAlert)
+	a.GetTable().SetSortCol("AGE", true)
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.alertContext)
+
+	return &a
+}
+
+func (a *Alert) alertContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAlerts, a.App().alerts)
+}
+
+func (a *Alert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyA:      ui.NewKeyAction("Acknowledge", a.ackCmd, true),
+		ui.KeyShiftA: ui.NewKeyAction("Acknowledge All", a.ackAllCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Clear Acknowledged", a.clearCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Source", a.GetTable().SortColCmd("SOURCE", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", a.GetTable().SortColCmd("COUNT", false), false),
+	})
+}
+
+func (a *Alert) showAlert(app *App, _ ui.Tabular, _, id string) {
+	h := a.GetTable().GetModel().Peek().Header
+	col := h.IndexOf("MESSAGE", true)
+	if col == -1 {
+		return
+	}
+	details := NewDetails(app, "Alert", id, false).Update(a.GetTable().GetSelectedRow().Fields[col])
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (a *Alert) ackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := a.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return evt
+	}
+	a.App().alerts.Ack(ids...)
+	a.GetTable().ClearMarks()
+	a.Refresh()
+
+	return nil
+}
+
+func (a *Alert) ackAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.App().alerts.AckAll()
+	a.Refresh()
+
+	return nil
+}
+
+func (a *Alert) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.App().alerts.Clear()
+	a.App().Flash().Info("Acknowledged alerts cleared")
+	a.Refresh()
+
+	return nil
+}
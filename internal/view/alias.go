@@ -2,16 +2,23 @@ package view
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
 
-const aliasTitle = "Aliases"
+const (
+	aliasTitle     = "Aliases"
+	aliasDialogKey = "alias"
+)
 
 // Alias represents a command alias view.
 type Alias struct {
@@ -46,13 +53,148 @@ func (a *Alias) aliasContext(ctx context.Context) context.Context {
 }
 
 func (a *Alias) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 	aa.Add(ui.KeyActions{
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, true),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd("RESOURCE", true), false),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort Command", a.GetTable().SortColCmd("COMMAND", true), false),
 		ui.KeyShiftA:   ui.NewKeyAction("Sort ApiGroup", a.GetTable().SortColCmd("APIGROUP", true), false),
+		ui.KeyA:        ui.NewKeyAction("Add", a.addCmd, true),
+		ui.KeyE:        ui.NewKeyAction("Edit", a.editCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", a.deleteCmd, true),
+	})
+}
+
+func (a *Alias) addCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.showAliasDialog("<Add Alias>", nil, "")
+
+	return nil
+}
+
+func (a *Alias) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cmd := a.GetTable().GetSelectedItem()
+	if cmd == "" {
+		return evt
+	}
+	a.showAliasDialog("<Edit Alias>", a.selectedAliases(), cmd)
+
+	return nil
+}
+
+func (a *Alias) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cmd := a.GetTable().GetSelectedItem()
+	if cmd == "" {
+		return evt
+	}
+	aliases := a.selectedAliases()
+	msg := fmt.Sprintf("Delete custom aliases %s for %s?", strings.Join(aliases, ","), cmd)
+	a.App().confirmAction("delete", a.GVR(), aliases, "Delete Aliases", msg, config.ConfirmPrompt, func() {
+		count, err := a.App().command.alias.Remove(aliases...)
+		if err != nil {
+			a.App().Flash().Err(err)
+			return
+		}
+		if count == 0 {
+			a.App().Flash().Warnf("No custom aliases defined for %s", cmd)
+			return
+		}
+		a.reload(fmt.Sprintf("%d alias(es) deleted", count))
+	})
+
+	return nil
+}
+
+func (a *Alias) selectedAliases() []string {
+	r, _ := a.GetTable().GetSelection()
+	if r == 0 {
+		return nil
+	}
+
+	return strings.Split(ui.TrimCell(a.GetTable().SelectTable, r, 1), ",")
+}
+
+func (a *Alias) showAliasDialog(title string, aliases []string, cmd string) {
+	f := a.makeStyledForm()
+	names := strings.Join(aliases, ",")
+	f.AddInputField("Aliases:", names, 30, nil, func(s string) {
+		names = s
 	})
+	f.AddInputField("Command:", cmd, 50, nil, func(s string) {
+		cmd = s
+	})
+	f.AddButton("OK", func() {
+		defer a.dismissDialog()
+		if err := a.saveAliases(aliases, splitAliases(names), strings.TrimSpace(cmd)); err != nil {
+			a.App().Flash().Err(err)
+			return
+		}
+		a.reload("Aliases saved successfully")
+	})
+	f.AddButton("Cancel", func() {
+		a.dismissDialog()
+	})
+
+	modal := tview.NewModalForm(title, f)
+	modal.SetText("Command: <resource> [namespace] [sort:<column>[:desc]] [/<filter>]")
+	modal.SetDoneFunc(func(int, string) {
+		a.dismissDialog()
+	})
+	a.App().Content.AddPage(aliasDialogKey, modal, false, false)
+	a.App().Content.ShowPage(aliasDialogKey)
+}
+
+func (a *Alias) saveAliases(old, aliases []string, cmd string) error {
+	if len(aliases) == 0 {
+		return errors.New("You must specify at least one alias")
+	}
+	var stale []string
+	for _, o := range old {
+		if !includes(aliases, o) {
+			stale = append(stale, o)
+		}
+	}
+	al := a.App().command.alias
+	if _, err := al.Remove(stale...); err != nil {
+		return err
+	}
+
+	return al.Persist(cmd, aliases...)
+}
+
+func (a *Alias) reload(msg string) {
+	if err := a.App().command.Reset(true); err != nil {
+		a.App().Flash().Err(err)
+		return
+	}
+	a.App().Flash().Info(msg)
+	a.Refresh()
+}
+
+func (a *Alias) dismissDialog() {
+	a.App().Content.RemovePage(aliasDialogKey)
+}
+
+func (a *Alias) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
+
+func splitAliases(s string) []string {
+	var aa []string
+	for _, a := range strings.Split(s, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			aa = append(aa, a)
+		}
+	}
+
+	return aa
 }
 
 func (a *Alias) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
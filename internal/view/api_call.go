@@ -0,0 +1,35 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// APICall represents the traced api server calls view.
+type APICall struct {
+	ResourceViewer
+}
+
+// NewAPICall returns a new api calls view.
+func NewAPICall(gvr client.GVR) ResourceViewer {
+	a := APICall{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.APICall{}.ColorerFunc())
+	a.GetTable().SetSortCol(ageCol, true)
+	a.SetBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+func (a *APICall) bindKeys(aa ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verb", a.GetTable().SortColCmd("VERB", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Bytes", a.GetTable().SortColCmd("BYTES", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Latency", a.GetTable().SortColCmd("LATENCY(ms)", false), false),
+	})
+}
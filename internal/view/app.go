@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
@@ -27,6 +31,9 @@ const (
 	maxConRetry      = 10
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
+
+	// slowCallFlashRate throttles slow api calls warnings.
+	slowCallFlashRate = 10 * time.Second
 )
 
 // App represents an application view.
@@ -41,18 +48,34 @@ type App struct {
 	cancelFn     context.CancelFunc
 	conRetry     int32
 	clusterModel *model.ClusterInfo
+	notifier     *dao.Notifier
+	alerts       *dao.AlertInbox
+	dashboard    *dao.DashboardSeries
+	auditor      *dao.Auditor
+	ctxHealth    *dao.ContextHealth
+	hops         *dao.NavHistory
+	profiler     *perf.Profiler
+	lastSlowCall int64
+	navigating   bool
 }
 
 // NewApp returns a K9s app instance.
 func NewApp(cfg *config.Config) *App {
 	a := App{
-		App:     ui.NewApp(cfg.K9s.CurrentContext),
-		Content: NewPageStack(),
+		App:       ui.NewApp(cfg.K9s.CurrentContext),
+		Content:   NewPageStack(),
+		alerts:    dao.NewAlertInbox(),
+		dashboard: dao.NewDashboardSeries(),
+		hops:      dao.NewNavHistory(),
+		profiler:  perf.NewProfiler(perf.DefaultProfilerAddr),
+		auditor:   dao.NewAuditor(config.K9sAuditLog, config.MustK9sUser()),
 	}
 	a.Config = cfg
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
 	a.Views()["clusterInfo"] = NewClusterInfo(&a)
+	a.alerts.AddListener(a.statusIndicator())
+	a.Flash().AddListener(&a)
 
 	return &a
 }
@@ -72,6 +95,7 @@ func (a *App) Init(version string, rate int) error {
 	}
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
+	a.Content.Stack.AddListener(a)
 
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
@@ -84,7 +108,13 @@ func (a *App) Init(version string, rate int) error {
 		log.Info().Msg("No namespace specified using all namespaces")
 	}
 
+	client.MetricsWindow = a.Config.K9s.GetMetricsWindow()
+	a.Conn().Config().SetReadOnly(a.Config.K9s.GetReadOnly())
+	a.Conn().Config().APICalls().SetSlowThreshold(a.Config.K9s.GetSlowAPICall())
+	a.Conn().Config().APICalls().AddListener(a)
+	a.Config.K9s.TouchContext(a.Config.K9s.CurrentContext)
 	a.factory = watch.NewFactory(a.Conn())
+	a.factory.SetIdleTTL(a.Config.K9s.GetInformerIdle())
 	a.initFactory(ns)
 
 	a.clusterModel = model.NewClusterInfo(a.factory, version)
@@ -92,6 +122,18 @@ func (a *App) Init(version string, rate int) error {
 	a.clusterModel.AddListener(a.statusIndicator())
 	a.clusterModel.Refresh()
 
+	a.setAuditIdentity()
+
+	a.notifier = dao.NewNotifier(a.Config.K9s.GetNotifications())
+	a.notifier.SetCluster(a.Config.K9s.CurrentCluster)
+	a.notifier.AddListener(a)
+	a.notifier.Init(ctx, a.factory)
+
+	if i := a.Config.K9s.GetContextsHealthInterval(); i > 0 {
+		a.ctxHealth = dao.NewContextHealth(a.Conn().Config(), i)
+		a.ctxHealth.Start(ctx)
+	}
+
 	a.command = NewCommand(a)
 	if err := a.command.Init(); err != nil {
 		return err
@@ -134,10 +176,13 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 
 func (a *App) bindKeys() {
 	a.AddActions(ui.KeyActions{
-		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlE:     ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
+		ui.KeyHelp:         ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
+		ui.KeyLeftBracket:  ui.NewSharedKeyAction("Back", a.navBackCmd, false),
+		ui.KeyRightBracket: ui.NewSharedKeyAction("Forward", a.navForwardCmd, false),
+		tcell.KeyCtrlP:     ui.NewSharedKeyAction("Palette", a.paletteCmd, false),
 	})
 }
 
@@ -192,6 +237,13 @@ func (a *App) Halt() {
 	}
 }
 
+// setVisibility notifies the current view of its visibility.
+func (a *App) setVisibility(v model.Visibility) {
+	if t, ok := a.Content.Top().(VisibilityTracker); ok {
+		t.SetVisibility(v)
+	}
+}
+
 // Resume restarts the app event loop.
 func (a *App) Resume() {
 	var ctx context.Context
@@ -206,6 +258,20 @@ func (a *App) Resume() {
 	if err := a.CustomViewsWatcher(ctx, a); err != nil {
 		log.Error().Err(err).Msgf("CustomView watcher failed")
 	}
+
+	if err := a.PluginsWatcher(ctx, a, a.pluginsChanged); err != nil {
+		log.Error().Err(err).Msgf("Plugins watcher failed")
+	}
+}
+
+// pluginsChanged restarts the active viewer so its menu picks up plugins/hotkeys changes.
+func (a *App) pluginsChanged() {
+	c := a.Content.Top()
+	if _, ok := c.(ResourceViewer); !ok {
+		return
+	}
+	a.Flash().Info("Plugins/HotKeys configuration reloaded...")
+	c.Start()
 }
 
 func (a *App) clusterUpdater(ctx context.Context) {
@@ -268,13 +334,13 @@ func (a *App) switchNS(ns string) bool {
 		log.Error().Err(err).Msg("Config Set NS failed!")
 		return false
 	}
-	a.factory.SetActiveNS(ns)
 
 	return true
 }
 
 func (a *App) switchCtx(name string, loadPods bool) error {
 	log.Debug().Msgf("--> Switching Context %q--%q", name, a.Config.ActiveView())
+	a.saveSession()
 	a.Halt()
 	defer a.Resume()
 	{
@@ -287,10 +353,12 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		if err := a.command.Reset(true); err != nil {
 			return err
 		}
+		a.Config.K9s.TouchContext(name)
 		a.Config.Reset()
 		if err := a.Config.Save(); err != nil {
 			log.Error().Err(err).Msg("Config save failed!")
 		}
+		a.Conn().Config().SetReadOnly(a.Config.K9s.GetReadOnly())
 		a.Flash().Infof("Switching context to %s", name)
 		a.ReloadStyles(name)
 		v := a.Config.ActiveView()
@@ -301,11 +369,109 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			a.Flash().Err(err)
 		}
 		a.clusterModel.Reset(a.factory)
+		a.notifier.SetCluster(a.Config.K9s.CurrentCluster)
+		a.notifier.Reset(a.factory)
+		a.setAuditIdentity()
 	}
 
 	return nil
 }
 
+// Notified notifies a notification rule matched a watched resource.
+func (a *App) Notified(rule config.Notification, n render.NotificationRes) {
+	if rule.Bell {
+		fmt.Fprint(os.Stdout, "\a")
+	}
+	msg := fmt.Sprintf("%s: %s is %s", rule.Name, n.Path, n.Status)
+	a.alerts.Raise(dao.AlertNotification, render.AlertWarn, msg)
+	a.Flash().Warn(msg)
+}
+
+// SlowAPICall notifies an api call exceeded the slow threshold.
+// Warnings are throttled so a sluggish api server does not drown the flash.
+func (a *App) SlowAPICall(c client.APICall) {
+	now, last := time.Now().UnixNano(), atomic.LoadInt64(&a.lastSlowCall)
+	if now-last < int64(slowCallFlashRate) || !atomic.CompareAndSwapInt64(&a.lastSlowCall, last, now) {
+		return
+	}
+	a.Flash().Warnf("Slow API call %s %s took %v", c.Verb, c.GVR, c.Latency.Round(time.Millisecond))
+}
+
+// setAuditIdentity tracks the kube context and user actions are performed as.
+func (a *App) setAuditIdentity() {
+	usr, err := a.Conn().Config().CurrentUserName()
+	if err != nil {
+		log.Warn().Err(err).Msg("No kube user found for audit trail")
+	}
+	a.auditor.SetIdentity(a.Config.K9s.CurrentContext, usr)
+}
+
+// audit records a mutating action in the audit trail.
+func (a *App) audit(action, gvr, path, details string, err error) {
+	a.auditor.Record(action, gvr, path, details, err)
+}
+
+// mutate runs a mutating action and records its outcome in the audit trail.
+func (a *App) mutate(action, gvr, path, details string, f func() error) error {
+	err := f()
+	a.audit(action, gvr, path, details, err)
+
+	return err
+}
+
+// auditApplied records each resource applied from a manifest source.
+func (a *App) auditApplied(action, source string, rr []render.ApplyRes, err error) {
+	if err != nil {
+		a.audit(action, "", source, "", err)
+		return
+	}
+	for _, r := range rr {
+		var e error
+		if r.Result == render.ApplyFailed {
+			e = errors.New(r.Message)
+		}
+		a.audit(action, r.GVR, client.FQN(r.Namespace, r.Name), r.Result+" from "+source, e)
+	}
+}
+
+// testNotification delivers a sample notification for a given rule.
+func (a *App) testNotification(rule string) {
+	a.Flash().Infof("Test firing notification %s...", rule)
+	go func() {
+		if err := a.notifier.TestFire(rule); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Notification %s delivered", rule)
+	}()
+}
+
+// FlashChanged notifies a flash message was raised.
+func (a *App) FlashChanged(l model.FlashLevel, msg string) {
+	if l == model.FlashErr {
+		a.alerts.Raise(dao.AlertFlash, render.AlertErr, msg)
+	}
+}
+
+// FlashCleared notifies the flash message was cleared.
+func (a *App) FlashCleared() {}
+
+// apiErr records an api server error in the alerts inbox and flashes it.
+func (a *App) apiErr(err error) {
+	a.alerts.Raise(dao.AlertAPI, render.AlertErr, err.Error())
+	a.Flash().Err(err)
+}
+
+// prometheus returns the active cluster Prometheus datasource if any.
+func (a *App) prometheus() *client.Prometheus {
+	cl := a.Config.K9s.ActiveCluster()
+	if cl == nil || cl.Prometheus == nil || cl.Prometheus.URL == "" {
+		return nil
+	}
+
+	return client.PrometheusFor(cl.Prometheus.URL)
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -313,6 +479,7 @@ func (a *App) initFactory(ns string) {
 
 // BailOut exists the application.
 func (a *App) BailOut() {
+	a.saveSession()
 	a.factory.Terminate()
 	a.App.BailOut()
 }
@@ -331,6 +498,14 @@ func (a *App) Run() error {
 	if err := a.command.defaultCmd(); err != nil {
 		return err
 	}
+	if src := a.Config.K9s.GetSource(); src != "" {
+		if err := a.runScript(src); err != nil {
+			log.Error().Err(err).Msgf("Script failed")
+			a.Flash().Err(err)
+		}
+	} else if a.Config.K9s.GetCommand() == "" {
+		a.offerSession()
+	}
 	if err := a.Application.Run(); err != nil {
 		return err
 	}
@@ -414,6 +589,8 @@ func (a *App) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if err := a.gotoResource(a.GetCmd(), "", true); err != nil {
 			log.Error().Err(err).Msgf("Goto resource for %q failed", a.GetCmd())
 			a.Flash().Err(err)
+		} else {
+			a.command.Remember(a.GetCmd())
 		}
 		a.ResetCmd()
 		return nil
@@ -464,6 +641,23 @@ func (a *App) gotoResource(cmd, path string, clearStack bool) error {
 	return a.command.run(cmd, path, clearStack)
 }
 
+// filterView applies a filter to the active table view.
+func (a *App) filterView(filter string) bool {
+	c := a.Content.Top()
+	v, ok := c.(TableViewer)
+	if !ok || v.GetTable() == nil {
+		return false
+	}
+	v.GetTable().SearchBuff().Set(filter)
+	if ui.IsSelector(filter) {
+		c.Start()
+	} else {
+		v.GetTable().Refresh()
+	}
+
+	return true
+}
+
 func (a *App) inject(c model.Component) error {
 	ctx := context.WithValue(context.Background(), internal.KeyApp, a)
 	if err := c.Init(ctx); err != nil {
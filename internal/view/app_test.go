@@ -12,5 +12,5 @@ func TestAppNew(t *testing.T) {
 	a := view.NewApp(config.NewConfig(ks{}))
 	a.Init("blee", 10)
 
-	assert.Equal(t, 12, len(a.GetActions()))
+	assert.Equal(t, 15, len(a.GetActions()))
 }
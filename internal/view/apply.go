@@ -0,0 +1,76 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Apply represents a manifests apply results view.
+type Apply struct {
+	ResourceViewer
+}
+
+// NewApply returns a new apply results view.
+func NewApply(gvr client.GVR) ResourceViewer {
+	a := Apply{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Apply{}.ColorerFunc())
+	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	a.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	a.GetTable().SetEnterFn(a.gotoResource)
+	a.SetBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Apply) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (a *Apply) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Result", a.GetTable().SortColCmd("RESULT", true), false),
+	})
+}
+
+func (a *Apply) gotoResource(app *App, _ ui.Tabular, _, path string) {
+	viewResourceRef(app, path)
+}
+
+func showApplied(app *App, path string) {
+	app.Flash().Infof("Applying manifests from %s...", path)
+	ns := app.Config.ActiveNamespace()
+	go func() {
+		rr, err := dao.ApplyManifests(app.factory, path, ns)
+		app.auditApplied("apply", path, rr, err)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			v := NewApply(client.NewGVR("applied"))
+			v.SetContextFn(func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, internal.KeyPath, path)
+				return context.WithValue(ctx, internal.KeyApplied, rr)
+			})
+			if err := app.inject(v); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
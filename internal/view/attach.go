@@ -0,0 +1,119 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/fatih/color"
+	"github.com/rs/zerolog/log"
+	"k8s.io/kubectl/pkg/util/term"
+)
+
+const (
+	attachBannerFmt = "<<K9s-Attach>> Pod: %s | Container: %s | %s \n"
+	ttyDetachHint   = "Detach: <ctrl-p ctrl-q>"
+	stdDetachHint   = "Detach: <ctrl-c>"
+)
+
+// attachIn attaches to a container main process until it exits or the operator detaches.
+func attachIn(a *App, path, co string) {
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	stdin, tty, err := po.AttachSpec(path, co)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	hint := stdDetachHint
+	if stdin && tty {
+		hint = ttyDetachHint
+	}
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	banner := c.Sprintf(attachBannerFmt, path, co, hint)
+
+	var detached bool
+	a.Halt()
+	defer a.Resume()
+	a.setVisibility(model.Hidden)
+	defer a.setVisibility(model.Foreground)
+	ok := a.Suspend(func() {
+		detached, err = attachSession(&po, path, dao.AttachOptions{Container: co, Stdin: stdin, TTY: tty}, banner)
+	})
+	if !ok {
+		err = errors.New("Attach failed")
+	}
+	a.audit("attach", "v1/pods", path, "container="+co, err)
+	switch {
+	case !ok:
+		a.Flash().Err(err)
+	case err != nil:
+		a.Flash().Errf("Attach exited: %v", err)
+	case detached:
+		a.Flash().Infof("Detached from %s:%s", path, co)
+	}
+}
+
+func attachSession(po *dao.Pod, path string, opts dao.AttachOptions, banner string) (bool, error) {
+	clearScreen()
+	defer clearScreen()
+	fmt.Print(banner)
+
+	done, detach := make(chan struct{}), make(chan struct{})
+	defer close(done)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+	go func() {
+		select {
+		case <-sigChan:
+			close(detach)
+		case <-done:
+		}
+	}()
+	opts.Out, opts.ErrOut, opts.Detach = os.Stdout, os.Stderr, detach
+
+	if !opts.Stdin {
+		return po.Attach(path, opts)
+	}
+
+	// Input is read off its own tty handle so pending reads unblock once the session ends.
+	in, err := os.Open("/dev/tty")
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing attach input")
+		}
+	}()
+	ctl, err := os.Open("/dev/tty")
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := ctl.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing attach terminal")
+		}
+	}()
+
+	opts.In = in
+	t := term.TTY{In: ctl, Out: os.Stdout, Raw: opts.TTY}
+	if opts.TTY {
+		opts.SizeQueue = t.MonitorSize(t.GetSize())
+	}
+	var detached bool
+	err = t.Safe(func() error {
+		var err error
+		detached, err = po.Attach(path, opts)
+		return err
+	})
+
+	return detached, err
+}
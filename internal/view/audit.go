@@ -0,0 +1,52 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Audit represents the audit trail view.
+type Audit struct {
+	ResourceViewer
+}
+
+// NewAudit returns a new audit trail view.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Audit{}.ColorerFunc())
+	a.GetTable().SetEnterFn(a.showResource)
+	a.GetTable().SetSortCol("AGE", true)
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.auditContext)
+
+	return &a
+}
+
+func (a *Audit) auditContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, a.App().auditor.Path())
+}
+
+func (a *Audit) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Sort User", a.GetTable().SortColCmd("USER", true), false),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Action", a.GetTable().SortColCmd("ACTION", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", a.GetTable().SortColCmd("RESOURCE", true), false),
+	})
+}
+
+func (a *Audit) showResource(app *App, _ ui.Tabular, _, _ string) {
+	row, h := a.GetTable().GetSelectedRow(), a.GetTable().GetModel().Peek().Header
+	gvrCol, nameCol := h.IndexOf("RESOURCE", true), h.IndexOf("NAME", true)
+	if gvrCol == -1 || nameCol == -1 || row.Fields[gvrCol] == "" {
+		return
+	}
+	viewResourceRef(app, row.Fields[gvrCol]+":"+row.Fields[nameCol])
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -15,6 +16,9 @@ import (
 	"github.com/gdamore/tcell"
 )
 
+// benchRefreshRate tracks how often live benchmark results are updated.
+const benchRefreshRate = time.Second
+
 // Benchmark represents a service benchmark results view.
 type Benchmark struct {
 	ResourceViewer
@@ -60,6 +64,18 @@ func (b *Benchmark) benchFile() string {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// showBenchResults displays a benchmark results as they come in.
+func showBenchResults(app *App, subject string, b *perf.Benchmark) {
+	details := NewDetails(app, "Benchmark", subject, false).
+		EnableRefresh(benchRefreshRate, func() (string, error) {
+			return b.Report(), nil
+		}).
+		Update(b.Report())
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 func fileToSubject(path string) string {
 	tokens := strings.Split(path, "/")
 	ee := strings.Split(tokens[len(tokens)-1], "_")
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -13,7 +12,6 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +26,7 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	customKeys []tcell.Key
 }
 
 // NewBrowser returns a new browser.
@@ -50,10 +49,15 @@ func (b *Browser) Init(ctx context.Context) error {
 		return err
 	}
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
+	// Only check access as large resources may be paged rather than cached.
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
-		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.MonitorAccess); e != nil {
+		auth, e := b.app.Conn().CanI(ns, b.GVR().String(), client.MonitorAccess)
+		if e != nil {
 			return e
 		}
+		if !auth {
+			return fmt.Errorf("%v access denied on resource %q:%q", client.MonitorAccess, ns, b.GVR())
+		}
 	}
 	b.app.CmdBuff().Reset()
 
@@ -72,7 +76,8 @@ func (b *Browser) Init(ctx context.Context) error {
 		b.Select(1, 0)
 	}
 	b.GetModel().AddListener(b)
-	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	b.SetEndFn(b.loadMore)
+	b.GetModel().SetRefreshRate(refreshRateFor(b.App(), b.GVR().String()))
 
 	return nil
 }
@@ -106,8 +111,36 @@ func (b *Browser) prepareContext() context.Context {
 	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
 		b.Path = path
 	}
+	b.labels, _ = ctx.Value(internal.KeyLabels).(string)
+	b.fields, _ = ctx.Value(internal.KeyFields).(string)
+	if filter := b.SearchBuff().String(); ui.IsLabelSelector(filter) && b.labels == ui.TrimLabelSelector(filter) {
+		b.labels = ""
+	}
 
-	return ctx
+	return b.selectorContext(ctx)
+}
+
+// selectorContext adds the filter label or field selector to the view
+// selectors so resources get filtered by the api server.
+func (b *Browser) selectorContext(ctx context.Context) context.Context {
+	var (
+		key    internal.ContextKey
+		filter = b.SearchBuff().String()
+		sel    string
+	)
+	switch {
+	case ui.IsLabelSelector(filter):
+		key, sel = internal.KeyLabels, ui.TrimLabelSelector(filter)
+	case ui.IsFieldSelector(filter):
+		key, sel = internal.KeyFields, ui.TrimFieldSelector(filter)
+	default:
+		return ctx
+	}
+	if s, _ := ctx.Value(key).(string); s != "" && s != sel {
+		sel = s + "," + sel
+	}
+
+	return context.WithValue(ctx, key, sel)
 }
 
 // Stop terminates browser updates.
@@ -120,6 +153,20 @@ func (b *Browser) Stop() {
 	b.cancelFn = nil
 }
 
+// loadMore fetches the next resources page once the selection reaches the last row.
+func (b *Browser) loadMore() {
+	p, ok := b.GetModel().(ui.Pager)
+	if !ok || !p.HasMore() {
+		return
+	}
+	ctx := b.defaultContext()
+	if b.contextFn != nil {
+		ctx = b.contextFn(ctx)
+	}
+	b.app.Flash().Info("Loading more...")
+	go p.NextPage(b.selectorContext(ctx))
+}
+
 func (b *Browser) refresh() {
 	b.Start()
 }
@@ -156,7 +203,7 @@ func (b *Browser) TableDataChanged(data render.TableData) {
 // TableLoadFailed notifies view something went south.
 func (b *Browser) TableLoadFailed(err error) {
 	b.app.QueueUpdateDraw(func() {
-		b.app.Flash().Err(err)
+		b.app.apiErr(err)
 		b.App().ClearStatus(false)
 	})
 }
@@ -177,7 +224,7 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(b.app, "YAML", path, true).Update(raw)
+	details := NewDetails(b.app, "YAML", path, true).EnableYAMLFilters().Update(raw)
 	if err := b.App().inject(details); err != nil {
 		b.App().Flash().Err(err)
 	}
@@ -195,7 +242,7 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	b.App().Flash().Info("Clearing filter...")
 	b.SearchBuff().Reset()
 
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsSelector(cmd) {
 		b.Start()
 	} else {
 		b.Refresh()
@@ -212,7 +259,7 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	b.SearchBuff().SetActive(false)
 
 	cmd := b.SearchBuff().String()
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsSelector(cmd) {
 		b.Start()
 		return nil
 	}
@@ -254,7 +301,7 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	{
 		msg := fmt.Sprintf("Delete %s %s?", b.GVR().R(), selections[0])
 		if len(selections) > 1 {
-			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
+			msg = targetsMsg(fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR()), selections)
 		}
 		if !dao.IsK8sMeta(b.meta) {
 			b.simpleDelete(selections, msg)
@@ -289,6 +336,17 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	if b.app.Config.K9s.EditDryRun {
+		b.editDryRun(path, nil)
+		return nil
+	}
+
+	trackRefs := dao.IsRefTarget(b.GVR().String())
+	var rev string
+	if trackRefs {
+		rev = b.resourceVersion(ns, n)
+	}
+
 	b.Stop()
 	defer b.Start()
 	{
@@ -297,13 +355,104 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		args = append(args, b.meta.SingularName)
 		args = append(args, "-n", ns)
 		if !runK(b.app, shellOpts{clear: true, args: append(args, n)}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
+			err := errors.New("Edit exec failed")
+			b.app.audit("edit", b.GVR().String(), path, "", err)
+			b.app.Flash().Err(err)
+			return evt
 		}
+		b.app.audit("edit", b.GVR().String(), path, "", nil)
+	}
+	if trackRefs && rev != b.resourceVersion(ns, n) {
+		b.offerRestart(ns, path)
 	}
 
 	return evt
 }
 
+func (b *Browser) resourceVersion(ns, n string) string {
+	o, err := b.app.factory.Client().DynDialOrDie().Resource(b.GVR().GVR()).Namespace(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to fetch resource %s/%s", ns, n)
+		return ""
+	}
+
+	return o.GetResourceVersion()
+}
+
+// offerRestart prompts for a rollout restart of all workloads referencing
+// an edited resource.
+func (b *Browser) offerRestart(ns, path string) {
+	idx, err := dao.BuildRefIndex(b.app.factory, ns)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	refs := idx.For(b.GVR().String(), path)
+	if len(refs) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%s was updated. Restart %d referencing workload(s)?", path, len(refs))
+	if len(refs) == 1 {
+		msg = fmt.Sprintf("%s was updated. Restart %s %s?", path, client.NewGVR(refs[0].GVR).R(), refs[0].FQN)
+	}
+	b.app.confirmRefs("restart", refs, "Restart", msg, config.ConfirmPrompt, func() {
+		for _, ref := range refs {
+			err := b.app.mutate("restart", ref.GVR, ref.FQN, "references "+path, func() error {
+				return restartRef(b.app.factory, ref)
+			})
+			if err != nil {
+				b.app.Flash().Err(err)
+				return
+			}
+		}
+		b.app.Flash().Infof("Rollout restart in progress for %d workload(s)...", len(refs))
+	})
+}
+
+func restartRef(f dao.Factory, ref dao.Ref) error {
+	res, err := dao.AccessorFor(f, client.NewGVR(ref.GVR))
+	if err != nil {
+		return err
+	}
+	r, ok := res.(dao.Restartable)
+	if !ok {
+		return fmt.Errorf("resource %s is not restartable", ref.GVR)
+	}
+
+	return r.Restart(ref.FQN)
+}
+
+func (b *Browser) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	raw, err := dao.PreviousDiff(b.app.factory, b.GVR().String(), path)
+	if err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(b.app, "Diff", path, true).EnableDiff().Update(raw)
+	if err := b.App().inject(details); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, n := client.Namespaced(path)
+	showEvents(b.app, path, dao.EventsSelector(b.meta.Kind, ns, n))
+
+	return nil
+}
+
 func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 	i, err := strconv.Atoi(string(evt.Rune()))
 	if err != nil {
@@ -364,6 +513,13 @@ func (b *Browser) defaultContext() context.Context {
 	}
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
+	ctx = context.WithValue(ctx, internal.KeyFieldCols, b.app.CustomView.FieldColumns(b.GVR().String()))
+	ctx = context.WithValue(ctx, internal.KeyServerTable, b.app.CustomView.ServerTable(b.GVR().String()))
+	ctx = context.WithValue(ctx, internal.KeyPaging, b.app.Config.K9s.GetPageThreshold())
+	if prom := b.app.prometheus(); prom != nil {
+		ctx = context.WithValue(ctx, internal.KeyPrometheus, prom)
+		ctx = context.WithValue(ctx, internal.KeyPromQueries, b.app.CustomView.PromQueries(b.GVR().String()))
+	}
 
 	return ctx
 }
@@ -377,6 +533,14 @@ func (b *Browser) refreshActions() {
 
 	if b.app.ConOK() {
 		b.namespaceActions(aa)
+		if dao.IsK8sMeta(b.meta) {
+			aa[ui.KeyShiftW] = ui.NewKeyAction("Who Can", b.whoCanCmd, true)
+			aa[ui.KeyZ] = ui.NewKeyAction("Finalizers", b.finalizersCmd, true)
+			aa[ui.KeyShiftQ] = ui.NewKeyAction("Conditions", b.conditionsCmd, true)
+			if b.app.Config.K9s.GetDrift() != nil {
+				aa[tcell.KeyCtrlN] = ui.NewKeyAction("Drift", b.driftCmd, true)
+			}
+		}
 		if !b.app.Config.K9s.GetReadOnly() {
 			if client.Can(b.meta.Verbs, "edit") {
 				aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
@@ -384,16 +548,24 @@ func (b *Browser) refreshActions() {
 			if client.Can(b.meta.Verbs, "delete") {
 				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
 			}
+			if dao.IsK8sMeta(b.meta) && client.Can(b.meta.Verbs, "patch") {
+				aa[tcell.KeyCtrlO] = ui.NewKeyAction("Label/Annotate", b.labelCmd, true)
+			}
+			if _, ok := b.template(); ok && client.Can(b.meta.Verbs, "create") {
+				aa[ui.KeyN] = ui.NewKeyAction("New", b.newCmd, true)
+			}
 		}
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyShiftE] = ui.NewKeyAction("Events", b.eventsCmd, true)
+		aa[tcell.KeyCtrlY] = ui.NewKeyAction("Diff Previous", b.diffCmd, true)
 	}
 
-	pluginActions(b, aa)
-	hotKeyActions(b, aa)
+	b.Actions().Delete(b.customKeys...)
+	b.customKeys = customActions(b, aa)
 	b.Actions().Add(aa)
 
 	if b.bindKeysFn != nil {
@@ -402,6 +574,36 @@ func (b *Browser) refreshActions() {
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
+func (b *Browser) conditionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showConditions(b.app, b.GVR(), path)
+
+	return nil
+}
+
+func (b *Browser) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDrift(b.app, b.GVR(), path)
+
+	return nil
+}
+
+func (b *Browser) whoCanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showWhoCan(b.app, b.GVR(), path)
+
+	return nil
+}
+
 func (b *Browser) namespaceActions(aa ui.KeyActions) {
 	if !b.meta.Namespaced || b.GetTable().Path != "" {
 		return
@@ -421,7 +623,7 @@ func (b *Browser) namespaceActions(aa ui.KeyActions) {
 }
 
 func (b *Browser) simpleDelete(selections []string, msg string) {
-	dialog.ShowConfirm(b.app.Content.Pages, "Confirm Delete", msg, func() {
+	b.app.confirmAction("delete", b.GVR(), selections, "Confirm Delete", msg, config.ConfirmPrompt, func() {
 		b.ShowDeleted()
 		if len(selections) > 1 {
 			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
@@ -434,26 +636,32 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
 				return
 			}
-			if err := nuker.Delete(sel, true, true); err != nil {
+			err := b.app.mutate("delete", b.GVR().String(), sel, "", func() error {
+				return nuker.Delete(sel, dao.DefaultDeleteOptions())
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.GetTable().DeleteMark(sel)
 			}
 		}
 		b.refresh()
-	}, func() {})
+	})
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Content.Pages, msg, func(cascade, force bool) {
+	b.app.confirmDelete(b.GVR(), selections, msg, func(opts dao.DeleteOptions) {
 		b.ShowDeleted()
 		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
+			b.app.Flash().Infof("Delete %d marked %s (%s)", len(selections), b.GVR(), opts)
 		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+			b.app.Flash().Infof("Delete resource %s %s (%s)", b.GVR(), selections[0], opts)
 		}
 		for _, sel := range selections {
-			if err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force); err != nil {
+			err := b.app.mutate("delete", b.GVR().String(), sel, opts.String(), func() error {
+				return b.GetModel().Delete(b.defaultContext(), sel, opts)
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), sel)
@@ -462,5 +670,5 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			}
 		}
 		b.refresh()
-	}, func() {})
+	})
 }
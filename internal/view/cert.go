@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Cert represents a certificates view.
+type Cert struct {
+	ResourceViewer
+}
+
+// NewCert returns a new certificates view.
+func NewCert(gvr client.GVR) ResourceViewer {
+	c := Cert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetColorerFn(render.Cert{}.ColorerFunc())
+	c.GetTable().SetEnterFn(c.showCert)
+	c.GetTable().SetSortCol("NOT AFTER", true)
+	c.SetBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Cert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Expiry", c.GetTable().SortColCmd("NOT AFTER", true), false),
+	})
+}
+
+func (c *Cert) showCert(app *App, _ ui.Tabular, _, id string) {
+	fetch := func() (string, error) {
+		return dao.CertDetails(app.factory, id)
+	}
+	raw, err := fetch()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	subject := id
+	if tokens := strings.Split(id, "|"); len(tokens) == 4 {
+		subject = client.FQN(tokens[1], tokens[2])
+	}
+	details := NewDetails(app, "Certificate", subject, true).
+		EnableRefresh(describeRefreshRate(app), fetch).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showCerts(app *App, gvr client.GVR, path string) {
+	v := NewCert(client.NewGVR("tlscerts"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyTargetGVR, gvr.String())
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func certsCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		showCerts(v.App(), v.GVR(), path)
+
+		return nil
+	}
+}
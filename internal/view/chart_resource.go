@@ -0,0 +1,51 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// ChartResource represents a chart release objects view.
+type ChartResource struct {
+	ResourceViewer
+}
+
+// NewChartResource returns a new chart release objects view.
+func NewChartResource(gvr client.GVR) ResourceViewer {
+	c := ChartResource{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetColorerFn(render.ChartResource{}.ColorerFunc())
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	c.GetTable().SetEnterFn(c.gotoResource)
+	c.SetBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Init initializes the view.
+func (c *ChartResource) Init(ctx context.Context) error {
+	if err := c.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	c.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (c *ChartResource) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", c.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", c.GetTable().SortColCmd(nameCol, true), false),
+	})
+}
+
+func (c *ChartResource) gotoResource(app *App, _ ui.Tabular, _, path string) {
+	viewResourceRef(app, path)
+}
@@ -1,13 +1,28 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const (
+	rollbackDialogKey = "rollback"
+	chartTestTimeout  = 5 * time.Minute
 )
 
 // Chart represents a helm chart view.
@@ -23,6 +38,7 @@ func NewChart(gvr client.GVR) ResourceViewer {
 	c.GetTable().SetColorerFn(render.Chart{}.ColorerFunc())
 	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
 	c.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	c.GetTable().SetEnterFn(c.showHistory)
 	c.SetBindKeysFn(c.bindKeys)
 	c.SetContextFn(c.chartContext)
 
@@ -33,21 +49,422 @@ func (c *Chart) chartContext(ctx context.Context) context.Context {
 	return ctx
 }
 
+func (c *Chart) showHistory(app *App, _ ui.Tabular, _, path string) {
+	h := NewHistory(client.NewGVR("history"))
+	h.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(h); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *Chart) resourcesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	v := NewChartResource(client.NewGVR("chartresources"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := c.App().inject(v); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *Chart) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 	aa.Add(ui.KeyActions{
-		ui.KeyB:      ui.NewKeyAction("Blee", c.bleeCmd, true),
+		ui.KeyM:      ui.NewKeyAction("Manifest", c.manifestCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Resources", c.resourcesCmd, true),
+		ui.KeyV:      ui.NewKeyAction("Values", c.valuesCmd(false), true),
+		ui.KeyShiftV: ui.NewKeyAction("All Values", c.valuesCmd(true), true),
 		ui.KeyShiftN: ui.NewKeyAction("Sort Name", c.GetTable().SortColCmd(nameCol, true), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", c.GetTable().SortColCmd(ageCol, true), false),
 	})
+	if c.App().Config.K9s.GetReadOnly() {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlD: ui.NewKeyAction("Uninstall (Dry Run)", c.uninstallCmd, true),
+		})
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR:        ui.NewKeyAction("Rollback", c.rollbackCmd, true),
+		ui.KeyE:        ui.NewKeyAction("Edit Values", c.editValuesCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Uninstall", c.uninstallCmd, true),
+		ui.KeyT:        ui.NewKeyAction("Run Tests", c.testCmd, true),
+	})
+}
+
+func (c *Chart) testCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	var t dao.ReleaseTest
+	t.Init(c.App().factory, client.NewGVR("charts"))
+	pods, err := t.TestPods(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if len(pods) == 0 {
+		c.App().Flash().Warnf("No tests defined for chart %s", path)
+		return nil
+	}
+
+	c.App().Flash().Infof("Running tests for chart %s...", path)
+	go func() {
+		err := t.Run(path, chartTestTimeout)
+		c.App().QueueUpdateDraw(func() {
+			if err != nil {
+				c.App().Flash().Errf("Chart %s tests failed -- %s", path, err)
+				return
+			}
+			c.App().Flash().Infof("Chart %s tests passed!", path)
+		})
+	}()
+	go c.showTestLogs(&t, pods)
+
+	return nil
+}
+
+// showTestLogs shows each test pod logs as the pod comes up. Helm runs tests
+// one at a time so the latest logs view tracks the running test while the
+// previous tests logs remain in the view history.
+func (c *Chart) showTestLogs(t *dao.ReleaseTest, pods []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), chartTestTimeout)
+	defer cancel()
+
+	for i, pod := range pods {
+		if err := t.WaitForPod(ctx, pod); err != nil {
+			log.Warn().Err(err).Msgf("No logs available for chart test")
+			continue
+		}
+		i, pod := i, pod
+		c.App().QueueUpdateDraw(func() {
+			if err := c.App().inject(NewLog(client.NewGVR("v1/pods"), pod, "", false)); err != nil {
+				c.App().Flash().Err(err)
+				return
+			}
+			c.App().Flash().Infof("Showing test pod %s logs (%d/%d)", pod, i+1, len(pods))
+		})
+	}
+}
+
+func (c *Chart) uninstallCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	u, ok := res.(dao.Uninstaller)
+	if !ok {
+		c.App().Flash().Err(errors.New("resource can not be uninstalled"))
+		return nil
+	}
+
+	readOnly := c.App().Config.K9s.GetReadOnly()
+	msg := fmt.Sprintf("Uninstall chart %s?", path)
+	if readOnly {
+		msg = fmt.Sprintf("Dry run uninstall of chart %s? K9s is in read-only mode.", path)
+	}
+	uninstall := func(keepHistory, dryRun bool) {
+		if dryRun {
+			c.App().Flash().Infof("Dry running chart %s uninstall...", path)
+		} else {
+			c.App().Flash().Infof("Uninstalling chart %s...", path)
+		}
+		go c.uninstall(u, path, keepHistory, dryRun)
+	}
+	c.App().confirmOptions("uninstall", c.GVR(), []string{path}, "Uninstall", msg, func() {
+		dialog.ShowUninstall(c.App().Content.Pages, msg, readOnly, uninstall, func() {})
+	}, func() {
+		uninstall(false, readOnly)
+	})
+
+	return nil
+}
+
+func (c *Chart) uninstall(u dao.Uninstaller, path string, keepHistory, dryRun bool) {
+	err := c.App().mutate("uninstall", c.GVR().String(), path, fmt.Sprintf("keepHistory=%t dryRun=%t", keepHistory, dryRun), func() error {
+		return u.Uninstall(path, keepHistory, dryRun)
+	})
+	c.App().QueueUpdateDraw(func() {
+		if err != nil {
+			log.Error().Err(err).Msgf("Chart %s uninstall failed", path)
+			c.App().Flash().Errf("Uninstall failed with `%s", err)
+			return
+		}
+		if dryRun {
+			c.App().Flash().Infof("Dry run: chart %s would be uninstalled", path)
+			return
+		}
+		c.App().Flash().Infof("Chart %s uninstalled successfully", path)
+	})
+}
+
+func (c *Chart) manifestCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	m, ok := res.(dao.Manifester)
+	if !ok {
+		c.App().Flash().Err(errors.New("resource does not support manifests"))
+		return nil
+	}
+	raw, err := m.Manifest(path)
+	if err != nil {
+		c.App().Flash().Errf("unable to get manifest for chart %q -- %s", path, err)
+		return nil
+	}
+
+	details := NewDetails(c.App(), "Manifest", path, true).EnableDocNav().Update(raw)
+	if err := c.App().inject(details); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Chart) valuesCmd(all bool) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := c.GetTable().GetSelectedItem()
+		if path == "" {
+			return nil
+		}
+
+		v, err := c.valuer()
+		if err != nil {
+			c.App().Flash().Err(err)
+			return nil
+		}
+		raw, err := v.GetValues(path, all)
+		if err != nil {
+			c.App().Flash().Errf("unable to get values for chart %q -- %s", path, err)
+			return nil
+		}
+
+		title := "Values"
+		if all {
+			title = "All Values"
+		}
+		details := NewDetails(c.App(), title, path, true).Update(string(raw))
+		if err := c.App().inject(details); err != nil {
+			c.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+func (c *Chart) editValuesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	v, err := c.valuer()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := v.GetValues(path, false)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+
+	c.Stop()
+	defer c.Start()
+	edited, err := c.editValues(raw)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if bytes.Equal(raw, edited) {
+		c.App().Flash().Info("No values changes detected")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Upgrade chart %s with new values?", path)
+	c.App().confirmAction("upgrade", c.GVR(), []string{path}, "Confirm Upgrade", msg, config.ConfirmPrompt, func() {
+		c.App().Flash().Infof("Upgrading chart %s...", path)
+		go c.upgrade(v, path, edited)
+	})
+
+	return nil
+}
+
+func (c *Chart) upgrade(v dao.Valuer, path string, values []byte) {
+	err := c.App().mutate("upgrade", c.GVR().String(), path, "values", func() error {
+		return v.SetValues(path, values)
+	})
+	c.App().QueueUpdateDraw(func() {
+		if err != nil {
+			log.Error().Err(err).Msgf("Chart %s upgrade failed", path)
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Chart %s upgraded successfully", path)
+	})
+}
+
+func (c *Chart) editValues(raw []byte) ([]byte, error) {
+	return editBuffer(c.App(), "k9s-values-*.yml", raw)
+}
+
+func (c *Chart) valuer() (dao.Valuer, error) {
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil, err
+	}
+	v, ok := res.(dao.Valuer)
+	if !ok {
+		return nil, errors.New("resource does not support values")
+	}
+
+	return v, nil
 }
 
-func (c *Chart) bleeCmd(evt *tcell.EventKey) *tcell.EventKey {
+func (c *Chart) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
 		return nil
 	}
-	log.Debug().Msgf("BLEE CMD %q", path)
+
+	rb, err := c.rollbacker()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	rr, err := rb.History(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if len(rr) < 2 {
+		c.App().Flash().Warnf("No previous revisions found for chart %s", path)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rollback chart %s?", path)
+	c.App().confirmOptions("rollback", c.GVR(), []string{path}, "Rollback", msg, func() {
+		c.showRollbackDialog(path, rr)
+	}, func() {
+		c.App().Flash().Infof("Rolling back chart %s to revision %d...", path, rr[1].Version)
+		go c.rollback(rb, path, rr[1].Version)
+	})
+
 	return nil
 }
+
+func (c *Chart) showRollbackDialog(path string, rr []*release.Release) {
+	confirm := tview.NewModalForm("<Rollback>", c.makeRollbackForm(path, rr))
+	confirm.SetText(fmt.Sprintf("Rollback chart %s (current revision %d)", path, rr[0].Version))
+	confirm.SetDoneFunc(func(int, string) {
+		c.dismissDialog()
+	})
+	c.App().Content.AddPage(rollbackDialogKey, confirm, false, false)
+	c.App().Content.ShowPage(rollbackDialogKey)
+}
+
+func (c *Chart) makeRollbackForm(path string, rr []*release.Release) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	// Skip the current revision as rolling back onto it is a noop.
+	revs, opts := make([]int, 0, len(rr)-1), make([]string, 0, len(rr)-1)
+	for _, r := range rr[1:] {
+		revs, opts = append(revs, r.Version), append(opts, revisionLabel(r))
+	}
+	rev := revs[0]
+	f.AddDropDown("Revision:", opts, 0, func(_ string, idx int) {
+		if idx >= 0 && idx < len(revs) {
+			rev = revs[idx]
+		}
+	})
+
+	f.AddButton("OK", func() {
+		defer c.dismissDialog()
+		rb, err := c.rollbacker()
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Rolling back chart %s to revision %d...", path, rev)
+		go c.rollback(rb, path, rev)
+	})
+	f.AddButton("Cancel", func() {
+		c.dismissDialog()
+	})
+
+	return f
+}
+
+func (c *Chart) rollback(rb dao.Rollbacker, path string, rev int) {
+	err := c.App().mutate("rollback", c.GVR().String(), path, fmt.Sprintf("revision=%d", rev), func() error {
+		return rb.Rollback(path, rev)
+	})
+	c.App().QueueUpdateDraw(func() {
+		if err != nil {
+			log.Error().Err(err).Msgf("Chart %s rollback failed", path)
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Chart %s rolled back to revision %d", path, rev)
+	})
+}
+
+func (c *Chart) dismissDialog() {
+	c.App().Content.RemovePage(rollbackDialogKey)
+}
+
+func (c *Chart) rollbacker() (dao.Rollbacker, error) {
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil, err
+	}
+	rb, ok := res.(dao.Rollbacker)
+	if !ok {
+		return nil, errors.New("resource does not support rollbacks")
+	}
+
+	return rb, nil
+}
+
+func revisionLabel(r *release.Release) string {
+	var chart string
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		chart = r.Chart.Metadata.Name + "-" + r.Chart.Metadata.Version
+	}
+	var status string
+	if r.Info != nil {
+		status = r.Info.Status.String()
+	}
+
+	return fmt.Sprintf("%d %s [%s]", r.Version, chart, status)
+}
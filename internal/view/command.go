@@ -8,23 +8,28 @@ import (
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/rs/zerolog/log"
 )
 
+const maxRecentCmds = 10
+
 var (
 	customViewers MetaViewers
 
-	canRX = regexp.MustCompile(`\Acan\s([u|g|s]):([\w-:]+)\b`)
+	canRX    = regexp.MustCompile(`\Acan\s([u|g|s]):([\w-:]+)\b`)
+	accessRX = regexp.MustCompile(`\Acan\s+([\w,*]+)\s+(\S+)\s*\z`)
 )
 
 // Command represents a user command.
 type Command struct {
 	app *App
 
-	alias *dao.Alias
-	mx    sync.Mutex
+	alias  *dao.Alias
+	recent []string
+	mx     sync.Mutex
 }
 
 // NewCommand returns a new command.
@@ -60,6 +65,31 @@ func (c *Command) Reset(clear bool) error {
 	return nil
 }
 
+// Remember tracks a command as recently used.
+func (c *Command) Remember(cmd string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	rr := []string{cmd}
+	for _, r := range c.recent {
+		if r != cmd && len(rr) < maxRecentCmds {
+			rr = append(rr, r)
+		}
+	}
+	c.recent = rr
+}
+
+// Recent returns the most recently used commands first.
+func (c *Command) Recent() []string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	rr := make([]string, len(c.recent))
+	copy(rr, c.recent)
+
+	return rr
+}
+
 func allowedXRay(gvr client.GVR) bool {
 	gg := []string{
 		"v1/pods",
@@ -125,18 +155,39 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 		view := c.componentFor(gvr, path, v)
 		return c.exec(cmd, gvr, view, clearStack)
 	default:
+		args, ok := c.alias.Expand(cmds[0])
+		if !ok {
+			return fmt.Errorf("Huh? `%s` Command not found", cmd)
+		}
 		// checks if Command includes a namespace
 		ns := c.app.Config.ActiveNamespace()
-		if len(cmds) == 2 {
+		switch {
+		case len(cmds) == 2:
 			ns = cmds[1]
+		case args.Namespace != "":
+			ns = args.Namespace
 		}
 		if !c.app.switchNS(ns) {
 			return fmt.Errorf("namespace switch failed for ns %q", ns)
 		}
-		if !c.alias.Check(cmds[0]) {
-			return fmt.Errorf("Huh? `%s` Command not found", cmd)
+		if err := c.exec(cmd, gvr, c.componentFor(gvr, path, v), clearStack); err != nil {
+			return err
+		}
+		c.applyArgs(args)
+
+		return nil
+	}
+}
+
+// applyArgs applies an alias default sort and filter to the active view.
+func (c *Command) applyArgs(args config.AliasArgs) {
+	if args.SortColumn != "" {
+		if v, ok := c.app.Content.Top().(TableViewer); ok && v.GetTable() != nil {
+			v.GetTable().SetSortCol(args.SortColumn, args.SortAsc)
 		}
-		return c.exec(cmd, gvr, c.componentFor(gvr, path, v), clearStack)
+	}
+	if args.Filter != "" {
+		c.app.filterView(args.Filter)
 	}
 }
 
@@ -166,7 +217,112 @@ func (c *Command) specialCmd(cmd string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "apply":
+		if len(cmds) != 2 {
+			c.app.Flash().Err(errors.New("You must specify a manifest file or directory"))
+			return true
+		}
+		showApplied(c.app, cmds[1])
+		return true
+	case "notify":
+		if len(cmds) != 2 {
+			c.app.Flash().Err(errors.New("You must specify a notification rule name"))
+			return true
+		}
+		c.app.testNotification(cmds[1])
+		return true
+	case "split":
+		res, contexts, err := splitArgs(cmd, c.app.Config.K9s.CurrentContext)
+		if err != nil {
+			c.app.Flash().Err(err)
+			return true
+		}
+		gvr, ok := c.alias.AsGVR(res)
+		if !ok {
+			c.app.Flash().Err(fmt.Errorf("Huh? `%s` Command not found", cmd))
+			return true
+		}
+		showSplit(c.app, gvr, contexts)
+		return true
+	case "explain":
+		res, path, err := explainArgs(cmd)
+		if err != nil {
+			c.app.Flash().Err(err)
+			return true
+		}
+		gvr, ok := c.alias.AsGVR(res)
+		if !ok {
+			c.app.Flash().Err(fmt.Errorf("Huh? `%s` Command not found", cmd))
+			return true
+		}
+		showExplain(c.app, gvr, path)
+		return true
+	case "find":
+		q := findPattern(cmd)
+		if q == "" {
+			c.app.Flash().Err(errors.New("You must specify a search pattern"))
+			return true
+		}
+		if err := c.app.inject(NewFind(q)); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "helmsearch":
+		q := chartQuery(cmd)
+		if q == "" {
+			c.app.Flash().Err(errors.New("You must specify a chart keyword"))
+			return true
+		}
+		showChartSearch(c.app, q)
+		return true
+	case "costs":
+		label := costLabel(cmd)
+		if label == "" {
+			label = c.app.Config.K9s.GetCostLabel()
+		}
+		if err := c.app.inject(NewCost(label)); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "vulns":
+		images := vulnImages(cmd)
+		if len(images) == 0 {
+			c.app.Flash().Err(errors.New("You must specify an image to scan"))
+			return true
+		}
+		scanImages(c.app, images)
+		return true
+	case "deprecations":
+		target := deprecationTarget(cmd)
+		if target != "" {
+			if _, err := dao.ParseVersion(target); err != nil {
+				c.app.Flash().Err(err)
+				return true
+			}
+		}
+		if err := c.app.inject(NewDeprecation(target)); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "source":
+		if len(cmds) != 2 {
+			c.app.Flash().Err(errors.New("You must specify a script file"))
+			return true
+		}
+		if err := c.app.runScript(cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "snapshot":
+		saveSnapshot(c.app)
+		return true
 	default:
+		if accessRX.MatchString(cmd) {
+			if err := c.canICmd(cmd); err != nil {
+				c.app.Flash().Err(err)
+			}
+			return true
+		}
 		if !canRX.MatchString(cmd) {
 			return false
 		}
@@ -182,6 +338,17 @@ func (c *Command) specialCmd(cmd string) bool {
 	return false
 }
 
+func (c *Command) canICmd(cmd string) error {
+	tokens := accessRX.FindStringSubmatch(cmd)
+	gvr, ok := c.alias.AsGVR(tokens[2])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", tokens[2])
+	}
+	showCanI(c.app, strings.Split(tokens[1], ","), gvr)
+
+	return nil
+}
+
 func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 	gvr, ok := c.alias.AsGVR(cmd)
 	if !ok {
@@ -189,6 +356,9 @@ func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 	}
 
 	v, ok := customViewers[gvr]
+	if !ok && dao.MetaAccess.IsScalable(gvr) {
+		return gvr.String(), &MetaViewer{viewerFn: newScalableBrowser}, nil
+	}
 	if !ok {
 		return gvr.String(), &MetaViewer{viewerFn: NewBrowser}, nil
 	}
@@ -208,6 +378,9 @@ func (c *Command) componentFor(gvr, path string, v *MetaViewer) ResourceViewer {
 	if v.enterFn != nil {
 		view.GetTable().SetEnterFn(v.enterFn)
 	}
+	if m, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil && dao.IsK8sMeta(m) {
+		view = NewOwnerExtender(view)
+	}
 
 	return view
 }
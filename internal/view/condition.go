@@ -0,0 +1,104 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Condition represents a resource status conditions view.
+type Condition struct {
+	ResourceViewer
+
+	target client.GVR
+}
+
+// NewCondition returns a new status conditions view.
+func NewCondition(gvr client.GVR) ResourceViewer {
+	c := Condition{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetColorerFn(render.Condition{}.ColorerFunc())
+	c.GetTable().SetEnterFn(c.showCondition)
+	c.SetBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Condition) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", c.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+func (c *Condition) showCondition(app *App, _ ui.Tabular, _, kind string) {
+	path := c.GetTable().Path
+	fetch := func() (string, error) {
+		return conditionDetails(app.factory, c.target, path, kind)
+	}
+	raw, err := fetch()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Condition", client.FQN(path, kind), true).
+		EnableRefresh(describeRefreshRate(app), fetch).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showConditions(app *App, gvr client.GVR, path string) {
+	v := NewCondition(client.NewGVR("conditions"))
+	if c, ok := v.(*Condition); ok {
+		c.target = gvr
+	}
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyTargetGVR, gvr.String())
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func conditionDetails(f dao.Factory, gvr client.GVR, path, kind string) (string, error) {
+	o, err := dao.Fetch(f, gvr, path)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range render.Conditions(o.Object) {
+		if c.Type == kind {
+			return conditionMsg(c), nil
+		}
+	}
+
+	return "", fmt.Errorf("no condition %s found on %s", kind, path)
+}
+
+func conditionMsg(c render.ConditionRes) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Type:    %s\n", c.Type)
+	fmt.Fprintf(&b, "Status:  %s\n", c.Status)
+	fmt.Fprintf(&b, "Reason:  %s\n", c.Reason)
+	if !c.LastTransition.IsZero() {
+		fmt.Fprintf(&b, "Since:   %s\n", c.LastTransition.UTC().Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "Message:\n%s", c.Message)
+
+	return b.String()
+}
@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionMsg(t *testing.T) {
+	c := render.ConditionRes{
+		Type:           "Ready",
+		Status:         "False",
+		Reason:         "Pending",
+		Message:        "waiting on fred",
+		LastTransition: time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, "Type:    Ready\nStatus:  False\nReason:  Pending\nSince:   2020-01-01T10:00:00Z\nMessage:\nwaiting on fred", conditionMsg(c))
+}
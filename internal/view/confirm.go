@@ -0,0 +1,122 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxListedTargets caps the number of resources listed in a confirmation.
+const maxListedTargets = 10
+
+// confirmMode returns the strictest confirmation mode for an action on the given resources.
+func (a *App) confirmMode(verb string, gvr client.GVR, sels []string, dflt string) string {
+	mode := config.ConfirmNone
+	for _, sel := range sels {
+		ns, _ := client.Namespaced(sel)
+		mode = config.StricterConfirm(mode, a.Config.K9s.ConfirmMode(verb, gvr.String(), ns, dflt))
+	}
+
+	return mode
+}
+
+// confirmAction runs an action on the given resources once confirmed as
+// mandated by the confirmation policy.
+func (a *App) confirmAction(verb string, gvr client.GVR, sels []string, title, msg, dflt string, ack func()) {
+	a.confirmWith(a.confirmMode(verb, gvr, sels, dflt), sels, title, msg, ack)
+}
+
+// confirmRefs runs an action on resources of various kinds once confirmed as
+// mandated by the strictest matching confirmation rule.
+func (a *App) confirmRefs(verb string, refs []dao.Ref, title, msg, dflt string, ack func()) {
+	mode, sels := config.ConfirmNone, make([]string, 0, len(refs))
+	for _, ref := range refs {
+		mode = config.StricterConfirm(mode, a.confirmMode(verb, client.NewGVR(ref.GVR), []string{ref.FQN}, dflt))
+		sels = append(sels, ref.FQN)
+	}
+	a.confirmWith(mode, sels, title, msg, ack)
+}
+
+// confirmOptions pops an action options dialog as mandated by the confirmation
+// policy. The dialog is skipped and the defaults applied when no confirmation
+// is required, and preceded by a typed name confirmation when one is.
+func (a *App) confirmOptions(verb string, gvr client.GVR, sels []string, title, msg string, show, dflt func()) {
+	switch a.confirmMode(verb, gvr, sels, config.ConfirmPrompt) {
+	case config.ConfirmNone:
+		dflt()
+	case config.ConfirmName:
+		dialog.ShowConfirmGuarded(a.Content.Pages, title, msg, confirmText(sels), show, func() {})
+	default:
+		show()
+	}
+}
+
+func (a *App) confirmWith(mode string, sels []string, title, msg string, ack func()) {
+	switch mode {
+	case config.ConfirmNone:
+		ack()
+	case config.ConfirmName:
+		dialog.ShowConfirmGuarded(a.Content.Pages, title, msg, confirmText(sels), ack, func() {})
+	default:
+		dialog.ShowConfirm(a.Content.Pages, title, msg, ack, func() {})
+	}
+}
+
+// confirmDelete runs a deletion once confirmed as mandated by the confirmation policy.
+func (a *App) confirmDelete(gvr client.GVR, sels []string, msg string, ok func(dao.DeleteOptions)) {
+	ack := func(opts dialog.DeleteOptions) {
+		ok(deleteOptions(opts))
+	}
+	switch a.confirmMode("delete", gvr, sels, config.ConfirmPrompt) {
+	case config.ConfirmNone:
+		ok(dao.DefaultDeleteOptions())
+	case config.ConfirmName:
+		dialog.ShowDeleteGuarded(a.Content.Pages, msg, confirmText(sels), ack, func() {})
+	default:
+		dialog.ShowDelete(a.Content.Pages, msg, ack, func() {})
+	}
+}
+
+// deleteOptions converts dialog deletion options to api deletion options.
+func deleteOptions(opts dialog.DeleteOptions) dao.DeleteOptions {
+	return dao.DeleteOptions{
+		Propagation:        metav1.DeletionPropagation(opts.Propagation),
+		GracePeriodSeconds: opts.GracePeriodSeconds,
+		Force:              opts.Force,
+	}
+}
+
+// confirmText returns the text to be typed in to confirm an action on the given resources.
+func confirmText(sels []string) string {
+	if len(sels) == 1 {
+		_, n := client.Namespaced(sels[0])
+		return n
+	}
+
+	return strconv.Itoa(len(sels))
+}
+
+// targetsMsg appends the list of targeted resources to a confirmation message
+// when an action applies to several resources.
+func targetsMsg(msg string, sels []string) string {
+	if len(sels) < 2 {
+		return msg
+	}
+
+	ss := make([]string, 0, maxListedTargets+1)
+	for i, sel := range sels {
+		if i == maxListedTargets {
+			ss = append(ss, fmt.Sprintf("...and %d more", len(sels)-i))
+			break
+		}
+		ss = append(ss, sel)
+	}
+
+	return msg + "\n\n" + strings.Join(ss, "\n")
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTargetsMsg(t *testing.T) {
+	many := make([]string, 12)
+	for i := range many {
+		many[i] = "default/p" + strconv.Itoa(i)
+	}
+
+	uu := map[string]struct {
+		sels []string
+		e    string
+	}{
+		"single": {
+			sels: []string{"default/p1"},
+			e:    "Delete?",
+		},
+		"multi": {
+			sels: []string{"default/p1", "default/p2"},
+			e:    "Delete?\n\ndefault/p1\ndefault/p2",
+		},
+		"capped": {
+			sels: many,
+			e:    "Delete?\n\ndefault/p0\ndefault/p1\ndefault/p2\ndefault/p3\ndefault/p4\ndefault/p5\ndefault/p6\ndefault/p7\ndefault/p8\ndefault/p9\n...and 2 more",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, targetsMsg("Delete?", u.sels))
+		})
+	}
+}
+
+func TestConfirmOptions(t *testing.T) {
+	uu := map[string]struct {
+		mode          string
+		shown, dflt   bool
+		guardedDialog bool
+	}{
+		"none":    {mode: config.ConfirmNone, dflt: true},
+		"prompt":  {mode: config.ConfirmPrompt, shown: true},
+		"name":    {mode: config.ConfirmName, guardedDialog: true},
+		"nomatch": {shown: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := NewApp(config.NewConfig(nil))
+			if u.mode != "" {
+				a.Config.K9s.Confirmations = []config.Confirmation{{Verbs: []string{"drain"}, Mode: u.mode}}
+			}
+			var shown, dflt bool
+			a.confirmOptions("drain", client.NewGVR("v1/nodes"), []string{"n1"}, "Drain", "Drain node n1?", func() {
+				shown = true
+			}, func() {
+				dflt = true
+			})
+
+			assert.Equal(t, u.shown, shown)
+			assert.Equal(t, u.dflt, dflt)
+			assert.Equal(t, u.guardedDialog, a.Content.Pages.HasPage("confirm"))
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -43,7 +45,7 @@ func (c *Container) bindDangerousKeys(aa ui.KeyActions) {
 }
 
 func (c *Container) bindKeys(aa ui.KeyActions) {
-	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 
 	if !c.App().Config.K9s.GetReadOnly() {
 		c.bindDangerousKeys(aa)
@@ -51,6 +53,11 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftF:   ui.NewKeyAction("PortForward", c.portFwdCmd, true),
+		ui.KeyR:        ui.NewKeyAction("Restarts", c.historyCmd, true),
+		ui.KeyI:        ui.NewKeyAction("Copy Image", c.cpImageCmd, true),
+		ui.KeyG:        ui.NewKeyAction("Image Tags", c.tagsCmd, true),
+		ui.KeyF:        ui.NewKeyAction("Files", c.filesCmd, true),
+		ui.KeyT:        ui.NewKeyAction("Top", c.topCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(memCol, false), false),
@@ -107,6 +114,97 @@ func (c *Container) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	var res dao.Container
+	res.Init(c.App().factory, c.GVR())
+	path := c.GetTable().Path
+	raw, err := res.History(path, sel)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(c.App(), "Restarts", client.FQN(path, sel), true).
+		EnableRefresh(describeRefreshRate(c.App()), func() (string, error) {
+			return res.History(path, sel)
+		}).
+		Update(raw)
+	if err := c.App().inject(details); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Container) cpImageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	col := c.GetTable().GetModel().Peek().Header.IndexOf("IMAGE", true)
+	if col == -1 {
+		return nil
+	}
+	img := c.GetTable().GetSelectedRow().Fields[col]
+	if err := clipboard.WriteAll(img); err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	c.App().Flash().Infof("Image %s copied to clipboard...", img)
+
+	return nil
+}
+
+func (c *Container) tagsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	path := c.GetTable().Path
+	po, err := fetchPod(c.App().factory, path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	for i, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, co := range cc {
+			if co.Name == sel {
+				showImageTags(c.App(), co.Image, path, sel, i == 0)
+				return nil
+			}
+		}
+	}
+	c.App().Flash().Errf("Unable to locate container %s", sel)
+
+	return nil
+}
+
+func (c *Container) filesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showContainerFS(c.App(), c.GetTable().Path, sel, "/")
+
+	return nil
+}
+
+func (c *Container) topCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showProcesses(c.App(), c.GetTable().Path, sel)
+
+	return nil
+}
+
 func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// ContainerFS represents a container file system browser.
+type ContainerFS struct {
+	ResourceViewer
+
+	path, container, dir string
+}
+
+// NewContainerFS returns a new container file system browser.
+func NewContainerFS(gvr client.GVR) ResourceViewer {
+	c := ContainerFS{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetColorerFn(render.ContainerFile{}.ColorerFunc())
+	c.GetTable().SetEnterFn(c.enterCmd)
+	c.SetBindKeysFn(c.bindKeys)
+	c.SetContextFn(c.fsContext)
+
+	return &c
+}
+
+func (c *ContainerFS) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyD:      ui.NewKeyAction("Download", c.downloadCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", c.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Size", c.GetTable().SortColCmd("SIZE", false), false),
+	})
+}
+
+func (c *ContainerFS) fsContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, c.path)
+	ctx = context.WithValue(ctx, internal.KeyContainer, c.container)
+
+	return context.WithValue(ctx, internal.KeyDir, c.dir)
+}
+
+func (c *ContainerFS) enterCmd(app *App, _ ui.Tabular, _, path string) {
+	if c.selectedType() == render.FileDir {
+		showContainerFS(app, c.path, c.container, path)
+		return
+	}
+
+	var fs dao.ContainerFS
+	fs.Init(app.factory, c.GVR())
+	raw, err := fs.Cat(c.path, c.container, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "File", c.container+":"+path, true).Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *ContainerFS) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if c.selectedType() == render.FileDir {
+		c.App().Flash().Errf("%s is a directory. Only files can be downloaded", path)
+		return nil
+	}
+
+	var fs dao.ContainerFS
+	fs.Init(c.App().factory, c.GVR())
+	dir := filepath.Join(config.K9sDumpDir, c.App().Config.K9s.CurrentCluster)
+	c.App().Flash().Infof("Downloading %s...", path)
+	go func() {
+		local, err := fs.Download(c.path, c.container, path, dir)
+		c.App().QueueUpdateDraw(func() {
+			if err != nil {
+				c.App().Flash().Errf("Download of %s failed -- %s", path, err)
+				return
+			}
+			c.App().Flash().Infof("Downloaded %s to %s", path, local)
+		})
+	}()
+
+	return nil
+}
+
+func (c *ContainerFS) selectedType() string {
+	col := c.GetTable().GetModel().Peek().Header.IndexOf("TYPE", true)
+	if col == -1 {
+		return ""
+	}
+
+	return c.GetTable().GetSelectedRow().Fields[col]
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showContainerFS(app *App, path, co, dir string) {
+	v := NewContainerFS(client.NewGVR("containerfs")).(*ContainerFS)
+	v.path, v.container, v.dir = path, co, dir
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -1,16 +1,23 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
 
+const ctxCheckTimeout = 5 * time.Second
+
 // Context presents a context viewer.
 type Context struct {
 	ResourceViewer
@@ -23,17 +30,47 @@ func NewContext(gvr client.GVR) ResourceViewer {
 	}
 	c.GetTable().SetEnterFn(c.useCtx)
 	c.GetTable().SetColorerFn(render.Context{}.ColorerFunc())
+	c.GetTable().SetSortCol("LAST USED", false)
+	c.GetTable().SetFuzzy(true)
 	c.SetBindKeysFn(c.bindKeys)
+	c.SetContextFn(c.contextsContext)
 
 	return &c
 }
 
+func (c *Context) contextsContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyLastUsed, c.App().Config.K9s.ContextsUsage())
+	if h := c.App().ctxHealth; h != nil {
+		ctx = context.WithValue(ctx, internal.KeyCtxHealth, h.Health())
+	}
+
+	return ctx
+}
+
 func (c *Context) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 }
 
 func (c *Context) useCtx(app *App, model ui.Tabular, gvr, path string) {
 	log.Debug().Msgf("SWITCH CTX %q--%q", gvr, path)
+	app.Flash().Infof("Checking context %s...", path)
+	go func() {
+		err := app.Conn().Config().CheckContext(path, ctxCheckTimeout)
+		app.QueueUpdateDraw(func() {
+			if err == nil {
+				c.switchCtx(app, path)
+				return
+			}
+			log.Warn().Err(err).Msgf("Context %s health check failed", path)
+			msg := fmt.Sprintf("Context %s is unreachable: %s\nSwitch anyway?", path, err)
+			dialog.ShowConfirm(app.Content.Pages, "Unhealthy Context", msg, func() {
+				c.switchCtx(app, path)
+			}, func() {})
+		})
+	}()
+}
+
+func (c *Context) switchCtx(app *App, path string) {
 	if err := useContext(app, path); err != nil {
 		app.Flash().Err(err)
 		return
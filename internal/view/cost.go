@@ -0,0 +1,62 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Cost represents a pods resources report grouped by a label value.
+type Cost struct {
+	ResourceViewer
+
+	label string
+}
+
+// NewCost returns a new pods resources report grouped by a given label key.
+func NewCost(label string) ResourceViewer {
+	c := Cost{
+		ResourceViewer: NewBrowser(client.NewGVR("costs")),
+		label:          label,
+	}
+	c.GetTable().SetColorerFn(render.Cost{}.ColorerFunc())
+	c.GetTable().SetEnterFn(c.showPods)
+	c.GetTable().SetSortCol("CPU/R(m)", false)
+	c.SetBindKeysFn(c.bindKeys)
+	c.SetContextFn(c.costContext)
+
+	return &c
+}
+
+func (c *Cost) costContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyGroupBy, c.label)
+}
+
+func (c *Cost) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", c.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU/R", c.GetTable().SortColCmd("CPU/R(m)", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM/R", c.GetTable().SortColCmd("MEM/R(Mi)", false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd("CPU(m)", false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd("MEM(Mi)", false), false),
+	})
+}
+
+func (c *Cost) showPods(app *App, _ ui.Tabular, _, sel string) {
+	var path string
+	if ns := c.GetTable().GetModel().GetNamespace(); !client.IsClusterWide(ns) {
+		path = client.FQN(ns, "")
+	}
+	showPods(app, path, sel, "")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func costLabel(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), "costs"))
+}
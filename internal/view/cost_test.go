@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostLabel(t *testing.T) {
+	uu := map[string]struct {
+		cmd, e string
+	}{
+		"plain":  {cmd: "costs team", e: "team"},
+		"spaces": {cmd: "  costs   cost-center  ", e: "cost-center"},
+		"empty":  {cmd: "costs", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, costLabel(u.cmd))
+		})
+	}
+}
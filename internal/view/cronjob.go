@@ -62,11 +62,59 @@ func jobCtx(path, uid string) ContextFunc {
 }
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
+	if c.App().Config.K9s.GetReadOnly() {
+		return
+	}
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		ui.KeyS:        ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
 	})
 }
 
+func (c *CronJob) toggleSuspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := c.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	suspender, ok := res.(dao.Suspendable)
+	if !ok {
+		c.App().Flash().Err(fmt.Errorf("expecting a suspendable resource for %q", c.GVR()))
+		return nil
+	}
+
+	for _, sel := range sels {
+		var suspended bool
+		err := c.App().mutate("suspend", c.GVR().String(), sel, "toggle", func() error {
+			var err error
+			suspended, err = suspender.ToggleSuspend(sel)
+			return err
+		})
+		if err != nil {
+			c.App().Flash().Errf("Cronjob suspend/resume failed %v", err)
+			c.Refresh()
+			return nil
+		}
+		if suspended {
+			c.App().Flash().Infof("Cronjob %s suspended", sel)
+		} else {
+			c.App().Flash().Infof("Cronjob %s resumed", sel)
+		}
+	}
+	if len(sels) > 1 {
+		c.App().Flash().Infof("Toggled suspend on %d marked cronjobs", len(sels))
+	}
+	c.GetTable().ClearMarks()
+	c.Refresh()
+
+	return nil
+}
+
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -83,7 +131,10 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	if err := runner.Run(sel); err != nil {
+	err = c.App().mutate("trigger", c.GVR().String(), sel, "", func() error {
+		return runner.Run(sel)
+	})
+	if err != nil {
 		c.App().Flash().Errf("Cronjob trigger failed %v", err)
 		return evt
 	}
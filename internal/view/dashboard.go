@@ -0,0 +1,48 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Dashboard represents a resources error rate dashboard view.
+type Dashboard struct {
+	ResourceViewer
+}
+
+// NewDashboard returns a new error rate dashboard view.
+func NewDashboard(gvr client.GVR) ResourceViewer {
+	d := Dashboard{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetColorerFn(render.Dashboard{}.ColorerFunc())
+	d.GetTable().SetEnterFn(d.showResource)
+	d.GetTable().SetSortCol("ERRORS", false)
+	d.SetBindKeysFn(d.bindKeys)
+	d.SetContextFn(d.dashboardContext)
+
+	return &d
+}
+
+func (d *Dashboard) dashboardContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDashboard, d.App().dashboard)
+}
+
+func (d *Dashboard) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftE: ui.NewKeyAction("Sort Errors", d.GetTable().SortColCmd("ERRORS", false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Total", d.GetTable().SortColCmd("TOTAL", false), false),
+	})
+}
+
+func (d *Dashboard) showResource(app *App, _ ui.Tabular, _, gvr string) {
+	if err := app.gotoResource(gvr, "", false); err != nil {
+		app.Flash().Err(err)
+	}
+}
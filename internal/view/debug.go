@@ -0,0 +1,51 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Debug represents the k9s self diagnostics view.
+type Debug struct {
+	ResourceViewer
+}
+
+// NewDebug returns a new diagnostics view.
+func NewDebug(gvr client.GVR) ResourceViewer {
+	d := Debug{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetColorerFn(render.Debug{}.ColorerFunc())
+	d.GetTable().SetSortCol("KIND", true)
+	d.SetBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *Debug) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Toggle Profiler", d.toggleProfilerCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Memory", d.GetTable().SortColCmd("MEMORY", false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", d.GetTable().SortColCmd("COUNT", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Latency", d.GetTable().SortColCmd("LATENCY(ms)", false), false),
+	})
+}
+
+func (d *Debug) toggleProfilerCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p := d.App().profiler
+	on, err := p.Toggle()
+	switch {
+	case err != nil:
+		d.App().Flash().Errf("Profiler failed: %v", err)
+	case on:
+		d.App().Flash().Infof("Serving pprof on http://%s/debug/pprof/", p.Addr())
+	default:
+		d.App().Flash().Info("Profiler stopped")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Deprecation represents a deprecated api versions scan view.
+type Deprecation struct {
+	ResourceViewer
+
+	target string
+}
+
+// NewDeprecation returns a new deprecated api versions scan view for a
+// given Kubernetes target version, defaulting to the cluster version.
+func NewDeprecation(target string) ResourceViewer {
+	d := Deprecation{
+		ResourceViewer: NewBrowser(client.NewGVR("deprecations")),
+		target:         target,
+	}
+	d.GetTable().SetColorerFn(render.Deprecation{}.ColorerFunc())
+	d.GetTable().SetEnterFn(d.openCmd)
+	d.GetTable().SetSortCol("STATUS", false)
+	d.SetBindKeysFn(d.bindKeys)
+	d.SetContextFn(d.deprecationContext)
+
+	return &d
+}
+
+func (d *Deprecation) deprecationContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyTargetVer, d.target)
+}
+
+func (d *Deprecation) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort API Version", d.GetTable().SortColCmd("API VERSION", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd("STATUS", false), false),
+	})
+}
+
+func (d *Deprecation) openCmd(app *App, _ ui.Tabular, _, id string) {
+	jumpToResource(app, id)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func deprecationTarget(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), "deprecations"))
+}
@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationTarget(t *testing.T) {
+	uu := map[string]struct {
+		cmd, e string
+	}{
+		"plain":  {cmd: "deprecations 1.22", e: "1.22"},
+		"spaces": {cmd: "  deprecations   v1.25  ", e: "v1.25"},
+		"empty":  {cmd: "deprecations", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deprecationTarget(u.cmd))
+		})
+	}
+}
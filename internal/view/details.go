@@ -4,17 +4,36 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 	"github.com/sahilm/fuzzy"
 )
 
-const detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+const (
+	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	foldFmt         = "%s: ... (%d lines folded)"
+)
+
+// DetailsRefreshFunc fetches the latest viewer content.
+type DetailsRefreshFunc func() (string, error)
+
+// foldKeys tracks describe sections that can be folded.
+var foldKeys = []struct {
+	key     tcell.Key
+	section string
+}{
+	{ui.KeyShiftE, "Events"},
+	{ui.KeyShiftO, "Conditions"},
+	{ui.KeyShiftV, "Volumes"},
+}
 
 // Details represents a generic text viewer.
 type Details struct {
@@ -27,6 +46,16 @@ type Details struct {
 	model                     *model.Text
 	currentRegion, maxRegions int
 	searchable                bool
+	docNav                    bool
+	docs                      []int
+	refreshFn                 DetailsRefreshFunc
+	refreshRate               time.Duration
+	cancelFn                  context.CancelFunc
+	foldable                  bool
+	folds                     map[string]bool
+	yamlFilter                *dao.YAMLFilter
+	raw                       string
+	diff                      bool
 }
 
 // NewDetails returns a details viewer.
@@ -40,17 +69,53 @@ func NewDetails(app *App, title, subject string, searchable bool) *Details {
 		cmdBuff:    ui.NewCmdBuff('/', ui.FilterBuff),
 		model:      model.NewText(),
 		searchable: searchable,
+		folds:      make(map[string]bool),
 	}
 
 	return &d
 }
 
+// EnableRefresh periodically refreshes the viewer content.
+func (d *Details) EnableRefresh(rate time.Duration, f DetailsRefreshFunc) *Details {
+	d.refreshRate, d.refreshFn = rate, f
+
+	return d
+}
+
+// EnableDiff colorizes the content as a unified diff.
+func (d *Details) EnableDiff() *Details {
+	d.diff = true
+
+	return d
+}
+
+// EnableYAMLFilters enables manifest filtering toggles.
+func (d *Details) EnableYAMLFilters() *Details {
+	d.yamlFilter = new(dao.YAMLFilter)
+
+	return d
+}
+
+// EnableFolding enables folding of describe sections.
+func (d *Details) EnableFolding() *Details {
+	d.foldable = true
+
+	return d
+}
+
+// EnableDocNav enables navigation between yaml documents.
+func (d *Details) EnableDocNav() *Details {
+	d.docNav = true
+
+	return d
+}
+
 // Init initializes the viewer.
 func (d *Details) Init(_ context.Context) error {
 	if d.title != "" {
 		d.SetBorder(true)
 	}
-	d.SetScrollable(true).SetWrap(true).SetRegions(true)
+	d.SetScrollable(true).SetWrap(!d.docNav).SetRegions(true)
 	d.SetDynamicColors(true)
 	d.SetHighlightColor(tcell.ColorOrange)
 	d.SetTitleColor(tcell.ColorAqua)
@@ -75,10 +140,24 @@ func (d *Details) Init(_ context.Context) error {
 
 // TextChanged notifies the model changed.
 func (d *Details) TextChanged(lines []string) {
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	d.docs = docStarts(lines)
+	row, col := d.GetScrollOffset()
+	d.SetText(d.colorize(strings.Join(foldSections(lines, d.folds), "\n")))
+	if d.refreshFn != nil {
+		d.ScrollTo(row, col)
+		return
+	}
 	d.ScrollToBeginning()
 }
 
+func (d *Details) colorize(raw string) string {
+	if d.diff {
+		return colorizeDiff(raw)
+	}
+
+	return colorizeYAML(d.app.Styles.Views().Yaml, raw)
+}
+
 // TextFiltered notifies when the filter changed.
 func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 	d.currentRegion, d.maxRegions = 0, 0
@@ -91,7 +170,7 @@ func (d *Details) TextFiltered(lines []string, matches fuzzy.Matches) {
 		d.maxRegions++
 	}
 
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
+	d.SetText(d.colorize(strings.Join(ll, "\n")))
 	d.Highlight()
 	if d.maxRegions > 0 {
 		d.Highlight("search_0")
@@ -125,6 +204,33 @@ func (d *Details) bindKeys() {
 	if !d.searchable {
 		d.actions.Delete(ui.KeyN, ui.KeyShiftN)
 	}
+	if d.docNav {
+		d.actions.Add(ui.KeyActions{
+			ui.KeyRightBracket: ui.NewKeyAction("Next Doc", d.nextDocCmd, true),
+			ui.KeyLeftBracket:  ui.NewKeyAction("Prev Doc", d.prevDocCmd, true),
+		})
+	}
+	if d.yamlFilter != nil {
+		d.actions.Add(ui.KeyActions{
+			ui.KeyM: ui.NewKeyAction("Toggle ManagedFields", d.toggleYAMLCmd(func(f *dao.YAMLFilter) {
+				f.HideManagedFields = !f.HideManagedFields
+			}), true),
+			ui.KeyS: ui.NewKeyAction("Toggle Status", d.toggleYAMLCmd(func(f *dao.YAMLFilter) {
+				f.HideStatus = !f.HideStatus
+			}), true),
+			ui.KeyF: ui.NewKeyAction("Toggle Defaults", d.toggleYAMLCmd(func(f *dao.YAMLFilter) {
+				f.HideDefaults = !f.HideDefaults
+			}), true),
+			ui.KeyA: ui.NewKeyAction("Last Applied", d.toggleYAMLCmd(func(f *dao.YAMLFilter) {
+				f.LastApplied = !f.LastApplied
+			}), true),
+		})
+	}
+	if d.foldable {
+		for _, f := range foldKeys {
+			d.actions[f.key] = ui.NewKeyAction("Fold "+f.section, d.foldCmd(f.section), true)
+		}
+	}
 }
 
 func (d *Details) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -168,11 +274,25 @@ func (d *Details) StylesChanged(s *config.Styles) {
 
 // Update updates the view content.
 func (d *Details) Update(buff string) *Details {
-	d.model.SetText(buff)
+	d.raw = buff
+	d.model.SetText(d.filterYAML(buff))
 
 	return d
 }
 
+func (d *Details) filterYAML(buff string) string {
+	if d.yamlFilter == nil {
+		return buff
+	}
+	res, err := dao.FilterYAML(buff, *d.yamlFilter)
+	if err != nil {
+		log.Error().Err(err).Msgf("YAML filter failed")
+		return buff
+	}
+
+	return res
+}
+
 // SetSubject updates the subject.
 func (d *Details) SetSubject(s string) {
 	d.subject = s
@@ -187,13 +307,51 @@ func (d *Details) Actions() ui.KeyActions {
 func (d *Details) Name() string { return d.title }
 
 // Start starts the view updater.
-func (d *Details) Start() {}
+func (d *Details) Start() {
+	if d.refreshFn == nil {
+		return
+	}
+	d.stopRefresh()
+	var ctx context.Context
+	ctx, d.cancelFn = context.WithCancel(context.Background())
+	go d.refresh(ctx)
+}
 
 // Stop terminates the updater.
 func (d *Details) Stop() {
+	d.stopRefresh()
 	d.app.Styles.RemoveListener(d)
 }
 
+func (d *Details) stopRefresh() {
+	if d.cancelFn != nil {
+		d.cancelFn()
+		d.cancelFn = nil
+	}
+}
+
+func (d *Details) refresh(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.refreshRate):
+			buff, err := d.refreshFn()
+			if err != nil {
+				log.Error().Err(err).Msgf("Details refresh failed")
+				continue
+			}
+			d.app.QueueUpdateDraw(func() {
+				// Leave filtered content alone until the filter is cleared.
+				if ctx.Err() != nil || !d.cmdBuff.Empty() {
+					return
+				}
+				d.Update(buff)
+			})
+		}
+	}
+}
+
 // Hints returns menu hints.
 func (d *Details) Hints() model.MenuHints {
 	return d.actions.Hints()
@@ -236,6 +394,55 @@ func (d *Details) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (d *Details) nextDocCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := d.GetScrollOffset()
+	for _, l := range d.docs {
+		if l > row {
+			d.ScrollTo(l, 0)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (d *Details) prevDocCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := d.GetScrollOffset()
+	for i := len(d.docs) - 1; i >= 0; i-- {
+		if d.docs[i] < row {
+			d.ScrollTo(d.docs[i], 0)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (d *Details) toggleYAMLCmd(toggle func(*dao.YAMLFilter)) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		f := *d.yamlFilter
+		toggle(&f)
+		res, err := dao.FilterYAML(d.raw, f)
+		if err != nil {
+			d.app.Flash().Err(err)
+			return nil
+		}
+		*d.yamlFilter = f
+		d.model.SetText(res)
+
+		return nil
+	}
+}
+
+func (d *Details) foldCmd(section string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		d.folds[section] = !d.folds[section]
+		d.TextChanged(d.model.Peek())
+
+		return nil
+	}
+}
+
 func (d *Details) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.model.Filter(d.cmdBuff.String())
 	d.cmdBuff.SetActive(false)
@@ -326,3 +533,57 @@ func (d *Details) updateTitle() {
 
 	d.SetTitle(ui.SkinTitle(fmat, d.app.Styles.Frame()))
 }
+
+// DocStarts returns the line indexes of each yaml document.
+func docStarts(lines []string) []int {
+	ll := []int{0}
+	for i, l := range lines {
+		if i > 0 && strings.TrimSpace(l) == "---" {
+			ll = append(ll, i)
+		}
+	}
+
+	return ll
+}
+
+// foldSections collapses the content of folded top level sections.
+func foldSections(lines []string, folds map[string]bool) []string {
+	if len(folds) == 0 {
+		return lines
+	}
+
+	ll := make([]string, 0, len(lines))
+	header, folded := -1, 0
+	closeFold := func() {
+		if header >= 0 && folded > 0 {
+			ll[header] = fmt.Sprintf(foldFmt, sectionName(ll[header]), folded)
+		}
+		header, folded = -1, 0
+	}
+	for _, l := range lines {
+		if l != "" && l[0] != ' ' && l[0] != '\t' {
+			closeFold()
+			if folds[sectionName(l)] {
+				header = len(ll)
+			}
+			ll = append(ll, l)
+			continue
+		}
+		if header >= 0 {
+			folded++
+			continue
+		}
+		ll = append(ll, l)
+	}
+	closeFold()
+
+	return ll
+}
+
+func sectionName(l string) string {
+	if i := strings.Index(l, ":"); i > 0 {
+		return l[:i]
+	}
+
+	return l
+}
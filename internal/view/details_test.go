@@ -0,0 +1,81 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocStarts(t *testing.T) {
+	uu := map[string]struct {
+		lines []string
+		e     []int
+	}{
+		"empty":  {e: []int{0}},
+		"single": {lines: []string{"a: 1", "b: 2"}, e: []int{0}},
+		"leadingSeparator": {
+			lines: []string{"---", "a: 1", "---", "b: 2"},
+			e:     []int{0, 2},
+		},
+		"multi": {
+			lines: []string{"a: 1", "---", "b: 2", " --- ", "c: 3"},
+			e:     []int{0, 1, 3},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, docStarts(u.lines))
+		})
+	}
+}
+
+func TestFoldSections(t *testing.T) {
+	lines := []string{
+		"Name:  p1",
+		"Conditions:",
+		"  Type   Status",
+		"  Ready  True",
+		"Volumes:",
+		"  data:",
+		"    Type:  EmptyDir",
+		"Events:  <none>",
+	}
+
+	uu := map[string]struct {
+		folds map[string]bool
+		e     []string
+	}{
+		"none": {e: lines},
+		"conditions": {
+			folds: map[string]bool{"Conditions": true},
+			e: []string{
+				"Name:  p1",
+				"Conditions: ... (2 lines folded)",
+				"Volumes:",
+				"  data:",
+				"    Type:  EmptyDir",
+				"Events:  <none>",
+			},
+		},
+		"multi": {
+			folds: map[string]bool{"Volumes": true, "Events": true, "Conditions": false},
+			e: []string{
+				"Name:  p1",
+				"Conditions:",
+				"  Type   Status",
+				"  Ready  True",
+				"Volumes: ... (2 lines folded)",
+				"Events:  <none>",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, foldSections(lines, u.folds))
+		})
+	}
+}
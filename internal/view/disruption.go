@@ -0,0 +1,54 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Disruption represents a pod eviction checks view.
+type Disruption struct {
+	ResourceViewer
+}
+
+// NewDisruption returns a new eviction checks view.
+func NewDisruption(gvr client.GVR) ResourceViewer {
+	d := Disruption{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetColorerFn(render.Disruption{}.ColorerFunc())
+	d.GetTable().SetEnterFn(d.showPod)
+	d.SetBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *Disruption) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort PDB", d.GetTable().SortColCmd("PDB", true), false),
+	})
+}
+
+func (d *Disruption) showPod(app *App, _ ui.Tabular, _, path string) {
+	viewResourceRef(app, "v1/pods:"+path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showDisruptions(app *App, gvr, path string) {
+	v := NewDisruption(client.NewGVR("disruptions"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyGVR, gvr)
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,93 @@
+package view
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const dnsDialogKey = "dns"
+
+func showDNSDialog(a *App, path string) error {
+	cc, err := fetchContainers(a.factory, path, false)
+	if err != nil {
+		return err
+	}
+	if len(cc) == 0 {
+		return errors.New("no containers found")
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	co, name := cc[0], dao.DefaultDNSName
+	f.AddDropDown("Container:", cc, 0, func(c string, _ int) {
+		co = c
+	})
+	f.AddInputField("Name:", name, 50, nil, func(s string) {
+		name = strings.TrimSpace(s)
+	})
+
+	confirm := tview.NewModalForm("<DNS Lookup>", f)
+	confirm.SetText("Resolve a name from within pod " + path)
+	dismiss := func() {
+		a.Content.RemovePage(dnsDialogKey)
+	}
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	f.AddButton("Lookup", func() {
+		if name == "" {
+			a.Flash().Err(errors.New("You must specify a name to resolve"))
+			return
+		}
+		dismiss()
+		lookupDNS(a, path, co, name)
+	})
+	f.AddButton("Cancel", func() {
+		dismiss()
+	})
+
+	a.Content.AddPage(dnsDialogKey, confirm, false, false)
+	a.Content.ShowPage(dnsDialogKey)
+
+	return nil
+}
+
+func lookupDNS(a *App, path, co, name string) {
+	var debug *dao.DebugOptions
+	if !a.Config.K9s.GetReadOnly() {
+		cfg := a.Config.K9s.GetDebugContainer()
+		debug = &dao.DebugOptions{Image: cfg.Image, Command: cfg.Command}
+	}
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	a.Flash().Infof("Resolving %s from pod %s...", name, path)
+	go func() {
+		l, err := po.LookupDNS(path, co, name, debug)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("DNS lookup of %s failed -- %s", name, err)
+				return
+			}
+			if l.Resolved {
+				a.Flash().Infof("%s resolved from pod %s", name, path)
+			} else {
+				a.Flash().Warnf("%s did not resolve from pod %s", name, path)
+			}
+			details := NewDetails(a, "DNS", name, true).Update(l.String())
+			if err := a.inject(details); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
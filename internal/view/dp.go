@@ -5,6 +5,7 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -21,9 +22,11 @@ func NewDeploy(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewPortForwardExtender(
 			NewRestartExtender(
 				NewScaleExtender(
-					NewLogsExtender(
-						NewBrowser(gvr),
-						nil,
+					NewSetImageExtender(
+						NewLogsExtender(
+							NewBrowser(gvr),
+							nil,
+						),
 					),
 				),
 			),
@@ -41,9 +44,31 @@ func (d *Deploy) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", d.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
+		ui.KeyX:      ui.NewKeyAction("Xray", d.xrayCmd, true),
+		ui.KeyW:      ui.NewKeyAction("Leader", d.leaderCmd, true),
 	})
 }
 
+func (d *Deploy) leaderCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showLeaders(d.App(), path)
+
+	return nil
+}
+
+func (d *Deploy) xrayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showXray(d.App(), d.GVR(), path)
+
+	return nil
+}
+
 func (d *Deploy) showPods(app *App, model ui.Tabular, gvr, path string) {
 	var ddp dao.Deployment
 	dp, err := ddp.Load(app.factory, path)
@@ -0,0 +1,40 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// showDrift diffs a resource, or all the resources of a namespace, against
+// the cluster configured manifests.
+func showDrift(app *App, gvr client.GVR, path string) {
+	cfg := app.Config.K9s.GetDrift()
+	if cfg == nil {
+		app.Flash().Warn("No drift manifests configured for this cluster")
+		return
+	}
+
+	app.Flash().Infof("Checking %s drift against %s...", path, cfg.Dir)
+	go func() {
+		var (
+			raw string
+			err error
+		)
+		if gvr.String() == "v1/namespaces" {
+			raw, err = dao.NamespaceDrift(app.factory, cfg, path)
+		} else {
+			raw, err = dao.ResourceDrift(app.factory, cfg, gvr, path)
+		}
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Clear()
+			details := NewDetails(app, "Drift", path, true).EnableDiff().Update(raw)
+			if err := app.inject(details); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -0,0 +1,131 @@
+package view
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const maxPreviewLines = 10
+
+// editDryRun edits a resource manifest and validates the changes via a
+// server-side dry-run prior to applying them.
+func (b *Browser) editDryRun(path string, raw []byte) {
+	curr, err := dao.Fetch(b.app.factory, b.GVR(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if raw == nil {
+		s, err := dao.ToYAML(curr)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		raw = []byte(s)
+	}
+
+	edited, err := editBuffer(b.app, "k9s-edit-*.yml", raw)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if bytes.Equal(raw, edited) {
+		b.app.Flash().Info("No changes detected")
+		return
+	}
+
+	retry := func() { b.editDryRun(path, edited) }
+	res, err := dao.Update(b.app.factory, b.GVR(), edited, true)
+	if err != nil {
+		msg := fmt.Sprintf("Validation failed for %s:\n%s", path, err)
+		dialog.ShowDryRun(b.app.Content.Pages, "Dry-Run Failed", msg, nil, retry, func() {})
+		return
+	}
+	diff, err := dao.ObjectDiff(curr, res)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	msg := fmt.Sprintf("Apply changes to %s?\n\n%s", path, diffPreview(diff, maxPreviewLines))
+	dialog.ShowDryRun(b.app.Content.Pages, "Dry-Run", msg, func() {
+		_, err := dao.Update(b.app.factory, b.GVR(), edited, false)
+		b.app.audit("edit", b.GVR().String(), path, "dry-run validated", err)
+		if err != nil {
+			log.Error().Err(err).Msgf("Edit %s failed", path)
+			b.app.Flash().Err(err)
+			return
+		}
+		b.app.Flash().Infof("%s updated successfully", path)
+		if dao.IsRefTarget(b.GVR().String()) {
+			ns, _ := client.Namespaced(path)
+			b.offerRestart(ns, path)
+		}
+	}, retry, func() {})
+}
+
+// diffPreview summarizes a unified diff to its first few changed lines.
+func diffPreview(diff string, max int) string {
+	var (
+		added, removed int
+		ll             []string
+	)
+	for _, l := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(l, "+++") || strings.HasPrefix(l, "---") {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(l, "+"):
+			added++
+		case strings.HasPrefix(l, "-"):
+			removed++
+		default:
+			continue
+		}
+		if len(ll) < max {
+			ll = append(ll, l)
+		}
+	}
+	if added+removed == 0 {
+		return diff
+	}
+	summary := fmt.Sprintf("%d line(s) added, %d line(s) removed", added, removed)
+	if added+removed > max {
+		ll = append(ll, "...")
+	}
+
+	return summary + "\n\n" + colorizeDiff(strings.Join(ll, "\n"))
+}
+
+func editBuffer(a *App, pattern string, raw []byte) ([]byte, error) {
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			log.Error().Err(err).Msgf("Removing edit file %s", f.Name())
+		}
+	}()
+	if _, err := f.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	if !edit(a, shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, errors.New("Failed to launch editor")
+	}
+
+	return ioutil.ReadFile(f.Name())
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPreview(t *testing.T) {
+	uu := map[string]struct {
+		diff string
+		max  int
+		e    string
+	}{
+		"none": {
+			diff: "No changes",
+			max:  5,
+			e:    "No changes",
+		},
+		"changes": {
+			diff: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n-  replicas: 1\n+  replicas: 2\n   name: fred",
+			max:  5,
+			e:    "1 line(s) added, 1 line(s) removed\n\n[red::]-  replicas: 1[-::]\n[green::]+  replicas: 2[-::]",
+		},
+		"truncated": {
+			diff: "-a: 1\n+a: 2\n+b: 3",
+			max:  2,
+			e:    "2 line(s) added, 1 line(s) removed\n\n[red::]-a: 1[-::]\n[green::]+a: 2[-::]\n...",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, diffPreview(u.diff, u.max))
+		})
+	}
+}
@@ -40,6 +40,20 @@ func (e K9sEnv) envFor(ns, args string) (string, error) {
 	return e.subOut(args, q)
 }
 
+// substitute replaces all env vars references in args.
+func (e K9sEnv) substitute(ns, args string) (string, error) {
+	var err error
+	s := envRX.ReplaceAllStringFunc(args, func(m string) string {
+		v, e1 := e.envFor(ns, m)
+		if e1 != nil {
+			err = e1
+		}
+		return v
+	})
+
+	return s, err
+}
+
 func (e K9sEnv) subOut(args, q string) (string, error) {
 	var reverse bool
 	if q[0] == '!' {
@@ -38,3 +38,32 @@ func TestK9sEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestK9sEnvSubstitute(t *testing.T) {
+	uu := map[string]struct {
+		q   string
+		err error
+		e   string
+	}{
+		"none":    {q: "blee", e: "blee"},
+		"single":  {q: "$A", e: "10"},
+		"multi":   {q: "$B/$COL0", e: "blee/fred"},
+		"labels":  {q: "-l app=$b,rev=$A", e: "-l app=blee,rev=10"},
+		"noMatch": {q: "$B/$BLEE", err: errors.New(`no env vars exists for argument "$BLEE" using key "BLEE"`), e: "blee/"},
+	}
+
+	e := K9sEnv{
+		"A":    "10",
+		"B":    "blee",
+		"COL0": "fred",
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, err := e.substitute("", u.q)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, a)
+		})
+	}
+}
@@ -1,35 +1,137 @@
 package view
 
 import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 )
 
+// eventTypes tracks the event type filters cycle.
+var eventTypes = []string{"", "Warning", "Normal"}
+
 // Event represents a command alias view.
 type Event struct {
 	ResourceViewer
+
+	contextFn ContextFunc
+	eventType int
+	dedup     bool
+	paused    bool
 }
 
 // NewEvent returns a new alias view.
 func NewEvent(gvr client.GVR) ResourceViewer {
 	e := Event{
 		ResourceViewer: NewBrowser(gvr),
+		dedup:          true,
 	}
 	e.GetTable().SetColorerFn(render.Event{}.ColorerFunc())
 	e.SetBindKeysFn(e.bindKeys)
 	e.GetTable().SetSortCol(ageCol, true)
+	e.ResourceViewer.SetContextFn(e.eventContext)
 
 	return &e
 }
 
+// SetContextFn populates a custom context.
+func (e *Event) SetContextFn(f ContextFunc) {
+	e.contextFn = f
+}
+
+// Start resumes the events stream unless paused.
+func (e *Event) Start() {
+	if e.paused {
+		e.GetTable().Start()
+		return
+	}
+	e.ResourceViewer.Start()
+}
+
 func (e *Event) bindKeys(aa ui.KeyActions) {
 	aa.Delete(tcell.KeyCtrlD, ui.KeyE)
 	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Pause", e.pauseCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Filter Type", e.typeCmd, true),
+		ui.KeyU:      ui.NewKeyAction("Toggle Dedup", e.dedupCmd, true),
 		ui.KeyShiftY: ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
 		ui.KeyShiftE: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false),
 	})
 }
+
+func (e *Event) eventContext(ctx context.Context) context.Context {
+	if e.contextFn != nil {
+		ctx = e.contextFn(ctx)
+	}
+	if t := eventTypes[e.eventType]; t != "" {
+		sel, _ := ctx.Value(internal.KeyFields).(string)
+		ctx = context.WithValue(ctx, internal.KeyFields, joinSelectors(sel, "type="+t))
+	}
+
+	return context.WithValue(ctx, internal.KeyDedup, e.dedup)
+}
+
+func (e *Event) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.paused = !e.paused
+	if e.paused {
+		e.ResourceViewer.Stop()
+		e.GetTable().Start()
+		e.App().Flash().Info("Events stream paused")
+		return nil
+	}
+	e.Start()
+	e.App().Flash().Info("Events stream resumed")
+
+	return nil
+}
+
+func (e *Event) typeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.eventType = (e.eventType + 1) % len(eventTypes)
+	if t := eventTypes[e.eventType]; t != "" {
+		e.App().Flash().Infof("Showing %s events", t)
+	} else {
+		e.App().Flash().Info("Showing all events")
+	}
+	e.restart()
+
+	return nil
+}
+
+func (e *Event) dedupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.dedup = !e.dedup
+	if e.dedup {
+		e.App().Flash().Info("Duplicate events grouped")
+	} else {
+		e.App().Flash().Info("Duplicate events ungrouped")
+	}
+	e.restart()
+
+	return nil
+}
+
+// restart reloads the stream unless paused.
+func (e *Event) restart() {
+	if !e.paused {
+		e.Start()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func joinSelectors(ss ...string) string {
+	rr := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != "" {
+			rr = append(rr, s)
+		}
+	}
+
+	return strings.Join(rr, ",")
+}
@@ -10,12 +10,14 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/derailed/k9s/internal/model"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	shellCheck = `command -v bash >/dev/null && exec bash || exec sh`
-	bannerFmt  = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	shellCheck    = `command -v bash >/dev/null && exec bash || exec sh`
+	bannerFmt     = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	nodeBannerFmt = "<<K9s-Shell>> Node: %s \n"
 )
 
 type shellOpts struct {
@@ -54,6 +56,8 @@ func runK(a *App, opts shellOpts) bool {
 func run(a *App, opts shellOpts) bool {
 	a.Halt()
 	defer a.Resume()
+	a.setVisibility(model.Hidden)
+	defer a.setVisibility(model.Foreground)
 
 	return a.Suspend(func() {
 		if err := execute(opts); err != nil {
@@ -0,0 +1,211 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const explainTitle = "Explain"
+
+// explainNode tracks a schema field shown in the explain tree.
+type explainNode struct {
+	path  string
+	field dao.SchemaField
+}
+
+// Explain represents a resource schema explorer.
+type Explain struct {
+	*ui.Tree
+
+	app  *App
+	gvr  client.GVR
+	path []string
+	api  *dao.OpenAPI
+}
+
+// NewExplain returns a new schema explorer for a resource field path.
+func NewExplain(gvr client.GVR, path []string) *Explain {
+	return &Explain{
+		Tree: ui.NewTree(),
+		gvr:  gvr,
+		path: path,
+	}
+}
+
+// Init initializes the view.
+func (e *Explain) Init(ctx context.Context) error {
+	if err := e.Tree.Init(ctx); err != nil {
+		return err
+	}
+
+	var err error
+	if e.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	api, f, err := dao.Explain(e.app.factory, e.gvr, e.path)
+	if err != nil {
+		return err
+	}
+	e.api = api
+
+	e.bindKeys()
+	e.SetBackgroundColor(e.app.Styles.Xray().BgColor.Color())
+	e.SetBorderColor(e.app.Styles.Xray().FgColor.Color())
+	e.SetBorderFocusColor(e.app.Styles.Frame().Border.FocusColor.Color())
+	e.SetGraphicsColor(e.app.Styles.Xray().GraphicColor.Color())
+	e.SetTitle(fmt.Sprintf(" %s-%s ", explainTitle, e.fqn()))
+
+	root := e.makeNode(explainNode{path: e.fqn(), field: f})
+	e.hydrate(root)
+	root.SetExpanded(true)
+	e.SetRoot(root)
+	e.SetCurrentNode(root)
+
+	return nil
+}
+
+func (e *Explain) bindKeys() {
+	e.Actions().Add(ui.KeyActions{
+		ui.KeyD:         ui.NewKeyAction("Describe", e.describeCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.app.PrevCmd, false),
+	})
+}
+
+// Name returns the component name.
+func (e *Explain) Name() string { return explainTitle }
+
+// Start starts the view.
+func (e *Explain) Start() {}
+
+// Stop terminates the view.
+func (e *Explain) Stop() {}
+
+func (e *Explain) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := e.GetCurrentNode()
+	if n == nil {
+		return nil
+	}
+	ref, ok := n.GetReference().(explainNode)
+	if !ok {
+		return nil
+	}
+
+	details := NewDetails(e.app, "Explain", ref.path, true).
+		Update(explainDoc(ref.field, e.api.Fields(ref.field.Schema)))
+	if err := e.app.inject(details); err != nil {
+		e.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (e *Explain) makeNode(ref explainNode) *tview.TreeNode {
+	n := tview.NewTreeNode(explainNodeTitle(ref.field))
+	n.SetReference(ref)
+	n.SetSelectable(true)
+	n.SetColor(e.app.Styles.Xray().CursorColor.Color())
+	n.SetSelectedFunc(func() {
+		if len(n.GetChildren()) == 0 {
+			e.hydrate(n)
+		}
+		n.SetExpanded(!n.IsExpanded())
+	})
+
+	return n
+}
+
+// hydrate lazily loads a node sub fields as schemas may be recursive.
+func (e *Explain) hydrate(n *tview.TreeNode) {
+	ref, ok := n.GetReference().(explainNode)
+	if !ok {
+		return
+	}
+	for _, f := range e.api.Fields(ref.field.Schema) {
+		c := e.makeNode(explainNode{path: ref.path + "." + f.Name, field: f})
+		c.SetExpanded(false)
+		n.AddChild(c)
+	}
+}
+
+func (e *Explain) fqn() string {
+	return strings.Join(append([]string{e.gvr.R()}, e.path...), ".")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showExplain(app *App, gvr client.GVR, path []string) {
+	if err := app.inject(NewExplain(gvr, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// explainArgs splits an explain command into a resource and a field path, ie explain po.spec.containers.
+func explainArgs(cmd string) (string, []string, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) != 2 {
+		return "", nil, errors.New("You must specify a resource field, ie po.spec.containers")
+	}
+	pp := strings.Split(strings.Trim(tokens[1], "."), ".")
+	for _, p := range pp {
+		if p == "" {
+			return "", nil, fmt.Errorf("invalid field path %q", tokens[1])
+		}
+	}
+
+	return pp[0], pp[1:], nil
+}
+
+func explainNodeTitle(f dao.SchemaField) string {
+	title := fmt.Sprintf("[::b]%s[::-] <%s>", f.Name, f.Type)
+	if f.Required {
+		title += " -required-"
+	}
+	if len(f.Enums) > 0 {
+		title += " " + tview.Escape("["+strings.Join(f.Enums, ", ")+"]")
+	}
+
+	return title
+}
+
+func explainDoc(f dao.SchemaField, ff []dao.SchemaField) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "FIELD:    %s <%s>\n", f.Name, f.Type)
+	if len(f.Enums) > 0 {
+		fmt.Fprintf(&b, "ENUM:     %s\n", strings.Join(f.Enums, ", "))
+	}
+	fmt.Fprintf(&b, "\nDESCRIPTION:\n%s\n", indent(f.Doc))
+	if len(ff) == 0 {
+		return b.String()
+	}
+	fmt.Fprintf(&b, "\nFIELDS:\n")
+	for _, c := range ff {
+		fmt.Fprintf(&b, "  %s <%s>", c.Name, c.Type)
+		if c.Required {
+			b.WriteString(" -required-")
+		}
+		fmt.Fprintf(&b, "\n%s\n\n", indent(indent(c.Doc)))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func indent(s string) string {
+	if s == "" {
+		return "  <empty>"
+	}
+	ll := strings.Split(s, "\n")
+	for i := range ll {
+		ll[i] = "  " + ll[i]
+	}
+
+	return strings.Join(ll, "\n")
+}
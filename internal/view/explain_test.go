@@ -0,0 +1,99 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainArgs(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		res  string
+		path []string
+		err  bool
+	}{
+		"resource": {cmd: "explain po", res: "po", path: []string{}},
+		"field":    {cmd: "explain  po.spec.containers ", res: "po", path: []string{"spec", "containers"}},
+		"trailing": {cmd: "explain dp.spec.", res: "dp", path: []string{"spec"}},
+		"missing":  {cmd: "explain", err: true},
+		"extra":    {cmd: "explain po spec", err: true},
+		"empty":    {cmd: "explain po..spec", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, path, err := explainArgs(u.cmd)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+func TestExplainNodeTitle(t *testing.T) {
+	uu := map[string]struct {
+		f dao.SchemaField
+		e string
+	}{
+		"plain": {
+			f: dao.SchemaField{Name: "image", Type: "string"},
+			e: "[::b]image[::-] <string>",
+		},
+		"required": {
+			f: dao.SchemaField{Name: "containers", Type: "[]Object", Required: true},
+			e: "[::b]containers[::-] <[]Object> -required-",
+		},
+		"enums": {
+			f: dao.SchemaField{Name: "restartPolicy", Type: "string", Enums: []string{"Always", "Never"}},
+			e: "[::b]restartPolicy[::-] <string> [Always, Never[]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, explainNodeTitle(u.f))
+		})
+	}
+}
+
+func TestExplainDoc(t *testing.T) {
+	f := dao.SchemaField{Name: "spec", Type: "Object", Doc: "Pod spec."}
+	ff := []dao.SchemaField{
+		{Name: "containers", Type: "[]Object", Doc: "Pod containers.", Required: true},
+		{Name: "restartPolicy", Type: "string"},
+	}
+
+	e := `FIELD:    spec <Object>
+
+DESCRIPTION:
+  Pod spec.
+
+FIELDS:
+  containers <[]Object> -required-
+    Pod containers.
+
+  restartPolicy <string>
+    <empty>
+`
+	assert.Equal(t, e, explainDoc(f, ff))
+}
+
+func TestExplainDocEnums(t *testing.T) {
+	f := dao.SchemaField{Name: "restartPolicy", Type: "string", Enums: []string{"Always", "Never"}}
+
+	e := `FIELD:    restartPolicy <string>
+ENUM:     Always, Never
+
+DESCRIPTION:
+  <empty>
+`
+	assert.Equal(t, e, explainDoc(f, nil))
+}
@@ -0,0 +1,78 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+func (b *Browser) finalizersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ff, ts, err := dao.Finalizers(b.app.factory, b.GVR(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	msg := finalizersMsg(b.GVR().R(), path, ff, ts, time.Now())
+	if b.app.Config.K9s.GetReadOnly() {
+		ff = nil
+	}
+	dialog.ShowFinalizers(b.app.Content.Pages, msg, ff, func(fin string) {
+		b.confirmRemoveFinalizer(path, fin, ts)
+	}, func() {})
+
+	return nil
+}
+
+func (b *Browser) confirmRemoveFinalizer(path, fin string, ts *metav1.Time) {
+	msg := fmt.Sprintf("Remove finalizer %s from %s %s?\nThe controller owning it will not get to clean up!", fin, b.GVR().R(), path)
+	if ts == nil {
+		msg += "\nWarning! This resource is not being deleted."
+	}
+	_, n := client.Namespaced(path)
+	dialog.ShowConfirmGuarded(b.app.Content.Pages, "Remove Finalizer", msg, n, func() {
+		err := b.app.mutate("finalizer", b.GVR().String(), path, "remove "+fin, func() error {
+			return dao.RemoveFinalizer(b.app.factory, b.GVR(), path, fin)
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("Remove finalizer %s from %s failed", fin, path)
+			b.app.Flash().Err(err)
+			return
+		}
+		b.app.Flash().Infof("Finalizer %s removed from %s", fin, path)
+		b.refresh()
+	}, func() {})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func finalizersMsg(res, path string, ff []string, ts *metav1.Time, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Finalizers for %s %s\n", res, path)
+	if ts != nil {
+		fmt.Fprintf(&b, "Deletion requested %s ago (%s)\n", duration.HumanDuration(now.Sub(ts.Time)), ts.UTC().Format(time.RFC3339))
+	} else {
+		b.WriteString("Not being deleted\n")
+	}
+	if len(ff) == 0 {
+		b.WriteString("\nNo finalizers")
+		return b.String()
+	}
+	b.WriteString("\n")
+	b.WriteString(strings.Join(ff, "\n"))
+
+	return b.String()
+}
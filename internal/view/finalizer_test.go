@@ -0,0 +1,36 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFinalizersMsg(t *testing.T) {
+	now := time.Date(2020, 1, 1, 10, 5, 0, 0, time.UTC)
+	ts := metav1.NewTime(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	uu := map[string]struct {
+		ff []string
+		ts *metav1.Time
+		e  string
+	}{
+		"none": {
+			e: "Finalizers for pods default/fred\nNot being deleted\n\nNo finalizers",
+		},
+		"stuck": {
+			ff: []string{"kubernetes.io/pvc-protection", "fred.io/blee"},
+			ts: &ts,
+			e:  "Finalizers for pods default/fred\nDeletion requested 5m ago (2020-01-01T10:00:00Z)\n\nkubernetes.io/pvc-protection\nfred.io/blee",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, finalizersMsg("pods", "default/fred", u.ff, u.ts, now))
+		})
+	}
+}
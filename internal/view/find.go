@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Find represents a search across resources view.
+type Find struct {
+	ResourceViewer
+
+	pattern string
+}
+
+// NewFind returns a new search view for the given pattern.
+func NewFind(pattern string) ResourceViewer {
+	f := Find{
+		ResourceViewer: NewBrowser(client.NewGVR("find")),
+		pattern:        pattern,
+	}
+	f.GetTable().SetColorerFn(render.Find{}.ColorerFunc())
+	f.GetTable().SetEnterFn(f.openCmd)
+	f.SetBindKeysFn(f.bindKeys)
+	f.SetContextFn(f.findContext)
+
+	return &f
+}
+
+func (f *Find) findContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyFind, f.pattern)
+	return context.WithValue(ctx, internal.KeyFindGVRs, f.App().Config.K9s.GetFindResources())
+}
+
+func (f *Find) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", f.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+func (f *Find) openCmd(app *App, _ ui.Tabular, _, id string) {
+	jumpToResource(app, id)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// jumpToResource navigates to a resource view filtered on a given gvr:path resource.
+func jumpToResource(app *App, id string) {
+	tokens := strings.SplitN(id, ":", 2)
+	if len(tokens) != 2 {
+		return
+	}
+	ns, n := client.Namespaced(tokens[1])
+	if ns != "" && !app.switchNS(ns) {
+		app.Flash().Errf("Unable to switch to namespace %s", ns)
+		return
+	}
+	if err := app.gotoResource(tokens[0], "", false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.filterView(n)
+}
+
+func findPattern(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), "find"))
+}
@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPattern(t *testing.T) {
+	uu := map[string]struct {
+		cmd, e string
+	}{
+		"plain":  {cmd: "find fred", e: "fred"},
+		"spaces": {cmd: "  find   app=blee  ", e: "app=blee"},
+		"empty":  {cmd: "find", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, findPattern(u.cmd))
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const grpcDialogKey = "grpc"
+
+func showGRPCHealthDialog(v ResourceViewer, path string) error {
+	mm, err := fetchPodPorts(v.App().factory, path)
+	if err != nil {
+		return err
+	}
+	var (
+		ports []int
+		opts  []string
+	)
+	for co, pp := range mm {
+		for _, p := range pp {
+			if p.Protocol != v1.ProtocolTCP {
+				continue
+			}
+			ports = append(ports, int(p.ContainerPort))
+			opts = append(opts, fmt.Sprintf("%s:%s:%d", co, p.Name, p.ContainerPort))
+		}
+	}
+	if len(ports) == 0 {
+		return fmt.Errorf("no tcp ports found on %s", path)
+	}
+	sort.Sort(portOpts{ports: ports, opts: opts})
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	var (
+		port     = ports[0]
+		services string
+	)
+	f.AddDropDown("Port:", opts, 0, func(_ string, idx int) {
+		if idx >= 0 && idx < len(ports) {
+			port = ports[idx]
+		}
+	})
+	f.AddInputField("Services:", "", 40, nil, func(s string) {
+		services = s
+	})
+
+	confirm := tview.NewModalForm("<gRPC Health>", f)
+	confirm.SetText(fmt.Sprintf("Check %s health. Leave services blank for the server overall health", path))
+	dismiss := func() {
+		v.App().Content.RemovePage(grpcDialogKey)
+	}
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	f.AddButton("Check", func() {
+		probeGRPCHealth(v.App(), confirm, path, port, splitServices(services))
+	})
+	f.AddButton("Close", func() {
+		dismiss()
+	})
+
+	v.App().Content.AddPage(grpcDialogKey, confirm, false, false)
+	v.App().Content.ShowPage(grpcDialogKey)
+
+	return nil
+}
+
+func probeGRPCHealth(app *App, m *tview.ModalForm, path string, port int, services []string) {
+	target := path + ":" + strconv.Itoa(port)
+	m.SetText(fmt.Sprintf("Checking %s health...", target))
+	go func() {
+		hh, err := dao.ProbeGRPCHealth(app.factory, path, port, services)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				m.SetText(fmt.Sprintf("%s health check failed: %s", target, err))
+				return
+			}
+			m.SetText(grpcHealthSummary(target, hh))
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type portOpts struct {
+	ports []int
+	opts  []string
+}
+
+func (p portOpts) Len() int           { return len(p.ports) }
+func (p portOpts) Less(i, j int) bool { return p.opts[i] < p.opts[j] }
+func (p portOpts) Swap(i, j int) {
+	p.ports[i], p.ports[j] = p.ports[j], p.ports[i]
+	p.opts[i], p.opts[j] = p.opts[j], p.opts[i]
+}
+
+func splitServices(s string) []string {
+	var ss []string
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			ss = append(ss, t)
+		}
+	}
+
+	return ss
+}
+
+func grpcHealthSummary(target string, hh []dao.GRPCHealth) string {
+	ss := make([]string, 0, len(hh)+1)
+	ss = append(ss, fmt.Sprintf("%s health", target))
+	for _, h := range hh {
+		name := h.Service
+		if name == "" {
+			name = "<server>"
+		}
+		if h.Err != nil {
+			ss = append(ss, fmt.Sprintf("%s -> %s", name, h.Err))
+			continue
+		}
+		ss = append(ss, fmt.Sprintf("%s -> %s (%dms)", name, h.Status, h.Latency.Milliseconds()))
+	}
+
+	return strings.Join(ss, "\n")
+}
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// HelmRepo represents a helm repositories view.
+type HelmRepo struct {
+	ResourceViewer
+}
+
+// NewHelmRepo returns a new helm repositories view.
+func NewHelmRepo(gvr client.GVR) ResourceViewer {
+	h := HelmRepo{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.HelmRepo{}.ColorerFunc())
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	h.GetTable().SetEnterFn(h.showCharts)
+	h.SetBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HelmRepo) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", h.GetTable().SortColCmd(nameCol, true), false),
+	})
+}
+
+func (h *HelmRepo) showCharts(app *App, _ ui.Tabular, _, path string) {
+	v := NewRepoChart(client.NewGVR("repocharts"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
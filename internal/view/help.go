@@ -56,7 +56,7 @@ func (h *Help) Init(ctx context.Context) error {
 }
 
 func (h *Help) bindKeys() {
-	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS)
+	h.Actions().Delete(ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV, tcell.KeyCtrlSpace, tcell.KeyCtrlS)
 	h.Actions().Set(ui.KeyActions{
 		tcell.KeyEsc:   ui.NewKeyAction("Back", h.app.PrevCmd, false),
 		ui.KeyHelp:     ui.NewKeyAction("Back", h.app.PrevCmd, false),
@@ -144,6 +144,10 @@ func (h *Help) showHelp() model.MenuHints {
 			Mnemonic:    "Ctrl-a",
 			Description: "Aliases",
 		},
+		{
+			Mnemonic:    "Ctrl-p",
+			Description: "Palette",
+		},
 	}
 }
 
@@ -180,6 +184,14 @@ func (h *Help) showNav() model.MenuHints {
 			Mnemonic:    "j",
 			Description: "Down",
 		},
+		{
+			Mnemonic:    "[",
+			Description: "History Back",
+		},
+		{
+			Mnemonic:    "]",
+			Description: "History Forward",
+		},
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -72,12 +73,42 @@ func describeResource(app *App, model ui.Tabular, gvr, path string) {
 		return
 	}
 
-	details := NewDetails(app, "Describe", path, true).Update(yaml)
+	details := NewDetails(app, "Describe", path, true).
+		EnableFolding().
+		EnableRefresh(describeRefreshRate(app), func() (string, error) {
+			return model.Describe(ctx, path)
+		}).
+		Update(yaml)
 	if err := app.inject(details); err != nil {
 		app.Flash().Err(err)
 	}
 }
 
+func describeRefreshRate(app *App) time.Duration {
+	return time.Duration(app.Config.K9s.GetRefreshRate()) * time.Second
+}
+
+// refreshRateFor returns a resource view refresh rate, favoring the view settings override.
+func refreshRateFor(app *App, gvr string) time.Duration {
+	if d := app.CustomView.RefreshRate(gvr); d > 0 {
+		return d
+	}
+
+	return time.Duration(app.Config.K9s.GetRefreshRate()) * time.Second
+}
+
+// viewResourceRef navigates to a resource given a gvr:path reference.
+func viewResourceRef(app *App, ref string) {
+	tokens := strings.SplitN(ref, ":", 2)
+	if len(tokens) != 2 || tokens[0] == "" {
+		app.Flash().Err(fmt.Errorf("no view available for %q", ref))
+		return
+	}
+	if err := app.viewResource(client.NewGVR(tokens[0]).R(), tokens[1], false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 func showPodsWithLabels(app *App, path string, sel map[string]string) {
 	var labels []string
 	for k, v := range sel {
@@ -87,10 +118,14 @@ func showPodsWithLabels(app *App, path string, sel map[string]string) {
 }
 
 func showPods(app *App, path, labelSel, fieldSel string) {
+	showPodsWithContext(app, path, podCtx(app, path, labelSel, fieldSel))
+}
+
+func showPodsWithContext(app *App, path string, ctxFn ContextFunc) {
 	app.switchNS(client.AllNamespaces)
 
-	v := NewPod(client.NewGVR("v1/pods"))
-	v.SetContextFn(podCtx(app, path, labelSel, fieldSel))
+	v := NewOwnerExtender(NewPod(client.NewGVR("v1/pods")))
+	v.SetContextFn(ctxFn)
 	v.GetTable().SetColorerFn(render.Pod{}.ColorerFunc())
 
 	ns, _ := client.Namespaced(path)
@@ -102,6 +137,33 @@ func showPods(app *App, path, labelSel, fieldSel string) {
 	}
 }
 
+func showEvents(app *App, path, fieldSel string) {
+	v := NewEvent(client.NewGVR("v1/events"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyFields, fieldSel)
+	})
+
+	ns, _ := client.Namespaced(path)
+	if ns == "" {
+		ns = client.AllNamespaces
+	}
+	if err := app.Config.SetActiveNamespace(ns); err != nil {
+		log.Error().Err(err).Msg("Config NS set failed!")
+	}
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func showXray(app *App, gvr client.GVR, path string) {
+	v := NewXray(gvr)
+	v.SetInstance(path)
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 func podCtx(app *App, path, labelSel, fieldSel string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		ctx = context.WithValue(ctx, internal.KeyPath, path)
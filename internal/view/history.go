@@ -0,0 +1,101 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// History represents a helm chart release history view.
+type History struct {
+	ResourceViewer
+}
+
+// NewHistory returns a new history view.
+func NewHistory(gvr client.GVR) ResourceViewer {
+	h := History{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.History{}.ColorerFunc())
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	h.GetTable().SetEnterFn(h.diff)
+	h.SetBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *History) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", false), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
+
+// Diff shows manifest changes between the two marked revisions or between
+// the selected revision and its predecessor.
+func (h *History) diff(app *App, _ ui.Tabular, _, _ string) {
+	from, to, err := diffRevisions(h.GetTable().GetSelectedItems())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	res, err := dao.AccessorFor(app.factory, h.GVR())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	d, ok := res.(dao.Differ)
+	if !ok {
+		app.Flash().Err(errors.New("resource does not support diffs"))
+		return
+	}
+	path := h.GetTable().Path
+	raw, err := d.Diff(path, from, to)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	title := fmt.Sprintf("%s [%d..%d]", path, from, to)
+	if from == 0 {
+		title = fmt.Sprintf("%s [..%d]", path, to)
+	}
+
+	details := NewDetails(app, "Diff", title, true).EnableDiff().Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// diffRevisions returns the revisions to diff. Release histories may have
+// gaps hence a single selection yields a zero from revision.
+func diffRevisions(sels []string) (int, int, error) {
+	if len(sels) == 0 || len(sels) > 2 {
+		return 0, 0, errors.New("mark two revisions or select one to diff against its predecessor")
+	}
+
+	revs := make([]int, 0, len(sels))
+	for _, s := range sels {
+		rev, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid revision %q", s)
+		}
+		revs = append(revs, rev)
+	}
+	if len(revs) == 1 {
+		return 0, revs[0], nil
+	}
+	sort.Ints(revs)
+
+	return revs[0], revs[1], nil
+}
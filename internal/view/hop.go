@@ -0,0 +1,158 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const hopsGVR = "hops"
+
+// Hop represents the navigation history view.
+type Hop struct {
+	ResourceViewer
+}
+
+// NewHop returns a new navigation history view.
+func NewHop(gvr client.GVR) ResourceViewer {
+	h := Hop{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.Hop{}.ColorerFunc())
+	h.GetTable().SetEnterFn(h.gotoHop)
+	h.GetTable().SetSortCol("AGE", true)
+	h.SetBindKeysFn(h.bindKeys)
+	h.SetContextFn(h.hopContext)
+
+	return &h
+}
+
+func (h *Hop) hopContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyHops, h.App().hops)
+}
+
+func (h *Hop) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", h.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Namespace", h.GetTable().SortColCmd("NAMESPACE", true), false),
+	})
+}
+
+func (h *Hop) gotoHop(app *App, _ ui.Tabular, _, id string) {
+	hop, ok := app.hops.Jump(id)
+	if !ok {
+		return
+	}
+	app.restoreHop(hop)
+}
+
+// ----------------------------------------------------------------------------
+// Navigation history...
+
+// StackPushed records a navigation hop.
+func (a *App) StackPushed(c model.Component) {
+	if a.navigating {
+		return
+	}
+	if prev, ok := a.hopFor(a.Content.Stack.Previous()); ok {
+		a.hops.Update(prev)
+	}
+	if h, ok := a.hopFor(c); ok {
+		a.hops.Record(h)
+	}
+}
+
+// StackPopped tracks the view revealed by a pop.
+func (a *App) StackPopped(_, top model.Component) {
+	if a.navigating {
+		return
+	}
+	if h, ok := a.hopFor(top); ok {
+		a.hops.Popped(h)
+	}
+}
+
+// StackTop notifies the top component changed.
+func (a *App) StackTop(model.Component) {}
+
+func (a *App) hopFor(c model.Component) (render.HopRes, bool) {
+	if _, ok := c.(*Split); ok {
+		return render.HopRes{}, false
+	}
+	v, ok := c.(TableViewer)
+	if !ok || v.GetTable() == nil || v.GetTable().GVR().String() == hopsGVR {
+		return render.HopRes{}, false
+	}
+	sv := v.GetTable().sessionView()
+
+	return render.HopRes{
+		GVR:        sv.GVR,
+		Namespace:  a.Config.ActiveNamespace(),
+		Path:       sv.Path,
+		Labels:     sv.Labels,
+		Fields:     sv.Fields,
+		Filter:     sv.Filter,
+		SortColumn: sv.SortColumn,
+		SortAsc:    sv.SortAsc,
+	}, true
+}
+
+func (a *App) navBackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return a.navigate(evt, a.hops.Back)
+}
+
+func (a *App) navForwardCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return a.navigate(evt, a.hops.Forward)
+}
+
+func (a *App) navigate(evt *tcell.EventKey, move func() (render.HopRes, bool)) *tcell.EventKey {
+	if v, ok := a.Content.Top().(TableViewer); ok && v.GetTable() != nil && v.GetTable().SearchBuff().IsActive() {
+		return evt
+	}
+	if v, ok := a.Content.Top().(interface{ Actions() ui.KeyActions }); ok {
+		if _, ok := v.Actions()[ui.AsKey(evt)]; ok {
+			return evt
+		}
+	}
+	if h, ok := a.hopFor(a.Content.Top()); ok {
+		a.hops.Update(h)
+	}
+	h, ok := move()
+	if !ok {
+		a.Flash().Info("No more navigation history")
+		return nil
+	}
+	a.restoreHop(h)
+
+	return nil
+}
+
+// restoreHop navigates to a recorded hop without recording it again.
+func (a *App) restoreHop(h render.HopRes) {
+	a.navigating = true
+	defer func() { a.navigating = false }()
+
+	if h.Namespace != a.Config.ActiveNamespace() && !a.switchNS(h.Namespace) {
+		a.Flash().Errf("Unable to switch to namespace %s", h.Namespace)
+		return
+	}
+	v := config.SessionView{
+		GVR:        h.GVR,
+		Path:       h.Path,
+		Labels:     h.Labels,
+		Fields:     h.Fields,
+		Filter:     h.Filter,
+		SortColumn: h.SortColumn,
+		SortAsc:    h.SortAsc,
+	}
+	if err := a.restoreView(v, true); err != nil {
+		a.Flash().Err(err)
+	}
+}
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// HorizontalPodAutoscaler represents an HPA viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{ResourceViewer: NewBrowser(gvr)}
+	h.SetBindKeysFn(h.bindKeys)
+	h.GetTable().SetEnterFn(h.showStatus)
+
+	return &h
+}
+
+func (h *HorizontalPodAutoscaler) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Scale Target", h.targetCmd, true),
+	})
+}
+
+func (h *HorizontalPodAutoscaler) showStatus(app *App, _ ui.Tabular, gvr, path string) {
+	var res dao.HorizontalPodAutoscaler
+	res.Init(app.factory, client.NewGVR(gvr))
+
+	raw, err := res.Status(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Status", path, true).
+		EnableRefresh(describeRefreshRate(app), func() (string, error) {
+			return res.Status(path)
+		}).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (h *HorizontalPodAutoscaler) targetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.HorizontalPodAutoscaler
+	res.Init(h.App().factory, h.GVR())
+	ref, err := res.ScaleTarget(path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	viewResourceRef(h.App(), ref.GVR+":"+ref.FQN)
+
+	return nil
+}
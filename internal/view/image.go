@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Image represents a container images inventory view.
+type Image struct {
+	ResourceViewer
+
+	filter string
+}
+
+// NewImage returns a new container images inventory view.
+func NewImage(gvr client.GVR) ResourceViewer {
+	i := Image{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetColorerFn(render.Image{}.ColorerFunc())
+	i.GetTable().SetEnterFn(i.showPods)
+	i.GetTable().SetSortCol("PODS", false)
+	i.SetBindKeysFn(i.bindKeys)
+	i.SetContextFn(i.imageContext)
+
+	return &i
+}
+
+func (i *Image) imageContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyImageFilter, i.filter)
+}
+
+func (i *Image) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Toggle Latest", i.toggleFilterCmd(dao.ImageFilterLatest), true),
+		ui.KeyU:      ui.NewKeyAction("Toggle Unpinned", i.toggleFilterCmd(dao.ImageFilterUnpinned), true),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", i.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Tag", i.GetTable().SortColCmd("TAG", true), false),
+		ui.KeyG:      ui.NewKeyAction("Image Tags", i.tagsCmd, true),
+	})
+	if i.App().Config.K9s.GetImageScanner() != nil {
+		aa[ui.KeyShiftV] = ui.NewKeyAction("Scan Image", scanImageCmd(i), true)
+	}
+}
+
+func (i *Image) toggleFilterCmd(filter string) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if i.filter == filter {
+			i.filter = ""
+			i.App().Flash().Info("Showing all images")
+		} else {
+			i.filter = filter
+			i.App().Flash().Infof("Showing %s images only", filter)
+		}
+		i.Start()
+
+		return nil
+	}
+}
+
+func (i *Image) tagsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	img := i.GetTable().GetSelectedItem()
+	if img == "" {
+		return evt
+	}
+	showImageTags(i.App(), img, "", "", false)
+
+	return nil
+}
+
+func (i *Image) showPods(app *App, _ ui.Tabular, _, img string) {
+	var path string
+	if ns := i.GetTable().GetModel().GetNamespace(); !client.IsClusterWide(ns) {
+		path = client.FQN(ns, "")
+	}
+	ctx := podCtx(app, path, "", "")
+	showPodsWithContext(app, path, func(c context.Context) context.Context {
+		return context.WithValue(ctx(c), internal.KeyImage, img)
+	})
+}
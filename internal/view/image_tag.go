@@ -0,0 +1,115 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// ImageTag represents an image repository tags view.
+type ImageTag struct {
+	ResourceViewer
+
+	tags   *dao.ImageTags
+	target *imageTarget
+}
+
+// imageTarget tracks the workload container an image tag can be set on.
+type imageTarget struct {
+	ref  dao.Ref
+	co   string
+	init bool
+}
+
+// NewImageTag returns a new image tags view. Tags can only be browsed when
+// no target container is given.
+func NewImageTag(tags *dao.ImageTags, target *imageTarget) ResourceViewer {
+	t := ImageTag{
+		ResourceViewer: NewBrowser(client.NewGVR("imagetags")),
+		tags:           tags,
+		target:         target,
+	}
+	t.GetTable().SetColorerFn(render.ImageTag{}.ColorerFunc())
+	t.GetTable().SetEnterFn(t.setImage)
+	t.GetTable().SetSortCol("AGE", true)
+	t.SetBindKeysFn(t.bindKeys)
+	t.SetContextFn(t.tagsContext)
+
+	return &t
+}
+
+func (t *ImageTag) tagsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyImageTags, t.tags)
+}
+
+func (t *ImageTag) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Tag", t.GetTable().SortColCmd("TAG", false), false),
+	})
+}
+
+func (t *ImageTag) setImage(app *App, _ ui.Tabular, _, tag string) {
+	if t.target == nil {
+		app.Flash().Warn("No deployment, statefulset or daemonset container to set the image on")
+		return
+	}
+	if app.Config.K9s.GetReadOnly() {
+		app.Flash().Err(dao.ErrReadOnly)
+		return
+	}
+
+	ref, img := t.target.ref, t.tags.Image(tag)
+	gvr := client.NewGVR(ref.GVR)
+	msg := fmt.Sprintf("Set %s %s container %s image to %s?", gvr.R(), ref.FQN, t.target.co, img)
+	app.confirmAction("setimage", gvr, []string{ref.FQN}, "Set Image", msg, config.ConfirmPrompt, func() {
+		err := app.mutate("setimage", gvr.String(), ref.FQN, "container="+t.target.co+" image="+img, func() error {
+			return dao.SetImage(app.factory, ref, t.target.co, t.target.init, img)
+		})
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("%s %s container %s image set to %s", gvr.R(), ref.FQN, t.target.co, img)
+		watchRollout(app, gvr, ref.FQN)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showImageTags fetches an image repository tags in the background. Tags can
+// be set on the workload managing the given pod container if any.
+func showImageTags(app *App, img, path, co string, init bool) {
+	app.Flash().Infof("Fetching %s tags...", img)
+	go func() {
+		tags, err := dao.FetchImageTags(context.Background(), img)
+		var target *imageTarget
+		if err == nil && path != "" {
+			ref, e := dao.PodController(app.factory, path)
+			if e != nil {
+				log.Debug().Err(e).Msgf("No image tags target for %s", path)
+			} else {
+				target = &imageTarget{ref: ref, co: co, init: init}
+			}
+		}
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Clear()
+			if err := app.inject(NewImageTag(tags, target)); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Informer represents the active resource informers view.
+type Informer struct {
+	ResourceViewer
+}
+
+// NewInformer returns a new informers view.
+func NewInformer(gvr client.GVR) ResourceViewer {
+	i := Informer{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetColorerFn(render.Informer{}.ColorerFunc())
+	i.GetTable().SetSortCol("MEMORY", false)
+	i.SetBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Informer) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", i.GetTable().SortColCmd("RESOURCE", true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Objects", i.GetTable().SortColCmd("OBJECTS", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Memory", i.GetTable().SortColCmd("MEMORY", false), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Idle", i.GetTable().SortColCmd("IDLE", true), false),
+	})
+}
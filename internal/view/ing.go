@@ -0,0 +1,157 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const routeDialogKey = "route"
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{ResourceViewer: NewBrowser(gvr)}
+	i.SetBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Ingress) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Test Routes", i.testCmd, true),
+		ui.KeyI: ui.NewKeyAction("Certificates", certsCmd(i), true),
+	})
+}
+
+func (i *Ingress) testCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Ingress
+	res.Init(i.App().factory, i.GVR())
+	i.App().Flash().Infof("Resolving routes for %s...", path)
+	go func() {
+		rr, err := res.Routes(path)
+		i.App().QueueUpdateDraw(func() {
+			if err != nil {
+				i.App().Flash().Err(err)
+				return
+			}
+			if len(rr) == 0 {
+				i.App().Flash().Err(errors.New("no routes found"))
+				return
+			}
+			i.showRouteDialog(path, rr)
+		})
+	}()
+
+	return nil
+}
+
+type routeTarget struct {
+	route    dao.IngressRoute
+	endpoint dao.IngressEndpoint
+}
+
+func (i *Ingress) showRouteDialog(path string, rr []dao.IngressRoute) {
+	var (
+		tt   []routeTarget
+		opts []string
+	)
+	for _, r := range rr {
+		for _, e := range r.Endpoints {
+			tt = append(tt, routeTarget{route: r, endpoint: e})
+			opts = append(opts, fmt.Sprintf("%s %s", r.URL(), endpointLabel(e)))
+		}
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	confirm := tview.NewModalForm("<Routes>", f)
+	confirm.SetText(routesSummary(path, rr))
+	confirm.SetDoneFunc(func(int, string) {
+		i.dismissDialog()
+	})
+
+	if len(tt) > 0 {
+		var target int
+		f.AddDropDown("Backend:", opts, 0, func(_ string, idx int) {
+			if idx >= 0 && idx < len(tt) {
+				target = idx
+			}
+		})
+		f.AddButton("Probe", func() {
+			i.probe(confirm, tt[target])
+		})
+	}
+	f.AddButton("Close", func() {
+		i.dismissDialog()
+	})
+
+	i.App().Content.AddPage(routeDialogKey, confirm, false, false)
+	i.App().Content.ShowPage(routeDialogKey)
+}
+
+func (i *Ingress) probe(m *tview.ModalForm, t routeTarget) {
+	url := t.route.URL()
+	m.SetText(fmt.Sprintf("Probing %s via %s...", url, endpointLabel(t.endpoint)))
+	go func() {
+		status, err := dao.ProbeRoute(i.App().factory, t.route, t.endpoint)
+		i.App().QueueUpdateDraw(func() {
+			if err != nil {
+				m.SetText(fmt.Sprintf("%s failed: %s", url, err))
+				return
+			}
+			m.SetText(fmt.Sprintf("%s via %s -> %s", url, endpointLabel(t.endpoint), status))
+		})
+	}()
+}
+
+func (i *Ingress) dismissDialog() {
+	i.App().Content.RemovePage(routeDialogKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func routesSummary(path string, rr []dao.IngressRoute) string {
+	ss := make([]string, 0, len(rr)+1)
+	ss = append(ss, fmt.Sprintf("Ingress %s routes", path))
+	for _, r := range rr {
+		if r.Error != "" {
+			ss = append(ss, fmt.Sprintf("%s -> %s:%s (broken: %s)", r.URL(), r.Service, r.Port.String(), r.Error))
+			continue
+		}
+		ss = append(ss, fmt.Sprintf("%s -> %s:%s (%d endpoints)", r.URL(), r.Service, r.Port.String(), len(r.Endpoints)))
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+func endpointLabel(e dao.IngressEndpoint) string {
+	_, n := client.Namespaced(e.Pod)
+	if n == "" {
+		n = e.IP
+	}
+
+	return fmt.Sprintf("%s:%d", n, e.Port)
+}
@@ -0,0 +1,30 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestRoutesSummary(t *testing.T) {
+	rr := []dao.IngressRoute{
+		{
+			Host:      "fred.com",
+			Path:      "/api",
+			Service:   "api",
+			Port:      intstr.FromInt(80),
+			Endpoints: []dao.IngressEndpoint{{Pod: "default/p1", IP: "10.0.0.1", Port: 8080}},
+		},
+		{
+			Service: "blee",
+			Port:    intstr.FromString("http"),
+			Error:   "services \"blee\" not found",
+		},
+	}
+
+	assert.Equal(t, "Ingress default/ing routes\n"+
+		"fred.com/api -> api:80 (1 endpoints)\n"+
+		"*/ -> blee:http (broken: services \"blee\" not found)", routesSummary("default/ing", rr))
+}
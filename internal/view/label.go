@@ -0,0 +1,252 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const labelDialogKey = "label"
+
+// labelOutcome tracks a metadata patch outcome for a given resource.
+type labelOutcome struct {
+	path    string
+	changes []string
+	err     error
+}
+
+func (b *Browser) labelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetSelectedItems()
+	if len(sels) == 0 || sels[0] == "" {
+		return evt
+	}
+	l, ok := b.accessor.(dao.Labeler)
+	if !ok {
+		b.app.Flash().Errf("Resource %s does not support labels updates", b.GVR())
+		return nil
+	}
+	b.showLabelDialog(l, sels, "", "")
+
+	return nil
+}
+
+func (b *Browser) showLabelDialog(l dao.Labeler, sels []string, labels, annotations string) {
+	msg := fmt.Sprintf("Label/Annotate %s %s", b.GVR().R(), sels[0])
+	if len(sels) > 1 {
+		msg = fmt.Sprintf("Label/Annotate %d marked %s", len(sels), b.GVR())
+	}
+	confirm := tview.NewModalForm("<Label/Annotate>", b.makeLabelForm(l, sels, labels, annotations))
+	confirm.SetText(msg + "\nUse key=value to set and key- to remove.")
+	confirm.SetDoneFunc(func(int, string) {
+		b.dismissLabelDialog()
+	})
+	b.app.Content.AddPage(labelDialogKey, confirm, false, false)
+	b.app.Content.ShowPage(labelDialogKey)
+}
+
+func (b *Browser) makeLabelForm(l dao.Labeler, sels []string, labels, annotations string) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	f.AddInputField("Labels:", labels, 40, nil, func(changed string) {
+		labels = changed
+	})
+	f.AddInputField("Annotations:", annotations, 40, nil, func(changed string) {
+		annotations = changed
+	})
+
+	f.AddButton("OK", func() {
+		b.dismissLabelDialog()
+		p, err := parseLabelForm(labels, annotations)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		b.previewLabels(l, sels, p, func() {
+			b.showLabelDialog(l, sels, labels, annotations)
+		})
+	})
+	f.AddButton("Cancel", func() {
+		b.dismissLabelDialog()
+	})
+
+	return f
+}
+
+// previewLabels dry-runs a metadata patch on all targeted resources and
+// offers to apply it to the resources that would change.
+func (b *Browser) previewLabels(l dao.Labeler, sels []string, p dao.MetaPatch, retry func()) {
+	b.app.Flash().Infof("Validating %d %s...", len(sels), b.GVR().R())
+	go func() {
+		oo := make([]labelOutcome, 0, len(sels))
+		for _, sel := range sels {
+			oo = append(oo, b.dryRunLabels(l, sel, p))
+		}
+		b.app.QueueUpdateDraw(func() {
+			targets := changedTargets(oo)
+			msg := fmt.Sprintf("Update %s with %s?\n\n%s", b.GVR(), p, labelPreview(oo))
+			if len(targets) == 0 {
+				dialog.ShowDryRun(b.app.Content.Pages, "Dry-Run", msg, nil, retry, func() {})
+				return
+			}
+			dialog.ShowDryRun(b.app.Content.Pages, "Dry-Run", msg, func() {
+				msg := targetsMsg(fmt.Sprintf("Update %d %s with %s?", len(targets), b.GVR(), p), targets)
+				b.app.confirmAction("label", b.GVR(), targets, "Confirm Label/Annotate", msg, config.ConfirmNone, func() {
+					b.applyLabels(l, targets, p)
+				})
+			}, retry, func() {})
+		})
+	}()
+}
+
+func (b *Browser) dryRunLabels(l dao.Labeler, path string, p dao.MetaPatch) labelOutcome {
+	o := labelOutcome{path: path}
+	curr, err := dao.Fetch(b.app.factory, b.GVR(), path)
+	if err != nil {
+		o.err = err
+		return o
+	}
+	res, err := l.PatchMeta(path, p, true)
+	if err != nil {
+		o.err = err
+		return o
+	}
+	o.changes = dao.MetaChanges(curr, res)
+
+	return o
+}
+
+func (b *Browser) applyLabels(l dao.Labeler, targets []string, p dao.MetaPatch) {
+	b.app.Flash().Infof("Updating %d %s...", len(targets), b.GVR().R())
+	go func() {
+		oo := make([]labelOutcome, 0, len(targets))
+		for _, path := range targets {
+			err := b.app.mutate("label", b.GVR().String(), path, p.String(), func() error {
+				_, err := l.PatchMeta(path, p, false)
+				return err
+			})
+			if err != nil {
+				log.Error().Err(err).Msgf("Label/Annotate %s failed", path)
+			}
+			oo = append(oo, labelOutcome{path: path, err: err})
+		}
+		b.app.QueueUpdateDraw(func() {
+			b.refresh()
+			failed := failedCount(oo)
+			if len(oo) == 1 && failed == 0 {
+				b.app.Flash().Infof("%s %s updated successfully", b.GVR(), oo[0].path)
+				return
+			}
+			if failed > 0 {
+				b.app.Flash().Errf("Label/Annotate failed on %d of %d %s", failed, len(oo), b.GVR().R())
+			} else {
+				b.app.Flash().Infof("Updated %d %s", len(oo), b.GVR().R())
+			}
+			details := NewDetails(b.app, "Label/Annotate", b.GVR().String(), true).Update(labelReport(oo))
+			if err := b.app.inject(details); err != nil {
+				b.app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func (b *Browser) dismissLabelDialog() {
+	b.app.Content.RemovePage(labelDialogKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseLabelForm(labels, annotations string) (dao.MetaPatch, error) {
+	var (
+		p   dao.MetaPatch
+		err error
+	)
+	if p.Labels, err = dao.ParseMetaPatch(labels); err != nil {
+		return p, fmt.Errorf("invalid labels: %v", err)
+	}
+	if p.Annotations, err = dao.ParseMetaPatch(annotations); err != nil {
+		return p, fmt.Errorf("invalid annotations: %v", err)
+	}
+	if p.IsEmpty() {
+		return p, errors.New("You must specify labels or annotations")
+	}
+
+	return p, nil
+}
+
+// labelPreview summarizes dry-run outcomes, listing at most maxListedTargets resources.
+func labelPreview(oo []labelOutcome) string {
+	var changed, failed int
+	ll := make([]string, 0, maxListedTargets+1)
+	for i, o := range oo {
+		var l string
+		switch {
+		case o.err != nil:
+			failed++
+			l = fmt.Sprintf("%s: failed (%s)", o.path, o.err)
+		case len(o.changes) == 0:
+			l = fmt.Sprintf("%s: unchanged", o.path)
+		default:
+			changed++
+			l = fmt.Sprintf("%s: %s", o.path, strings.Join(o.changes, ", "))
+		}
+		switch {
+		case i < maxListedTargets:
+			ll = append(ll, l)
+		case i == maxListedTargets:
+			ll = append(ll, fmt.Sprintf("...and %d more", len(oo)-i))
+		}
+	}
+	summary := fmt.Sprintf("%d changed, %d unchanged, %d failed", changed, len(oo)-changed-failed, failed)
+
+	return summary + "\n\n" + strings.Join(ll, "\n")
+}
+
+// labelReport lists the outcome of a metadata patch for all resources.
+func labelReport(oo []labelOutcome) string {
+	ll := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if o.err != nil {
+			ll = append(ll, fmt.Sprintf("FAILED %s: %s", o.path, o.err))
+			continue
+		}
+		ll = append(ll, fmt.Sprintf("OK     %s", o.path))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func changedTargets(oo []labelOutcome) []string {
+	var tt []string
+	for _, o := range oo {
+		if o.err == nil && len(o.changes) > 0 {
+			tt = append(tt, o.path)
+		}
+	}
+
+	return tt
+}
+
+func failedCount(oo []labelOutcome) int {
+	var n int
+	for _, o := range oo {
+		if o.err != nil {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelForm(t *testing.T) {
+	uu := map[string]struct {
+		labels, annotations string
+		e                   string
+		err                 bool
+	}{
+		"labels":      {labels: "app=fred", e: "labels app=fred"},
+		"annotations": {annotations: "note=blee", e: "annotations note=blee"},
+		"both":        {labels: "app=fred,tier-", annotations: "note=blee", e: "labels app=fred,tier- and annotations note=blee"},
+		"empty":       {err: true},
+		"badLabels":   {labels: "app", err: true},
+		"badAnnots":   {labels: "app=fred", annotations: "=blee", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := parseLabelForm(u.labels, u.annotations)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, p.String())
+			}
+		})
+	}
+}
+
+func TestLabelPreview(t *testing.T) {
+	oo := []labelOutcome{
+		{path: "default/p1", changes: []string{"+label app=fred", "-label tier=web"}},
+		{path: "default/p2"},
+		{path: "default/p3", err: errors.New("boom")},
+	}
+
+	assert.Equal(t, "1 changed, 1 unchanged, 1 failed\n\ndefault/p1: +label app=fred, -label tier=web\ndefault/p2: unchanged\ndefault/p3: failed (boom)", labelPreview(oo))
+	assert.Equal(t, []string{"default/p1"}, changedTargets(oo))
+	assert.Equal(t, 1, failedCount(oo))
+	assert.Equal(t, "OK     default/p1\nOK     default/p2\nFAILED default/p3: boom", labelReport(oo))
+}
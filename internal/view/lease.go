@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// Lease represents a lease viewer.
+type Lease struct {
+	ResourceViewer
+}
+
+// NewLease returns a new viewer.
+func NewLease(gvr client.GVR) ResourceViewer {
+	l := Lease{ResourceViewer: NewBrowser(gvr)}
+	l.GetTable().SetColorerFn(render.Lease{}.ColorerFunc())
+	l.SetBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+func (l *Lease) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Holder", l.GetTable().SortColCmd("HOLDER", true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Stale", l.GetTable().SortColCmd("STALE", false), false),
+		ui.KeyH:      ui.NewKeyAction("Holder", l.holderCmd, true),
+	})
+}
+
+func (l *Lease) holderCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := l.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	u, err := dao.Fetch(l.App().factory, l.GVR(), path)
+	if err != nil {
+		l.App().Flash().Err(err)
+		return nil
+	}
+	identity, _, _ := unstructured.NestedString(u.Object, "spec", "holderIdentity")
+	if identity == "" {
+		l.App().Flash().Warnf("Lease %s is not held", path)
+		return nil
+	}
+	ns, _ := client.Namespaced(path)
+	pod := client.FQN(ns, render.LeaseHolder(identity))
+	if _, err := dao.Fetch(l.App().factory, client.NewGVR("v1/pods"), pod); err != nil {
+		l.App().Flash().Warnf("Lease holder %s is not a pod in namespace %s", identity, ns)
+		return nil
+	}
+
+	co := NewContainer(client.NewGVR("containers"))
+	co.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, pod)
+	})
+	if err := l.App().inject(co); err != nil {
+		l.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showLeaders(app *App, path string) {
+	app.Flash().Infof("Looking up %s leases...", path)
+	go func() {
+		ll, err := dao.DeploymentLeaders(app.factory, path)
+		var raw []byte
+		if err == nil && len(ll) > 0 {
+			raw, err = yaml.Marshal(ll)
+		}
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			if len(ll) == 0 {
+				app.Flash().Warnf("No leases held by %s pods", path)
+				return
+			}
+			if ll[0].Stale {
+				app.Flash().Warnf("Leader %s holds a stale lease %s", ll[0].Pod, ll[0].Lease)
+			} else {
+				app.Flash().Infof("Leader is %s via lease %s", ll[0].Pod, ll[0].Lease)
+			}
+			details := NewDetails(app, "Leaders", path, true).Update(string(raw))
+			if err := app.inject(details); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
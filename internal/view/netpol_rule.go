@@ -0,0 +1,59 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// NetpolRule represents a pod network policy rules view.
+type NetpolRule struct {
+	ResourceViewer
+}
+
+// NewNetpolRule returns a new network policy rules view.
+func NewNetpolRule(gvr client.GVR) ResourceViewer {
+	n := NetpolRule{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetColorerFn(render.NetpolRule{}.ColorerFunc())
+	n.GetTable().SetEnterFn(n.showPolicy)
+	n.SetBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *NetpolRule) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Policy", n.GetTable().SortColCmd("POLICY", true), false),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Direction", n.GetTable().SortColCmd("DIRECTION", true), false),
+	})
+}
+
+func (n *NetpolRule) showPolicy(app *App, _ ui.Tabular, _, path string) {
+	tokens := strings.Split(path, render.PolicyRefSeparator)
+	if _, name := client.Namespaced(tokens[0]); name == "" {
+		app.Flash().Info("No network policy selects this pod for this direction")
+		return
+	}
+	viewResourceRef(app, "networking.k8s.io/v1/networkpolicies:"+tokens[0])
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showNetpolRules(app *App, path string) {
+	v := NewNetpolRule(client.NewGVR("netpolrules"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -1,10 +1,19 @@
 package view
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/fatih/color"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,14 +33,29 @@ func NewNode(gvr client.GVR) ResourceViewer {
 	return &n
 }
 
+func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyO: ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
+		ui.KeyU: ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
+		ui.KeyR: ui.NewKeyAction("Drain", n.drainCmd, true),
+		ui.KeyS: ui.NewKeyAction("Shell", n.shellCmd, true),
+	})
+}
+
 func (n *Node) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Delete(ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	if !n.App().Config.K9s.GetReadOnly() {
+		n.bindDangerousKeys(aa)
+	}
 	aa.Add(ui.KeyActions{
-		ui.KeyY:      ui.NewKeyAction("YAML", n.viewCmd, true),
-		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
-		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
-		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", n.GetTable().SortColCmd("%CPU", false), false),
-		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", n.GetTable().SortColCmd("%MEM", false), false),
+		ui.KeyY:        ui.NewKeyAction("YAML", n.viewCmd, true),
+		ui.KeyV:        ui.NewKeyAction("Usage", n.usageCmd, true),
+		ui.KeyI:        ui.NewKeyAction("Eviction Check", n.evictionsCmd, true),
+		tcell.KeyCtrlG: ui.NewKeyAction("Toggle Gauges", n.GetTable().toggleGaugesCmd, false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
+		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
+		ui.KeyShiftX:   ui.NewKeyAction("Sort CPU%", n.GetTable().SortColCmd("%CPU", false), false),
+		ui.KeyShiftZ:   ui.NewKeyAction("Sort MEM%", n.GetTable().SortColCmd("%MEM", false), false),
 	})
 }
 
@@ -39,6 +63,164 @@ func (n *Node) showPods(app *App, _ ui.Tabular, _, path string) {
 	showPods(app, n.GetTable().GetSelectedItem(), "", "spec.nodeName="+path)
 }
 
+func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := n.GetTable().GetSelectedItem()
+		if path == "" {
+			return nil
+		}
+
+		d, err := n.drainable()
+		if err != nil {
+			n.App().Flash().Err(err)
+			return nil
+		}
+
+		title, action := "Cordon", "cordoned"
+		if !cordon {
+			title, action = "Uncordon", "uncordoned"
+		}
+		msg := fmt.Sprintf("%s node %s?", title, path)
+		verb := strings.ToLower(title)
+		n.App().confirmAction(verb, n.GVR(), []string{path}, title, msg, config.ConfirmPrompt, func() {
+			err := n.App().mutate(verb, n.GVR().String(), path, "", func() error {
+				return d.ToggleCordon(path, cordon)
+			})
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			n.App().Flash().Infof("Node %s %s!", path, action)
+			n.Refresh()
+		})
+
+		return nil
+	}
+}
+
+func (n *Node) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	cfg := n.App().Config.K9s.GetShellPod()
+	opts := dao.NodeShellOptions{
+		Image:     cfg.Image,
+		Namespace: cfg.Namespace,
+		Template:  cfg.Template,
+	}
+	sh := dao.NewNodeShell(n.App().factory)
+	n.App().Flash().Infof("Launching shell pod on node %s...", path)
+	go func() {
+		var po, co string
+		err := n.App().mutate("nodeshell", n.GVR().String(), path, "image="+opts.Image, func() error {
+			var err error
+			po, co, err = sh.Launch(path, opts)
+			return err
+		})
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Errf("Node shell on %s failed -- %s", path, err)
+				return
+			}
+			nodeShellIn(n.App(), path, po, co, cfg.Command)
+			go func() {
+				if err := sh.Cleanup(po); err != nil {
+					log.Error().Err(err).Msgf("Shell pod %s cleanup failed", po)
+				}
+			}()
+		})
+	}()
+
+	return nil
+}
+
+func (n *Node) usageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	showUsage(n.App(), n.GVR().String(), path)
+
+	return nil
+}
+
+func (n *Node) evictionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	showDisruptions(n.App(), n.GVR().String(), path)
+
+	return nil
+}
+
+func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	d, err := n.drainable()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Drain node %s?", path)
+	n.App().confirmOptions("drain", n.GVR(), []string{path}, "Drain", msg, func() {
+		dialog.ShowDrain(n.App().Content.Pages, msg, func(opts dao.DrainOptions) {
+			n.drain(d, path, opts)
+		}, func() {})
+	}, func() {
+		n.drain(d, path, dao.DefaultDrainOptions())
+	})
+
+	return nil
+}
+
+func (n *Node) drain(d dao.Drainable, path string, opts dao.DrainOptions) {
+	ctx, cancel := context.WithCancel(context.Background())
+	progress := dialog.ShowDrainProgress(n.App().Content.Pages, path, func() { cancel() })
+	go func() {
+		defer cancel()
+		err := n.App().mutate("drain", n.GVR().String(), path, opts.String(), func() error {
+			return d.Drain(ctx, path, opts, func(remaining int) {
+				n.App().QueueUpdateDraw(func() {
+					progress.Update(remaining)
+				})
+			})
+		})
+		n.App().QueueUpdateDraw(func() {
+			progress.Dismiss()
+			switch {
+			case err == context.Canceled:
+				n.App().Flash().Warnf("Drain of node %s canceled", path)
+			case err != nil:
+				log.Error().Err(err).Msgf("Drain node %s failed", path)
+				n.App().Flash().Errf("Drain of node %s failed -- %s", path, err)
+			default:
+				n.App().Flash().Infof("Node %s drained!", path)
+			}
+			n.Refresh()
+		})
+	}()
+}
+
+func (n *Node) drainable() (dao.Drainable, error) {
+	res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	if err != nil {
+		return nil, err
+	}
+	d, ok := res.(dao.Drainable)
+	if !ok {
+		return nil, errors.New("resource can not be drained")
+	}
+
+	return d, nil
+}
+
 func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := n.GetTable().GetSelectedItem()
 	if path == "" {
@@ -59,10 +241,27 @@ func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(n.App(), "YAML", sel, true).Update(raw)
+	details := NewDetails(n.App(), "YAML", sel, true).EnableYAMLFilters().Update(raw)
 	if err := n.App().inject(details); err != nil {
 		n.App().Flash().Err(err)
 	}
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func nodeShellIn(a *App, node, path, co string, cmd []string) {
+	args := buildShellArgs("exec", path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
+	args = append(args, "--")
+	args = append(args, cmd...)
+
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
+	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(nodeBannerFmt, node), args: args}) {
+		err = errors.New("Node shell exec failed")
+		a.Flash().Err(err)
+	}
+	a.audit("exec", "v1/pods", path, "node="+node+" container="+co, err)
+}
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Notification represents a raised notifications view.
+type Notification struct {
+	ResourceViewer
+}
+
+// NewNotification returns a new notifications view.
+func NewNotification(gvr client.GVR) ResourceViewer {
+	n := Notification{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetColorerFn(render.Notification{}.ColorerFunc())
+	n.GetTable().SetEnterFn(n.showResource)
+	n.GetTable().SetSortCol("AGE", true)
+	n.SetBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *Notification) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyX:      ui.NewKeyAction("Clear", n.clearCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rule", n.GetTable().SortColCmd("RULE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+func (n *Notification) showResource(app *App, _ ui.Tabular, _, _ string) {
+	row, h := n.GetTable().GetSelectedRow(), n.GetTable().GetModel().Peek().Header
+	gvrCol, nameCol := h.IndexOf("RESOURCE", true), h.IndexOf("NAME", true)
+	if gvrCol == -1 || nameCol == -1 {
+		return
+	}
+	viewResourceRef(app, row.Fields[gvrCol]+":"+row.Fields[nameCol])
+}
+
+func (n *Notification) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	var res dao.Notification
+	res.Init(n.App().factory, n.GVR())
+	res.Clear()
+	n.App().Flash().Info("Notifications cleared")
+	n.Refresh()
+
+	return nil
+}
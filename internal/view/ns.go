@@ -1,6 +1,7 @@
 package view
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -14,6 +15,7 @@ import (
 const (
 	favNSIndicator     = "+"
 	defaultNSIndicator = "(*)"
+	usageCol           = "USAGE"
 )
 
 // Namespace represents a namespace viewer.
@@ -29,6 +31,7 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 	n.GetTable().SetDecorateFn(n.decorate)
 	n.GetTable().SetColorerFn(render.Namespace{}.ColorerFunc())
 	n.GetTable().SetEnterFn(n.switchNs)
+	n.GetTable().SetFuzzy(true)
 	n.SetBindKeysFn(n.bindKeys)
 
 	return &n
@@ -36,7 +39,9 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Toggle Favorite", n.favNsCmd, true),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Usage", n.GetTable().SortColCmd(usageCol, false), false),
 	})
 }
 
@@ -57,6 +62,30 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) favNsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	fav, err := n.App().Config.ToggleFavNamespace(ns)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	if err := n.App().Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config file save failed!")
+	}
+	if fav {
+		n.App().Flash().Infof("Namespace %s added to favorites", ns)
+	} else {
+		n.App().Flash().Infof("Namespace %s removed from favorites", ns)
+	}
+	n.Refresh()
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	log.Debug().Msgf("SWITCHING NS %q", ns)
@@ -89,14 +118,33 @@ func (n *Namespace) decorate(data render.TableData) render.TableData {
 		)
 	}
 
-	for _, re := range data.RowEvents {
+	// usage goes right before the age column.
+	col := len(data.Header) - 1
+	hh := make(render.Header, 0, len(data.Header)+1)
+	hh = append(hh, data.Header[:col]...)
+	hh = append(hh, render.HeaderColumn{Name: usageCol})
+	data.Header = append(hh, data.Header[col:]...)
+
+	usage := n.App().Config.NamespaceUsage()
+	for i, re := range data.RowEvents {
+		ff := make(render.Fields, 0, len(re.Row.Fields)+1)
+		ff = append(ff, re.Row.Fields[:col]...)
+		ff = append(ff, strconv.Itoa(usage[re.Row.ID]))
+		ff = append(ff, re.Row.Fields[col:]...)
 		if config.InList(n.App().Config.FavNamespaces(), re.Row.ID) {
-			re.Row.Fields[0] += favNSIndicator
-			re.Kind = render.EventUnchanged
+			ff[0] += favNSIndicator
+			data.RowEvents[i].Kind = render.EventUnchanged
 		}
 		if n.App().Config.ActiveNamespace() == re.Row.ID {
-			re.Row.Fields[0] += defaultNSIndicator
-			re.Kind = render.EventUnchanged
+			ff[0] += defaultNSIndicator
+			data.RowEvents[i].Kind = render.EventUnchanged
+		}
+		data.RowEvents[i].Row.Fields = ff
+		if !re.Deltas.IsBlank() {
+			dd := make(render.DeltaRow, 0, len(re.Deltas)+1)
+			dd = append(dd, re.Deltas[:col]...)
+			dd = append(dd, "")
+			data.RowEvents[i].Deltas = append(dd, re.Deltas[col:]...)
 		}
 	}
 
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// OwnerExtender navigates resources owners and children.
+type OwnerExtender struct {
+	ResourceViewer
+}
+
+// NewOwnerExtender returns a new extender.
+func NewOwnerExtender(v ResourceViewer) ResourceViewer {
+	o := OwnerExtender{ResourceViewer: v}
+	o.bindKeys(v.Actions())
+
+	return &o
+}
+
+// BindKeys creates additional menu actions.
+func (o *OwnerExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftJ: ui.NewKeyAction("Jump Owner", o.ownerCmd, true),
+		ui.KeyShiftH: ui.NewKeyAction("Show Children", o.childrenCmd, true),
+	})
+}
+
+func (o *OwnerExtender) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := o.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ref, err := dao.Owner(o.App().factory, o.GVR().String(), path)
+	if err != nil {
+		o.App().Flash().Err(err)
+		return nil
+	}
+	viewResourceRef(o.App(), ref.GVR+":"+ref.FQN)
+
+	return nil
+}
+
+func (o *OwnerExtender) childrenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := o.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	gvr, sel, err := dao.Children(o.App().factory, o.GVR().String(), path)
+	if err != nil {
+		o.App().Flash().Err(err)
+		return nil
+	}
+	showChildren(o.App(), client.NewGVR(gvr), path, sel)
+
+	return nil
+}
+
+func showChildren(app *App, gvr client.GVR, path, sel string) {
+	if gvr.String() == "v1/pods" {
+		showPods(app, path, sel, "")
+		return
+	}
+
+	v := NewOwnerExtender(viewerFor(gvr))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, sel)
+	})
+
+	ns, _ := client.Namespaced(path)
+	if err := app.Config.SetActiveNamespace(ns); err != nil {
+		log.Error().Err(err).Msg("Config NS set failed!")
+	}
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func viewerFor(gvr client.GVR) ResourceViewer {
+	m, ok := customViewers[gvr]
+	if !ok || m.viewerFn == nil {
+		return NewBrowser(gvr)
+	}
+	v := m.viewerFn(gvr)
+	if m.enterFn != nil {
+		v.GetTable().SetEnterFn(m.enterFn)
+	}
+
+	return v
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Palette represents a command palette view.
+type Palette struct {
+	ResourceViewer
+
+	actions ui.KeyActions
+}
+
+// NewPalette returns a new command palette view.
+func NewPalette(gvr client.GVR) ResourceViewer {
+	p := Palette{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.Palette{}.ColorerFunc())
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	p.GetTable().SetFuzzy(true)
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.paletteContext)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *Palette) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetNamespace(client.ClusterScope)
+	if v, ok := p.App().Content.Top().(interface{ Actions() ui.KeyActions }); ok {
+		p.actions = v.Actions()
+	}
+
+	return nil
+}
+
+func (p *Palette) paletteContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPalette, p.entries())
+}
+
+func (p *Palette) entries() []render.PaletteRes {
+	pp := dao.PaletteAliases(p.App().command.alias)
+	for _, cmd := range p.App().command.Recent() {
+		pp = append(pp, render.PaletteRes{
+			Kind:        render.PaletteRecent,
+			Name:        cmd,
+			Description: "Run " + cmd,
+			Command:     cmd,
+		})
+	}
+	for k, a := range p.actions {
+		name, ok := tcell.KeyNames[k]
+		if !ok || a.Shared || a.Description == "" {
+			continue
+		}
+		pp = append(pp, render.PaletteRes{
+			Kind:        render.PaletteAction,
+			Name:        a.Description,
+			Description: "Press " + name,
+			Command:     name,
+		})
+	}
+
+	return pp
+}
+
+func (p *Palette) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Run", p.runCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", p.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+func (p *Palette) runCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	tokens := strings.SplitN(id, ":", 2)
+	if len(tokens) != 2 {
+		return evt
+	}
+	if tokens[0] == render.PaletteAction {
+		p.runAction(tokens[1])
+		return nil
+	}
+	if err := p.App().gotoResource(tokens[1], "", true); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.App().command.Remember(tokens[1])
+
+	return nil
+}
+
+func (p *Palette) runAction(name string) {
+	for k, a := range p.actions {
+		if tcell.KeyNames[k] != name {
+			continue
+		}
+		p.App().Content.Pop()
+		a.Action(asKeyEvent(k))
+		return
+	}
+	p.App().Flash().Errf("No action bound to %s", name)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if _, ok := a.Content.Top().(*Palette); ok {
+		a.Content.Pop()
+		return nil
+	}
+	p := NewPalette(client.NewGVR("palette"))
+	if err := a.inject(p); err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	p.GetTable().SearchBuff().SetActive(true)
+
+	return nil
+}
+
+// asKeyEvent builds a keyboard event triggering the given key binding.
+func asKeyEvent(k tcell.Key) *tcell.EventKey {
+	if k >= tcell.Key(ui.KeySpace) && k < tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(k), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(k, 0, tcell.ModNone)
+}
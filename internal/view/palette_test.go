@@ -0,0 +1,49 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandRemember(t *testing.T) {
+	c := NewCommand(nil)
+	c.Remember("po")
+	c.Remember("dp default")
+	c.Remember("po")
+
+	assert.Equal(t, []string{"po", "dp default"}, c.Recent())
+
+	for i := 0; i < maxRecentCmds+5; i++ {
+		c.Remember(string(rune('a' + i)))
+	}
+	assert.Equal(t, maxRecentCmds, len(c.Recent()))
+	assert.Equal(t, string(rune('a'+maxRecentCmds+4)), c.Recent()[0])
+}
+
+func TestAsKeyEvent(t *testing.T) {
+	uu := map[string]struct {
+		k    tcell.Key
+		key  tcell.Key
+		rune rune
+	}{
+		"rune":  {k: ui.KeyD, key: tcell.KeyRune, rune: 'd'},
+		"shift": {k: ui.KeyShiftD, key: tcell.KeyRune, rune: 'D'},
+		"ctrl":  {k: tcell.KeyCtrlD, key: tcell.KeyCtrlD},
+		"enter": {k: tcell.KeyEnter, key: tcell.KeyEnter},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			evt := asKeyEvent(u.k)
+			assert.Equal(t, u.key, evt.Key())
+			if u.key == tcell.KeyRune {
+				assert.Equal(t, u.rune, evt.Rune())
+				assert.Equal(t, u.k, ui.AsKey(evt))
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// PodDisruptionBudget represents a pdb viewer.
+type PodDisruptionBudget struct {
+	ResourceViewer
+}
+
+// NewPodDisruptionBudget returns a new viewer.
+func NewPodDisruptionBudget(gvr client.GVR) ResourceViewer {
+	p := PodDisruptionBudget{ResourceViewer: NewBrowser(gvr)}
+	p.SetBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PodDisruptionBudget) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyI: ui.NewKeyAction("Eviction Check", p.evictionsCmd, true),
+	})
+}
+
+func (p *PodDisruptionBudget) evictionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDisruptions(p.App(), p.GVR().String(), path)
+
+	return nil
+}
@@ -35,6 +35,7 @@ func NewPortForwardExtender(r ResourceViewer) ResourceViewer {
 func (p *PortForwardExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", p.portFwdCmd, true),
+		ui.KeyShiftG: ui.NewKeyAction("gRPC Health", p.grpcHealthCmd, true),
 	})
 }
 
@@ -56,6 +57,24 @@ func (p *PortForwardExtender) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *PortForwardExtender) grpcHealthCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	pod, err := p.fetchPodName(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if err := showGRPCHealthDialog(p, pod); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *PortForwardExtender) fetchPodName(path string) (string, error) {
 	res, err := dao.AccessorFor(p.App().factory, p.GVR())
 	if err != nil {
@@ -114,6 +133,7 @@ func startFwdCB(v ResourceViewer, path, co string, t client.PortTunnel) {
 
 	pf := dao.NewPortForwarder(v.App().factory)
 	fwd, err := pf.Start(path, co, t)
+	v.App().audit("port-forward", "v1/pods", path, fmt.Sprintf("container=%s ports=%s", co, t.PortMap()), err)
 	if err != nil {
 		v.App().Flash().Err(err)
 		return
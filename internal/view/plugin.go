@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Plugin represents a plugins and hotkeys view.
+type Plugin struct {
+	ResourceViewer
+}
+
+// NewPlugin returns a new plugin view.
+func NewPlugin(gvr client.GVR) ResourceViewer {
+	p := Plugin{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.Plugin{}.ColorerFunc())
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.pluginContext)
+
+	return &p
+}
+
+// Init initialiazes the view.
+func (p *Plugin) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (p *Plugin) pluginContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPlugins, p.App().Plugins)
+	return context.WithValue(ctx, internal.KeyHotKeys, p.App().HotKeys)
+}
+
+func (p *Plugin) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyT:      ui.NewKeyAction("Toggle", p.toggleCmd, true),
+		ui.KeyE:      ui.NewKeyAction("Edit", p.editCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", p.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", p.GetTable().SortColCmd("NAME", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Shortcut", p.GetTable().SortColCmd("SHORTCUT", true), false),
+	})
+}
+
+func (p *Plugin) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	kind, name := client.Namespaced(path)
+	enabled, err := p.App().ToggleAction(kind, name)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if enabled {
+		p.App().Flash().Infof("%s %s enabled", kind, name)
+	} else {
+		p.App().Flash().Infof("%s %s disabled", kind, name)
+	}
+	p.Start()
+
+	return nil
+}
+
+func (p *Plugin) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if p.GetTable().GetSelectedItem() == "" {
+		return evt
+	}
+	col := p.GetTable().GetModel().Peek().Header.IndexOf("SOURCE", true)
+	if col < 0 {
+		return nil
+	}
+	source := p.GetTable().GetSelectedRow().Fields[col]
+	if source == "" {
+		p.App().Flash().Warn("No configuration file found for this entry")
+		return nil
+	}
+
+	p.Stop()
+	defer p.Start()
+	if !edit(p.App(), shellOpts{clear: true, args: []string{source}}) {
+		p.App().Flash().Err(errors.New("Failed to launch editor"))
+	}
+	// Plugins and hotkeys get reloaded once the app resumes.
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
@@ -42,8 +43,10 @@ func NewPod(gvr client.GVR) ResourceViewer {
 func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
+		ui.KeyX:        ui.NewKeyAction("Evict", p.evictCmd, true),
 		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
 		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		ui.KeyShiftD:   ui.NewKeyAction("Debug", p.debugCmd, true),
 	})
 }
 
@@ -64,7 +67,91 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 		tcell.KeyCtrlQ: ui.NewKeyAction("Sort %MEM (LIM)", p.GetTable().SortColCmd("%MEM/L", false), false),
 		ui.KeyShiftI:   ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
 		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
+		tcell.KeyCtrlG: ui.NewKeyAction("Toggle Gauges", p.GetTable().toggleGaugesCmd, false),
+		ui.KeyB:        ui.NewKeyAction("Rules", p.policyCmd, true),
+		ui.KeyO:        ui.NewKeyAction("Net Policies", p.netpolCmd, true),
+		ui.KeyV:        ui.NewKeyAction("Usage", p.usageCmd, true),
+		ui.KeyT:        ui.NewKeyAction("Terminations", p.terminationsCmd, true),
+		ui.KeyR:        ui.NewKeyAction("DNS Lookup", p.dnsCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Connectivity Probe", p.probeCmd, true),
 	})
+	if p.App().Config.K9s.GetImageScanner() != nil {
+		aa[ui.KeyShiftV] = ui.NewKeyAction("Scan Images", scanPodImagesCmd(p), true)
+	}
+}
+
+func (p *Pod) dnsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := showDNSDialog(p.App(), path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) probeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := showProbeDialog(p.App(), path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) policyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	po, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	n := po.Spec.ServiceAccountName
+	if n == "" {
+		n = "default"
+	}
+	showSAPolicies(p.App(), client.FQN(po.Namespace, n))
+
+	return nil
+}
+
+func (p *Pod) netpolCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showNetpolRules(p.App(), path)
+
+	return nil
+}
+
+func (p *Pod) usageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showUsage(p.App(), p.GVR().String(), path)
+
+	return nil
+}
+
+func (p *Pod) terminationsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showTerminations(p.App(), path)
+
+	return nil
 }
 
 func (p *Pod) showContainers(app *App, model ui.Tabular, gvr, path string) {
@@ -97,16 +184,71 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
 		return nil
 	}
-	p.GetTable().ShowDeleted()
-	for _, res := range sels {
-		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, true); err != nil {
-			p.App().Flash().Errf("Delete failed with %s", err)
-		} else {
-			p.App().factory.DeleteForwarder(res)
+	msg := fmt.Sprintf("Kill pod %s?", sels[0])
+	if len(sels) > 1 {
+		msg = fmt.Sprintf("Kill %d marked pods?", len(sels))
+	}
+	opts := dao.DefaultDeleteOptions()
+	opts.Force = true
+	p.App().confirmAction("kill", p.GVR(), sels, "Kill", msg, config.ConfirmNone, func() {
+		p.GetTable().ShowDeleted()
+		for _, res := range sels {
+			p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
+			err := p.App().mutate("kill", p.GVR().String(), res, opts.String(), func() error {
+				return nuker.Delete(res, opts)
+			})
+			if err != nil {
+				p.App().Flash().Errf("Delete failed with %s", err)
+			} else {
+				p.App().factory.DeleteForwarder(res)
+			}
 		}
+		p.Refresh()
+	})
+
+	return nil
+}
+
+func (p *Pod) evictCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := p.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	evictor, ok := res.(dao.Evictable)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting an evictor for %q", p.GVR()))
+		return nil
 	}
-	p.Refresh()
+
+	msg := fmt.Sprintf("Evict pod %s? Disruption budgets will be honored.", sels[0])
+	if len(sels) > 1 {
+		msg = fmt.Sprintf("Evict %d marked pods? Disruption budgets will be honored.", len(sels))
+	}
+	p.App().confirmAction("evict", p.GVR(), sels, "Evict", msg, config.ConfirmPrompt, func() {
+		for _, sel := range sels {
+			err := p.App().mutate("evict", p.GVR().String(), sel, "", func() error {
+				return evictor.Evict(sel)
+			})
+			if err != nil {
+				p.App().Flash().Errf("Evict failed with %s", err)
+				return
+			}
+			p.App().factory.DeleteForwarder(sel)
+		}
+		if len(sels) == 1 {
+			p.App().Flash().Infof("Pod %s evicted", sels[0])
+		} else {
+			p.App().Flash().Infof("%d marked pods evicted", len(sels))
+		}
+		p.GetTable().ClearMarks()
+		p.Refresh()
+	})
 
 	return nil
 }
@@ -147,6 +289,24 @@ func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+
+	if err := containerDebugIn(p.App(), p, path, ""); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -187,9 +347,12 @@ func shellIn(a *App, path, co string) {
 	args := computeShellArgs(path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
 
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
-		a.Flash().Err(errors.New("Shell exec failed"))
+		err = errors.New("Shell exec failed")
+		a.Flash().Err(err)
 	}
+	a.audit("exec", "v1/pods", path, "container="+co, err)
 }
 
 func containerAttachIn(a *App, comp model.Component, path, co string) error {
@@ -225,12 +388,58 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 	attachIn(a, path, co)
 }
 
-func attachIn(a *App, path, co string) {
-	args := buildShellArgs("attach", path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
-	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
-	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
-		a.Flash().Err(errors.New("Attach exec failed"))
+func containerDebugIn(a *App, comp model.Component, path, co string) error {
+	if co != "" {
+		debugIn(a, comp, path, co)
+		return nil
+	}
+
+	cc, err := fetchContainers(a.factory, path, false)
+	if err != nil {
+		return err
+	}
+	if len(cc) == 1 {
+		debugIn(a, comp, path, cc[0])
+		return nil
 	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		debugIn(a, comp, path, co)
+	})
+	if err := a.inject(picker); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// debugIn injects an ephemeral container targeting the given container and attaches to it.
+func debugIn(a *App, comp model.Component, path, target string) {
+	cfg := a.Config.K9s.GetDebugContainer()
+	opts := dao.DebugOptions{
+		Image:   cfg.Image,
+		Command: cfg.Command,
+		Target:  target,
+	}
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	a.Flash().Infof("Launching debug container in pod %s...", path)
+	go func() {
+		var co string
+		err := a.mutate("debug", "v1/pods", path, fmt.Sprintf("image=%s target=%s", opts.Image, target), func() error {
+			var err error
+			co, err = po.Debug(path, opts)
+			return err
+		})
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("Debug of %s failed -- %s", path, err)
+				return
+			}
+			resumeAttachIn(a, comp, path, co)
+		})
+	}()
 }
 
 func computeShellArgs(path, co, context string, kcfg *string) []string {
@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -34,11 +35,19 @@ func NewPolicy(app *App, subject, name string) *Policy {
 	p.SetBindKeysFn(p.bindKeys)
 	p.GetTable().SetSortCol(nameCol, false)
 	p.SetContextFn(p.subjectCtx)
-	p.GetTable().SetEnterFn(blankEnterFn)
+	p.GetTable().SetEnterFn(p.showBinding)
 
 	return &p
 }
 
+func (p *Policy) showBinding(app *App, _ ui.Tabular, _, path string) {
+	tokens := strings.SplitN(path, render.PolicyRefSeparator, 2)
+	if len(tokens) != 2 {
+		return
+	}
+	viewResourceRef(app, tokens[0])
+}
+
 func (p *Policy) subjectCtx(ctx context.Context) context.Context {
 	ctx = context.WithValue(ctx, internal.KeySubjectKind, mapSubject(p.subjectKind))
 	ctx = context.WithValue(ctx, internal.KeyPath, mapSubject(p.subjectKind)+":"+p.subjectName)
@@ -46,7 +55,7 @@ func (p *Policy) subjectCtx(ctx context.Context) context.Context {
 }
 
 func (p *Policy) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftN: ui.NewKeyAction("Sort Name", p.GetTable().SortColCmd(nameCol, true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Group", p.GetTable().SortColCmd("GROUP", true), false),
@@ -7,18 +7,16 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
 
-const promptPage = "prompt"
-
 // PortForward presents active portforward viewer.
 type PortForward struct {
 	ResourceViewer
@@ -90,6 +88,7 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	p.App().Status(model.FlashWarn, "Benchmark in progress...")
 	go p.runBenchmark()
+	showBenchResults(p.App(), path, p.bench)
 
 	return nil
 }
@@ -126,10 +125,14 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	showModal(p.App().Content.Pages, fmt.Sprintf("Delete PortForward `%s?", path), func() {
+	msg := fmt.Sprintf("Delete PortForward `%s?", path)
+	p.App().confirmAction("delete", p.GVR(), []string{path}, "Delete", msg, config.ConfirmPrompt, func() {
 		var pf dao.PortForward
 		pf.Init(p.App().factory, client.NewGVR("portforwards"))
-		if err := pf.Delete(path, true, true); err != nil {
+		err := p.App().mutate("delete", p.GVR().String(), path, "", func() error {
+			return pf.Delete(path, dao.DefaultDeleteOptions())
+		})
+		if err != nil {
 			p.App().Flash().Err(err)
 			return
 		}
@@ -139,26 +142,3 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
-
-// ----------------------------------------------------------------------------
-// Helpers...
-
-func showModal(p *ui.Pages, msg string, ok func()) {
-	m := tview.NewModal().
-		AddButtons([]string{"Cancel", "OK"}).
-		SetTextColor(tcell.ColorFuchsia).
-		SetText(msg).
-		SetDoneFunc(func(_ int, b string) {
-			if b == "OK" {
-				ok()
-			}
-			dismissModal(p)
-		})
-	m.SetTitle("<Delete Benchmark>")
-	p.AddPage(promptPage, m, false, false)
-	p.ShowPage(promptPage)
-}
-
-func dismissModal(p *ui.Pages) {
-	p.RemovePage(promptPage)
-}
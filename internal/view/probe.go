@@ -0,0 +1,99 @@
+package view
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const probeDialogKey = "probe"
+
+func showProbeDialog(a *App, path string) error {
+	cc, err := fetchContainers(a.factory, path, false)
+	if err != nil {
+		return err
+	}
+	if len(cc) == 0 {
+		return errors.New("no containers found")
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	protocols := []string{dao.ProbeTCP, dao.ProbeHTTP}
+	co, protocol, dest, urlPath := cc[0], protocols[0], "", "/"
+	f.AddDropDown("Container:", cc, 0, func(c string, _ int) {
+		co = c
+	})
+	f.AddDropDown("Protocol:", protocols, 0, func(p string, _ int) {
+		protocol = p
+	})
+	f.AddInputField("Destination:", dest, 50, nil, func(s string) {
+		dest = strings.TrimSpace(s)
+	})
+	f.AddInputField("HTTP Path:", urlPath, 50, nil, func(s string) {
+		urlPath = strings.TrimSpace(s)
+	})
+
+	confirm := tview.NewModalForm("<Connectivity Probe>", f)
+	confirm.SetText("Probe a host:port, po/ns/name:port or svc/ns/name:port from pod " + path)
+	dismiss := func() {
+		a.Content.RemovePage(probeDialogKey)
+	}
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	f.AddButton("Probe", func() {
+		if dest == "" {
+			a.Flash().Err(errors.New("You must specify a destination"))
+			return
+		}
+		dismiss()
+		runProbe(a, path, co, protocol, dest, urlPath)
+	})
+	f.AddButton("Cancel", func() {
+		dismiss()
+	})
+
+	a.Content.AddPage(probeDialogKey, confirm, false, false)
+	a.Content.ShowPage(probeDialogKey)
+
+	return nil
+}
+
+func runProbe(a *App, path, co, protocol, dest, urlPath string) {
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	a.Flash().Infof("Probing %s from pod %s...", dest, path)
+	go func() {
+		t, err := dao.ResolveProbeTarget(a.factory, protocol, dest, urlPath)
+		var r *dao.ProbeResult
+		if err == nil {
+			r, err = po.Probe(path, co, t)
+		}
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("Probe of %s failed -- %s", dest, err)
+				return
+			}
+			if r.OK {
+				a.Flash().Infof("%s reachable from pod %s", dest, path)
+			} else {
+				a.Flash().Warnf("%s unreachable from pod %s", dest, path)
+			}
+			details := NewDetails(a, "Probe", dest, true).Update(r.String())
+			if err := a.inject(details); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -0,0 +1,59 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Process represents a container processes view.
+type Process struct {
+	ResourceViewer
+
+	path, container string
+}
+
+// NewProcess returns a new container processes view.
+func NewProcess(gvr client.GVR) ResourceViewer {
+	p := Process{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.Process{}.ColorerFunc())
+	p.GetTable().SetSortCol("%CPU", false)
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.processContext)
+
+	return &p
+}
+
+func (p *Process) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftI: ui.NewKeyAction("Sort PID", p.GetTable().SortColCmd("PID", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd("%CPU", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd("%MEM", false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Command", p.GetTable().SortColCmd("COMMAND", true), false),
+	})
+}
+
+func (p *Process) processContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, p.path)
+	ctx = context.WithValue(ctx, internal.KeyContainer, p.container)
+
+	return context.WithValue(ctx, internal.KeyCommand, p.App().Config.K9s.GetProcessCommand())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showProcesses(app *App, path, co string) {
+	v := NewProcess(client.NewGVR("processes")).(*Process)
+	v.path, v.container = path, co
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -101,6 +101,9 @@ func (p *Pulse) Init(ctx context.Context) error {
 	}
 	p.bindKeys()
 	p.model.AddListener(p)
+	if d := p.app.CustomView.RefreshRate(p.GVR().String()); d > 0 {
+		p.model.SetRefreshRate(d)
+	}
 	p.app.SetFocus(p.charts[0])
 	p.app.Styles.AddListener(p)
 	p.StylesChanged(p.app.Styles)
@@ -128,7 +131,7 @@ func (p *Pulse) StylesChanged(s *config.Styles) {
 }
 
 const (
-	genFmat = " %s([%s::]%d[white::]:[%s::b]%d[-::])"
+	genFmat = " %s([%s::]%d[white::]:[%s::b]%d[white::-]) [%s::b]%s[-::]"
 	cpuFmt  = " %s [%s::b]%s[white::-]([%s::]%sm[white::]/[%s::]%sm[-::])"
 	memFmt  = " %s [%s::b]%s[white::-]([%s::]%sMi[white::]/[%s::]%sMi[-::])"
 )
@@ -184,6 +187,8 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 			c.Tally(health.S1),
 			nn[1],
 			c.Tally(health.S2),
+			nn[1],
+			render.PrintPerc(c.ErrorRate()),
 		))
 	}
 	v.Add(tchart.Metric{S1: c.Tally(health.S1), S2: c.Tally(health.S2)})
@@ -241,6 +246,11 @@ func (p *Pulse) Stop() {
 	p.cancelFn = nil
 }
 
+// SetVisibility adapts the model refresh rate to the view visibility.
+func (p *Pulse) SetVisibility(v model.Visibility) {
+	p.model.SetVisibility(v)
+}
+
 // Refresh updates the view
 func (p *Pulse) Refresh() {
 	// p.update(p.model.Peek())
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// PersistentVolumeClaim represents a pvc viewer.
+type PersistentVolumeClaim struct {
+	ResourceViewer
+}
+
+// NewPersistentVolumeClaim returns a new viewer.
+func NewPersistentVolumeClaim(gvr client.GVR) ResourceViewer {
+	p := PersistentVolumeClaim{ResourceViewer: NewBrowser(gvr)}
+	p.GetTable().SetEnterFn(p.showDiagnosis)
+	p.GetTable().SetColorerFn(render.PersistentVolumeClaim{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PersistentVolumeClaim) showDiagnosis(app *App, _ ui.Tabular, gvr, path string) {
+	var res dao.PersistentVolumeClaim
+	res.Init(app.factory, client.NewGVR(gvr))
+
+	raw, err := res.Diagnose(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Diagnose", path, true).
+		EnableRefresh(describeRefreshRate(app), func() (string, error) {
+			return res.Diagnose(path)
+		}).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
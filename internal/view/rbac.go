@@ -29,7 +29,7 @@ func NewRbac(gvr client.GVR) ResourceViewer {
 }
 
 func (r *Rbac) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftO: ui.NewKeyAction("Sort APIGroup", r.GetTable().SortColCmd("APIGROUP", true), false),
 	})
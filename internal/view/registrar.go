@@ -15,6 +15,7 @@ func loadCustomViewers() MetaViewers {
 	rbacViewers(m)
 	batchViewers(m)
 	extViewers(m)
+	scalingViewers(m)
 	helmViewers(m)
 
 	return m
@@ -24,6 +25,18 @@ func helmViewers(vv MetaViewers) {
 	vv[client.NewGVR("charts")] = MetaViewer{
 		viewerFn: NewChart,
 	}
+	vv[client.NewGVR("helmrepos")] = MetaViewer{
+		viewerFn: NewHelmRepo,
+	}
+	vv[client.NewGVR("repocharts")] = MetaViewer{
+		viewerFn: NewRepoChart,
+	}
+	vv[client.NewGVR("chartresources")] = MetaViewer{
+		viewerFn: NewChartResource,
+	}
+	vv[client.NewGVR("history")] = MetaViewer{
+		viewerFn: NewHistory,
+	}
 }
 
 func coreViewers(vv MetaViewers) {
@@ -39,12 +52,29 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/services")] = MetaViewer{
 		viewerFn: NewService,
 	}
+	vv[client.NewGVR("extensions/v1beta1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
+	vv[client.NewGVR("v1/persistentvolumeclaims")] = MetaViewer{
+		viewerFn: NewPersistentVolumeClaim,
+	}
 	vv[client.NewGVR("v1/nodes")] = MetaViewer{
 		viewerFn: NewNode,
 	}
 	vv[client.NewGVR("v1/secrets")] = MetaViewer{
 		viewerFn: NewSecret,
 	}
+	vv[client.NewGVR("v1/serviceaccounts")] = MetaViewer{
+		viewerFn: NewServiceAccount,
+	}
+	vv[client.NewGVR("policy/v1beta1/poddisruptionbudgets")] = MetaViewer{
+		viewerFn: NewPodDisruptionBudget,
+	}
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("coordination.k8s.io/"+v+"/leases")] = MetaViewer{
+			viewerFn: NewLease,
+		}
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -57,6 +87,69 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("containers")] = MetaViewer{
 		viewerFn: NewContainer,
 	}
+	vv[client.NewGVR("secretdata")] = MetaViewer{
+		viewerFn: NewSecretData,
+	}
+	vv[client.NewGVR("applied")] = MetaViewer{
+		viewerFn: NewApply,
+	}
+	vv[client.NewGVR("access")] = MetaViewer{
+		viewerFn: NewAccess,
+	}
+	vv[client.NewGVR("netpolrules")] = MetaViewer{
+		viewerFn: NewNetpolRule,
+	}
+	vv[client.NewGVR("containerfs")] = MetaViewer{
+		viewerFn: NewContainerFS,
+	}
+	vv[client.NewGVR("processes")] = MetaViewer{
+		viewerFn: NewProcess,
+	}
+	vv[client.NewGVR("disruptions")] = MetaViewer{
+		viewerFn: NewDisruption,
+	}
+	vv[client.NewGVR("terminations")] = MetaViewer{
+		viewerFn: NewTermination,
+	}
+	vv[client.NewGVR("conditions")] = MetaViewer{
+		viewerFn: NewCondition,
+	}
+	vv[client.NewGVR("tlscerts")] = MetaViewer{
+		viewerFn: NewCert,
+	}
+	vv[client.NewGVR("webhooks")] = MetaViewer{
+		viewerFn: NewWebhookHealth,
+	}
+	vv[client.NewGVR("images")] = MetaViewer{
+		viewerFn: NewImage,
+	}
+	vv[client.NewGVR("notifications")] = MetaViewer{
+		viewerFn: NewNotification,
+	}
+	vv[client.NewGVR("alerts")] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.NewGVR("dashboard")] = MetaViewer{
+		viewerFn: NewDashboard,
+	}
+	vv[client.NewGVR("hops")] = MetaViewer{
+		viewerFn: NewHop,
+	}
+	vv[client.NewGVR("palette")] = MetaViewer{
+		viewerFn: NewPalette,
+	}
+	vv[client.NewGVR("audits")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
+	vv[client.NewGVR("informers")] = MetaViewer{
+		viewerFn: NewInformer,
+	}
+	vv[client.NewGVR("debug")] = MetaViewer{
+		viewerFn: NewDebug,
+	}
+	vv[client.NewGVR("apicalls")] = MetaViewer{
+		viewerFn: NewAPICall,
+	}
 	vv[client.NewGVR("portforwards")] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
@@ -69,6 +162,9 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("aliases")] = MetaViewer{
 		viewerFn: NewAlias,
 	}
+	vv[client.NewGVR("plugins")] = MetaViewer{
+		viewerFn: NewPlugin,
+	}
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -125,6 +221,18 @@ func batchViewers(vv MetaViewers) {
 	}
 }
 
+func scalingViewers(vv MetaViewers) {
+	vv[client.NewGVR("autoscaling/v1/horizontalpodautoscalers")] = MetaViewer{
+		viewerFn: NewHorizontalPodAutoscaler,
+	}
+	vv[client.NewGVR("autoscaling/v2beta1/horizontalpodautoscalers")] = MetaViewer{
+		viewerFn: NewHorizontalPodAutoscaler,
+	}
+	vv[client.NewGVR("autoscaling/v2beta2/horizontalpodautoscalers")] = MetaViewer{
+		viewerFn: NewHorizontalPodAutoscaler,
+	}
+}
+
 func extViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		enterFn: showCRD,
@@ -132,6 +240,13 @@ func extViewers(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1beta1/customresourcedefinitions")] = MetaViewer{
 		enterFn: showCRD,
 	}
+	for _, v := range []string{"v1", "v1beta1"} {
+		for _, r := range []string{"mutatingwebhookconfigurations", "validatingwebhookconfigurations"} {
+			vv[client.NewGVR("admissionregistration.k8s.io/"+v+"/"+r)] = MetaViewer{
+				viewerFn: NewWebhook,
+			}
+		}
+	}
 }
 
 func showCRD(app *App, _ ui.Tabular, _, path string) {
@@ -0,0 +1,171 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const installDialogKey = "install"
+
+// RepoChart represents a helm repository charts view.
+type RepoChart struct {
+	ResourceViewer
+
+	query string
+}
+
+// NewRepoChart returns a new helm repository charts view.
+func NewRepoChart(gvr client.GVR) ResourceViewer {
+	r := RepoChart{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetColorerFn(render.RepoChart{}.ColorerFunc())
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	r.SetBindKeysFn(r.bindKeys)
+	r.SetContextFn(r.chartContext)
+
+	return &r
+}
+
+func (r *RepoChart) chartContext(ctx context.Context) context.Context {
+	if r.query == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyChartQuery, r.query)
+}
+
+func (r *RepoChart) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", r.GetTable().SortColCmd(nameCol, true), false),
+	})
+	if !r.App().Config.K9s.GetReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyI: ui.NewKeyAction("Install", r.installCmd, true),
+		})
+	}
+}
+
+func (r *RepoChart) installCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	r.showInstallDialog(path)
+
+	return nil
+}
+
+func (r *RepoChart) showInstallDialog(path string) {
+	confirm := tview.NewModalForm("<Install>", r.makeInstallForm(path))
+	confirm.SetText(fmt.Sprintf("Install chart %s", path))
+	confirm.SetDoneFunc(func(int, string) {
+		r.dismissDialog()
+	})
+	r.App().Content.AddPage(installDialogKey, confirm, false, false)
+	r.App().Content.ShowPage(installDialogKey)
+}
+
+func (r *RepoChart) makeInstallForm(path string) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	ns := client.CleanseNamespace(r.App().Config.ActiveNamespace())
+	if client.IsAllNamespace(ns) {
+		ns = "default"
+	}
+	_, name := client.Namespaced(path)
+	var values string
+	f.AddInputField("Namespace:", ns, 30, nil, func(changed string) {
+		ns = changed
+	})
+	f.AddInputField("Name:", name, 30, nil, func(changed string) {
+		name = changed
+	})
+	f.AddInputField("Values File:", values, 30, nil, func(changed string) {
+		values = changed
+	})
+
+	f.AddButton("OK", func() {
+		defer r.dismissDialog()
+		if ns == "" || name == "" {
+			r.App().Flash().Err(errors.New("namespace and name are required"))
+			return
+		}
+		r.App().Flash().Infof("Installing chart %s as %s...", path, client.FQN(ns, name))
+		go r.install(path, ns, name, values)
+	})
+	f.AddButton("Cancel", func() {
+		r.dismissDialog()
+	})
+
+	return f
+}
+
+func (r *RepoChart) dismissDialog() {
+	r.App().Content.RemovePage(installDialogKey)
+}
+
+func (r *RepoChart) install(path, ns, name, values string) {
+	err := r.App().mutate("install", r.GVR().String(), path, "release="+client.FQN(ns, name), func() error {
+		return r.doInstall(path, ns, name, values)
+	})
+	r.App().QueueUpdateDraw(func() {
+		if err != nil {
+			log.Error().Err(err).Msgf("Chart %s install failed", path)
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Infof("Chart %s installed as %s", path, client.FQN(ns, name))
+	})
+}
+
+func (r *RepoChart) doInstall(path, ns, name, values string) error {
+	res, err := dao.AccessorFor(r.App().factory, r.GVR())
+	if err != nil {
+		return err
+	}
+	i, ok := res.(dao.Installer)
+	if !ok {
+		return fmt.Errorf("expecting an installer but got %T", res)
+	}
+
+	return i.Install(path, ns, name, values)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// showChartSearch shows the charts of all repositories matching a keyword.
+func showChartSearch(app *App, query string) {
+	v := NewRepoChart(client.NewGVR("repocharts"))
+	if r, ok := v.(*RepoChart); ok {
+		r.query = query
+	}
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func chartQuery(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), "helmsearch"))
+}
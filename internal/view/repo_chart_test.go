@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChartQuery(t *testing.T) {
+	uu := map[string]struct {
+		cmd, e string
+	}{
+		"plain":  {cmd: "helmsearch nginx", e: "nginx"},
+		"spaces": {cmd: "  helmsearch   ingress  ", e: "ingress"},
+		"empty":  {cmd: "helmsearch", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, chartQuery(u.cmd))
+		})
+	}
+}
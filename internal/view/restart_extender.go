@@ -1,12 +1,13 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 )
 
@@ -17,10 +18,22 @@ type RestartExtender struct {
 
 // NewRestartExtender returns a new extender.
 func NewRestartExtender(v ResourceViewer) ResourceViewer {
-	r := RestartExtender{ResourceViewer: v}
-	r.bindKeys(v.Actions())
+	return &RestartExtender{ResourceViewer: v}
+}
 
-	return &r
+// Init initializes the view.
+func (r *RestartExtender) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.Actions().Add(ui.KeyActions{
+		ui.KeyH: ui.NewKeyAction("Revisions", r.revisionsCmd, true),
+	})
+	if !r.App().Config.K9s.GetReadOnly() {
+		r.bindKeys(r.Actions())
+	}
+
+	return nil
 }
 
 // BindKeys creates additional menu actions.
@@ -40,17 +53,30 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 	defer r.Start()
 	msg := fmt.Sprintf("Restart deployment %s?", paths[0])
 	if len(paths) > 1 {
-		msg = fmt.Sprintf("Restart %d deployments?", len(paths))
+		msg = targetsMsg(fmt.Sprintf("Restart %d %s?", len(paths), r.GVR().R()), paths)
 	}
-	dialog.ShowConfirm(r.App().Content.Pages, "<Confirm Restart>", msg, func() {
+	r.App().confirmAction("restart", r.GVR(), paths, "Confirm Restart", msg, config.ConfirmPrompt, func() {
 		for _, path := range paths {
-			if err := r.restartRollout(path); err != nil {
+			err := r.App().mutate("restart", r.GVR().String(), path, "", func() error {
+				return r.restartRollout(path)
+			})
+			if err != nil {
 				r.App().Flash().Err(err)
 			} else {
 				r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
 			}
 		}
-	}, func() {})
+	})
+
+	return nil
+}
+
+func (r *RestartExtender) revisionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	showRevisions(r.App(), r.GVR(), path)
 
 	return nil
 }
@@ -0,0 +1,144 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Revision represents a workload rollout revisions view.
+type Revision struct {
+	ResourceViewer
+
+	target client.GVR
+}
+
+// NewRevision returns a new rollout revisions view.
+func NewRevision(gvr client.GVR) ResourceViewer {
+	r := Revision{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetColorerFn(render.Revision{}.ColorerFunc())
+	r.GetTable().SetEnterFn(r.diff)
+	r.GetTable().SetSortCol("REVISION", false)
+	r.SetBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *Revision) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", r.GetTable().SortColCmd("REVISION", false), false),
+	})
+	if !r.App().Config.K9s.GetReadOnly() {
+		aa[tcell.KeyCtrlL] = ui.NewKeyAction("Rollback", r.rollbackCmd, true)
+	}
+}
+
+// Diff shows pod template changes between the two marked revisions or
+// between the selected revision and its predecessor.
+func (r *Revision) diff(app *App, _ ui.Tabular, _, _ string) {
+	from, to, err := workloadRevisions(r.GetTable().GetSelectedItems())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	path := r.GetTable().Path
+	raw, err := dao.RevisionDiff(app.factory, r.target, path, from, to)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	title := fmt.Sprintf("%s [%d..%d]", path, from, to)
+	if from == 0 {
+		title = fmt.Sprintf("%s [..%d]", path, to)
+	}
+
+	details := NewDetails(app, "Diff", title, true).EnableDiff().Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (r *Revision) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := r.GetTable().GetSelectedItem()
+	if sel == "" {
+		return nil
+	}
+	rev, err := strconv.ParseInt(sel, 10, 64)
+	if err != nil {
+		r.App().Flash().Errf("invalid revision %q", sel)
+		return nil
+	}
+
+	app, gvr, path := r.App(), r.target, r.GetTable().Path
+	msg := fmt.Sprintf("Rollback %s %s to revision %d?", gvr.R(), path, rev)
+	app.confirmAction("rollback", gvr, []string{path}, "Confirm Rollback", msg, config.ConfirmPrompt, func() {
+		res, err := dao.RollbackRevision(app.factory, gvr, path, rev)
+		app.audit("rollback", gvr.String(), path, fmt.Sprintf("revision=%d", rev), err)
+		if err != nil {
+			log.Error().Err(err).Msgf("Rollback of %s failed", path)
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Rollback of %s to revision %d: %s", path, rev, res)
+		watchRollout(app, gvr, path)
+	})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showRevisions(app *App, gvr client.GVR, path string) {
+	v := NewRevision(client.NewGVR("revisions"))
+	if r, ok := v.(*Revision); ok {
+		r.target = gvr
+	}
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyTargetGVR, gvr.String())
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// workloadRevisions returns the revisions to diff. Workload revisions are not
+// contiguous hence a single selection yields a zero from revision.
+func workloadRevisions(sels []string) (int64, int64, error) {
+	if len(sels) == 0 || len(sels) > 2 {
+		return 0, 0, errors.New("mark two revisions or select one to diff against its predecessor")
+	}
+
+	revs := make([]int64, 0, len(sels))
+	for _, s := range sels {
+		rev, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid revision %q", s)
+		}
+		revs = append(revs, rev)
+	}
+	if len(revs) == 1 {
+		return 0, revs[0], nil
+	}
+	sort.Slice(revs, func(i, j int) bool {
+		return revs[i] < revs[j]
+	})
+
+	return revs[0], revs[1], nil
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkloadRevisions(t *testing.T) {
+	uu := map[string]struct {
+		sels     []string
+		from, to int64
+		err      bool
+	}{
+		"none":       {err: true},
+		"single":     {sels: []string{"3"}, to: 3},
+		"marked":     {sels: []string{"12", "2"}, from: 2, to: 12},
+		"tooMany":    {sels: []string{"1", "2", "3"}, err: true},
+		"notANumber": {sels: []string{"blee"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			from, to, err := workloadRevisions(u.sels)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.from, from)
+			assert.Equal(t, u.to, to)
+		})
+	}
+}
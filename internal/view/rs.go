@@ -4,10 +4,10 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
 
@@ -19,7 +19,7 @@ type ReplicaSet struct {
 // NewReplicaSet returns a new viewer.
 func NewReplicaSet(gvr client.GVR) ResourceViewer {
 	r := ReplicaSet{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewScaleExtender(NewBrowser(gvr)),
 	}
 	r.SetBindKeysFn(r.bindKeys)
 	r.GetTable().SetEnterFn(r.showPods)
@@ -30,11 +30,13 @@ func NewReplicaSet(gvr client.GVR) ResourceViewer {
 
 func (r *ReplicaSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd("DESIRED", true), false),
-		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd("CURRENT", true), false),
-		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(readyCol, true), false),
-		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd("DESIRED", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd("CURRENT", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(readyCol, true), false),
 	})
+	if !r.App().Config.K9s.GetReadOnly() {
+		aa[tcell.KeyCtrlL] = ui.NewKeyAction("Rollback", r.rollbackCmd, true)
+	}
 }
 
 func (r *ReplicaSet) showPods(app *App, model ui.Tabular, gvr, path string) {
@@ -54,16 +56,15 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	r.showModal(fmt.Sprintf("Rollback %s %s?", r.GVR(), path), func(_ int, button string) {
-		defer r.dismissModal()
-
-		if button != "OK" {
-			return
-		}
+	msg := fmt.Sprintf("Rollback %s %s?", r.GVR(), path)
+	r.App().confirmAction("rollback", r.GVR(), []string{path}, "Confirm Rollback", msg, config.ConfirmPrompt, func() {
 		r.App().Flash().Infof("Rolling back %s %s", r.GVR(), path)
 		var drs dao.ReplicaSet
 		drs.Init(r.App().factory, r.GVR())
-		if err := drs.Rollback(path); err != nil {
+		err := r.App().mutate("rollback", r.GVR().String(), path, "", func() error {
+			return drs.Rollback(path)
+		})
+		if err != nil {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("%s successfully rolled back", path)
@@ -73,17 +74,3 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
-
-func (r *ReplicaSet) dismissModal() {
-	r.App().Content.RemovePage("confirm")
-}
-
-func (r *ReplicaSet) showModal(msg string, done func(int, string)) {
-	confirm := tview.NewModal().
-		AddButtons([]string{"Cancel", "OK"}).
-		SetTextColor(tcell.ColorFuchsia).
-		SetText(msg).
-		SetDoneFunc(done)
-	r.App().Content.AddPage("confirm", confirm, false, false)
-	r.App().Content.ShowPage("confirm")
-}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// ServiceAccount presents a serviceaccount viewer.
+type ServiceAccount struct {
+	ResourceViewer
+}
+
+// NewServiceAccount returns a new viewer.
+func NewServiceAccount(gvr client.GVR) ResourceViewer {
+	s := ServiceAccount{ResourceViewer: NewBrowser(gvr)}
+	s.SetBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(s.showPolicies)
+
+	return &s
+}
+
+func (s *ServiceAccount) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyB: ui.NewKeyAction("Rules", s.policyCmd, true),
+	})
+}
+
+func (s *ServiceAccount) policyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	s.showPolicies(s.App(), nil, s.GVR().String(), path)
+
+	return nil
+}
+
+func (s *ServiceAccount) showPolicies(app *App, _ ui.Tabular, _, path string) {
+	showSAPolicies(app, path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showSAPolicies(app *App, path string) {
+	if err := app.inject(NewPolicy(app, sa, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -1,10 +1,11 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
@@ -19,10 +20,24 @@ type ScaleExtender struct {
 
 // NewScaleExtender returns a new extender.
 func NewScaleExtender(r ResourceViewer) ResourceViewer {
-	s := ScaleExtender{ResourceViewer: r}
-	s.bindKeys(s.Actions())
+	return &ScaleExtender{ResourceViewer: r}
+}
+
+// newScalableBrowser returns a browser for custom resources exposing a scale subresource.
+func newScalableBrowser(gvr client.GVR) ResourceViewer {
+	return NewScaleExtender(NewBrowser(gvr))
+}
 
-	return &s
+// Init initializes the view.
+func (s *ScaleExtender) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	if !s.App().Config.K9s.GetReadOnly() {
+		s.bindKeys(s.Actions())
+	}
+
+	return nil
 }
 
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
@@ -37,16 +52,31 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	scaler, err := s.scaler()
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	current, desired, err := scaler.Replicas(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
 	s.Stop()
 	defer s.Start()
-	s.showScaleDialog(path)
+	s.showScaleDialog(scaler, path, current, desired)
 
 	return nil
 }
 
-func (s *ScaleExtender) showScaleDialog(path string) {
-	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path))
-	confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), path))
+func (s *ScaleExtender) showScaleDialog(scaler dao.Scalable, path string, current, desired int32) {
+	msg := fmt.Sprintf("Scale %s %s (current: %d, desired: %d)", s.GVR().R(), path, current, desired)
+	if hpa := s.scaledBy(path); hpa != "" {
+		msg += fmt.Sprintf("\nWarning! Replicas are managed by HPA %s and may be overridden.", hpa)
+	}
+	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(scaler, path, desired))
+	confirm.SetText(msg)
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -54,17 +84,34 @@ func (s *ScaleExtender) showScaleDialog(path string) {
 	s.App().Content.ShowPage(scaleDialogKey)
 }
 
-func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
+func (s *ScaleExtender) makeScaleForm(scaler dao.Scalable, sel string, desired int32) *tview.Form {
 	f := s.makeStyledForm()
-	replicas := strings.TrimSpace(s.GetTable().GetCell(s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1).Text)
-	tokens := strings.Split(replicas, "/")
-	replicas = tokens[1]
+	replicas := strconv.Itoa(int(desired))
 	f.AddInputField("Replicas:", replicas, 4, func(textToCheck string, lastChar rune) bool {
 		_, err := strconv.Atoi(textToCheck)
 		return err == nil
 	}, func(changed string) {
 		replicas = changed
 	})
+	if field, ok := f.GetFormItem(0).(*tview.InputField); ok {
+		field.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+			var delta int
+			switch evt.Rune() {
+			case '+':
+				delta = 1
+			case '-':
+				delta = -1
+			default:
+				return evt
+			}
+			count, _ := strconv.Atoi(replicas)
+			if count += delta; count < 0 {
+				count = 0
+			}
+			field.SetText(strconv.Itoa(count))
+			return nil
+		})
+	}
 
 	f.AddButton("OK", func() {
 		defer s.dismissDialog()
@@ -73,7 +120,10 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 			return
 		}
-		if err := s.scale(sel, count); err != nil {
+		err = s.App().mutate("scale", s.GVR().String(), sel, fmt.Sprintf("replicas=%d", count), func() error {
+			return scaler.Scale(sel, int32(count))
+		})
+		if err != nil {
 			log.Error().Err(err).Msgf("DP %s scaling failed", sel)
 			s.App().Flash().Err(err)
 		} else {
@@ -104,15 +154,29 @@ func (s *ScaleExtender) makeStyledForm() *tview.Form {
 	return f
 }
 
-func (s *ScaleExtender) scale(path string, replicas int) error {
+func (s *ScaleExtender) scaler() (dao.Scalable, error) {
 	res, err := dao.AccessorFor(s.App().factory, s.GVR())
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	scaler, ok := res.(dao.Scalable)
 	if !ok {
-		return fmt.Errorf("expecting a scalable resource for %q", s.GVR())
+		return nil, fmt.Errorf("expecting a scalable resource for %q", s.GVR())
+	}
+
+	return scaler, nil
+}
+
+func (s *ScaleExtender) scaledBy(path string) string {
+	meta, err := dao.MetaAccess.MetaFor(s.GVR())
+	if err != nil {
+		log.Warn().Err(err).Msgf("No meta for %q", s.GVR())
+		return ""
+	}
+	hpa, err := dao.ScaledBy(s.App().factory, meta.Kind, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("HPA lookup failed for %s", path)
 	}
 
-	return scaler.Scale(path, int32(replicas))
+	return hpa
 }
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	scriptGoto   = "goto"
+	scriptNS     = "ns"
+	scriptFilter = "filter"
+	scriptSort   = "sort"
+)
+
+// ScriptCmd represents a single command in a k9s script.
+type scriptCmd struct {
+	line int
+	verb string
+	args []string
+}
+
+// LoadScript reads a k9s script from a file.
+func loadScript(path string) ([]scriptCmd, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing script %s", path)
+		}
+	}()
+
+	return parseScript(f)
+}
+
+// ParseScript parses a k9s script. Each line is either a comment (#),
+// a filter (/xxx or filter xxx), a sort (sort COL [asc|desc]),
+// a namespace switch (set ns xxx) or a regular k9s command.
+func parseScript(r io.Reader) ([]scriptCmd, error) {
+	var (
+		cc   []scriptCmd
+		line int
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+		l := strings.TrimSpace(scanner.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		c, err := parseScriptLine(l)
+		if err != nil {
+			return nil, fmt.Errorf("script line %d: %s", line, err)
+		}
+		c.line = line
+		cc = append(cc, c)
+	}
+
+	return cc, scanner.Err()
+}
+
+func parseScriptLine(l string) (scriptCmd, error) {
+	if strings.HasPrefix(l, "/") {
+		return filterScriptCmd(l[1:])
+	}
+
+	tokens := strings.Fields(l)
+	switch tokens[0] {
+	case scriptFilter:
+		return filterScriptCmd(strings.TrimSpace(strings.TrimPrefix(l, scriptFilter)))
+	case scriptSort:
+		if len(tokens) < 2 || len(tokens) > 3 {
+			return scriptCmd{}, errors.New("usage: sort COLUMN [asc|desc]")
+		}
+		if len(tokens) == 3 && tokens[2] != "asc" && tokens[2] != "desc" {
+			return scriptCmd{}, fmt.Errorf("invalid sort order %q", tokens[2])
+		}
+		return scriptCmd{verb: scriptSort, args: tokens[1:]}, nil
+	case "set":
+		if len(tokens) != 3 || tokens[1] != scriptNS {
+			return scriptCmd{}, errors.New("usage: set ns NAMESPACE")
+		}
+		return scriptCmd{verb: scriptNS, args: tokens[2:]}, nil
+	case "source":
+		return scriptCmd{}, errors.New("nested scripts are not supported")
+	default:
+		return scriptCmd{verb: scriptGoto, args: []string{l}}, nil
+	}
+}
+
+func filterScriptCmd(f string) (scriptCmd, error) {
+	if f == "" {
+		return scriptCmd{}, errors.New("missing filter")
+	}
+
+	return scriptCmd{verb: scriptFilter, args: []string{f}}, nil
+}
+
+// RunScript executes a k9s script. Execution stops at the first failing command.
+func (a *App) runScript(path string) error {
+	cc, err := loadScript(path)
+	if err != nil {
+		return err
+	}
+	for _, c := range cc {
+		if err := a.runScriptCmd(c); err != nil {
+			return fmt.Errorf("script %s line %d: %s", path, c.line, err)
+		}
+	}
+	a.Flash().Infof("Script %s completed successfully!", path)
+
+	return nil
+}
+
+func (a *App) runScriptCmd(c scriptCmd) error {
+	log.Debug().Msgf("Script %d -- %s %v", c.line, c.verb, c.args)
+	switch c.verb {
+	case scriptNS:
+		if !a.switchNS(c.args[0]) {
+			return fmt.Errorf("namespace switch failed for ns %q", c.args[0])
+		}
+		return nil
+	case scriptFilter:
+		if !a.filterView(c.args[0]) {
+			return errors.New("no table view active to filter")
+		}
+		return nil
+	case scriptSort:
+		v, ok := a.Content.Top().(TableViewer)
+		if !ok || v.GetTable() == nil {
+			return errors.New("no table view active to sort")
+		}
+		asc := len(c.args) == 1 || c.args[1] == "asc"
+		v.GetTable().SetSortCol(strings.ToUpper(c.args[0]), asc)
+		v.GetTable().Refresh()
+		return nil
+	default:
+		return a.gotoResource(c.args[0], "", true)
+	}
+}
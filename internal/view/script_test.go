@@ -0,0 +1,60 @@
+package view
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScript(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		cc  []scriptCmd
+		err error
+	}{
+		"empty": {
+			s: "# Nothing to see here\n\n",
+		},
+		"goto": {
+			s:  "pods prod",
+			cc: []scriptCmd{{line: 1, verb: scriptGoto, args: []string{"pods prod"}}},
+		},
+		"full": {
+			s: "# Web tier\nset ns prod\ndp\n/-l app=web\n  sort AGE desc\nfilter fred\n",
+			cc: []scriptCmd{
+				{line: 2, verb: scriptNS, args: []string{"prod"}},
+				{line: 3, verb: scriptGoto, args: []string{"dp"}},
+				{line: 4, verb: scriptFilter, args: []string{"-l app=web"}},
+				{line: 5, verb: scriptSort, args: []string{"AGE", "desc"}},
+				{line: 6, verb: scriptFilter, args: []string{"fred"}},
+			},
+		},
+		"badSort": {
+			s:   "po\nsort AGE blee",
+			err: errors.New(`script line 2: invalid sort order "blee"`),
+		},
+		"badNS": {
+			s:   "set ns",
+			err: errors.New("script line 1: usage: set ns NAMESPACE"),
+		},
+		"noFilter": {
+			s:   "/",
+			err: errors.New("script line 1: missing filter"),
+		},
+		"nested": {
+			s:   "source blee.k9s",
+			err: errors.New("script line 1: nested scripts are not supported"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := parseScript(strings.NewReader(u.s))
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.cc, cc)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package view
 
 import (
+	"context"
+
 	"sigs.k8s.io/yaml"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -23,6 +26,7 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewBrowser(gvr),
 	}
 	s.SetBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(s.showData)
 
 	return &s
 }
@@ -30,9 +34,20 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 func (s *Secret) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlX: ui.NewKeyAction("Decode", s.decodeCmd, true),
+		ui.KeyI:        ui.NewKeyAction("Certificates", certsCmd(s), true),
 	})
 }
 
+func (s *Secret) showData(app *App, _ ui.Tabular, _, path string) {
+	v := NewSecretData(client.NewGVR("secretdata"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := s.GetTable().GetSelectedItem()
 	if path == "" {
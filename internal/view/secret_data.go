@@ -0,0 +1,132 @@
+package view
+
+import (
+	"errors"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const secretValueCol = 1
+
+// SecretData represents a masked secret keys view.
+type SecretData struct {
+	ResourceViewer
+
+	revealed map[string]string
+}
+
+// NewSecretData returns a new secret keys view.
+func NewSecretData(gvr client.GVR) ResourceViewer {
+	s := SecretData{
+		ResourceViewer: NewBrowser(gvr),
+		revealed:       make(map[string]string),
+	}
+	s.GetTable().SetColorerFn(render.SecretData{}.ColorerFunc())
+	s.GetTable().SetBorderFocusColor(tcell.ColorLightSkyBlue)
+	s.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorLightSkyBlue, tcell.AttrNone)
+	s.GetTable().SetDecorateFn(s.decorate)
+	s.GetTable().SetEnterFn(s.toggleReveal)
+	s.SetBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *SecretData) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyR:      ui.NewKeyAction("Reveal/Mask", s.revealCmd, true),
+		ui.KeyC:      ui.NewKeyAction("Copy", s.cpCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Key", s.GetTable().SortColCmd("KEY", true), false),
+	})
+}
+
+func (s *SecretData) decorate(data render.TableData) render.TableData {
+	if len(s.revealed) == 0 {
+		return data
+	}
+
+	// Revealed values must not leak back into the model rows.
+	rr := make(render.RowEvents, len(data.RowEvents))
+	copy(rr, data.RowEvents)
+	for i, re := range rr {
+		v, ok := s.revealed[re.Row.ID]
+		if !ok {
+			continue
+		}
+		ff := make(render.Fields, len(re.Row.Fields))
+		copy(ff, re.Row.Fields)
+		ff[secretValueCol] = v
+		rr[i].Row.Fields = ff
+	}
+	data.RowEvents = rr
+
+	return data
+}
+
+func (s *SecretData) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	s.toggleReveal(s.App(), s.GetTable().GetModel(), s.GVR().String(), key)
+
+	return nil
+}
+
+func (s *SecretData) toggleReveal(app *App, _ ui.Tabular, _, key string) {
+	if _, ok := s.revealed[key]; ok {
+		delete(s.revealed, key)
+		s.Refresh()
+		return
+	}
+
+	v, err := s.reveal(key)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.audit("reveal", "v1/secrets", s.GetTable().Path, "key="+key, nil)
+	s.revealed[key] = string(v)
+	s.Refresh()
+}
+
+func (s *SecretData) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+
+	v, err := s.reveal(key)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	err = s.App().mutate("copy", "v1/secrets", s.GetTable().Path, "key="+key, func() error {
+		return clipboard.WriteAll(string(v))
+	})
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	s.App().Flash().Infof("Secret key %s copied to clipboard...", key)
+
+	return nil
+}
+
+func (s *SecretData) reveal(key string) ([]byte, error) {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return nil, err
+	}
+	r, ok := res.(dao.Revealer)
+	if !ok {
+		return nil, errors.New("resource values can not be revealed")
+	}
+
+	return r.Reveal(s.GetTable().Path, key)
+}
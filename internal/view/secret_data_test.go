@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretDataDecorate(t *testing.T) {
+	s := SecretData{revealed: map[string]string{"password": "s3cr3t"}}
+	data := render.TableData{
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "password", Fields: render.Fields{"password", render.SecretMask, "6"}}},
+			{Row: render.Row{ID: "user", Fields: render.Fields{"user", render.SecretMask, "4"}}},
+		},
+	}
+
+	res := s.decorate(data)
+
+	assert.Equal(t, "s3cr3t", res.RowEvents[0].Row.Fields[secretValueCol])
+	assert.Equal(t, render.SecretMask, res.RowEvents[1].Row.Fields[secretValueCol])
+	assert.Equal(t, render.SecretMask, data.RowEvents[0].Row.Fields[secretValueCol])
+}
@@ -0,0 +1,119 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// sessionView captures the table navigation state.
+func (t *Table) sessionView() config.SessionView {
+	col, asc := t.SortCol()
+	return config.SessionView{
+		GVR:        t.GVR().String(),
+		Path:       t.Path,
+		Labels:     t.labels,
+		Fields:     t.fields,
+		Filter:     t.SearchBuff().String(),
+		SortColumn: col,
+		SortAsc:    asc,
+	}
+}
+
+// saveSession records the current navigation state for the active cluster.
+func (a *App) saveSession() {
+	var s config.Session
+	for _, c := range a.Content.Stack.Peek() {
+		if _, ok := c.(*Split); ok {
+			continue
+		}
+		v, ok := c.(TableViewer)
+		if !ok || v.GetTable() == nil || v.GetTable().GVR().String() == "contexts" {
+			continue
+		}
+		s.Views = append(s.Views, v.GetTable().sessionView())
+	}
+	if len(s.Views) == 0 {
+		a.Config.SetSession(nil)
+	} else {
+		a.Config.SetSession(&s)
+	}
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+}
+
+// offerSession prompts to restore the last navigation state of the active cluster.
+func (a *App) offerSession() {
+	s := a.Config.Session()
+	if !s.Restorable() {
+		return
+	}
+	msg := fmt.Sprintf("Restore your last session on cluster %s?", a.Config.K9s.CurrentCluster)
+	dialog.ShowConfirm(a.Content.Pages, "Restore Session", msg, func() {
+		if err := a.restoreSession(s); err != nil {
+			a.Flash().Err(err)
+		}
+	}, func() {})
+}
+
+// restoreSession replays a recorded navigation state.
+func (a *App) restoreSession(s *config.Session) error {
+	for i, v := range s.Views {
+		if err := a.restoreView(v, i == 0); err != nil {
+			return fmt.Errorf("session restore failed for %s: %v", v.GVR, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) restoreView(v config.SessionView, clearStack bool) error {
+	gvr := client.NewGVR(v.GVR)
+	switch {
+	case v.Path == "" && v.Labels == "" && v.Fields == "":
+		if err := a.gotoResource(gvr.String(), "", clearStack); err != nil {
+			return err
+		}
+	case gvr.String() == "v1/pods":
+		if clearStack {
+			a.Content.Stack.Clear()
+		}
+		showPods(a, v.Path, v.Labels, v.Fields)
+	default:
+		_, mv, err := a.command.viewMetaFor(gvr.String())
+		if err != nil {
+			return err
+		}
+		comp := a.command.componentFor(gvr.String(), "", mv)
+		comp.SetContextFn(func(ctx context.Context) context.Context {
+			ctx = context.WithValue(ctx, internal.KeyPath, v.Path)
+			ctx = context.WithValue(ctx, internal.KeyLabels, v.Labels)
+			return context.WithValue(ctx, internal.KeyFields, v.Fields)
+		})
+		if clearStack {
+			a.Content.Stack.Clear()
+		}
+		if err := a.inject(comp); err != nil {
+			return err
+		}
+	}
+
+	top, ok := a.Content.Top().(TableViewer)
+	if !ok || top.GetTable() == nil {
+		return nil
+	}
+	if v.SortColumn != "" {
+		top.GetTable().SetSortCol(v.SortColumn, v.SortAsc)
+	}
+	if v.Filter != "" {
+		a.filterView(v.Filter)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	setImageDialogKey = "setimage"
+	rolloutPoll       = 2 * time.Second
+	rolloutTimeout    = 5 * time.Minute
+)
+
+// SetImageExtender adds workload images update extensions.
+type SetImageExtender struct {
+	ResourceViewer
+}
+
+// NewSetImageExtender returns a new extender.
+func NewSetImageExtender(r ResourceViewer) ResourceViewer {
+	return &SetImageExtender{ResourceViewer: r}
+}
+
+// Init initializes the view.
+func (s *SetImageExtender) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	if !s.App().Config.K9s.GetReadOnly() {
+		s.bindKeys(s.Actions())
+	}
+
+	return nil
+}
+
+func (s *SetImageExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyI: ui.NewKeyAction("Set Image", s.setImageCmd, true),
+	})
+}
+
+func (s *SetImageExtender) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	ii, err := dao.TemplateImages(s.App().factory, s.GVR(), path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if len(ii) == 0 {
+		s.App().Flash().Errf("No containers found on %s %s", s.GVR().R(), path)
+		return nil
+	}
+
+	s.Stop()
+	defer s.Start()
+	s.showSetImageDialog(path, ii)
+
+	return nil
+}
+
+func (s *SetImageExtender) showSetImageDialog(path string, ii []dao.ContainerImage) {
+	confirm := tview.NewModalForm("<Set Image>", s.makeSetImageForm(path, ii))
+	confirm.SetText(fmt.Sprintf("Set %s %s container image", s.GVR().R(), path))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(setImageDialogKey, confirm, false, false)
+	s.App().Content.ShowPage(setImageDialogKey)
+}
+
+func (s *SetImageExtender) makeSetImageForm(path string, ii []dao.ContainerImage) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	cc := make([]string, 0, len(ii))
+	for _, i := range ii {
+		cc = append(cc, containerOption(i))
+	}
+	co, image := ii[0], ii[0].Image
+	var field *tview.InputField
+	f.AddDropDown("Container:", cc, 0, func(_ string, idx int) {
+		if idx < 0 {
+			return
+		}
+		co, image = ii[idx], ii[idx].Image
+		if field != nil {
+			field.SetText(image)
+		}
+	})
+	f.AddInputField("Image:", image, 60, nil, func(changed string) {
+		image = strings.TrimSpace(changed)
+	})
+	field, _ = f.GetFormItem(1).(*tview.InputField)
+
+	f.AddButton("OK", func() {
+		s.dismissDialog()
+		if image == "" {
+			s.App().Flash().Err(errors.New("You must specify an image"))
+			return
+		}
+		if image == co.Image {
+			s.App().Flash().Warnf("Container %s already runs image %s", co.Name, image)
+			return
+		}
+		s.setImage(path, co, image)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	return f
+}
+
+func (s *SetImageExtender) setImage(path string, co dao.ContainerImage, image string) {
+	gvr, app := s.GVR(), s.App()
+	msg := fmt.Sprintf("Set %s %s container %s image to %s?", gvr.R(), path, co.Name, image)
+	app.confirmAction("setimage", gvr, []string{path}, "Set Image", msg, config.ConfirmNone, func() {
+		ref := dao.Ref{GVR: gvr.String(), FQN: path}
+		err := app.mutate("setimage", gvr.String(), path, fmt.Sprintf("%s=%s", co.Name, image), func() error {
+			return dao.SetImage(app.factory, ref, co.Name, co.Init, image)
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("Set image failed on %s", path)
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("%s %s container %s image set to %s", gvr.R(), path, co.Name, image)
+		watchRollout(app, gvr, path)
+	})
+}
+
+func (s *SetImageExtender) dismissDialog() {
+	s.App().Content.RemovePage(setImageDialogKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func containerOption(i dao.ContainerImage) string {
+	if i.Init {
+		return i.Name + " (init)"
+	}
+
+	return i.Name
+}
+
+// watchRollout flashes a workload rollout progress until it completes.
+func watchRollout(app *App, gvr client.GVR, path string) {
+	go func() {
+		var last string
+		deadline := time.Now().Add(rolloutTimeout)
+		for time.Now().Before(deadline) {
+			<-time.After(rolloutPoll)
+			msg, done, err := dao.RolloutStatus(app.factory, gvr, path)
+			if err != nil {
+				app.QueueUpdateDraw(func() {
+					app.Flash().Errf("Rollout status for %s failed -- %s", path, err)
+				})
+				return
+			}
+			if msg = strings.TrimSpace(msg); msg != last || done {
+				last = msg
+				app.QueueUpdateDraw(func() {
+					app.Flash().Info(msg)
+				})
+			}
+			if done {
+				return
+			}
+		}
+		app.QueueUpdateDraw(func() {
+			app.Flash().Warnf("Rollout of %s still in progress after %v", path, rolloutTimeout)
+		})
+	}()
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+// saveSnapshot dumps the informers cache to disk so it can be browsed offline.
+func saveSnapshot(app *App) {
+	app.Flash().Info("Taking cluster snapshot...")
+	cluster, ctxName := app.Config.K9s.CurrentCluster, app.Config.K9s.CurrentContext
+	go func() {
+		path, count, err := writeSnapshot(app.factory, cluster, ctxName)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("Snapshot of %d resources saved to %s", count, path)
+		})
+	}()
+}
+
+func writeSnapshot(f dao.Factory, cluster, ctxName string) (string, int, error) {
+	snap, err := dao.NewSnapshot(f)
+	if err != nil {
+		return "", 0, err
+	}
+
+	dir := filepath.Join(config.K9sDumpDir, cluster)
+	if err := ensureDir(dir); err != nil {
+		return "", 0, err
+	}
+	name := fmt.Sprintf("snapshot-%s-%d.json.gz", strings.Replace(ctxName, "/", "-", -1), time.Now().UnixNano())
+	path := strings.ToLower(filepath.Join(dir, name))
+	if err := client.SaveSnapshot(path, snap); err != nil {
+		return "", 0, err
+	}
+
+	var count int
+	for _, oo := range snap.Objects {
+		count += len(oo)
+	}
+
+	return path, count, nil
+}
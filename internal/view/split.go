@@ -0,0 +1,314 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const splitTitle = "Split"
+
+var _ ResourceViewer = (*Split)(nil)
+
+// Split represents two resource views side by side bound to different contexts.
+type Split struct {
+	*tview.Flex
+
+	app      *App
+	gvr      client.GVR
+	contexts []string
+	panes    []*splitPane
+	focus    int
+}
+
+// NewSplit returns a new split view for a resource across the given contexts.
+func NewSplit(gvr client.GVR, contexts ...string) *Split {
+	return &Split{
+		Flex:     tview.NewFlex().SetDirection(tview.FlexColumn),
+		gvr:      gvr,
+		contexts: contexts,
+	}
+}
+
+// Init initializes the view.
+func (s *Split) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	if len(s.contexts) != 2 {
+		return fmt.Errorf("expecting 2 contexts but got %d", len(s.contexts))
+	}
+
+	for i, n := range s.contexts {
+		f, owned, err := s.factoryFor(n)
+		if err != nil {
+			return err
+		}
+		p := newSplitPane(s.gvr, n, f, owned)
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+		p.Actions().Add(ui.KeyActions{
+			tcell.KeyTab:     ui.NewKeyAction("Switch Pane", s.switchCmd, true),
+			tcell.KeyBacktab: ui.NewKeyAction("Switch Pane", s.switchCmd, false),
+		})
+		s.panes = append(s.panes, p)
+		s.AddItem(p, 0, 1, i == 0)
+	}
+
+	return nil
+}
+
+func (s *Split) factoryFor(n string) (*watch.Factory, bool, error) {
+	if n == s.app.Config.K9s.CurrentContext {
+		return s.app.factory, false, nil
+	}
+	cfg, err := s.app.Conn().Config().ForContext(n)
+	if err != nil {
+		return nil, false, err
+	}
+
+	f := watch.NewFactory(client.InitConnectionOrDie(cfg))
+	f.SetIdleTTL(s.app.Config.K9s.GetInformerIdle())
+
+	return f, true, nil
+}
+
+// Start initializes the panes watch loops.
+func (s *Split) Start() {
+	ns := client.CleanseNamespace(s.app.Config.ActiveNamespace())
+	for _, p := range s.panes {
+		p.start(ns)
+	}
+	s.SetVisibility(model.Foreground)
+}
+
+// Stop terminates the panes watch loops.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.stop()
+	}
+}
+
+// Focus delegates focus to the active pane.
+func (s *Split) Focus(delegate func(p tview.Primitive)) {
+	if len(s.panes) == 0 {
+		s.Flex.Focus(delegate)
+		return
+	}
+	delegate(s.panes[s.focus])
+}
+
+func (s *Split) switchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.focus = (s.focus + 1) % len(s.panes)
+	s.app.SetFocus(s.panes[s.focus])
+	s.SetVisibility(model.Foreground)
+
+	return nil
+}
+
+// SetVisibility adapts the panes refresh rate to the view visibility.
+// Only the focused pane refreshes at full rate.
+func (s *Split) SetVisibility(v model.Visibility) {
+	for i, p := range s.panes {
+		if i != s.focus && v == model.Foreground {
+			p.SetVisibility(model.Background)
+			continue
+		}
+		p.SetVisibility(v)
+	}
+}
+
+// Refresh updates the view.
+func (s *Split) Refresh() {
+	for _, p := range s.panes {
+		p.Table.Refresh()
+	}
+}
+
+// GVR returns a resource descriptor.
+func (s *Split) GVR() client.GVR {
+	return s.gvr
+}
+
+// Name returns the component name.
+func (s *Split) Name() string {
+	return splitTitle
+}
+
+// App returns the current app handle.
+func (s *Split) App() *App {
+	return s.app
+}
+
+// SetInstance sets specific resource instance.
+func (s *Split) SetInstance(string) {}
+
+// SetEnvFn sets the custom environment function.
+func (s *Split) SetEnvFn(EnvFunc) {}
+
+// SetBindKeysFn sets up extra key bindings.
+func (s *Split) SetBindKeysFn(BindKeysFunc) {}
+
+// SetContextFn sets custom context.
+func (s *Split) SetContextFn(ContextFunc) {}
+
+// GetTable return the active pane table.
+func (s *Split) GetTable() *Table {
+	if len(s.panes) == 0 {
+		return nil
+	}
+
+	return s.panes[s.focus].Table
+}
+
+// Actions returns active menu bindings.
+func (s *Split) Actions() ui.KeyActions {
+	if len(s.panes) == 0 {
+		return ui.KeyActions{}
+	}
+
+	return s.panes[s.focus].Actions()
+}
+
+// Hints returns the view hints.
+func (s *Split) Hints() model.MenuHints {
+	return s.Actions().Hints()
+}
+
+// ExtraHints returns additional hints.
+func (s *Split) ExtraHints() map[string]string {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// splitPane represents a resource table bound to a given context.
+type splitPane struct {
+	*Table
+
+	context  string
+	factory  *watch.Factory
+	owned    bool
+	cancelFn context.CancelFunc
+}
+
+func newSplitPane(gvr client.GVR, n string, f *watch.Factory, owned bool) *splitPane {
+	return &splitPane{
+		Table:   NewTable(gvr),
+		context: n,
+		factory: f,
+		owned:   owned,
+	}
+}
+
+// Init initializes the pane.
+func (p *splitPane) Init(ctx context.Context) error {
+	if err := p.Table.Init(ctx); err != nil {
+		return err
+	}
+	p.SetContextName(p.context)
+	p.GetModel().AddListener(p)
+
+	return nil
+}
+
+func (p *splitPane) start(ns string) {
+	p.stop()
+
+	if p.owned {
+		p.factory.Start(ns)
+	}
+	p.GetModel().SetNamespace(ns)
+	p.Table.Start()
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(p.defaultContext(ns))
+	p.GetModel().Watch(ctx)
+}
+
+func (p *splitPane) stop() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.Table.Stop()
+	p.cancelFn()
+	p.cancelFn = nil
+	if p.owned {
+		p.factory.Terminate()
+	}
+}
+
+func (p *splitPane) defaultContext(ns string) context.Context {
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, p.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, p.GVR().String())
+	ctx = context.WithValue(ctx, internal.KeyPath, "")
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+
+	return context.WithValue(ctx, internal.KeyNamespace, ns)
+}
+
+// TableDataChanged notifies view new data is available.
+func (p *splitPane) TableDataChanged(data render.TableData) {
+	p.App().QueueUpdateDraw(func() {
+		p.Update(data)
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (p *splitPane) TableLoadFailed(err error) {
+	p.App().QueueUpdateDraw(func() {
+		p.App().Flash().Errf("%s: %s", p.context, err)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitArgs extracts the resource and the contexts from a split command.
+func splitArgs(cmd, current string) (string, []string, error) {
+	tokens := strings.Fields(cmd)
+	switch len(tokens) {
+	case 3:
+		return tokens[1], []string{current, tokens[2]}, nil
+	case 4:
+		return tokens[1], []string{tokens[2], tokens[3]}, nil
+	default:
+		return "", nil, errors.New("You must specify a resource and a context: split <resource> [<context>] <context>")
+	}
+}
+
+func showSplit(app *App, gvr client.GVR, contexts []string) {
+	app.Flash().Infof("Checking contexts %s...", strings.Join(contexts, ", "))
+	go func() {
+		for _, n := range contexts {
+			if n == app.Config.K9s.CurrentContext {
+				continue
+			}
+			if err := app.Conn().Config().CheckContext(n, ctxCheckTimeout); err != nil {
+				log.Warn().Err(err).Msgf("Context %s health check failed", n)
+				app.QueueUpdateDraw(func() {
+					app.Flash().Errf("Context %s is unreachable: %s", n, err)
+				})
+				return
+			}
+		}
+		app.QueueUpdateDraw(func() {
+			if err := app.inject(NewSplit(gvr, contexts...)); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
@@ -0,0 +1,44 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitArgs(t *testing.T) {
+	uu := map[string]struct {
+		cmd, res string
+		cc       []string
+		err      bool
+	}{
+		"current": {
+			cmd: "split po staging",
+			res: "po",
+			cc:  []string{"prod", "staging"},
+		},
+		"both": {
+			cmd: "split  dp  dev staging",
+			res: "dp",
+			cc:  []string{"dev", "staging"},
+		},
+		"missing": {
+			cmd: "split po",
+			err: true,
+		},
+		"tooMany": {
+			cmd: "split po a b c",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, cc, err := splitArgs(u.cmd, "prod")
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.cc, cc)
+		})
+	}
+}
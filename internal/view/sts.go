@@ -4,6 +4,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -21,7 +22,9 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewPortForwardExtender(
 			NewRestartExtender(
 				NewScaleExtender(
-					NewLogsExtender(NewBrowser(gvr), nil),
+					NewSetImageExtender(
+						NewLogsExtender(NewBrowser(gvr), nil),
+					),
 				),
 			),
 		),
@@ -36,9 +39,20 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 func (s *StatefulSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false),
+		ui.KeyO:      ui.NewKeyAction("Ordinals", s.ordinalsCmd, true),
 	})
 }
 
+func (s *StatefulSet) ordinalsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showOrdinals(s.App(), path)
+
+	return nil
+}
+
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _, path string) {
 	sts, err := s.sts(path)
 	if err != nil {
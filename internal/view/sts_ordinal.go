@@ -0,0 +1,158 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+var stsGVR = client.NewGVR("apps/v1/statefulsets")
+
+// Ordinal represents a statefulset ordinals view.
+type Ordinal struct {
+	ResourceViewer
+
+	path    string
+	restart *dao.RollingRestart
+}
+
+// NewOrdinal returns a new statefulset ordinals view.
+func NewOrdinal(gvr client.GVR) ResourceViewer {
+	o := Ordinal{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	o.GetTable().SetColorerFn(render.Ordinal{}.ColorerFunc())
+	o.GetTable().SetEnterFn(o.showContainers)
+	o.GetTable().SetSortCol("ORDINAL", true)
+	o.SetBindKeysFn(o.bindKeys)
+	o.SetContextFn(o.ordinalContext)
+
+	return &o
+}
+
+func (o *Ordinal) ordinalContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, o.path)
+	if o.restart == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyRestart, o.restart)
+}
+
+func (o *Ordinal) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Ordinal", o.GetTable().SortColCmd("ORDINAL", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", o.GetTable().SortColCmd(statusCol, true), false),
+	})
+	if !o.App().Config.K9s.GetReadOnly() {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlT: ui.NewKeyAction("Restart Ordinal", o.restartCmd, true),
+			ui.KeyR:        ui.NewKeyAction("Rolling Restart", o.rollingRestartCmd, true),
+		})
+	}
+}
+
+func (o *Ordinal) showContainers(app *App, _ ui.Tabular, _, path string) {
+	co := NewContainer(client.NewGVR("containers"))
+	co.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(co); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (o *Ordinal) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := o.GetTable().GetSelectedItem()
+	if sel == "" {
+		return nil
+	}
+	app, path := o.App(), o.GetTable().Path
+	ordinal, err := ordinalFor(path, sel)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Restart statefulset %s ordinal %d?", path, ordinal)
+	app.confirmAction("restart", stsGVR, []string{sel}, "Confirm Restart", msg, config.ConfirmPrompt, func() {
+		err := app.mutate("restart", stsGVR.String(), path, fmt.Sprintf("ordinal=%d", ordinal), func() error {
+			return dao.RestartOrdinal(app.factory, path, ordinal)
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("Ordinal %d restart failed", ordinal)
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Restarting %s ordinal %d...", path, ordinal)
+	})
+
+	return nil
+}
+
+func (o *Ordinal) rollingRestartCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if o.restart != nil && !o.restart.Done() {
+		o.App().Flash().Warn("A rolling restart is already in progress")
+		return nil
+	}
+
+	app, path := o.App(), o.GetTable().Path
+	msg := fmt.Sprintf("Restart statefulset %s ordinals one at a time? Ordinals below the partition are skipped.", path)
+	app.confirmAction("restart", stsGVR, []string{path}, "Confirm Rolling Restart", msg, config.ConfirmPrompt, func() {
+		o.restart = dao.NewRollingRestart(app.factory, path)
+		app.Flash().Infof("Rolling restart of %s in progress...", path)
+		go o.rollingRestart(app, path, o.restart)
+	})
+
+	return nil
+}
+
+func (o *Ordinal) rollingRestart(app *App, path string, r *dao.RollingRestart) {
+	count, err := r.Run(context.Background())
+	app.audit("restart", stsGVR.String(), path, fmt.Sprintf("rolling ordinals=%d", count), err)
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Errf("Rolling restart of %s stopped after %d ordinals -- %s", path, count, err)
+			return
+		}
+		app.Flash().Infof("Rolling restart of %s completed (%d ordinals)", path, count)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showOrdinals(app *App, path string) {
+	v := NewOrdinal(client.NewGVR("ordinals"))
+	if o, ok := v.(*Ordinal); ok {
+		o.path = path
+	}
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ordinalFor returns the ordinal of a statefulset pod.
+func ordinalFor(path, pod string) (int, error) {
+	_, sts := client.Namespaced(path)
+	_, n := client.Namespaced(pod)
+	if !strings.HasPrefix(n, sts+"-") {
+		return 0, fmt.Errorf("pod %s is not an ordinal of %s", pod, path)
+	}
+	ordinal, err := strconv.Atoi(strings.TrimPrefix(n, sts+"-"))
+	if err != nil || ordinal < 0 {
+		return 0, fmt.Errorf("invalid ordinal for pod %s", pod)
+	}
+
+	return ordinal, nil
+}
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinalFor(t *testing.T) {
+	uu := map[string]struct {
+		pod string
+		e   int
+		err bool
+	}{
+		"ordinal":   {pod: "default/fred-2", e: 2},
+		"otherSts":  {pod: "default/blee-2", err: true},
+		"notNumber": {pod: "default/fred-blee", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := ordinalFor("default/fred", u.pod)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, o)
+		})
+	}
+}
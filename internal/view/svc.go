@@ -47,9 +47,20 @@ func (s *Service) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlB: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyX:        ui.NewKeyAction("Xray", s.xrayCmd, true),
 	})
 }
 
+func (s *Service) xrayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showXray(s.App(), s.GVR(), path)
+
+	return nil
+}
+
 func (s *Service) showPods(a *App, _ ui.Tabular, gvr, path string) {
 	var res dao.Service
 	res.Init(a.factory, s.GVR())
@@ -147,6 +158,7 @@ func (s *Service) runBenchmark(port string, cfg config.BenchConfig) error {
 	s.App().Status(model.FlashWarn, "Benchmark in progress...")
 	log.Debug().Msg("Bench starting...")
 	go s.bench.Run(s.App().Config.K9s.CurrentCluster, s.benchDone)
+	showBenchResults(s.App(), cfg.Name, s.bench)
 
 	return nil
 }
@@ -2,11 +2,11 @@ package view
 
 import (
 	"context"
-	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -20,6 +20,8 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn BindKeysFunc
+	labels     string
+	fields     string
 }
 
 // NewTable returns a new viewer.
@@ -45,7 +47,7 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	t.Table.Init(ctx)
 	t.SetInputCapture(t.keyboard)
 	t.bindKeys()
-	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
+	t.GetModel().SetRefreshRate(refreshRateFor(t.app, t.GVR().String()))
 
 	return nil
 }
@@ -120,6 +122,11 @@ func (t *Table) Stop() {
 	t.Styles().RemoveListener(t.Table)
 }
 
+// SetVisibility adapts the model refresh rate to the view visibility.
+func (t *Table) SetVisibility(v model.Visibility) {
+	t.GetModel().SetVisibility(v)
+}
+
 // SetEnterFn specifies the default enter behavior.
 func (t *Table) SetEnterFn(f EnterFunc) {
 	t.enterFn = f
@@ -149,6 +156,9 @@ func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
 		ui.KeySpace:         ui.NewSharedKeyAction("Mark", t.markCmd, false),
+		ui.KeyAsterisk:      ui.NewSharedKeyAction("Mark Filtered", t.markFilteredCmd, false),
+		tcell.KeyCtrlV:      ui.NewSharedKeyAction("Mark Range", t.markRangeCmd, false),
+		ui.KeyBang:          ui.NewSharedKeyAction("Marks Invert", t.invertMarksCmd, false),
 		tcell.KeyCtrlSpace:  ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
 		tcell.KeyCtrlS:      ui.NewSharedKeyAction("Save", t.saveCmd, false),
 		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
@@ -175,6 +185,12 @@ func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) toggleGaugesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleGauges()
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -202,6 +218,31 @@ func (t *Table) markCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) markFilteredCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n := t.MarkFiltered(); n > 0 {
+		t.app.Flash().Infof("Marked %d %s", n, t.GVR().R())
+	}
+
+	return nil
+}
+
+func (t *Table) markRangeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !t.MarkRange() {
+		t.app.Flash().Warn("Mark a row first to start a range")
+		return nil
+	}
+	t.Refresh()
+
+	return nil
+}
+
+func (t *Table) invertMarksCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.InvertMarks()
+	t.Refresh()
+
+	return nil
+}
+
 func (t *Table) clearMarksCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
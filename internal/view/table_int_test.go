@@ -10,6 +10,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -104,7 +105,7 @@ func (t *testTableModel) Watch(context.Context)           {}
 func (t *testTableModel) Get(context.Context, string) (runtime.Object, error) {
 	return nil, nil
 }
-func (t *testTableModel) Delete(context.Context, string, bool, bool) error {
+func (t *testTableModel) Delete(context.Context, string, dao.DeleteOptions) error {
 	return nil
 }
 func (t *testTableModel) Describe(context.Context, string) (string, error) {
@@ -114,8 +115,9 @@ func (t *testTableModel) ToYAML(ctx context.Context, path string) (string, error
 	return "", nil
 }
 
-func (t *testTableModel) InNamespace(string) bool      { return true }
-func (t *testTableModel) SetRefreshRate(time.Duration) {}
+func (t *testTableModel) InNamespace(string) bool        { return true }
+func (t *testTableModel) SetRefreshRate(time.Duration)   {}
+func (t *testTableModel) SetVisibility(model.Visibility) {}
 
 func makeTableData() render.TableData {
 	t := render.NewTableData()
@@ -171,3 +173,20 @@ func (k ks) ClusterNames() ([]string, error) {
 func (k ks) NamespaceNames(nn []v1.Namespace) []string {
 	return []string{"test"}
 }
+
+func TestTableSessionView(t *testing.T) {
+	v := NewTable(client.NewGVR("v1/pods"))
+	v.Init(makeContext())
+	v.Path = "default/dp1"
+	v.labels = "app=dp1"
+	v.SearchBuff().Set("fred")
+	v.SetSortCol("AGE", false)
+
+	assert.Equal(t, config.SessionView{
+		GVR:        "v1/pods",
+		Path:       "default/dp1",
+		Labels:     "app=dp1",
+		Filter:     "fred",
+		SortColumn: "AGE",
+	}, v.sessionView())
+}
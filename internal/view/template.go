@@ -0,0 +1,84 @@
+package view
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// template returns the resource creation template if one is defined.
+func (b *Browser) template() (string, bool) {
+	return config.TemplateFor(b.meta.SingularName, strings.ToLower(b.meta.Kind), b.meta.Name)
+}
+
+func (b *Browser) newCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path, ok := b.template()
+	if !ok {
+		return evt
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+
+	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
+	env := generalEnv(b.app)
+	env["NAMESPACE"] = ns
+	b.createFrom([]byte(expandTemplate(string(raw), env)), ns)
+
+	return nil
+}
+
+// createFrom edits a resource manifest and applies it on save.
+func (b *Browser) createFrom(raw []byte, ns string) {
+	edited, err := editBuffer(b.app, "k9s-new-*.yml", raw)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if len(strings.TrimSpace(string(edited))) == 0 {
+		b.app.Flash().Info("Empty manifest. Creation canceled")
+		return
+	}
+
+	rr, err := dao.ApplyRaw(b.app.factory, edited, ns)
+	b.app.auditApplied("create", b.GVR().String(), rr, err)
+	if err != nil {
+		b.retryCreate(edited, ns, err.Error())
+		return
+	}
+	for _, r := range rr {
+		if r.Result == render.ApplyFailed {
+			b.retryCreate(edited, ns, fmt.Sprintf("%s %s: %s", r.Kind, r.Name, r.Message))
+			return
+		}
+	}
+	b.app.Flash().Infof("%d resource(s) applied successfully", len(rr))
+	b.refresh()
+}
+
+func (b *Browser) retryCreate(raw []byte, ns, msg string) {
+	dialog.ShowDryRun(b.app.Content.Pages, "Create Failed", msg, nil, func() {
+		b.createFrom(raw, ns)
+	}, func() {})
+}
+
+// expandTemplate substitutes K9s env vars in a template. Unknown vars are
+// left untouched.
+func expandTemplate(raw string, env K9sEnv) string {
+	return os.Expand(raw, func(k string) string {
+		if v, ok := env[k]; ok {
+			return v
+		}
+		return "${" + k + "}"
+	})
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	env := K9sEnv{"NAMESPACE": "fred", "CONTEXT": "blee"}
+	uu := map[string]struct {
+		raw, e string
+	}{
+		"plain":   {raw: "name: zorg", e: "name: zorg"},
+		"vars":    {raw: "namespace: $NAMESPACE\ncontext: ${CONTEXT}", e: "namespace: fred\ncontext: blee"},
+		"unknown": {raw: "cmd: echo $HOME", e: "cmd: echo ${HOME}"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, expandTemplate(u.raw, env))
+		})
+	}
+}
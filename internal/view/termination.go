@@ -0,0 +1,70 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Termination represents a pod containers terminations view.
+type Termination struct {
+	ResourceViewer
+}
+
+// NewTermination returns a new terminations view.
+func NewTermination(gvr client.GVR) ResourceViewer {
+	t := Termination{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetColorerFn(render.Termination{}.ColorerFunc())
+	t.GetTable().SetEnterFn(t.showHistory)
+	t.SetBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *Termination) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", t.GetTable().SortColCmd("RESTARTS", false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", t.GetTable().SortColCmd("LAST REASON", true), false),
+	})
+}
+
+func (t *Termination) showHistory(app *App, _ ui.Tabular, _, co string) {
+	var res dao.Container
+	res.Init(app.factory, client.NewGVR("containers"))
+	path := t.GetTable().Path
+
+	raw, err := res.History(path, co)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Restarts", client.FQN(path, co), true).
+		EnableRefresh(describeRefreshRate(app), func() (string, error) {
+			return res.History(path, co)
+		}).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showTerminations(app *App, path string) {
+	v := NewTermination(client.NewGVR("terminations"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -51,6 +51,12 @@ type Hinter interface {
 	Hints() model.MenuHints
 }
 
+// VisibilityTracker represents a viewer slowing down its refreshes when not in focus.
+type VisibilityTracker interface {
+	// SetVisibility notifies the viewer of its current visibility.
+	SetVisibility(model.Visibility)
+}
+
 // Viewer represents a component viewer.
 type Viewer interface {
 	model.Component
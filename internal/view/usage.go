@@ -0,0 +1,22 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/dao"
+)
+
+func showUsage(app *App, gvr, path string) {
+	prom, qq := app.prometheus(), app.CustomView.PromQueries(gvr)
+	raw, err := dao.Usage(app.Conn(), gvr, path, prom, qq)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Usage", path, false).
+		EnableRefresh(describeRefreshRate(app), func() (string, error) {
+			return dao.Usage(app.Conn(), gvr, path, prom, qq)
+		}).
+		Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
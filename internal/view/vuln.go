@@ -0,0 +1,136 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Vuln represents an images vulnerabilities scan view.
+type Vuln struct {
+	ResourceViewer
+
+	scan *dao.ImageScan
+}
+
+// NewVuln returns a new vulnerabilities view.
+func NewVuln(scan *dao.ImageScan) ResourceViewer {
+	v := Vuln{
+		ResourceViewer: NewBrowser(client.NewGVR("vulns")),
+		scan:           scan,
+	}
+	v.GetTable().SetColorerFn(render.Vuln{}.ColorerFunc())
+	v.GetTable().SetEnterFn(v.showVuln)
+	v.GetTable().SetSortCol("#", true)
+	v.SetBindKeysFn(v.bindKeys)
+	v.SetContextFn(v.vulnContext)
+
+	return &v
+}
+
+func (v *Vuln) vulnContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyImageScan, v.scan)
+}
+
+func (v *Vuln) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Severity", v.GetTable().SortColCmd("#", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Package", v.GetTable().SortColCmd("PACKAGE", true), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Image", v.GetTable().SortColCmd("IMAGE", true), false),
+	})
+}
+
+func (v *Vuln) showVuln(app *App, _ ui.Tabular, _, id string) {
+	raw, err := v.scan.Details(id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	subject := id
+	if tokens := strings.Split(id, "|"); len(tokens) == 4 {
+		subject = tokens[3]
+	}
+	details := NewDetails(app, "Vulnerability", subject, true).Update(raw)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// scanImages runs the configured image scanner in the background and shows
+// the vulnerabilities found once done.
+func scanImages(app *App, images []string) {
+	cfg := app.Config.K9s.GetImageScanner()
+	if cfg == nil {
+		app.Flash().Warn("No image scanner configured")
+		return
+	}
+	s, err := dao.NewCommandScanner(cfg)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	app.Flash().Infof("Scanning %s with %s...", strings.Join(images, ","), cfg.Command)
+	go func() {
+		scan, err := dao.ScanImages(context.Background(), s, images)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("%d vulnerabilities found", scan.Count())
+			if err := app.inject(NewVuln(scan)); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func scanImageCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		img := v.GetTable().GetSelectedItem()
+		if img == "" {
+			return evt
+		}
+		scanImages(v.App(), []string{img})
+
+		return nil
+	}
+}
+
+func scanPodImagesCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		po, err := fetchPod(v.App().factory, path)
+		if err != nil {
+			v.App().Flash().Err(err)
+			return nil
+		}
+		ii := dao.ImagesInventory([]v1.Pod{*po})
+		images := make([]string, 0, len(ii))
+		for _, i := range ii {
+			images = append(images, i.Image)
+		}
+		scanImages(v.App(), images)
+
+		return nil
+	}
+}
+
+func vulnImages(cmd string) []string {
+	return strings.Fields(strings.TrimPrefix(strings.TrimSpace(cmd), "vulns"))
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulnImages(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   []string
+	}{
+		"single": {cmd: "vulns nginx:1.17", e: []string{"nginx:1.17"}},
+		"multi":  {cmd: "  vulns  nginx:1.17   fred@sha256:abc ", e: []string{"nginx:1.17", "fred@sha256:abc"}},
+		"empty":  {cmd: "vulns", e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, vulnImages(u.cmd))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Webhook represents an admission webhook configuration viewer.
+type Webhook struct {
+	ResourceViewer
+}
+
+// NewWebhook returns a new viewer.
+func NewWebhook(gvr client.GVR) ResourceViewer {
+	w := Webhook{ResourceViewer: NewBrowser(gvr)}
+	w.SetBindKeysFn(w.bindKeys)
+	w.GetTable().SetEnterFn(w.showHealth)
+
+	return &w
+}
+
+func (w *Webhook) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyI: ui.NewKeyAction("Certificates", certsCmd(w), true),
+	})
+}
+
+func (w *Webhook) showHealth(app *App, _ ui.Tabular, gvr, path string) {
+	showWebhooks(app, client.NewGVR(gvr), path)
+}
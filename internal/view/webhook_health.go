@@ -0,0 +1,66 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// WebhookHealth represents an admission webhooks health view.
+type WebhookHealth struct {
+	ResourceViewer
+}
+
+// NewWebhookHealth returns a new webhooks health view.
+func NewWebhookHealth(gvr client.GVR) ResourceViewer {
+	w := WebhookHealth{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetColorerFn(render.Webhook{}.ColorerFunc())
+	w.GetTable().SetEnterFn(w.showEndpoints)
+	w.SetBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *WebhookHealth) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, ui.KeyAsterisk, ui.KeyBang, tcell.KeyCtrlV)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Configuration", w.GetTable().SortColCmd("CONFIGURATION", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Endpoints", w.GetTable().SortColCmd("ENDPOINTS", true), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failure Policy", w.GetTable().SortColCmd("FAILURE POLICY", true), false),
+	})
+}
+
+func (w *WebhookHealth) showEndpoints(app *App, _ ui.Tabular, _, _ string) {
+	row, h := w.GetTable().GetSelectedRow(), w.GetTable().GetModel().Peek().Header
+	targetCol := h.IndexOf("TARGET", true)
+	if targetCol == -1 {
+		return
+	}
+	target := row.Fields[targetCol]
+	if target == render.NAValue || strings.Contains(target, "://") {
+		app.Flash().Warn("Webhook is not backed by a service")
+		return
+	}
+	viewResourceRef(app, "v1/endpoints:"+strings.SplitN(target, ":", 2)[0])
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showWebhooks(app *App, gvr client.GVR, path string) {
+	v := NewWebhookHealth(client.NewGVR("webhooks"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyTargetGVR, gvr.String())
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
-	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -14,7 +13,6 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
-	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -37,6 +35,7 @@ type Xray struct {
 	model    *model.Tree
 	cancelFn context.CancelFunc
 	envFn    EnvFunc
+	instance string
 }
 
 // NewXray returns a new view.
@@ -74,8 +73,12 @@ func (x *Xray) Init(ctx context.Context) error {
 	x.SetGraphicsColor(x.app.Styles.Xray().GraphicColor.Color())
 	x.SetTitle(fmt.Sprintf(" %s-%s ", xrayTitle, strings.Title(x.gvr.R())))
 
-	x.model.SetRefreshRate(time.Duration(x.app.Config.K9s.GetRefreshRate()) * time.Second)
-	x.model.SetNamespace(client.CleanseNamespace(x.app.Config.ActiveNamespace()))
+	x.model.SetRefreshRate(refreshRateFor(x.app, x.GVR().String()))
+	ns := client.CleanseNamespace(x.app.Config.ActiveNamespace())
+	if x.instance != "" {
+		ns, _ = client.Namespaced(x.instance)
+	}
+	x.model.SetNamespace(ns)
 	x.model.AddListener(x)
 
 	x.SetChangedFunc(func(n *tview.TreeNode) {
@@ -101,7 +104,10 @@ func (x *Xray) ExtraHints() map[string]string {
 }
 
 // SetInstance sets specific resource instance.
-func (x *Xray) SetInstance(string) {}
+func (x *Xray) SetInstance(path string) {
+	x.instance = path
+	x.model.SetInstance(path)
+}
 
 func (x *Xray) bindKeys() {
 	x.Actions().Add(ui.KeyActions{
@@ -148,10 +154,11 @@ func (x *Xray) refreshActions() {
 		return
 	}
 
-	if client.Can(x.meta.Verbs, "edit") {
+	readOnly := x.app.Config.K9s.GetReadOnly()
+	if !readOnly && client.Can(x.meta.Verbs, "edit") {
 		aa[ui.KeyE] = ui.NewKeyAction("Edit", x.editCmd, true)
 	}
-	if client.Can(x.meta.Verbs, "delete") {
+	if !readOnly && client.Can(x.meta.Verbs, "delete") {
 		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", x.deleteCmd, true)
 	}
 	if !dao.IsK9sMeta(x.meta) {
@@ -164,12 +171,16 @@ func (x *Xray) refreshActions() {
 		x.Actions().Delete(tcell.KeyEnter)
 	case "containers":
 		x.Actions().Delete(tcell.KeyEnter)
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
+		if !readOnly {
+			aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
+		}
 		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	case "v1/pods":
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
-		aa[ui.KeyA] = ui.NewKeyAction("Attach", x.attachCmd, true)
+		if !readOnly {
+			aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
+			aa[ui.KeyA] = ui.NewKeyAction("Attach", x.attachCmd, true)
+		}
 		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	}
@@ -331,7 +342,7 @@ func (x *Xray) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(x.app, "YAML", spec.Path(), true).Update(raw)
+	details := NewDetails(x.app, "YAML", spec.Path(), true).EnableYAMLFilters().Update(raw)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -381,7 +392,12 @@ func (x *Xray) describe(gvr, path string) {
 		return
 	}
 
-	details := NewDetails(x.app, "Describe", path, true).Update(yaml)
+	details := NewDetails(x.app, "Describe", path, true).
+		EnableFolding().
+		EnableRefresh(describeRefreshRate(x.app), func() (string, error) {
+			return x.model.Describe(ctx, gvr, path)
+		}).
+		Update(yaml)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -405,8 +421,14 @@ func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if cfg := x.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
 			args = append(args, "--kubeconfig", *cfg)
 		}
-		if !runK(x.app, shellOpts{args: append(args, n)}) {
-			x.app.Flash().Err(errors.New("Edit exec failed"))
+		err := x.app.mutate("edit", spec.GVR(), spec.Path(), "", func() error {
+			if !runK(x.app, shellOpts{args: append(args, n)}) {
+				return errors.New("Edit exec failed")
+			}
+			return nil
+		})
+		if err != nil {
+			x.app.Flash().Err(err)
 		}
 	}
 
@@ -509,7 +531,7 @@ func (x *Xray) TreeNodeSelected() {
 
 // TreeLoadFailed notifies the load failed.
 func (x *Xray) TreeLoadFailed(err error) {
-	x.app.Flash().Err(err)
+	x.app.apiErr(err)
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
@@ -635,6 +657,11 @@ func (x *Xray) GetTable() *Table { return nil }
 // GVR returns a resource descriptor.
 func (x *Xray) GVR() client.GVR { return x.gvr }
 
+// SetVisibility adapts the model refresh rate to the view visibility.
+func (x *Xray) SetVisibility(v model.Visibility) {
+	x.model.SetVisibility(v)
+}
+
 // App returns the current app handle.
 func (x *Xray) App() *App {
 	return x.app
@@ -650,6 +677,10 @@ func (x *Xray) UpdateTitle() {
 
 func (x *Xray) styleTitle() string {
 	base := fmt.Sprintf("%s-%s", xrayTitle, strings.Title(x.gvr.R()))
+	if x.instance != "" {
+		_, n := client.Namespaced(x.instance)
+		base += ":" + n
+	}
 	ns := x.model.GetNamespace()
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
@@ -674,7 +705,7 @@ func (x *Xray) styleTitle() string {
 }
 
 func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
-	dialog.ShowDelete(x.app.Content.Pages, msg, func(cascade, force bool) {
+	x.app.confirmDelete(gvr, []string{spec.Path()}, msg, func(opts dao.DeleteOptions) {
 		x.app.Flash().Infof("Delete resource %s %s", spec.GVR(), spec.Path())
 		accessor, err := dao.AccessorFor(x.app.factory, gvr)
 		if err != nil {
@@ -687,14 +718,17 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 			x.app.Flash().Errf("Invalid nuker %T", accessor)
 			return
 		}
-		if err := nuker.Delete(spec.Path(), true, true); err != nil {
+		err = x.app.mutate("delete", gvr.String(), spec.Path(), opts.String(), func() error {
+			return nuker.Delete(spec.Path(), opts)
+		})
+		if err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
 			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
 			x.app.factory.DeleteForwarder(spec.Path())
 		}
 		x.Refresh()
-	}, func() {})
+	})
 }
 
 // ----------------------------------------------------------------------------
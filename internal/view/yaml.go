@@ -57,6 +57,25 @@ func colorizeYAML(style config.Yaml, raw string) string {
 	return strings.Join(buff, "\n")
 }
 
+func colorizeDiff(raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	for i, l := range lines {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+			l = "[::b]" + l + "[::-]"
+		case strings.HasPrefix(l, "+"):
+			l = "[green::]" + l + "[-::]"
+		case strings.HasPrefix(l, "-"):
+			l = "[red::]" + l + "[-::]"
+		case strings.HasPrefix(l, "@@"):
+			l = "[aqua::]" + l + "[-::]"
+		}
+		lines[i] = enableRegion(l)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func enableRegion(str string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(str, "<<<", "["), ">>>", "]")
 }
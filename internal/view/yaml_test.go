@@ -60,3 +60,22 @@ func TestYaml(t *testing.T) {
 		assert.Equal(t, u.e, colorizeYAML(s.Views().Yaml, u.s))
 	}
 }
+
+func TestColorizeDiff(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"header":  {s: "--- a", e: "[::b]--- a[::-]"},
+		"added":   {s: "+  replicas: 2", e: "[green::]+  replicas: 2[-::]"},
+		"removed": {s: "-  replicas: 1", e: "[red::]-  replicas: 1[-::]"},
+		"hunk":    {s: "@@ -1,3 +1,3 @@", e: "[aqua::]@@ -1,3 +1,3 @@[-::]"},
+		"context": {s: "   name: [fred]", e: "   name: [fred[]"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, colorizeDiff(u.s))
+		})
+	}
+}
@@ -9,44 +9,95 @@ import (
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	defaultResync   = 10 * time.Minute
 	defaultWaitTime = 500 * time.Millisecond
+	evictRate       = 30 * time.Second
 )
 
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
+	informers  map[string]*informer
 	client     client.Connection
 	stopChan   chan struct{}
+	idleTTL    time.Duration
 	forwarders Forwarders
+	revisions  *Revisions
+	listeners  map[string][]ResourceListener
 	mx         sync.RWMutex
 }
 
+// ResourceListener listens to watched resources changes.
+type ResourceListener interface {
+	// ResourceChanged notifies a resource was added or updated.
+	ResourceChanged(gvr string, o runtime.Object)
+
+	// ResourceDeleted notifies a resource was deleted.
+	ResourceDeleted(gvr string, o runtime.Object)
+}
+
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]*informer),
 		forwarders: NewForwarders(),
+		revisions:  NewRevisions(),
+		listeners:  make(map[string][]ResourceListener),
 	}
 }
 
+// AddListener registers a listener for a given resource changes.
+// Listeners survive the factory termination.
+func (f *Factory) AddListener(gvr string, l ResourceListener) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.listeners[gvr] = append(f.listeners[gvr], l)
+}
+
+// RemoveListener unregisters a resource changes listener.
+func (f *Factory) RemoveListener(gvr string, l ResourceListener) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	ll := f.listeners[gvr]
+	for i, lis := range ll {
+		if lis == l {
+			f.listeners[gvr] = append(ll[:i:i], ll[i+1:]...)
+			return
+		}
+	}
+}
+
+// SetIdleTTL sets how long an unused informer is kept around.
+// A zero or negative ttl keeps informers until the factory terminates.
+func (f *Factory) SetIdleTTL(ttl time.Duration) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.idleTTL = ttl
+}
+
 // Start initializes the informers until caller cancels the context.
+// Informers are only started once a view asks for them.
 func (f *Factory) Start(ns string) {
 	f.mx.Lock()
 	defer f.mx.Unlock()
 
 	log.Debug().Msgf("Factory START with ns `%q", ns)
+	if f.stopChan != nil {
+		close(f.stopChan)
+	}
 	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
+	for _, i := range f.informers {
+		i.start()
 	}
+	go f.evictor(f.stopChan)
 }
 
 // Terminate terminates all watchers and forwards.
@@ -58,12 +109,98 @@ func (f *Factory) Terminate() {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for k, i := range f.informers {
+		i.stop()
+		delete(f.informers, k)
 	}
+	f.revisions.Clear()
 	f.forwarders.DeleteAll()
 }
 
+// Informers returns the active informers stats.
+func (f *Factory) Informers() []InformerStat {
+	f.mx.RLock()
+	ii, ll := make([]*informer, 0, len(f.informers)), make(map[string]int, len(f.listeners))
+	for _, i := range f.informers {
+		ii = append(ii, i)
+	}
+	for gvr, l := range f.listeners {
+		ll[gvr] = len(l)
+	}
+	f.mx.RUnlock()
+
+	ss := make([]InformerStat, 0, len(ii))
+	for _, i := range ii {
+		s := i.stat()
+		s.Listeners = ll[i.gvr]
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+// Cached returns all the resources held by the informers keyed by gvr.
+func (f *Factory) Cached() map[string][]runtime.Object {
+	f.mx.RLock()
+	ii := make([]*informer, 0, len(f.informers))
+	for _, i := range f.informers {
+		ii = append(ii, i)
+	}
+	f.mx.RUnlock()
+
+	oo, seen := make(map[string][]runtime.Object), make(map[string]struct{})
+	for _, i := range ii {
+		for _, o := range i.inf.Informer().GetStore().List() {
+			obj, ok := o.(runtime.Object)
+			if !ok {
+				continue
+			}
+			key, err := cache.MetaNamespaceKeyFunc(o)
+			if err != nil {
+				continue
+			}
+			// Informers may overlap, ie all namespaces and filtered ones.
+			if _, ok := seen[i.gvr+":"+key]; ok {
+				continue
+			}
+			seen[i.gvr+":"+key] = struct{}{}
+			oo[i.gvr] = append(oo[i.gvr], obj)
+		}
+	}
+
+	return oo
+}
+
+func (f *Factory) evictor(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(evictRate):
+			f.evictIdle(time.Now())
+		}
+	}
+}
+
+// evictIdle stops informers not used within the idle ttl. Informers backing
+// a listened resource are kept as their watchers still need them.
+func (f *Factory) evictIdle(now time.Time) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if f.idleTTL <= 0 {
+		return
+	}
+	for k, i := range f.informers {
+		if len(f.listeners[i.gvr]) > 0 || i.idle(now) < f.idleTTL {
+			continue
+		}
+		log.Debug().Msgf("Evicting idle informer %q", k)
+		i.stop()
+		delete(f.informers, k)
+	}
+}
+
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
 	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
@@ -71,7 +208,7 @@ func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]run
 		return nil, err
 	}
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().List(labels)
@@ -92,7 +229,7 @@ func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime
 	}
 
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().Get(n)
@@ -101,38 +238,21 @@ func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if client.IsClusterWide(ns) {
-		ns = client.AllNamespaces
-	}
-
-	if f.isClusterWide() {
-		ns = client.AllNamespaces
-	}
-
+// WaitForCacheSync waits for all running informers to update their cache.
+func (f *Factory) WaitForCacheSync() {
 	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fac, ok := f.factories[ns]
-	if !ok {
+	stop, ii := f.stopChan, make([]*informer, 0, len(f.informers))
+	for _, i := range f.informers {
+		ii = append(ii, i)
+	}
+	f.mx.RUnlock()
+	if stop == nil {
 		return
 	}
 
-	// Hang for a sec for the cache to refresh if still not done bail out!
-	c := make(chan struct{})
-	go func(c chan struct{}) {
-		<-time.After(defaultWaitTime)
-		close(c)
-	}(c)
-	_ = fac.WaitForCacheSync(c)
-}
-
-// WaitForCacheSync waits for all factories to update their cache.
-func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
-		}
+	for _, i := range ii {
+		ok := cache.WaitForCacheSync(stop, i.hasSynced)
+		log.Debug().Msgf("CACHE `%q Loaded %t:%s", i.ns, ok, i.gvr)
 	}
 }
 
@@ -141,26 +261,6 @@ func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
-
-// SetActiveNS sets the active namespace.
-func (f *Factory) SetActiveNS(ns string) {
-	if !f.isClusterWide() {
-		f.ensureFactory(ns)
-	}
-}
-
-func (f *Factory) isClusterWide() bool {
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-
-	_, ok := f.factories[client.AllNamespaces]
-	return ok
-}
-
 // CanForResource return an informer is user has access.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	// If user can access resource cluster wide, prefer cluster wide factory.
@@ -181,39 +281,109 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 	return f.ForResource(ns, gvr), nil
 }
 
-// ForResource returns an informer for a given resource.
-func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
-	fact := f.ensureFactory(ns)
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf
+// ListSelected returns resources matching the given selectors. Selectors are
+// pushed down to the api server so only matching resources are cached.
+func (f *Factory) ListSelected(gvr, ns string, wait bool, sel Selector) ([]runtime.Object, error) {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+	auth, err := f.Client().CanI(ns, gvr, client.MonitorAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("%v access denied on resource %q:%q", client.MonitorAccess, ns, gvr)
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fact.Start(f.stopChan)
+	inf := f.forSelector(ns, gvr, sel)
+	if wait {
+		waitForCacheSync(inf)
+	}
 
-	return inf
+	return inf.Lister().List(labels.Everything())
 }
 
-func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
+// ForResource returns an informer for a given resource.
+// The informer is created and started on first use.
+func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
+	return f.forSelector(ns, gvr, Selector{})
+}
+
+func (f *Factory) forSelector(ns, gvr string, sel Selector) informers.GenericInformer {
 	if client.IsClusterWide(ns) {
 		ns = client.AllNamespaces
 	}
+	key := informerKey(ns, gvr)
+	if !sel.Empty() {
+		key += "|" + sel.Labels + "|" + sel.Fields
+	}
+
 	f.mx.Lock()
 	defer f.mx.Unlock()
-	if fac, ok := f.factories[ns]; ok {
-		return fac
+	i, ok := f.informers[key]
+	if !ok {
+		i = newInformer(f.client.DynDialOrDie(), ns, gvr, sel)
+		f.track(i)
+		f.informers[key] = i
 	}
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		f.client.DynDialOrDie(),
-		defaultResync,
-		ns,
-		nil,
-	)
+	if f.stopChan != nil {
+		i.start()
+	}
+	i.touch()
+
+	return i.inf
+}
+
+// Previous returns the resource revision observed prior to the current one.
+func (f *Factory) Previous(gvr, path string) (runtime.Object, bool) {
+	return f.revisions.Previous(gvr, path)
+}
 
-	return f.factories[ns]
+func (f *Factory) track(i *informer) {
+	gvr := i.gvr
+	i.inf.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(o interface{}) {
+			f.fireChanged(gvr, o)
+		},
+		UpdateFunc: func(old, new interface{}) {
+			f.revisions.Update(gvr, old, new)
+			f.fireChanged(gvr, new)
+		},
+		DeleteFunc: func(o interface{}) {
+			f.revisions.Delete(gvr, o)
+			f.fireDeleted(gvr, o)
+		},
+	})
+}
+
+func (f *Factory) listenersFor(gvr string) []ResourceListener {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.listeners[gvr]
+}
+
+func (f *Factory) fireChanged(gvr string, o interface{}) {
+	obj, ok := o.(runtime.Object)
+	if !ok {
+		return
+	}
+	for _, l := range f.listenersFor(gvr) {
+		l.ResourceChanged(gvr, obj)
+	}
+}
+
+func (f *Factory) fireDeleted(gvr string, o interface{}) {
+	if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = d.Obj
+	}
+	obj, ok := o.(runtime.Object)
+	if !ok {
+		return
+	}
+	for _, l := range f.listenersFor(gvr) {
+		l.ResourceDeleted(gvr, obj)
+	}
 }
 
 // AddForwarder registers a new portforward for a given container.
@@ -246,3 +416,20 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 	fwd, ok := f.forwarders[path]
 	return fwd, ok
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func informerKey(ns, gvr string) string {
+	return ns + ":" + gvr
+}
+
+// waitForCacheSync hangs for a sec for the cache to refresh if still not done bail out!
+func waitForCacheSync(inf informers.GenericInformer) {
+	c := make(chan struct{})
+	go func(c chan struct{}) {
+		<-time.After(defaultWaitTime)
+		close(c)
+	}(c)
+	_ = cache.WaitForCacheSync(c, inf.Informer().HasSynced)
+}
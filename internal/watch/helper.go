@@ -29,9 +29,9 @@ func namespaced(n string) (string, string) {
 
 // DumpFactory for debug.
 func DumpFactory(f *Factory) {
-	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	log.Debug().Msgf("----------- INFORMERS -------------")
+	for _, s := range f.Informers() {
+		log.Debug().Msgf("  Informer for %q:%q (%d)", s.Namespace, s.GVR, s.Objects)
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
@@ -39,12 +39,21 @@ func DumpFactory(f *Factory) {
 // DebugFactory for debug.
 func DebugFactory(f *Factory, ns string, gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	fac, ok := f.factories[ns]
+	f.mx.RLock()
+	i, ok := f.informers[informerKey(ns, gvr)]
+	f.mx.RUnlock()
 	if !ok {
 		return
 	}
-	inf := fac.ForResource(toGVR(gvr))
-	for i, k := range inf.Informer().GetStore().ListKeys() {
+	for i, k := range i.inf.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
 }
+
+func fqn(ns, n string) string {
+	if ns == "" {
+		return n
+	}
+
+	return ns + "/" + n
+}
@@ -0,0 +1,178 @@
+package watch
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InformerStat represents an active informer status.
+type InformerStat struct {
+	GVR       string
+	Namespace string
+	Selector  string
+	Objects   int
+	Bytes     int64
+	Listeners int
+	Synced    bool
+	Started   time.Time
+	LastUsed  time.Time
+}
+
+// Selector represents server side label and field selectors.
+type Selector struct {
+	Labels string
+	Fields string
+}
+
+// Empty returns true if no selectors are set.
+func (s Selector) Empty() bool {
+	return s.Labels == "" && s.Fields == ""
+}
+
+// String returns the selectors as a human readable string.
+func (s Selector) String() string {
+	switch {
+	case s.Labels == "":
+		return s.Fields
+	case s.Fields == "":
+		return s.Labels
+	default:
+		return s.Labels + " " + s.Fields
+	}
+}
+
+// informer tracks a resource informer lifecycle.
+type informer struct {
+	ns, gvr  string
+	sel      Selector
+	inf      informers.GenericInformer
+	stopChan chan struct{}
+	started  time.Time
+	lastUsed time.Time
+	mx       sync.RWMutex
+}
+
+func newInformer(dial dynamic.Interface, ns, gvr string, sel Selector) *informer {
+	var tweak di.TweakListOptionsFunc
+	if !sel.Empty() {
+		tweak = func(opts *metav1.ListOptions) {
+			opts.LabelSelector, opts.FieldSelector = sel.Labels, sel.Fields
+		}
+	}
+
+	return &informer{
+		ns:  ns,
+		gvr: gvr,
+		sel: sel,
+		inf: di.NewFilteredDynamicInformer(
+			dial,
+			toGVR(gvr),
+			ns,
+			defaultResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			tweak,
+		),
+		lastUsed: time.Now(),
+	}
+}
+
+// start runs the informer unless it is already running.
+func (i *informer) start() {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	if i.stopChan != nil {
+		return
+	}
+	i.stopChan, i.started = make(chan struct{}), time.Now()
+	go i.inf.Informer().Run(i.stopChan)
+}
+
+// stop terminates the informer. A stopped informer can not be restarted.
+func (i *informer) stop() {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	if i.stopChan != nil {
+		close(i.stopChan)
+		i.stopChan = nil
+	}
+}
+
+func (i *informer) touch() {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	i.lastUsed = time.Now()
+}
+
+func (i *informer) idle(now time.Time) time.Duration {
+	i.mx.RLock()
+	defer i.mx.RUnlock()
+
+	return now.Sub(i.lastUsed)
+}
+
+func (i *informer) hasSynced() bool {
+	return i.inf.Informer().HasSynced()
+}
+
+func (i *informer) stat() InformerStat {
+	i.mx.RLock()
+	s := InformerStat{
+		GVR:       i.gvr,
+		Namespace: i.ns,
+		Selector:  i.sel.String(),
+		Started:   i.started,
+		LastUsed:  i.lastUsed,
+	}
+	i.mx.RUnlock()
+
+	oo := i.inf.Informer().GetStore().List()
+	s.Objects, s.Synced = len(oo), i.hasSynced()
+	for _, o := range oo {
+		s.Bytes += sizeOf(reflect.ValueOf(o))
+	}
+
+	return s
+}
+
+// sizeOf approximates the memory held by a cached object.
+func sizeOf(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return int64(v.Type().Size())
+		}
+		return int64(v.Type().Size()) + sizeOf(v.Elem())
+	case reflect.String:
+		return int64(v.Type().Size()) + int64(v.Len())
+	case reflect.Slice:
+		size := int64(v.Type().Size())
+		for i := 0; i < v.Len(); i++ {
+			size += sizeOf(v.Index(i))
+		}
+		return size
+	case reflect.Map:
+		size := int64(v.Type().Size())
+		for _, k := range v.MapKeys() {
+			size += sizeOf(k) + sizeOf(v.MapIndex(k))
+		}
+		return size
+	case reflect.Struct:
+		var size int64
+		for i := 0; i < v.NumField(); i++ {
+			size += sizeOf(v.Field(i))
+		}
+		return size
+	default:
+		return int64(v.Type().Size())
+	}
+}
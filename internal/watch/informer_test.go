@@ -0,0 +1,93 @@
+package watch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestInformerStat(t *testing.T) {
+	i := newInformer(fake.NewSimpleDynamicClient(runtime.NewScheme()), "default", "v1/pods", Selector{})
+	assert.Nil(t, i.inf.Informer().GetStore().Add(makePod("p1")))
+	assert.Nil(t, i.inf.Informer().GetStore().Add(makePod("p2")))
+
+	s := i.stat()
+	assert.Equal(t, "v1/pods", s.GVR)
+	assert.Equal(t, "default", s.Namespace)
+	assert.Equal(t, 2, s.Objects)
+	assert.True(t, s.Bytes > 0)
+	assert.False(t, s.Synced)
+}
+
+func TestFactoryEvictIdle(t *testing.T) {
+	dial := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	f := &Factory{
+		informers: map[string]*informer{
+			"default:v1/pods":             newInformer(dial, "default", "v1/pods", Selector{}),
+			"default:v1/services":         newInformer(dial, "default", "v1/services", Selector{}),
+			"default:apps/v1/deployments": newInformer(dial, "default", "apps/v1/deployments", Selector{}),
+		},
+		listeners: map[string][]ResourceListener{"v1/services": {&listener{}}},
+	}
+	f.informers["default:v1/pods"].lastUsed = time.Now().Add(-2 * time.Minute)
+	f.informers["default:v1/services"].lastUsed = time.Now().Add(-2 * time.Minute)
+
+	f.evictIdle(time.Now())
+	assert.Equal(t, 3, len(f.informers))
+
+	f.SetIdleTTL(time.Minute)
+	f.evictIdle(time.Now())
+	_, ok := f.informers["default:v1/pods"]
+	assert.False(t, ok)
+	_, ok = f.informers["default:v1/services"]
+	assert.True(t, ok)
+	_, ok = f.informers["default:apps/v1/deployments"]
+	assert.True(t, ok)
+}
+
+func TestSelectorString(t *testing.T) {
+	uu := map[string]struct {
+		sel Selector
+		e   string
+	}{
+		"none":   {},
+		"labels": {sel: Selector{Labels: "app=fred"}, e: "app=fred"},
+		"fields": {sel: Selector{Fields: "spec.nodeName=n1"}, e: "spec.nodeName=n1"},
+		"both":   {sel: Selector{Labels: "app=fred", Fields: "spec.nodeName=n1"}, e: "app=fred spec.nodeName=n1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.sel.String())
+			assert.Equal(t, u.e == "", u.sel.Empty())
+		})
+	}
+}
+
+func TestSizeOf(t *testing.T) {
+	assert.True(t, sizeOf(reflect.ValueOf(makePod("fred-blee"))) > sizeOf(reflect.ValueOf(makePod("p1"))))
+}
+
+// Helpers...
+
+type listener struct{}
+
+func (*listener) ResourceChanged(string, runtime.Object) {}
+func (*listener) ResourceDeleted(string, runtime.Object) {}
+
+func makePod(n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      n,
+			"namespace": "default",
+		},
+	}}
+}
@@ -0,0 +1,121 @@
+package watch
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MaxRevisions caps the number of resources tracked for prior revisions.
+const MaxRevisions = 200
+
+// Revisions tracks the previously observed revision of the most recently
+// updated watched resources.
+type Revisions struct {
+	revs map[string]*list.Element
+	lru  *list.List
+	max  int
+	mx   sync.Mutex
+}
+
+type revision struct {
+	key string
+	o   runtime.Object
+}
+
+// NewRevisions returns a new revisions tracker.
+func NewRevisions() *Revisions {
+	return newRevisions(MaxRevisions)
+}
+
+func newRevisions(max int) *Revisions {
+	return &Revisions{
+		revs: make(map[string]*list.Element),
+		lru:  list.New(),
+		max:  max,
+	}
+}
+
+// Previous returns the revision observed prior to the current one if any.
+func (r *Revisions) Previous(gvr, path string) (runtime.Object, bool) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	e, ok := r.revs[revKey(gvr, path)]
+	if !ok {
+		return nil, false
+	}
+	r.lru.MoveToFront(e)
+
+	return e.Value.(*revision).o, true
+}
+
+// Update records the old revision of an updated resource.
+func (r *Revisions) Update(gvr string, old, new interface{}) {
+	o, ok := old.(runtime.Object)
+	if !ok {
+		return
+	}
+	om, err := meta.Accessor(o)
+	if err != nil {
+		log.Error().Err(err).Msgf("Revision tracking failed for %s", gvr)
+		return
+	}
+	nm, err := meta.Accessor(new)
+	if err != nil || om.GetResourceVersion() == nm.GetResourceVersion() {
+		return
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	key := revKey(gvr, fqn(om.GetNamespace(), om.GetName()))
+	if e, ok := r.revs[key]; ok {
+		e.Value.(*revision).o = o
+		r.lru.MoveToFront(e)
+		return
+	}
+	r.revs[key] = r.lru.PushFront(&revision{key: key, o: o})
+	for r.lru.Len() > r.max {
+		e := r.lru.Back()
+		r.lru.Remove(e)
+		delete(r.revs, e.Value.(*revision).key)
+	}
+}
+
+// Delete clears out revisions of a deleted resource.
+func (r *Revisions) Delete(gvr string, o interface{}) {
+	if d, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = d.Obj
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	key := revKey(gvr, fqn(m.GetNamespace(), m.GetName()))
+	if e, ok := r.revs[key]; ok {
+		r.lru.Remove(e)
+		delete(r.revs, key)
+	}
+}
+
+// Clear clears out all revisions.
+func (r *Revisions) Clear() {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.revs = make(map[string]*list.Element)
+	r.lru.Init()
+}
+
+func revKey(gvr, path string) string {
+	return gvr + ":" + path
+}
@@ -0,0 +1,48 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRevisionsUpdate(t *testing.T) {
+	r := newRevisions(2)
+	r.Update("v1/pods", makeRev("p1", "1"), makeRev("p1", "2"))
+	r.Update("v1/pods", makeRev("p1", "2"), makeRev("p1", "2"))
+
+	o, ok := r.Previous("v1/pods", "default/p1")
+	assert.True(t, ok)
+	assert.Equal(t, "1", o.(*unstructured.Unstructured).GetResourceVersion())
+
+	r.Delete("v1/pods", makeRev("p1", "2"))
+	_, ok = r.Previous("v1/pods", "default/p1")
+	assert.False(t, ok)
+}
+
+func TestRevisionsEvict(t *testing.T) {
+	r := newRevisions(2)
+	r.Update("v1/pods", makeRev("p1", "1"), makeRev("p1", "2"))
+	r.Update("v1/pods", makeRev("p2", "1"), makeRev("p2", "2"))
+	_, _ = r.Previous("v1/pods", "default/p1")
+	r.Update("v1/pods", makeRev("p3", "1"), makeRev("p3", "2"))
+
+	_, ok := r.Previous("v1/pods", "default/p1")
+	assert.True(t, ok)
+	_, ok = r.Previous("v1/pods", "default/p2")
+	assert.False(t, ok)
+	_, ok = r.Previous("v1/pods", "default/p3")
+	assert.True(t, ok)
+
+	r.Clear()
+	_, ok = r.Previous("v1/pods", "default/p3")
+	assert.False(t, ok)
+}
+
+func makeRev(n, rev string) *unstructured.Unstructured {
+	o := makePod(n)
+	o.SetResourceVersion(rev)
+
+	return o
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
-	"github.com/derailed/k9s/internal/render"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,20 +36,22 @@ func (d *Deployment) Render(ctx context.Context, ns string, o interface{}) error
 	}
 
 	root := NewTreeNode("apps/v1/deployments", client.FQN(dp.Namespace, dp.Name))
-	oo, err := locatePods(ctx, dp.Namespace, dp.Spec.Selector)
+	rr, err := locateReplicaSets(ctx, dp)
 	if err != nil {
 		return err
 	}
 	ctx = context.WithValue(ctx, KeyParent, root)
-	var re Pod
-	for _, o := range oo {
-		p, ok := o.(*unstructured.Unstructured)
-		if !ok {
-			return fmt.Errorf("expecting *Unstructured but got %T", o)
-		}
-		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+	var re ReplicaSet
+	for _, rs := range rr {
+		n, err := re.hydrate(ctx, ns, rs)
+		if err != nil {
 			return err
 		}
+		// Skip retired revisions.
+		if n.IsLeaf() {
+			continue
+		}
+		root.Add(n)
 	}
 
 	if root.IsLeaf() {
@@ -102,3 +103,38 @@ func locatePods(ctx context.Context, ns string, sel *metav1.LabelSelector) ([]ru
 
 	return f.List("v1/pods", ns, false, fsel.AsSelector())
 }
+
+// locateReplicaSets returns all replicasets controlled by a given deployment.
+func locateReplicaSets(ctx context.Context, dp appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	l, err := metav1.LabelSelectorAsSelector(dp.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	oo, err := f.List("apps/v1/replicasets", dp.Namespace, false, l)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		raw, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rs); err != nil {
+			return nil, err
+		}
+		ref := metav1.GetControllerOf(&rs)
+		if ref == nil || ref.Kind != "Deployment" || ref.Name != dp.Name {
+			continue
+		}
+		rr = append(rr, rs)
+	}
+
+	return rr, nil
+}
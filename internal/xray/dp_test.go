@@ -7,33 +7,44 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestDeployRender(t *testing.T) {
 	uu := map[string]struct {
-		file           string
-		level1, level2 int
-		status         string
+		file                   string
+		owner                  string
+		level1, level2, level3 int
+		status                 string
 	}{
 		"plain": {
 			file:   "dp",
+			owner:  "nginx",
 			level1: 1,
 			level2: 1,
+			level3: 1,
 			status: xray.OkStatus,
 		},
+		"not-owned": {
+			file:  "dp",
+			owner: "fred",
+		},
 	}
 
 	var re xray.Deployment
 	for k := range uu {
-		f := makeFactory()
-		f.rows = map[string][]runtime.Object{
-			"v1/pods":            {load(t, "po")},
-			"v1/serviceaccounts": {load(t, "sa")},
-		}
-
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
+			rs := load(t, "rs")
+			rs.SetOwnerReferences([]metav1.OwnerReference{makeControllerRef("Deployment", u.owner)})
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{
+				"apps/v1/replicasets": {rs},
+				"v1/pods":             {load(t, "po")},
+				"v1/serviceaccounts":  {load(t, "sa")},
+			}
+
 			o := load(t, u.file)
 			root := xray.NewTreeNode("deployments", "deployments")
 			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
@@ -41,7 +52,26 @@ func TestDeployRender(t *testing.T) {
 
 			assert.Nil(t, re.Render(ctx, "", o))
 			assert.Equal(t, u.level1, root.CountChildren())
-			assert.Equal(t, u.level2, root.Children[0].CountChildren())
+			if u.level1 == 0 {
+				return
+			}
+			dp := root.Children[0].Children[0]
+			assert.Equal(t, u.level2, dp.CountChildren())
+			assert.Equal(t, "apps/v1/replicasets", dp.Children[0].GVR)
+			assert.Equal(t, u.level3, dp.Children[0].CountChildren())
+			assert.Equal(t, u.status, dp.Extras[xray.StatusKey])
 		})
 	}
 }
+
+// Helpers...
+
+func makeControllerRef(kind, n string) metav1.OwnerReference {
+	ok := true
+	return metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       kind,
+		Name:       n,
+		Controller: &ok,
+	}
+}
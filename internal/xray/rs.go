@@ -31,24 +31,10 @@ func (r *ReplicaSet) Render(ctx context.Context, ns string, o interface{}) error
 		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
 	}
 
-	root := NewTreeNode("apps/v1/replicasets", client.FQN(rs.Namespace, rs.Name))
-	oo, err := locatePods(ctx, rs.Namespace, rs.Spec.Selector)
+	root, err := r.hydrate(ctx, ns, rs)
 	if err != nil {
 		return err
 	}
-
-	ctx = context.WithValue(ctx, KeyParent, root)
-	var re Pod
-	for _, o := range oo {
-		p, ok := o.(*unstructured.Unstructured)
-		if !ok {
-			return fmt.Errorf("expecting *Unstructured but got %T", o)
-		}
-		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
-			return err
-		}
-	}
-
 	if root.IsLeaf() {
 		return nil
 	}
@@ -61,7 +47,30 @@ func (r *ReplicaSet) Render(ctx context.Context, ns string, o interface{}) error
 	}
 	nsn.Add(root)
 
-	return r.validate(root, rs)
+	return nil
+}
+
+// hydrate builds a replicaset node along with its pods.
+func (r *ReplicaSet) hydrate(ctx context.Context, ns string, rs appsv1.ReplicaSet) (*TreeNode, error) {
+	root := NewTreeNode("apps/v1/replicasets", client.FQN(rs.Namespace, rs.Name))
+	oo, err := locatePods(ctx, rs.Namespace, rs.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, KeyParent, root)
+	var re Pod
+	for _, o := range oo {
+		p, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return nil, err
+		}
+	}
+
+	return root, r.validate(root, rs)
 }
 
 func (*ReplicaSet) validate(root *TreeNode, rs appsv1.ReplicaSet) error {
@@ -322,6 +322,21 @@ func (t *TreeNode) Clear() {
 	t.Children = []*TreeNode{}
 }
 
+// Rollup propagates unhealthy descendants status up to this node and
+// returns the resulting node status.
+func (t *TreeNode) Rollup() string {
+	status := t.Extras[StatusKey]
+	for _, c := range t.Children {
+		switch c.Rollup() {
+		case ToastStatus, MissingRefStatus:
+			status = ToastStatus
+		}
+	}
+	t.Extras[StatusKey] = status
+
+	return status
+}
+
 // Find locates a node given a gvr/id spec.
 func (t *TreeNode) Find(gvr, id string) *TreeNode {
 	if t.GVR == gvr && t.ID == id {
@@ -494,6 +509,8 @@ func toEmoji(gvr string) string {
 		return "🎎"
 	case "apps/v1/daemonsets", "daemonsets":
 		return "😈"
+	case "apps/v1/replicasets", "replicasets":
+		return "👯"
 	default:
 		return "📎"
 	}
@@ -514,6 +531,7 @@ func EmojiInfo() map[string]string {
 		"apps/v1/deployments",
 		"apps/v1/statefulsets",
 		"apps/v1/daemonsets",
+		"apps/v1/replicasets",
 	}
 
 	m := make(map[string]string, len(GVRs))
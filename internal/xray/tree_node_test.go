@@ -253,6 +253,49 @@ func TestTreeNodeDiff(t *testing.T) {
 	}
 }
 
+func TestTreeNodeRollup(t *testing.T) {
+	uu := map[string]struct {
+		status string
+		e      string
+	}{
+		"ok": {
+			status: xray.OkStatus,
+			e:      xray.OkStatus,
+		},
+		"toast": {
+			status: xray.ToastStatus,
+			e:      xray.ToastStatus,
+		},
+		"missing": {
+			status: xray.MissingRefStatus,
+			e:      xray.ToastStatus,
+		},
+		"completed": {
+			status: xray.CompletedStatus,
+			e:      xray.OkStatus,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dp := xray.NewTreeNode("apps/v1/deployments", "default/dp1")
+			rs := xray.NewTreeNode("apps/v1/replicasets", "default/rs1")
+			po := xray.NewTreeNode("v1/pods", "default/p1")
+			cm := xray.NewTreeNode("v1/configmaps", "default/cm1")
+			cm.Extras[xray.StatusKey] = u.status
+			po.Add(cm)
+			rs.Add(po)
+			dp.Add(rs)
+
+			assert.Equal(t, u.e, dp.Rollup())
+			assert.Equal(t, u.e, rs.Extras[xray.StatusKey])
+			assert.Equal(t, u.e, po.Extras[xray.StatusKey])
+			assert.Equal(t, u.status, cm.Extras[xray.StatusKey])
+		})
+	}
+}
+
 func TestTreeNodeClone(t *testing.T) {
 	n := xray.NewTreeNode("v1/pods", "default/p1")
 	c1 := xray.NewTreeNode("containers", "c1")